@@ -2,6 +2,8 @@ package bayes
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -34,3 +36,24 @@ func TestDiscHPI2(t *testing.T) {
 	fmt.Println("should be [6  7  8  9 10 11 12 13 14 15 16 17 18]")
 
 }
+
+// Test the frequencies of a simulated sample from the posterior of PropDisc
+func TestDiscPostSim(t *testing.T) {
+	p := []float64{.2, .25, .3, .35}
+	post := PropDisc(p, []float64{.25, .25, .25, .25}, 5, 10)
+	const m = 100000
+	x := DiscPostSimR(rand.New(rand.NewSource(1)), p, post, m)
+	for i := range p {
+		f := 0.0
+		for _, v := range x {
+			if v == p[i] {
+				f++
+			}
+		}
+		f /= m
+		fmt.Println("DiscPostSim frequency of", p[i], ":", f, "should be", post[i])
+		if math.Abs(f-post[i]) > 0.006 {
+			t.Error()
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// test of the simulation functions with a user-supplied random source
+package bayes
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSourceReproducible(t *testing.T) {
+	fmt.Println("test of reproducibility with a random source")
+	data := []float64{5.1, 4.9, 5.6, 5.8, 6.0, 5.3, 4.7}
+	mu1, s1 := NormPostSimR(rand.New(rand.NewSource(7)), data, 1, 1, 5, 1, 50)
+	mu2, s2 := NormPostSimR(rand.New(rand.NewSource(7)), data, 1, 1, 5, 1, 50)
+	for i := range mu1 {
+		if mu1[i] != mu2[i] || s1[i] != s2[i] {
+			fmt.Println(i, mu1[i], mu2[i], s1[i], s2[i])
+			t.Error()
+		}
+	}
+	a := PoissonLambdaNextGPriR(rand.New(rand.NewSource(7)), 12, 4, 1, 1)
+	b := PoissonLambdaNextGPriR(rand.New(rand.NewSource(7)), 12, 4, 1, 1)
+	if a != b {
+		fmt.Println(a, b)
+		t.Error()
+	}
+}
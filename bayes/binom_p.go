@@ -9,6 +9,7 @@ import (
 	"code.google.com/p/probab/dst"
 	"fmt"
 	"math"
+	"math/rand"
 )
 
 // BinomPiPDFFPri returns posterior PDF of the Binomial proportion, Flat prior.
@@ -262,13 +263,18 @@ func BinomPiDeviance(pi float64, n, k int64) float64 {
 
 // Binomial proportion, Sampling from posterior, Beta prior
 func BinomPiCDFBPriNext(k, n int64, α, β float64) float64 {
+	return BinomPiCDFBPriNextR(dst.GlobalRand, k, n, α, β)
+}
+
+// Binomial proportion, Sampling from posterior, Beta prior, using the random source src.
+func BinomPiCDFBPriNextR(src *rand.Rand, k, n int64, α, β float64) float64 {
 	if k > n {
 		panic(fmt.Sprintf("The number of observed successes (k) must be <= number of trials (n)"))
 	}
 	if α < 0 || β < 0 {
 		panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
 	}
-	return dst.BetaNext(α+float64(k), β+float64(n-k))
+	return dst.BetaNextR(src, α+float64(k), β+float64(n-k))
 }
 
 // Binomial proportion, Deviance difference of a point null hypothesis pi = p against general alternative pi != p
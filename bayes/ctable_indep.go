@@ -9,10 +9,11 @@ package bayes
 import (
 	"code.google.com/p/probab/dst"
 	"fmt"
+	"math/rand"
 )
 
 // rdirichlet simulates a sample from a Dirichlet distribution.
-func rdirichlet(m int, par []float64) [][]float64 { //// should accept vector, not mtx!! rewrite! Pass mtx unloaded colwise to vec
+func rdirichlet(src *rand.Rand, m int, par []float64) [][]float64 { //// should accept vector, not mtx!! rewrite! Pass mtx unloaded colwise to vec
 	// m number of simulations required
 	// par vector of parameters of the Dirichlet distribution
 
@@ -26,7 +27,7 @@ func rdirichlet(m int, par []float64) [][]float64 { //// should accept vector, n
 	}
 
 	for i := range out { // nRows
-		dir := dst.DirichletNext(par)
+		dir := dst.DirichletNextR(src, par)
 		for j, _ := range dir {
 			out[i][j] = dir[j]
 		}
@@ -47,6 +48,12 @@ func ldirichlet(a [][]float64) []float64 {
 // FactCTableIndep returns a Bayes factor against independence for a two-way contingency table assuming a 
 // "close to independence" alternative model.
 func FactCTableIndep(y [][]float64, k float64, m int) (bf, nse float64) {
+	return FactCTableIndepR(dst.GlobalRand, y, k, m)
+}
+
+// FactCTableIndepR returns a Bayes factor against independence for a two-way contingency table assuming a 
+// "close to independence" alternative model, using the random source src.
+func FactCTableIndepR(src *rand.Rand, y [][]float64, k float64, m int) (bf, nse float64) {
 	// Arguments:
 	// y - matrix of counts
 	// k - Dirichlet precision hyperparameter
@@ -79,8 +86,8 @@ func FactCTableIndep(y [][]float64, k float64, m int) (bf, nse float64) {
 		//fmt.Println("yc1[i]: ", yc1[i])
 	}
 
-	etaA := rdirichlet(m, yr1)
-	etaB := rdirichlet(m, yc1)
+	etaA := rdirichlet(src, m, yr1)
+	etaB := rdirichlet(src, m, yc1)
 
 	// make keta
 	nCol := yRows * yCols
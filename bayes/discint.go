@@ -6,6 +6,8 @@ package bayes
 // Ref.: Albert (2009): 184 [mnormt.onesided()]
 
 import (
+	"code.google.com/p/probab/dst"
+	"math/rand"
 	"sort"
 )
 
@@ -91,3 +93,20 @@ func DiscHPI(x, p []float64, probContent float64) (probExact float64, hpiSet []f
 	sort.Float64s(hpiSet)
 	return probExact, hpiSet
 }
+
+// DiscPostSim returns a simulated sample of size m from a discrete distribution, such as the posterior of PropDisc,
+// with values x and probabilities p.
+func DiscPostSim(x, p []float64, m int) []float64 {
+	return DiscPostSimR(dst.GlobalRand, x, p, m)
+}
+
+// DiscPostSimR returns a simulated sample of size m from a discrete distribution with values x and probabilities p,
+// using the random source src; the draws take constant time each, from the alias table of p.
+func DiscPostSimR(src *rand.Rand, x, p []float64, m int) []float64 {
+	a := dst.NewAlias(p)
+	v := make([]float64, m)
+	for i := range v {
+		v[i] = x[a.NextR(src)]
+	}
+	return v
+}
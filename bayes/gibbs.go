@@ -41,6 +41,11 @@ return(stuff)
 
 // Metropolis within Gibbs sampling algorithm of a posterior distribution.
 func Gibbs(logpost func([]float64) float64, start []float64, m int, scale []float64) (vth [][]float64, arate []float64) {
+	return GibbsR(dst.GlobalRand, logpost, start, m, scale)
+}
+
+// GibbsR is the Metropolis within Gibbs sampling algorithm of a posterior distribution, using the random source src.
+func GibbsR(src *rand.Rand, logpost func([]float64) float64, start []float64, m int, scale []float64) (vth [][]float64, arate []float64) {
 	// Arguments:
 	// logpost - function defining the log posterior density
 	// start - array with a single row that gives the starting value of the parameter vector
@@ -78,13 +83,13 @@ func Gibbs(logpost func([]float64) float64, start []float64, m int, scale []floa
 				th1[k] = val
 			}
 
-			th1[j] = th0[j] + dst.NormalNext(0, 1)*scale[j]
+			th1[j] = th0[j] + dst.NormalNextR(src, 0, 1)*scale[j]
 			f1 := logpost(th1)
 			//  u=runif(1)<exp(f1-f0)
 			//  th0[j]=th1[j]*(u==1)+th0[j]*(u==0)
 			//  f0=f1*(u==1)+f0*(u==0)
 
-			if rand.Float64() < exp(f1-f0) {
+			if src.Float64() < exp(f1-f0) {
 				th0[j] = th1[j]
 				f0 = f1
 				arate[j] += 1
@@ -4,6 +4,7 @@ package bayes
 // Ref.: Albert (2009)
 
 import (
+	"code.google.com/p/probab/dst"
 	"math/rand"
 )
 
@@ -23,6 +24,11 @@ func LnHowardPrior(p1, p2, alpha, beta, gamma, delta, sigma float64) float64 {
 
 // HowardPosteriorProb returns the posterior probability that p1 > p2.
 func HowardPosteriorProb(y1, n1, y2, n2, alpha, beta, gamma, delta, sigma float64) float64 {
+	return HowardPosteriorProbR(dst.GlobalRand, y1, n1, y2, n2, alpha, beta, gamma, delta, sigma)
+}
+
+// HowardPosteriorProbR returns the posterior probability that p1 > p2, using the random source src.
+func HowardPosteriorProbR(src *rand.Rand, y1, n1, y2, n2, alpha, beta, gamma, delta, sigma float64) float64 {
 	nIter := 10000000
 	// updated params for posterior that has the same functional form as prior
 	alpha += y1
@@ -33,7 +39,7 @@ func HowardPosteriorProb(y1, n1, y2, n2, alpha, beta, gamma, delta, sigma float6
 	sum2 := 0.0
 	// brute force sampling from posterior, should be improved
 	for i := 0; i < nIter; i++ {
-		p1 := rand.Float64()
+		p1 := src.Float64()
 		if p1 < 0.0001 {
 			p1 = 0.0001
 		}
@@ -41,7 +47,7 @@ func HowardPosteriorProb(y1, n1, y2, n2, alpha, beta, gamma, delta, sigma float6
 			p1 = 0.9999
 		}
 
-		p2 := rand.Float64()
+		p2 := src.Float64()
 		if p2 < 0.0001 {
 			p2 = 0.0001
 		}
@@ -6,6 +6,7 @@ import (
 	"code.google.com/p/probab/dst"
 	"fmt"
 	mx "github.com/skelterjohn/go.matrix"
+	"math/rand"
 )
 
 type KnownVarianceLRPosterior struct {
@@ -109,6 +110,11 @@ func (this *KnownVarianceLRPosterior) Sample() (A *mx.DenseMatrix) {
 	this returns a sampler for P(A|X,Y,Sigma,M,Phi)
 */
 func KnownVariancePosterior(Y, X, Sigma, M, Phi *mx.DenseMatrix) func() (A *mx.DenseMatrix) {
+	return KnownVariancePosteriorR(dst.GlobalRand, Y, X, Sigma, M, Phi)
+}
+
+// KnownVariancePosteriorR is KnownVariancePosterior, with the sampler using the random source src.
+func KnownVariancePosteriorR(src *rand.Rand, Y, X, Sigma, M, Phi *mx.DenseMatrix) func() (A *mx.DenseMatrix) {
 	o := Y.Rows()
 	i := X.Rows()
 	n := Y.Cols()
@@ -182,5 +188,5 @@ func KnownVariancePosterior(Y, X, Sigma, M, Phi *mx.DenseMatrix) func() (A *mx.D
 		fmt.Printf("Omega:\n%v\n", Omega)
 	}
 
-	return dst.MatrixNormal(Mxy, Sigma, Omega)
+	return dst.MatrixNormalR(src, Mxy, Sigma, Omega)
 }
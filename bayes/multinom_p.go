@@ -9,6 +9,7 @@ package bayes
 import (
 	. "code.google.com/p/probab/dst"
 	"fmt"
+	"math/rand"
 )
 
 // Posterior PDF, Dirichlet prior
@@ -36,8 +37,13 @@ func MultinomPiPDFDirPri(α, x []float64) float64 {
 // Sampling from posterior, Dirichlet prior
 // Returns an array of sampled Multinomial Pi's
 func MultinomPiNext(α, x []float64) []float64 {
+	return MultinomPiNextR(GlobalRand, α, x)
+}
+
+// Sampling from posterior, Dirichlet prior, using the random source src.
+func MultinomPiNextR(src *rand.Rand, α, x []float64) []float64 {
 	for i := 0; i < len(x); i++ {
 		α[i] += x[i] // posterior params
 	}
-	return DirichletNext(α)
+	return DirichletNextR(src, α)
 }
@@ -7,10 +7,11 @@ package bayes
 
 import (
 	"code.google.com/p/probab/dst"
+	"math/rand"
 )
 
-func rigamma(shape, rate float64) float64 {
-	return (1 / dst.GammaNext(shape, 1/rate))
+func rigamma(src *rand.Rand, shape, rate float64) float64 {
+	return (1 / dst.GammaNextR(src, shape, 1/rate))
 }
 
 // NormPostSim returns a simulated sample from the joint posterior distribution of the mean and variance for a normal
@@ -18,6 +19,12 @@ func rigamma(shape, rate float64) float64 {
 // independent with mu assigned a normal prior with mean mu0 and variance tau2, and sigma2 is
 // assigned a inverse gamma prior with parameters a and b.
 func NormPostSim(data []float64, a, b, mu0, tau2 float64, m int) (postMu, postS2 []float64) {
+	return NormPostSimR(dst.GlobalRand, data, a, b, mu0, tau2, m)
+}
+
+// NormPostSimR returns a simulated sample from the joint posterior distribution of the mean and variance for a normal
+// sampling prior with a noninformative or informative prior, using the random source src.
+func NormPostSimR(src *rand.Rand, data []float64, a, b, mu0, tau2 float64, m int) (postMu, postS2 []float64) {
 	// Arguments:
 	// data - vector of observations
 	// prior params:
@@ -47,7 +54,7 @@ func NormPostSim(data []float64, a, b, mu0, tau2 float64, m int) (postMu, postS2
 		mu1 := (xbar*float64(n)/sigma2 + mu0/tau2) / prec
 		v1 := 1 / prec
 		//    mu=rnorm(1,mu1,sqrt(v1))
-		mu := dst.NormalNext(mu1, sqrt(v1))
+		mu := dst.NormalNextR(src, mu1, sqrt(v1))
 
 		a1 := a + float64(n)/2
 
@@ -57,7 +64,7 @@ func NormPostSim(data []float64, a, b, mu0, tau2 float64, m int) (postMu, postS2
 		}
 
 		b1 := b + sum(d2)/2
-		sigma2 := rigamma(a1, b1)
+		sigma2 := rigamma(src, a1, b1)
 
 		postS2[j] = sigma2
 		postMu[j] = mu
@@ -68,6 +75,12 @@ func NormPostSim(data []float64, a, b, mu0, tau2 float64, m int) (postMu, postS2
 // NormPostSimNoPrior returns a simulated sample from the joint posterior distribution of the mean and variance for a normal
 // sampling prior.
 func NormPostSimNoPrior(data []float64, m int) (postMu, postS2 []float64) {
+	return NormPostSimNoPriorR(dst.GlobalRand, data, m)
+}
+
+// NormPostSimNoPriorR returns a simulated sample from the joint posterior distribution of the mean and variance for a normal
+// sampling prior, using the random source src.
+func NormPostSimNoPriorR(src *rand.Rand, data []float64, m int) (postMu, postS2 []float64) {
 	// Arguments:
 	// data - vector of observations
 	// m - number of simulations desired
@@ -88,9 +101,9 @@ func NormPostSimNoPrior(data []float64, m int) (postMu, postS2 []float64) {
 	postMu = make([]float64, m)
 
 	for i, _ := range postMu {
-		postS2[i] = s / dst.ChiSquareNext(int64(n)-1)
+		postS2[i] = s / dst.ChiSquareNextR(src, int64(n)-1)
 		sd := sqrt(postS2[i]) / sqrt(float64(n))
-		postMu[i] = dst.NormalNext(xbar, sd)
+		postMu[i] = dst.NormalNextR(src, xbar, sd)
 	}
 	return
 }
@@ -98,6 +111,12 @@ func NormPostSimNoPrior(data []float64, m int) (postMu, postS2 []float64) {
 // NormPostNoPriorNext returns a  sampled tuple from the joint posterior distribution of the mean and variance for a normal
 // sampling prior.
 func NormPostNoPriorNext(data []float64) (postMu, postS2 float64) {
+	return NormPostNoPriorNextR(dst.GlobalRand, data)
+}
+
+// NormPostNoPriorNextR returns a  sampled tuple from the joint posterior distribution of the mean and variance for a normal
+// sampling prior, using the random source src.
+func NormPostNoPriorNextR(src *rand.Rand, data []float64) (postMu, postS2 float64) {
 	// Arguments:
 	// data - vector of observations
 	// Returns:
@@ -113,9 +132,9 @@ func NormPostNoPriorNext(data []float64) (postMu, postS2 float64) {
 	}
 
 	s := sum(diff2)
-	postS2 = s / dst.ChiSquareNext(int64(n)-1)
+	postS2 = s / dst.ChiSquareNextR(src, int64(n)-1)
 	sd := sqrt(postS2) / sqrt(float64(n))
-	postMu = dst.NormalNext(xbar, sd)
+	postMu = dst.NormalNextR(src, xbar, sd)
 	return
 }
 
@@ -124,6 +143,12 @@ func NormPostNoPriorNext(data []float64) (postMu, postS2 float64) {
 // independent with mu assigned a normal prior with mean mu0 and variance tau2, and sigma2 is
 // assigned a inverse gamma prior with parameters a and b.
 func NormPostInfPriorNext(data []float64, a, b, mu0, tau2 float64) (postMu, postS2 float64) {
+	return NormPostInfPriorNextR(dst.GlobalRand, data, a, b, mu0, tau2)
+}
+
+// NormPostInfPriorNextR returns a simulated tuple from the joint posterior distribution of the mean and variance for a normal
+// sampling prior with a noninformative or informative prior, using the random source src.
+func NormPostInfPriorNextR(src *rand.Rand, data []float64, a, b, mu0, tau2 float64) (postMu, postS2 float64) {
 	// Arguments:
 	// data - vector of observations
 	// prior params:
@@ -147,7 +172,7 @@ func NormPostInfPriorNext(data []float64, a, b, mu0, tau2 float64) (postMu, post
 	prec := float64(n)/postS2 + 1/tau2
 	mu1 := (xbar*float64(n)/postS2 + mu0/tau2) / prec
 	v1 := 1 / prec
-	postMu = dst.NormalNext(mu1, sqrt(v1))
+	postMu = dst.NormalNextR(src, mu1, sqrt(v1))
 
 	a1 := a + float64(n)/2
 
@@ -157,6 +182,6 @@ func NormPostInfPriorNext(data []float64, a, b, mu0, tau2 float64) (postMu, post
 	}
 
 	b1 := b + sum(d2)/2
-	postS2 = rigamma(a1, b1)
+	postS2 = rigamma(src, a1, b1)
 	return
 }
@@ -9,6 +9,7 @@ import (
 	. "code.google.com/p/probab/dst"
 	//	. "code.google.com/p/go-fn/fn"
 	"math"
+	"math/rand"
 )
 
 // Poisson λ, posterior PDF, flat prior.
@@ -124,26 +125,41 @@ func PoissonLambdaQtlGPri(sumK, n int64, r, v float64) func(p float64) float64 {
 
 // PoissonLambdaNextFPri returns random number drawn from the posterior, flat prior.
 func PoissonLambdaNextFPri(sumK, n int64) float64 {
+	return PoissonLambdaNextFPriR(GlobalRand, sumK, n)
+}
+
+// PoissonLambdaNextFPriR returns random number drawn from the posterior, flat prior, using the random source src.
+func PoissonLambdaNextFPriR(src *rand.Rand, sumK, n int64) float64 {
 	if sumK < 0 || n <= 0 {
 		panic("bad data")
 	}
 	r1 := float64(sumK) + 1.0
 	v1 := float64(n)
-	return GammaNext(r1, 1/v1)
+	return GammaNextR(src, r1, 1/v1)
 }
 
 // PoissonLambdaNextJPri returns random number drawn from the posterior, Jeffreys' prior.
 func PoissonLambdaNextJPri(sumK, n int64) float64 {
+	return PoissonLambdaNextJPriR(GlobalRand, sumK, n)
+}
+
+// PoissonLambdaNextJPriR returns random number drawn from the posterior, Jeffreys' prior, using the random source src.
+func PoissonLambdaNextJPriR(src *rand.Rand, sumK, n int64) float64 {
 	if sumK < 0 || n <= 0 {
 		panic("bad data")
 	}
 	r1 := float64(sumK) + 0.5
 	v1 := float64(n)
-	return GammaNext(r1, 1/v1)
+	return GammaNextR(src, r1, 1/v1)
 }
 
 // PoissonLambdaNextGPri returns random number drawn from the posterior, Gamma prior.
 func PoissonLambdaNextGPri(sumK, n int64, r, v float64) float64 {
+	return PoissonLambdaNextGPriR(GlobalRand, sumK, n, r, v)
+}
+
+// PoissonLambdaNextGPriR returns random number drawn from the posterior, Gamma prior, using the random source src.
+func PoissonLambdaNextGPriR(src *rand.Rand, sumK, n int64, r, v float64) float64 {
 	if sumK < 0 || n <= 0 {
 		panic("bad data")
 	}
@@ -152,7 +168,7 @@ func PoissonLambdaNextGPri(sumK, n int64, r, v float64) float64 {
 	}
 	r1 := r + float64(sumK)
 	v1 := v + float64(n)
-	return GammaNext(r1, 1/v1)
+	return GammaNextR(src, r1, 1/v1)
 }
 
 // Likelihood of Poisson λ.
@@ -0,0 +1,7 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Random sources.
+// Every simulation function Xxx has a variant XxxR taking a *rand.Rand as its first argument,
+// see package dst; Xxx draws from dst.GlobalRand, the default source of the dst samplers.
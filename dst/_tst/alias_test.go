@@ -0,0 +1,144 @@
+// test of the prepared discrete samplers
+package dst
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// chi2Freq returns the chi-square statistic of the counts of the values of k in {a, ..., a+len(p)-1} against the probabilities p,
+// over the expected counts of at least 5, and its degrees of freedom; values of probability 0 count as failures
+func chi2Freq(k []int64, a int64, p []float64) (chi2, df float64, ok bool) {
+	count := make([]float64, len(p))
+	for _, v := range k {
+		i := v - a
+		if i < 0 || i >= int64(len(p)) || p[i] == 0 {
+			return 0, 0, false
+		}
+		count[i]++
+	}
+	n := float64(len(k))
+	for i := range p {
+		if e := n * p[i]; e >= 5 {
+			chi2 += (count[i] - e) * (count[i] - e) / e
+			df++
+		}
+	}
+	return chi2, df - 1, true
+}
+
+// test the frequencies of the alias and guide tables, and of the families drawing from them, against their PMFs
+func TestAliasFreq(t *testing.T) {
+	fmt.Println("test of Alias and Guide: frequencies")
+	const n = 200000
+	src := rand.New(rand.NewSource(1))
+	k := make([]int64, n)
+	w := []float64{3, 0, 1, 0.5, 10, 0, 2.5, 0}
+	p := make([]float64, len(w))
+	for i := range w {
+		p[i] = w[i] / 17
+	}
+	zipf := make([]float64, 100)
+	for i := range zipf {
+		zipf[i] = ZipfMandelbrotPMF(100, 2.5, 1.2)(int64(i + 1))
+	}
+	hyper := make([]float64, 81)
+	for i := range hyper {
+		hyper[i] = HypergeometricPMF(500, 120, 80)(int64(i))
+	}
+	tests := []struct {
+		name string
+		fill func()
+		a    int64
+		p    []float64
+	}{
+		{"Alias", func() { NewAlias(w).FillR(src, k) }, 0, p},
+		{"Guide", func() { NewGuide(-3, w).FillR(src, k) }, -3, p},
+		{"GuideDist", func() { NewGuideDist(PoissonDist{4}, 0, 30).FillR(src, k) }, 0, pmfTable(PoissonDist{4}.PMF, 0, 30)},
+		{"Choice", func() { ChoiceFillR(src, []float64{0.2, 0, 0.5}, k) }, 0, []float64{0.2, 0, 0.5, 0.3}},
+		{"LogChoice", func() { LogChoiceFillR(src, []float64{-1, -3, math.Inf(-1), 0}, k) }, 0, logChoiceWeights([]float64{-1, -3, math.Inf(-1), 0})},
+		{"ZipfMandelbrot", func() { ZipfMandelbrotFillR(src, 100, 2.5, 1.2, k) }, 1, zipf},
+		{"ZipfMandelbrotR", func() { fillFrom(ZipfMandelbrotR(src, 100, 2.5, 1.2), k) }, 1, zipf},
+		{"Hypergeometric", func() { HypergeometricFillR(src, 500, 120, 80, k) }, 0, hyper},
+	}
+	for _, tt := range tests {
+		tt.fill()
+		chi2, df, ok := chi2Freq(k, tt.a, tt.p)
+		// 5 standard deviations above the mean
+		if !ok || chi2 > df+5*math.Sqrt(2*df) {
+			t.Error()
+			fmt.Println(tt.name, ok, chi2, df)
+		}
+	}
+}
+
+// fillFrom fills k with the draws of the generator next
+func fillFrom(next func() int64, k []int64) {
+	for i := range k {
+		k[i] = next()
+	}
+}
+
+// test that the guide table inverts the CDF: Qtl(p) is the smallest k with CDF(k) > p
+func TestGuideQtl(t *testing.T) {
+	fmt.Println("test of Guide: quantiles")
+	d := BinomialDist{40, 0.3}
+	g := NewGuideDist(d, 0, 40)
+	for _, p := range []float64{0, 1e-12, 0.01, 0.25, 0.5, 0.75, 0.99, 1 - 1e-12} {
+		k := g.Qtl(p)
+		if !(d.CDF(k) > p) || (k > 0 && d.CDF(k-1) > p*(1+1e-12)) {
+			t.Error()
+			fmt.Println(p, k, d.CDF(k))
+		}
+	}
+	for j := 0; j <= 40; j++ {
+		p := d.CDF(int64(j))
+		if k := g.Qtl(p * (1 - 1e-9)); k != int64(j) && d.PMF(int64(j)) > 1e-6 {
+			t.Error()
+			fmt.Println(j, k)
+		}
+	}
+}
+
+// benchmarks of the linear scan of ChoiceNext against the alias table of ChoiceFill, per variate, over 1000 categories
+
+const benchAlias = 1000
+
+func benchChoiceWeights() []float64 {
+	θ := make([]float64, 1000)
+	for i := range θ {
+		θ[i] = 1.0 / 1000
+	}
+	return θ
+}
+
+func BenchmarkChoiceNext(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	θ := benchChoiceWeights()
+	k := make([]int64, benchAlias)
+	for i := 0; i < b.N; i++ {
+		for j := range k {
+			k[j] = ChoiceNextR(src, θ)
+		}
+	}
+}
+
+func BenchmarkChoiceFill(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	θ := benchChoiceWeights()
+	k := make([]int64, benchAlias)
+	for i := 0; i < b.N; i++ {
+		ChoiceFillR(src, θ, k)
+	}
+}
+
+func BenchmarkGuideFill(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	g := NewGuide(0, benchChoiceWeights())
+	k := make([]int64, benchAlias)
+	for i := 0; i < b.N; i++ {
+		g.FillR(src, k)
+	}
+}
@@ -0,0 +1,76 @@
+// test of the Beta-binomial and Dirichlet-multinomial distributions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values and identities
+func TestBetaBinomial(t *testing.T) {
+	fmt.Println("test of Beta-binomial distribution")
+	d := BetaBinomialDist{12, 2.5, 4}
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		// discrete uniform
+		{BetaBinomialPMFAt(10, 1, 1, 3), 1.0 / 11},
+		{BetaBinomialCDFAt(10, 1, 1, 4), 5.0 / 11},
+		// n = 1 is Bernoulli
+		{BetaBinomialPMFAt(1, 2, 3, 1), 0.4},
+		// mean and sample size
+		{BetaBinomialμνDist{12, 2.5 / 6.5, 6.5}.PMF(5), d.PMF(5)},
+		{BetaBinomialμνDist{12, 2.5 / 6.5, 6.5}.Var(), d.Var()},
+		{d.Surv(9), d.PMF(10) + d.PMF(11) + d.PMF(12)},
+	}
+	// moments against the sums over the support
+	μ, σ2, skew, kurt := 0.0, 0.0, 0.0, 0.0
+	for k := int64(0); k <= d.N; k++ {
+		μ += float64(k) * d.PMF(k)
+	}
+	for k := int64(0); k <= d.N; k++ {
+		x := float64(k) - μ
+		σ2 += x * x * d.PMF(k)
+		skew += x * x * x * d.PMF(k)
+		kurt += x * x * x * x * d.PMF(k)
+	}
+	skew /= σ2 * math.Sqrt(σ2)
+	kurt = kurt/(σ2*σ2) - 3
+	tests = append(tests, tc{d.Mean(), μ}, tc{d.Var(), σ2}, tc{d.Skew(), skew}, tc{d.ExKurt(), kurt})
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// with two categories, the Dirichlet-multinomial is Beta-binomial; its PMF sums to one
+func TestDirichletMultinomial(t *testing.T) {
+	fmt.Println("test of Dirichlet-multinomial distribution")
+	if !check(DirichletMultinomialPMFAt([]float64{2.5, 4}, 12, []int64{5, 7}), BetaBinomialPMFAt(12, 2.5, 4, 5)) {
+		t.Error()
+	}
+	α := []float64{0.5, 2, 3}
+	n := int64(7)
+	pmf := DirichletMultinomialPMF(α, n)
+	var s, m, v float64
+	for i := int64(0); i <= n; i++ {
+		for j := int64(0); i+j <= n; j++ {
+			p := pmf([]int64{i, j, n - i - j})
+			s += p
+			m += float64(i) * p
+			v += float64(i*i) * p
+		}
+	}
+	v -= m * m
+	if !check(s, 1) || !check(m, DirichletMultinomialMean(α, n)[0]) || !check(v, DirichletMultinomialVar(α, n)[0]) {
+		t.Error()
+		fmt.Println(s, m, DirichletMultinomialMean(α, n)[0], v, DirichletMultinomialVar(α, n)[0])
+	}
+	if !check(DirichletMultinomialCov(α, n)[1][1], BetaBinomialVar(n, 2, 3.5)) {
+		t.Error()
+	}
+}
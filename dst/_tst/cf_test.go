@@ -0,0 +1,110 @@
+// test of the characteristic and cumulant generating functions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// test the closed forms of the characteristic function against its numerical integral and sum
+func TestCF(t *testing.T) {
+	fmt.Println("test of CF")
+	cs := []Continuous{NormalDist{1, 2}, ExponentialDist{0.5}, GammaDist{0.5, 2}, GammaDist{3, 0.5}, ChiSquareDist{5},
+		BetaDist{2, 3}, BetaDist{0.5, 0.7}, Beta4Dist{2, 3, -1, 4}, UniformDist{1, 4}, CauchyDist{1, 2}, LogisticDist{1, 2},
+		GumbelDist{1, 2}, GumbelMinDist{1, 2}, WeibullDist{1.5, 2}, LevyDist{1, 2}, StudentsTDist{3}, SkewNormalDist{1, 2, 3},
+		VonMisesDist{0, 2}, WrapCauchyDist{0, 0.5}}
+	for _, d := range cs {
+		for _, s := range []float64{-3, 0.7, 2.5} {
+			if c, n := d.CF(s), cfContinuous(d, s); cmplx.Abs(c-n) > 1e-8 {
+				t.Error()
+				fmt.Printf("%T %v %v %v %v\n", d, d, s, c, n)
+			}
+		}
+	}
+	ds := []Discrete{BernoulliDist{0.3}, BinomialDist{10, 0.3}, PoissonDist{3.5}, GeometricDist{0.3}, Geometric1Dist{0.3},
+		NegBinomialDist{0.3, 5}, PolyaDist{0.3, 2.5}, ZIPoissonDist{0.3, 3}, HurdlePoissonDist{0.3, 3}, ZINegBinomialDist{0.3, 0.4, 5},
+		HurdleNegBinomialDist{0.3, 0.4, 5}, RangeDist{7}, ChoiceDist{[]float64{0.2, 0.5, 0, 0.3}}, HypergeometricDist{50, 12, 8},
+		TruncDiscreteDist{PoissonDist{5}, 2, 8}, MixtureDiscreteDist{[]float64{0.3, 0.7}, []Discrete{PoissonDist{2}, GeometricDist{0.4}}}}
+	for _, d := range ds {
+		for _, s := range []float64{-3, 0.7, 2.5} {
+			if c, n := d.CF(s), cfDiscrete(d, s); cmplx.Abs(c-n) > 1e-12 {
+				t.Error()
+				fmt.Printf("%T %v %v %v %v\n", d, d, s, c, n)
+			}
+		}
+	}
+	// Empirical: the mean of e^{itx}
+	x := []float64{3, 1, 2, 3, 2, 3}
+	if c, n := EmpiricalCF(x, 1), complex((3*math.Cos(3)+math.Cos(1)+2*math.Cos(2))/6, (3*math.Sin(3)+math.Sin(1)+2*math.Sin(2))/6); cmplx.Abs(c-n) > 1e-15 {
+		t.Error()
+		fmt.Println("Empirical", c, n)
+	}
+}
+
+// test the closed forms of the cumulant generating function against its numerical integral and sum, and its derivatives against the mean and variance
+func TestCGF(t *testing.T) {
+	fmt.Println("test of CGF")
+	cs := []Continuous{NormalDist{1, 2}, ExponentialDist{0.5}, GammaDist{0.5, 2}, BetaDist{0.5, 0.7}, UniformDist{1, 4}, LogisticDist{1, 2},
+		GumbelDist{1, 2}, WeibullDist{1.5, 2}, LevyDist{1, 2}, ParetoDist{2, 3}, InvGammaDist{3, 2}, SkewNormalDist{1, 2, 3}}
+	for _, d := range cs {
+		if c, n := d.CGF(-0.1), cgfContinuous(d, -0.1); math.Abs(c-n) > 1e-8 {
+			t.Error()
+			fmt.Printf("%T %v %v %v\n", d, d, c, n)
+		}
+	}
+	ds := []Discrete{BernoulliDist{0.3}, BinomialDist{10, 0.3}, PoissonDist{3.5}, GeometricDist{0.3}, NegBinomialDist{0.3, 5},
+		HurdlePoissonDist{0.3, 3}, ZINegBinomialDist{0.3, 0.4, 5}, RangeDist{7}, ChoiceDist{[]float64{0.2, 0.5, 0, 0.3}},
+		MixtureDiscreteDist{[]float64{0.3, 0.7}, []Discrete{PoissonDist{2}, GeometricDist{0.4}}}}
+	for _, d := range ds {
+		for _, s := range []float64{-0.1, 0.05} {
+			if c, n := d.CGF(s), cgfDiscrete(d, s); math.Abs(c-n) > 1e-12 {
+				t.Error()
+				fmt.Printf("%T %v %v %v %v\n", d, d, s, c, n)
+			}
+		}
+		// the first two cumulants by central differences
+		h := 1e-4
+		k1 := (d.CGF(h) - d.CGF(-h)) / (2 * h)
+		k2 := (d.CGF(h) - 2*d.CGF(0) + d.CGF(-h)) / (h * h)
+		if math.Abs(k1-d.Mean()) > 1e-6 || math.Abs(k2-d.Var()) > 1e-4 {
+			t.Error()
+			fmt.Printf("%T %v %v %v %v %v\n", d, d, k1, d.Mean(), k2, d.Var())
+		}
+	}
+	// the moment-generating function diverges
+	tests := []struct {
+		name string
+		k    float64
+	}{
+		{"Exponential", ExponentialCGF(0.5, 0.5)},
+		{"Cauchy", CauchyDist{1, 2}.CGF(-0.1)},
+		{"StudentsT", StudentsTDist{3}.CGF(0.1)},
+		{"Pareto", ParetoDist{2, 3}.CGF(0.1)},
+		{"Logistic", LogisticCGF(1, 2, 0.5)},
+		{"Geometric", GeometricDist{0.3}.CGF(1)},
+		{"Zeta", ZetaDist{4.5}.CGF(0.1)},
+		{"Trunc", TruncDist{ParetoDist{2, 3}, 3, math.Inf(1)}.CGF(0.1)},
+	}
+	for _, tt := range tests {
+		if !math.IsInf(tt.k, 1) {
+			t.Error()
+			fmt.Println(tt.name, tt.k)
+		}
+	}
+	// the Logistic MGF e^{μt} B(1 - σt, 1 + σt)
+	if m, k := LogisticMGF(1, 0.5, 0.3), LogisticCGF(1, 0.5, 0.3); math.Abs(math.Log(m)-k) > 1e-12 {
+		t.Error()
+		fmt.Println("Logistic", m, k)
+	}
+	// a mixture of one component, and the truncation of the Exponential above its lower end, which is memoryless
+	if k, n := (MixtureDist{[]float64{1}, []Continuous{GammaDist{2, 1}}}).CGF(-0.5), GammaCGF(2, 1, -0.5); math.Abs(k-n) > 1e-12 {
+		t.Error()
+		fmt.Println("Mixture", k, n)
+	}
+	if k, n := (TruncDist{ExponentialDist{1}, 2, math.Inf(1)}).CGF(-0.5), 2*-0.5+ExponentialCGF(1, -0.5); math.Abs(k-n) > 1e-8 {
+		t.Error()
+		fmt.Println("Trunc", k, n)
+	}
+}
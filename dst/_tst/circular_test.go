@@ -0,0 +1,99 @@
+// test of the circular and directional distributions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values
+func TestVonMises(t *testing.T) {
+	fmt.Println("test of von Mises distribution")
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		// e / 2π I0(1)
+		{VonMisesPDFAt(0, 1, 0), 0.3417104886234632},
+		// 1 - I1(10) / I0(10)
+		{VonMisesCircVar(0, 10), 0.051400174045153735},
+		{VonMisesCDFAt(1, 3, 1), 0.5},
+		{VonMisesCDFAt(1, 0, 1.5), (math.Pi + 0.5) / (2 * math.Pi)},
+		{VonMisesCDFAt(1, 3, 1+math.Pi), 1},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// the wrapped distributions should be the sums of the unwrapped densities over the windings
+func TestWrapped(t *testing.T) {
+	fmt.Println("test of Wrapped normal and Wrapped Cauchy distributions")
+	for _, θ := range []float64{-3, -1, 0, 0.5, 2.5} {
+		for _, σ := range []float64{0.5, 1.5} {
+			var y float64
+			for k := -20.0; k <= 20; k++ {
+				y += NormalPDFAt(0, σ, θ+2*math.Pi*k)
+			}
+			x := WrapNormalPDFAt(0, σ, θ)
+			if !check(x, y) {
+				t.Error()
+				fmt.Println(θ, σ, x, y)
+			}
+		}
+		// the windings beyond ±n add about 2γ / 4π³(n + 1/2)
+		const n, γ = 100000.0, 0.7
+		y := 2 * γ / (4 * math.Pi * math.Pi * math.Pi * (n + 0.5))
+		for k := -n; k <= n; k++ {
+			y += CauchyPDFAt(0, γ, θ+2*math.Pi*k)
+		}
+		x := WrapCauchyPDFAt(0, γ, θ)
+		if !check(x, y) {
+			t.Error()
+			fmt.Println(θ, x, y)
+		}
+	}
+}
+
+// the mean of a von Mises–Fisher sample should approach A_p(κ) μ
+func TestVonMisesFisher(t *testing.T) {
+	fmt.Println("test of von Mises–Fisher distribution")
+	for _, κ := range []float64{2, 40} {
+		// p = 3: C = κ / 4π sinh κ and A = coth κ - 1/κ
+		μ := []float64{0.6, 0, 0.8}
+		x := VonMisesFisherPDFAt(μ, κ, μ)
+		y := κ / (2 * math.Pi * (1 - math.Exp(-2*κ)))
+		if !check(x, y) {
+			t.Error()
+			fmt.Println(κ, x, y)
+		}
+		m := VonMisesFisherMean(μ, κ)
+		a := 1/math.Tanh(κ) - 1/κ
+		if !check(m[2], a*0.8) {
+			t.Error()
+			fmt.Println(κ, m, a)
+		}
+		const n = 100000
+		s := make([]float64, 3)
+		for i := 0; i < n; i++ {
+			v := VonMisesFisherNext(μ, κ)
+			if math.Abs(v[0]*v[0]+v[1]*v[1]+v[2]*v[2]-1) > 1e-12 {
+				t.Error()
+				fmt.Println(v)
+			}
+			for j := range s {
+				s[j] += v[j] / n
+			}
+		}
+		for j := range s {
+			if math.Abs(s[j]-m[j]) > 0.01 {
+				t.Error()
+				fmt.Println(κ, s, m)
+			}
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// test of the Continuous and Discrete interfaces
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+var continuousDists = []Continuous{
+	NormalDist{1, 2},
+	GammaDist{2.5, 1.5},
+	BetaDist{2, 3},
+	BetaμνDist{0.4, 5},
+	BetaμσDist{0.4, 0.2},
+	Beta4Dist{2, 3, -1, 4},
+	ChiSquareDist{5},
+	ExponentialDist{2},
+	FDist{5, 12},
+	InvGammaDist{6, 2},
+	LogisticDist{1, 2},
+	LogNormalDist{0.5, 0.4},
+	ParetoDist{1, 5},
+	ParetoIIDist{2, 6},
+	ParetoSingDist{6, 1},
+	ParetoTapDist{1, 3, 2},
+	PlanckDist{2, 1},
+	StudentsTDist{7},
+	UniformDist{-1, 3},
+	WeibullDist{1.5, 2},
+	Weibull3Dist{2, 1, 3},
+	GumbelDist{1, 2},
+	GumbelMinDist{1, 2},
+	FrechetDist{6, 2, 1},
+	RevWeibullDist{2, 1, 3},
+	GEVDist{1, 2, 0.1},
+	GEVDist{1, 2, -0.2},
+	GenParetoDist{1, 2, 0.1},
+	GenParetoDist{1, 2, -0.3},
+	VonMisesDist{1, 2},
+	VonMisesDist{-2, 0.3},
+	WrapNormalDist{1, 0.7},
+	WrapNormalDist{-1, 2},
+	WrapCauchyDist{0.5, 0.8},
+	SkewNormalDist{1, 2, 4},
+	SkewNormalDist{-1, 1, -2},
+	SkewTDist{0.5, 2, 3, 8},
+	SkewTDist{0, 1, -2, 10},
+	TruncDist{NormalDist{0, 1}, 1, 2},
+	TruncDist{NormalDist{0, 1}, 30, math.Inf(1)},
+	TruncDist{GammaDist{2.5, 1.5}, 1, 6},
+	TruncDist{GammaDist{2.5, 1.5}, 15, math.Inf(1)},
+	TruncDist{LogisticDist{1, 2}, -100, 0},
+	MixtureDist{[]float64{0.3, 0.7}, []Continuous{NormalDist{-2, 1}, NormalDist{3, 0.5}}},
+	MixtureDist{[]float64{1, 1, 2}, []Continuous{GammaDist{2, 1}, NormalDist{6, 2}, LogisticDist{0, 1}}},
+	NoncentralChiSquareDist{3, 2.5},
+	NoncentralStudentsTDist{10, 1.5},
+	NoncentralStudentsTDist{12, -3},
+	NoncentralFDist{3, 12, 5},
+	NoncentralBetaDist{1.5, 6, 5},
+}
+
+var discreteDists = []Discrete{
+	BernoulliDist{0.3},
+	BinomialDist{20, 0.3},
+	GeometricDist{0.3},
+	Geometric1Dist{0.3},
+	HypergeometricDist{50, 20, 10},
+	NegBinomialDist{0.4, 5},
+	PoissonDist{3.5},
+	PolyaDist{0.4, 2.5},
+	RangeDist{10},
+	YuleDist{6},
+	ZetaDist{8},
+	ZipfMandelbrotDist{100, 3, 2},
+	ChoiceDist{[]float64{0.1, 0.2, 0.3, 0.4}},
+	TruncDiscreteDist{PoissonDist{3.5}, 1, math.MaxInt64},
+	TruncDiscreteDist{PoissonDist{3.5}, 9, 14},
+	TruncDiscreteDist{BinomialDist{20, 0.3}, 2, 10},
+	MixtureDiscreteDist{[]float64{0.5, 0.5}, []Discrete{PoissonDist{1}, PoissonDist{10}}},
+	MixtureDiscreteDist{[]float64{0.2, 0.8}, []Discrete{BinomialDist{20, 0.3}, GeometricDist{0.3}}},
+	BetaBinomialDist{20, 2, 3},
+	BetaBinomialDist{50, 0.5, 0.8},
+	BetaBinomialμνDist{30, 0.3, 5},
+	ZIPoissonDist{0.3, 2.5},
+	ZINegBinomialDist{0.25, 0.4, 3},
+	HurdlePoissonDist{0.4, 2.5},
+	HurdlePoissonDist{0.4, 0.05},
+	HurdleNegBinomialDist{0.2, 0.4, 3},
+}
+
+// CDF(Qtl(p)) should give p back
+func TestContinuousQtl(t *testing.T) {
+	fmt.Println("test of Continuous: CDF(Qtl(p))")
+	for _, d := range continuousDists {
+		for _, p := range []float64{0.05, 0.5, 0.95} {
+			x := d.CDF(d.Qtl(p))
+			if !check(x, p) {
+				t.Error()
+				fmt.Printf("%#v %v %v\n", d, x, p)
+			}
+		}
+		// 0 and 1 map to the ends of the support
+		if a, b := d.Support(); d.Qtl(0) != a || d.Qtl(1) != b {
+			t.Error()
+			fmt.Printf("%#v %v %v %v %v\n", d, a, b, d.Qtl(0), d.Qtl(1))
+		}
+	}
+}
+
+// the density should integrate to the CDF
+func TestContinuousPDF(t *testing.T) {
+	fmt.Println("test of Continuous: PDF vs. CDF")
+	for _, d := range continuousDists {
+		a, b := d.Qtl(0.2), d.Qtl(0.8)
+		// Simpson's rule
+		n := 1000
+		h := (b - a) / float64(n)
+		s := d.PDF(a) + d.PDF(b)
+		for i := 1; i < n; i++ {
+			if i%2 == 1 {
+				s += 4 * d.PDF(a+float64(i)*h)
+			} else {
+				s += 2 * d.PDF(a+float64(i)*h)
+			}
+		}
+		x := s * h / 3
+		y := d.CDF(b) - d.CDF(a)
+		if !check(x, y) {
+			t.Error()
+			fmt.Printf("%#v %v %v\n", d, x, y)
+		}
+		x = d.LnPDF(d.Qtl(0.5))
+		y = math.Log(d.PDF(d.Qtl(0.5)))
+		if !check(x, y) {
+			t.Error()
+			fmt.Printf("%#v %v %v\n", d, x, y)
+		}
+	}
+}
+
+// the excess kurtosis against known values
+func TestContinuousExKurt(t *testing.T) {
+	fmt.Println("test of Continuous: ExKurt")
+	tests := []struct {
+		d Continuous
+		k float64
+	}{
+		// Beta(2, 3): 6((α-β)²(α+β+1) - αβ(α+β+2)) / (αβ(α+β+2)(α+β+3)) = -9/14
+		{BetaDist{2, 3}, -9.0 / 14},
+		{Beta4Dist{2, 3, -1, 4}, -9.0 / 14},
+		{BetaμνDist{0.4, 5}, -9.0 / 14},
+		{BetaμσDist{0.5, math.Sqrt(1.0 / 12)}, -1.2},
+		{UniformDist{-1, 3}, -1.2},
+		{GammaDist{2.5, 1.5}, 2.4},
+		{NormalDist{1, 2}, 0},
+		{LogisticDist{1, 2}, 1.2},
+		{StudentsTDist{7}, 2},
+	}
+	for _, tt := range tests {
+		if k := tt.d.ExKurt(); math.Abs(k-tt.k) > 1e-12 {
+			t.Error()
+			fmt.Printf("%#v %v %v\n", tt.d, k, tt.k)
+		}
+	}
+}
+
+// the density should vanish outside the support
+func TestContinuousSupport(t *testing.T) {
+	fmt.Println("test of Continuous: PDF outside Support")
+	for _, d := range append(continuousDists, ParetoGDist{2, 3, 1.5}) {
+		a, b := d.Support()
+		for _, x := range []float64{a - 1, a - 1e-9, b + 1e-9, b + 1} {
+			if math.IsInf(x, 0) {
+				continue
+			}
+			if p, l := d.PDF(x), d.LnPDF(x); p != 0 || !math.IsInf(l, -1) {
+				t.Error()
+				fmt.Printf("%#v %v %v %v\n", d, x, p, l)
+			}
+		}
+	}
+}
+
+// sample moments should be close to the theoretical ones
+func TestContinuousRand(t *testing.T) {
+	fmt.Println("test of Continuous: Rand vs. Mean and Var")
+	const n = 100000
+	for _, d := range continuousDists {
+		var s, s2 float64
+		for i := 0; i < n; i++ {
+			x := d.Rand()
+			s += x
+			s2 += x * x
+		}
+		mean := s / n
+		v := s2/n - mean*mean
+		// allow for 5 standard errors of the sample mean and variance
+		sd := math.Sqrt(d.Var())
+		sdv := d.Var() * math.Sqrt((d.ExKurt()+2)/n)
+		if math.Abs(mean-d.Mean()) > 5*sd/math.Sqrt(n) || math.Abs(v-d.Var()) > 5*sdv {
+			t.Error()
+			fmt.Printf("%#v %v %v %v %v\n", d, mean, d.Mean(), v, d.Var())
+		}
+	}
+}
+
+// Qtl should be the smallest k with CDF(k) >= p
+func TestDiscreteQtl(t *testing.T) {
+	fmt.Println("test of Discrete: Qtl")
+	for _, d := range discreteDists {
+		for _, p := range []float64{0.05, 0.5, 0.95} {
+			k := d.Qtl(p)
+			if d.CDF(k) < p || d.CDF(k-1) >= p {
+				t.Error()
+				fmt.Printf("%#v %v %v %v %v\n", d, p, k, d.CDF(k-1), d.CDF(k))
+			}
+		}
+		// 0 and 1 map to the ends of the support
+		if a, b := d.Support(); d.Qtl(0) != a || d.Qtl(1) != b {
+			t.Error()
+			fmt.Printf("%#v %v %v %v %v\n", d, a, b, d.Qtl(0), d.Qtl(1))
+		}
+	}
+}
+
+// the PMF should sum to the CDF
+func TestDiscretePMF(t *testing.T) {
+	fmt.Println("test of Discrete: PMF vs. CDF")
+	for _, d := range discreteDists {
+		a, _ := d.Support()
+		k := d.Qtl(0.9)
+		var s float64
+		for i := a; i <= k; i++ {
+			s += d.PMF(i)
+			if !check(d.LnPMF(i), math.Log(d.PMF(i))) {
+				t.Error()
+				fmt.Printf("%#v %v %v %v\n", d, i, d.LnPMF(i), math.Log(d.PMF(i)))
+			}
+		}
+		if !check(s, d.CDF(k)) {
+			t.Error()
+			fmt.Printf("%#v %v %v\n", d, s, d.CDF(k))
+		}
+	}
+}
+
+// sample moments should be close to the theoretical ones
+func TestDiscreteRand(t *testing.T) {
+	fmt.Println("test of Discrete: Rand vs. Mean and Var")
+	const n = 100000
+	for _, d := range discreteDists {
+		var s, s2 float64
+		for i := 0; i < n; i++ {
+			x := float64(d.Rand())
+			s += x
+			s2 += x * x
+		}
+		mean := s / n
+		v := s2/n - mean*mean
+		// allow for 5 standard errors of the sample mean and variance
+		sd := math.Sqrt(d.Var())
+		sdv := d.Var() * math.Sqrt((d.ExKurt()+2)/n)
+		if math.Abs(mean-d.Mean()) > 5*sd/math.Sqrt(n) || math.Abs(v-d.Var()) > 5*sdv {
+			t.Error()
+			fmt.Printf("%#v %v %v %v %v\n", d, mean, d.Mean(), v, d.Var())
+		}
+	}
+}
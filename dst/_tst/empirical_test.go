@@ -0,0 +1,69 @@
+// test of the Empirical distribution
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against the quantiles of R's quantile(1:10, type = t) and the moments of the discrete uniform distribution
+func TestEmpirical(t *testing.T) {
+	fmt.Println("test of Empirical distribution")
+	x := []float64{7, 3, 10, 1, 5, 8, 2, 9, 4, 6}
+	d := NewEmpiricalDist(x)
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{d.CDF(5), 0.5},
+		{d.CDF(4.9), 0.4},
+		{d.CDF(0), 0},
+		{d.Surv(9.5), 0.1},
+		{d.LnSurv(9.5), math.Log(0.1)},
+		{EmpiricalCDFAt(x, 10), 1},
+		{d.Mean(), 5.5},
+		{d.Var(), 8.25},
+		{d.Skew() + 1, 1},
+		{d.ExKurt(), -6 * 101.0 / (5 * 99)},
+		{d.Qtl(0.5), 5.5},
+		{d.QtlTail(0.75, false, false), d.Qtl(0.25)},
+		{d.QtlTail(math.Log(0.25), true, true), d.Qtl(0.25)},
+		{EmpiricalQtlFor(x, 2, 0.5), 5.5},
+		{EmpiricalQtlFor(x, 3, 0.5), 5},
+		{EmpiricalQtlFor(x, 1, 0), 1},
+		{EmpiricalQtlFor(x, 7, 1), 10},
+	}
+	for i, y := range []float64{3, 3, 2, 2.5, 3, 2.75, 3.25, 2.9166666666666667, 2.9375} {
+		tests = append(tests, tc{EmpiricalQtlFor(x, i+1, 0.25), y})
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+	// type 1 inverts the CDF
+	for _, p := range []float64{0.05, 0.1, 0.33, 0.5, 0.95} {
+		q := EmpiricalQtlFor(x, 1, p)
+		if d.CDF(q) < p || d.CDF(q-0.5) >= p {
+			t.Error()
+			fmt.Println(p, q)
+		}
+	}
+	// bootstrap resampling
+	next := Empirical(x)
+	var s float64
+	const n = 100000
+	for i := 0; i < n; i++ {
+		v := next()
+		if v != math.Floor(v) || v < 1 || v > 10 {
+			t.Error()
+		}
+		s += v
+	}
+	if math.Abs(s/n-5.5) > 5*math.Sqrt(8.25/n) {
+		t.Error()
+		fmt.Println(s / n)
+	}
+}
@@ -0,0 +1,171 @@
+// test of the entropies and divergences
+package dst
+
+import (
+	"fmt"
+	. "github.com/skelterjohn/go.matrix"
+	"math"
+	"testing"
+)
+
+// test the closed forms of the entropy against its numerical integral and sum
+func TestEntropy(t *testing.T) {
+	fmt.Println("test of Entropy")
+	cs := []Continuous{NormalDist{1, 2}, ExponentialDist{0.5}, GammaDist{0.5, 2}, GammaDist{3, 0.5}, ChiSquareDist{5},
+		BetaDist{2, 3}, Beta4Dist{2, 3, -1, 4}, BetaμνDist{0.3, 10}, UniformDist{1, 4}, CauchyDist{1, 2}, LogisticDist{1, 2},
+		LogNormalDist{0.5, 0.7}, GumbelDist{1, 2}, GumbelMinDist{1, 2}, WeibullDist{1.5, 2}, Weibull3Dist{0.8, 2, 1}, RevWeibullDist{1.5, 2, 1},
+		FrechetDist{3, 2, 1}, GEVDist{1, 2, 0.2}, GEVDist{1, 2, -0.3}, GenParetoDist{1, 2, 0.2}, ParetoDist{2, 3}, ParetoIIDist{2, 3},
+		ParetoSingDist{3, 2}, StudentsTDist{3}, FDist{4, 7}, InvGammaDist{3, 2}, LevyDist{1, 2}, VonMisesDist{0, 2}, WrapCauchyDist{0, 0.5}}
+	for _, d := range cs {
+		if h, n := d.Entropy(), entropyContinuous(d); math.Abs(h-n) > 1e-8 {
+			t.Error()
+			fmt.Printf("%T %v %v %v\n", d, d, h, n)
+		}
+	}
+	ds := []Discrete{BernoulliDist{0.3}, GeometricDist{0.3}, Geometric1Dist{0.05}, RangeDist{7}, ChoiceDist{[]float64{0.2, 0.5, 0, 0.3}}}
+	for _, d := range ds {
+		if h, n := d.Entropy(), entropyDiscrete(d); math.Abs(h-n) > 1e-12 {
+			t.Error()
+			fmt.Printf("%T %v %v %v\n", d, d, h, n)
+		}
+	}
+	// the numerical sums against the sums of the PMF over the bulk of the support
+	ds = []Discrete{PoissonDist{3.5}, BinomialDist{20, 0.3}, NegBinomialDist{0.3, 5}, HurdlePoissonDist{0.3, 3}, ZINegBinomialDist{0.3, 0.4, 5},
+		HypergeometricDist{50, 12, 8}, PolyaDist{0.3, 2.5}, TruncDiscreteDist{PoissonDist{5}, 2, 8}}
+	for _, d := range ds {
+		h := 0.0
+		for k := int64(0); k < 500; k++ {
+			if p := d.PMF(k); p > 0 {
+				h -= p * math.Log(p)
+			}
+		}
+		if e := d.Entropy(); math.Abs(e-h) > 1e-10 {
+			t.Error()
+			fmt.Printf("%T %v %v %v\n", d, d, e, h)
+		}
+	}
+	// the Normal limit ½ ln(2πeλ) of the Poisson entropy, with error 1/(12λ)
+	if h, n := PoissonEntropy(1e4), 0.5*math.Log(2*math.Pi*math.E*1e4)-1/12e4; math.Abs(h-n) > 1e-8 {
+		t.Error()
+		fmt.Println("Poisson", h, n)
+	}
+	// Zeta(4), by direct summation
+	z, l := 0.0, 0.0
+	for k := 1.0; k < 1e5; k++ {
+		z += math.Pow(k, -4)
+		l += math.Log(k) * math.Pow(k, -4)
+	}
+	tests := []struct {
+		name string
+		h, n float64
+	}{
+		{"Zeta(4)", ZetaEntropy(4), math.Log(z) + 4*l/z},
+		{"Empirical", EmpiricalEntropy([]float64{3, 1, 2, 3, 2, 3}), -(math.Log(1.0/6)/6 + math.Log(1.0/3)/3 + math.Log(0.5)/2)},
+		{"Dirichlet(2, 3)", DirichletEntropy([]float64{2, 3}), BetaEntropy(2, 3)},
+		{"Mixture", MixtureDist{[]float64{1}, []Continuous{GammaDist{2, 1}}}.Entropy(), GammaEntropy(2, 1)},
+		{"Trunc", TruncDist{ExponentialDist{1}, 0, math.Inf(1)}.Entropy(), 1},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.h-tt.n) > 1e-8 {
+			t.Error()
+			fmt.Println(tt.name, tt.h, tt.n)
+		}
+	}
+}
+
+// test the closed forms of the Kullback–Leibler divergence against its numerical integral
+func TestKL(t *testing.T) {
+	fmt.Println("test of KL divergence")
+	tests := []struct {
+		name string
+		kl   float64
+		p, q Continuous
+	}{
+		{"Normal", NormalKL(1, 2, 0, 1), NormalDist{1, 2}, NormalDist{0, 1}},
+		{"Gamma", GammaKL(2, 3, 4, 0.5), GammaDist{2, 3}, GammaDist{4, 0.5}},
+		{"Beta", BetaKL(2, 3, 0.5, 4), BetaDist{2, 3}, BetaDist{0.5, 4}},
+		{"Beta, singular", BetaKL(0.5, 4, 2, 3), BetaDist{0.5, 4}, BetaDist{2, 3}},
+		{"Dirichlet", DirichletKL([]float64{3, 1.5}, []float64{1, 2}), BetaDist{3, 1.5}, BetaDist{1, 2}},
+		{"same", 0, LogisticDist{1, 2}, LogisticDist{1, 2}},
+	}
+	for _, tt := range tests {
+		if kl := KLContinuous(tt.p, tt.q); math.Abs(kl-tt.kl) > 1e-7 {
+			t.Error()
+			fmt.Println(tt.name, tt.kl, kl)
+		}
+	}
+	// p not absolutely continuous with respect to q
+	if kl := KLContinuous(NormalDist{0, 1}, ExponentialDist{1}); !math.IsInf(kl, 1) {
+		t.Error()
+		fmt.Println("Normal, Exponential", kl)
+	}
+	if kl := KLContinuous(UniformDist{0, 1}, UniformDist{0, 2}); math.Abs(kl-math.Ln2) > 1e-12 {
+		t.Error()
+		fmt.Println("Uniform", kl)
+	}
+	// Poisson: λ1 ln(λ1/λ2) + λ2 - λ1
+	if kl := KLDiscrete(PoissonDist{3}, PoissonDist{5}); math.Abs(kl-(3*math.Log(3.0/5)+2)) > 1e-12 {
+		t.Error()
+		fmt.Println("Poisson", kl)
+	}
+	if kl := KLDiscrete(PoissonDist{3}, BinomialDist{10, 0.3}); !math.IsInf(kl, 1) {
+		t.Error()
+		fmt.Println("Poisson, Binomial", kl)
+	}
+}
+
+// test the Hellinger and total-variation distances against their closed forms
+func TestHellingerTV(t *testing.T) {
+	fmt.Println("test of Hellinger and total-variation distances")
+	n1, n2 := NormalDist{0, 1}, NormalDist{1, 2}
+	tests := []struct {
+		name string
+		x, y float64
+	}{
+		{"Hellinger, Normal", HellingerContinuous(n1, n2), math.Sqrt(1 - math.Sqrt(4.0/5)*math.Exp(-1.0/20))},
+		{"Hellinger, Poisson", HellingerDiscrete(PoissonDist{3}, PoissonDist{5}), math.Sqrt(1 - math.Exp(math.Sqrt(15)-4))},
+		{"Hellinger, disjoint", HellingerContinuous(UniformDist{0, 1}, UniformDist{2, 3}), 1},
+		{"Hellinger, same", HellingerDiscrete(BinomialDist{10, 0.4}, BinomialDist{10, 0.4}), 0},
+		// Normal with shift 1: 2Φ(½) - 1
+		{"TV, Normal", TotalVariationContinuous(n1, NormalDist{1, 1}), 2*ZCDFAt(0.5) - 1},
+		{"TV, Uniform", TotalVariationContinuous(UniformDist{0, 1}, UniformDist{0.5, 1.5}), 0.5},
+		{"TV, far", TotalVariationContinuous(n1, NormalDist{1000, 0.001}), 1},
+		{"TV, Bernoulli", TotalVariationDiscrete(BernoulliDist{0.3}, BernoulliDist{0.5}), 0.2},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.x-tt.y) > 1e-8 {
+			t.Error()
+			fmt.Println(tt.name, tt.x, tt.y)
+		}
+	}
+}
+
+// test the multivariate entropies and divergences against independent univariate ones, and the Wishart in one dimension against the Gamma
+func TestMVEntropy(t *testing.T) {
+	fmt.Println("test of multivariate entropies and KL divergences")
+	μ1 := MakeDenseMatrix([]float64{1, 2}, 2, 1)
+	μ2 := MakeDenseMatrix([]float64{0, -1}, 2, 1)
+	Σ1 := MakeDenseMatrix([]float64{4, 0, 0, 9}, 2, 2)
+	Σ2 := MakeDenseMatrix([]float64{1, 0, 0, 2}, 2, 2)
+	V1 := MakeDenseMatrix([]float64{2}, 1, 1)
+	V2 := MakeDenseMatrix([]float64{0.5}, 1, 1)
+	tests := []struct {
+		name string
+		x, y float64
+	}{
+		{"MVNormal entropy", MVNormalEntropy(μ1, Σ1), NormalEntropy(1, 2) + NormalEntropy(2, 3)},
+		{"MVNormal KL", MVNormalKL(μ1, Σ1, μ2, Σ2), NormalKL(1, 2, 0, 1) + NormalKL(2, 3, -1, math.Sqrt(2))},
+		{"MVNormal KL, same", MVNormalKL(μ1, Σ1, μ1, Σ1), 0},
+		// W(n, v) in one dimension is Gamma(n/2, 2v)
+		{"Wishart entropy", WishartEntropy(5, V1), GammaEntropy(2.5, 4)},
+		{"Wishart KL", WishartKL(5, V1, 3, V2), GammaKL(2.5, 4, 1.5, 1)},
+		{"Wishart KL, same", WishartKL(5, Σ1, 5, Σ1), 0},
+		{"Dirichlet KL", DirichletKL([]float64{2, 3, 4}, []float64{2, 3, 4}), 0},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.x-tt.y) > 1e-12 {
+			t.Error()
+			fmt.Println(tt.name, tt.x, tt.y)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// test of the extreme value distributions
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+// test against known values
+func TestExtremeValue(t *testing.T) {
+	fmt.Println("test of extreme value distributions: known values")
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{GumbelPDFAt(0, 1, 1), 0.2546464},
+		{GumbelCDFAt(0, 1, 1), 0.6922006},
+		{GumbelMinCDFAt(0, 1, 1), 0.9340120},
+		{FrechetCDFAt(2, 1, 0, 2), 0.7788008},
+		{RevWeibullCDFAt(2, 1, 0, -0.5), 0.7788008},
+		{GenParetoCDFAt(0, 2, 0.5, 3), 0.6734694},
+		{GenParetoCDFAt(0, 1, -1, 0.3), 0.3},
+		{GEVQtlFor(0, 1, 0, 0.6922006), 1},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// GEV unites Gumbel, Fréchet and reversed Weibull; GPD with ξ = 0 is Exponential
+func TestExtremeValueSpecialCases(t *testing.T) {
+	fmt.Println("test of extreme value distributions: special cases")
+	μ, σ := 1.0, 2.0
+	for _, x := range []float64{-3, 0, 1.5, 4, 10} {
+		if !check(GEVCDFAt(μ, σ, 0, x), GumbelCDFAt(μ, σ, x)) || !check(GEVPDFAt(μ, σ, 0, x), GumbelPDFAt(μ, σ, x)) {
+			t.Error()
+			fmt.Println(x, GEVCDFAt(μ, σ, 0, x), GumbelCDFAt(μ, σ, x))
+		}
+		ξ := 0.25
+		if !check(GEVCDFAt(μ, σ, ξ, x), FrechetCDFAt(1/ξ, σ/ξ, μ-σ/ξ, x)) {
+			t.Error()
+			fmt.Println(x, GEVCDFAt(μ, σ, ξ, x), FrechetCDFAt(1/ξ, σ/ξ, μ-σ/ξ, x))
+		}
+		ξ = -0.25
+		if !check(GEVCDFAt(μ, σ, ξ, x), RevWeibullCDFAt(-1/ξ, -σ/ξ, μ-σ/ξ, x)) {
+			t.Error()
+			fmt.Println(x, GEVCDFAt(μ, σ, ξ, x), RevWeibullCDFAt(-1/ξ, -σ/ξ, μ-σ/ξ, x))
+		}
+		if x >= 0 && !check(GenParetoCDFAt(0, σ, 0, x), ExponentialCDFAt(1/σ, x)) {
+			t.Error()
+			fmt.Println(x, GenParetoCDFAt(0, σ, 0, x), ExponentialCDFAt(1/σ, x))
+		}
+	}
+}
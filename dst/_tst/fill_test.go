@@ -0,0 +1,269 @@
+// test of the bulk samplers XxxFill
+package dst
+
+import (
+	"fmt"
+	. "github.com/skelterjohn/go.matrix"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// test that XxxFillR draws the same numbers as as many calls of XxxNextR from the same source
+func TestFillStream(t *testing.T) {
+	fmt.Println("test of Fill functions: same stream as Next")
+	const n = 1000
+	x := make([]float64, n)
+	k := make([]int64, n)
+	src := func() *rand.Rand { return rand.New(rand.NewSource(7)) }
+	for name, tt := range map[string]struct {
+		fill func(src *rand.Rand)
+		next func(src *rand.Rand) float64
+	}{
+		"Normal":              {func(s *rand.Rand) { NormalFillR(s, 1, 2, x) }, func(s *rand.Rand) float64 { return NormalNextR(s, 1, 2) }},
+		"Gamma":               {func(s *rand.Rand) { GammaFillR(s, 0.7, 2, x) }, func(s *rand.Rand) float64 { return GammaNextR(s, 0.7, 2) }},
+		"Poisson3":            {func(s *rand.Rand) { PoissonFillR(s, 3, k) }, func(s *rand.Rand) float64 { return float64(PoissonNextR(s, 3)) }},
+		"Poisson":             {func(s *rand.Rand) { PoissonFillR(s, 50, k) }, func(s *rand.Rand) float64 { return float64(PoissonNextR(s, 50)) }},
+		"Binomial":            {func(s *rand.Rand) { BinomialFillR(s, 1000, 0.3, k) }, func(s *rand.Rand) float64 { return float64(BinomialNextR(s, 1000, 0.3)) }},
+		"StudentsT":           {func(s *rand.Rand) { StudentsTFillR(s, 3, x) }, func(s *rand.Rand) float64 { return StudentsTNextR(s, 3) }},
+		"Polya":               {func(s *rand.Rand) { PolyaFillR(s, 0.4, 2.5, k) }, func(s *rand.Rand) float64 { return float64(PolyaNextR(s, 0.4, 2.5)) }},
+		"NoncentralChiSquare": {func(s *rand.Rand) { NoncentralChiSquareFillR(s, 3, 20, x) }, func(s *rand.Rand) float64 { return NoncentralChiSquareNextR(s, 3, 20) }},
+		"F":                   {func(s *rand.Rand) { FFillR(s, 4, 9, x) }, func(s *rand.Rand) float64 { return FNextR(s, 4, 9) }},
+	} {
+		for i := range x {
+			x[i] = math.NaN()
+			k[i] = -1
+		}
+		tt.fill(src())
+		s := src()
+		for i := 0; i < n; i++ {
+			v := x[i]
+			if math.IsNaN(v) {
+				v = float64(k[i])
+			}
+			if w := tt.next(s); v != w {
+				t.Error()
+				fmt.Println(name, i, v, w)
+				break
+			}
+		}
+	}
+}
+
+// test the frequencies of the Binomial and Poisson samplers against their PMFs by the chi-square statistic,
+// in both regimes of each sampler: inversion, and BTPE or the normal approximation of Ahrens and Dieter
+func TestFillFreq(t *testing.T) {
+	fmt.Println("test of Fill functions: frequencies of Binomial and Poisson")
+	const n = 200000
+	src := rand.New(rand.NewSource(1))
+	k := make([]int64, n)
+	lnFact := func(k int64) float64 {
+		v, _ := math.Lgamma(float64(k + 1))
+		return v
+	}
+	binomial := func(m int64, p float64) func(k int64) float64 {
+		return func(k int64) float64 {
+			return math.Exp(lnFact(m) - lnFact(k) - lnFact(m-k) + float64(k)*math.Log(p) + float64(m-k)*math.Log1p(-p))
+		}
+	}
+	poisson := func(λ float64) func(k int64) float64 {
+		return func(k int64) float64 { return math.Exp(float64(k)*math.Log(λ) - λ - lnFact(k)) }
+	}
+	tests := []struct {
+		name string
+		fill func()
+		pmf  func(k int64) float64
+		max  int64
+	}{
+		{"Binomial(40, 0.2)", func() { BinomialFillR(src, 40, 0.2, k) }, binomial(40, 0.2), 40},
+		{"Binomial(1000, 0.7)", func() { BinomialFillR(src, 1000, 0.7, k) }, binomial(1000, 0.7), 1000},
+		{"Poisson(3)", func() { PoissonFillR(src, 3, k) }, poisson(3), 60},
+		{"Poisson(50)", func() { PoissonFillR(src, 50, k) }, poisson(50), 200},
+	}
+	for _, tt := range tests {
+		tt.fill()
+		count := make(map[int64]float64)
+		for _, v := range k {
+			count[v]++
+		}
+		chi2, df := 0.0, 0.0
+		for j := int64(0); j <= tt.max; j++ {
+			if e := n * tt.pmf(j); e >= 5 {
+				chi2 += (count[j] - e) * (count[j] - e) / e
+				df++
+			}
+		}
+		// 5 standard deviations above the mean
+		if chi2 > df+5*math.Sqrt(2*df) {
+			t.Error()
+			fmt.Println(tt.name, chi2, df)
+		}
+	}
+}
+
+// test the means of the vector samplers, and the covariance of the Multivariate normal one
+func TestFillVector(t *testing.T) {
+	fmt.Println("test of Fill functions: vector distributions")
+	const n = 100000
+	src := rand.New(rand.NewSource(1))
+	θ := []float64{0.2, 0.5, 0.3}
+	k := make([][]int64, n)
+	for i := range k {
+		k[i] = make([]int64, 3)
+	}
+	MultinomialFillR(src, θ, 40, k)
+	α := []float64{2, 5, 3}
+	x := make([][]float64, n)
+	for i := range x {
+		x[i] = make([]float64, 3)
+	}
+	DirichletFillR(src, α, x)
+	// the same stream as DirichletNextR
+	d := make([][]float64, 10)
+	for i := range d {
+		d[i] = make([]float64, 3)
+	}
+	DirichletFillR(rand.New(rand.NewSource(7)), α, d)
+	r := rand.New(rand.NewSource(7))
+	for i := range d {
+		if w := DirichletNextR(r, α); w[0] != d[i][0] || w[2] != d[i][2] {
+			t.Error()
+			fmt.Println(i, d[i], w)
+			break
+		}
+	}
+	for j := range θ {
+		mk, mx := 0.0, 0.0
+		for i := 0; i < n; i++ {
+			mk += float64(k[i][j]) / n
+			mx += x[i][j] / n
+		}
+		if math.Abs(mk-40*θ[j]) > 0.05 || math.Abs(mx-α[j]/10) > 0.002 {
+			t.Error()
+			fmt.Println(j, mk, mx)
+		}
+	}
+
+	μ := MakeDenseMatrix([]float64{1, -2}, 2, 1)
+	Σ := MakeDenseMatrix([]float64{4, 1.2, 1.2, 1}, 2, 2)
+	y := Zeros(n, 2)
+	MVNormalFillR(src, μ, Σ, y)
+	var m0, m1, c00, c01, c11 float64
+	for i := 0; i < n; i++ {
+		a, b := y.Get(i, 0)-1, y.Get(i, 1)+2
+		m0 += a / n
+		m1 += b / n
+		c00 += a * a / n
+		c01 += a * b / n
+		c11 += b * b / n
+	}
+	for i, d := range []float64{m0, m1, c00 - 4, c01 - 1.2, c11 - 1} {
+		if math.Abs(d) > 0.05 {
+			t.Error()
+			fmt.Println(i, d)
+		}
+	}
+}
+
+// benchmarks of the samplers XxxNext against the bulk ones XxxFill, per variate
+
+const benchFill = 1000
+
+func BenchmarkNormalNext(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	x := make([]float64, benchFill)
+	for i := 0; i < b.N; i++ {
+		next := NormalR(src, 1, 2)
+		for j := range x {
+			x[j] = next()
+		}
+	}
+}
+
+func BenchmarkNormalFill(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	x := make([]float64, benchFill)
+	for i := 0; i < b.N; i++ {
+		NormalFillR(src, 1, 2, x)
+	}
+}
+
+func BenchmarkPoissonNext(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	k := make([]int64, benchFill)
+	for i := 0; i < b.N; i++ {
+		for j := range k {
+			k[j] = PoissonNextR(src, 50)
+		}
+	}
+}
+
+func BenchmarkPoissonFill(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	k := make([]int64, benchFill)
+	for i := 0; i < b.N; i++ {
+		PoissonFillR(src, 50, k)
+	}
+}
+
+func BenchmarkBinomialNext(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	k := make([]int64, benchFill)
+	for i := 0; i < b.N; i++ {
+		for j := range k {
+			k[j] = BinomialNextR(src, 1000, 0.3)
+		}
+	}
+}
+
+func BenchmarkBinomialFill(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	k := make([]int64, benchFill)
+	for i := 0; i < b.N; i++ {
+		BinomialFillR(src, 1000, 0.3, k)
+	}
+}
+
+func BenchmarkDirichletNext(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	α := []float64{2, 5, 3, 1}
+	x := make([][]float64, benchFill)
+	for i := 0; i < b.N; i++ {
+		for j := range x {
+			x[j] = DirichletNextR(src, α)
+		}
+	}
+}
+
+func BenchmarkDirichletFill(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	α := []float64{2, 5, 3, 1}
+	x := make([][]float64, benchFill)
+	for j := range x {
+		x[j] = make([]float64, len(α))
+	}
+	for i := 0; i < b.N; i++ {
+		DirichletFillR(src, α, x)
+	}
+}
+
+func BenchmarkMVNormalNext(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	μ := Zeros(5, 1)
+	Σ := Eye(5)
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchFill; j++ {
+			MVNormalNextR(src, μ, Σ)
+		}
+	}
+}
+
+func BenchmarkMVNormalFill(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	μ := Zeros(5, 1)
+	Σ := Eye(5)
+	x := Zeros(benchFill, 5)
+	for i := 0; i < b.N; i++ {
+		MVNormalFillR(src, μ, Σ, x)
+	}
+}
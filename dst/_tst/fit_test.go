@@ -0,0 +1,161 @@
+// test of the maximum-likelihood fitting
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test the digamma and trigamma functions used by GammaFit against their known values
+func TestDigamma(t *testing.T) {
+	fmt.Println("test of digamma and trigamma functions")
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{digamma(1), -0.5772156649015329},
+		{digamma(0.5), -1.9635100260214235},
+		{digamma(20.5), math.Log(20.5) - 1/41.0 - 1/(12*20.5*20.5) + 1/(120*math.Pow(20.5, 4))},
+		// ψ(1-x) - ψ(x) = π cot(πx)
+		{digamma(-0.3) - digamma(1.3), math.Pi / math.Tan(1.3*math.Pi)},
+		{trigamma(1), math.Pi * math.Pi / 6},
+		{trigamma(0.5), math.Pi * math.Pi / 2},
+		{trigamma(3), math.Pi*math.Pi/6 - 1 - 0.25},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// test the closed forms, and their standard errors from the observed information against the known ones
+func TestFitClosedForm(t *testing.T) {
+	fmt.Println("test of maximum-likelihood fitting: closed forms")
+	x := []float64{2.1, 3.4, 1.9, 5.6, 4.2, 3.3, 2.8, 4.9, 3.7, 2.5}
+	k := []int64{3, 0, 2, 5, 1, 4, 2, 3, 6, 2}
+	n := float64(len(x))
+	μ, σ := 3.44, 0.0
+	for _, v := range x {
+		σ += (v - μ) * (v - μ)
+	}
+	σ = math.Sqrt(σ / n)
+	normal := NormalFit(x)
+	exp := ExponentialFit(x)
+	poisson := PoissonFit(k)
+	pareto := ParetoFit(x)
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{normal.Est[0], μ},
+		{normal.Est[1], σ},
+		{normal.LnL, -n * (math.Log(2*math.Pi*σ*σ) + 1) / 2},
+		{exp.Est[0], 1 / μ},
+		{poisson.Est[0], 2.8},
+		{pareto.Est[0], 1.9},
+		{ChoiceFit(k).Est[2], 0.3},
+		{RangeFit(k).Est[0], 7},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+	// the numerical Hessian is accurate to about 1e-6
+	se := []tc{
+		{normal.StdErr[0], σ / math.Sqrt(n)},
+		{normal.StdErr[1], σ / math.Sqrt(2*n)},
+		{exp.StdErr[0], exp.Est[0] / math.Sqrt(n)},
+		{poisson.StdErr[0], math.Sqrt(2.8 / n)},
+		{pareto.StdErr[1], pareto.Est[1] / math.Sqrt(n)},
+		{ChoiceFit(k).StdErr[2], math.Sqrt(0.3 * 0.7 / n)},
+	}
+	for i, tt := range se {
+		if math.Abs(tt.x/tt.y-1) > 1e-4 {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+	if !math.IsNaN(pareto.StdErr[0]) || !math.IsNaN(UniformFit(x).StdErr[1]) {
+		t.Error()
+	}
+}
+
+// test the Newton iterations for the Gamma and Weibull distributions against the Nelder-Mead maximization of the same likelihood
+func TestFitNewton(t *testing.T) {
+	fmt.Println("test of maximum-likelihood fitting: Newton's method")
+	x := []float64{0.8, 2.3, 1.1, 4.7, 0.4, 1.9, 3.2, 0.9, 2.6, 1.5, 0.6, 5.1}
+	gamma := mleFit(mleLnL(x, func(θ []float64) func(x float64) float64 { return GammaLnPDF(θ[0], θ[1]) }), []float64{1, 1}, []mleBound{mlePos, mlePos})
+	weibull := mleFit(mleLnL(x, func(θ []float64) func(x float64) float64 { return WeibullLnPDF(θ[0], θ[1]) }), []float64{1, 1}, []mleBound{mlePos, mlePos})
+	for i, r := range [][2]MLE{{GammaFit(x), gamma}, {WeibullFit(x), weibull}} {
+		for j := range r[0].Est {
+			if math.Abs(r[0].Est[j]/r[1].Est[j]-1) > 1e-5 {
+				t.Error()
+				fmt.Println(i, j, r[0].Est[j], r[1].Est[j])
+			}
+		}
+		// the Newton estimates are the maximum
+		if r[0].LnL < r[1].LnL-1e-9 {
+			t.Error()
+			fmt.Println(i, r[0].LnL, r[1].LnL)
+		}
+	}
+}
+
+// test that the fits recover the parameters of large samples, within 4 standard errors
+func TestFitRecover(t *testing.T) {
+	fmt.Println("test of maximum-likelihood fitting: recovery of the parameters")
+	const n = 3000
+	cont := func(d Continuous) []float64 {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = d.Rand()
+		}
+		return x
+	}
+	disc := func(d Discrete) []int64 {
+		k := make([]int64, n)
+		for i := range k {
+			k[i] = d.Rand()
+		}
+		return k
+	}
+	tests := []struct {
+		name string
+		r    MLE
+		want []float64
+	}{
+		{"Gamma", GammaFit(cont(GammaDist{2.5, 1.5})), []float64{2.5, 1.5}},
+		{"Weibull", WeibullFit(cont(WeibullDist{1.7, 3})), []float64{1.7, 3}},
+		{"Beta", BetaFit(cont(BetaDist{2, 5})), []float64{2, 5}},
+		{"Logistic", LogisticFit(cont(LogisticDist{1, 2})), []float64{1, 2}},
+		{"GEV", GEVFit(cont(GEVDist{1, 2, 0.2})), []float64{1, 2, 0.2}},
+		{"Weibull3", Weibull3Fit(cont(Weibull3Dist{2, 3, 1})), []float64{2, 3, 1}},
+		{"VonMises", VonMisesFit(cont(VonMisesDist{3, 2})), []float64{3, 2}},
+		{"NoncentralStudentsT", NoncentralStudentsTFit(cont(NoncentralStudentsTDist{10, 1.5})), []float64{10, 1.5}},
+		{"NegBinomial", NegBinomialFit(3, disc(NegBinomialDist{0.6, 3})), []float64{0.6}},
+		{"BetaBinomial", BetaBinomialFit(12, disc(BetaBinomialDist{12, 2, 3})), []float64{2, 3}},
+		{"ZIPoisson", ZIPoissonFit(disc(ZIPoissonDist{0.3, 3})), []float64{0.3, 3}},
+	}
+	for _, tt := range tests {
+		for j, w := range tt.want {
+			if math.Abs(tt.r.Est[j]-w) > 4*tt.r.StdErr[j] {
+				t.Error()
+				fmt.Println(tt.name, j, tt.r.Est[j], w, tt.r.StdErr[j])
+			}
+		}
+	}
+	// integer parameters
+	if m := HypergeometricFit(100, 20, disc(HypergeometricDist{100, 30, 20})).Est[0]; math.Abs(m-30) > 1 {
+		t.Error()
+		fmt.Println(m)
+	}
+	if d := ChiSquareFit(cont(ChiSquareDist{7})).Est[0]; d != 7 {
+		t.Error()
+		fmt.Println(d)
+	}
+}
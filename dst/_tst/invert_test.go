@@ -0,0 +1,172 @@
+// test of the numerical inversion of CDFs
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+var invertProbs = []float64{1e-300, 1e-12, 1e-6, 0.001, 0.05, 0.3, 0.5, 0.7, 0.95, 0.999, 1 - 1e-6, 1 - 1e-12}
+
+// test that the continuous quantiles invert the CDF, down to the precision of float64 in the tails
+func TestInvertCDF(t *testing.T) {
+	fmt.Println("test of InvertCDF")
+	tests := []struct {
+		name     string
+		qtl, cdf func(x float64) float64
+	}{
+		{"Beta(2, 3)", BetaQtl(2, 3), BetaCDF(2, 3)},
+		{"Beta(0.1, 0.1)", BetaQtl(0.1, 0.1), BetaCDF(0.1, 0.1)},
+		{"Beta(50, 0.5)", BetaQtl(50, 0.5), BetaCDF(50, 0.5)},
+		{"Betaμν(0.2, 30)", BetaμνQtl(0.2, 30), BetaμνCDF(0.2, 30)},
+		{"Beta4(3, 1.5, -2, 5)", Beta4Qtl(3, 1.5, -2, 5), Beta4CDF(3, 1.5, -2, 5)},
+		{"InvGamma(3, 2)", InvGammaQtl(3, 2), InvGammaCDFTail(3, 2, true, false)},
+		{"ParetoTap(1, 1.5, 100)", ParetoTapQtl(1, 1.5, 100), ParetoTapCDF(1, 1.5, 100)},
+		{"Planck(2, 1)", PlanckQtl(2, 1), PlanckCDF(2, 1)},
+		{"Normal, by Brent", func(p float64) float64 { x, _ := InvertCDF(ZCDF(), nil, p, math.Inf(-1), math.Inf(1)); return x }, ZCDF()},
+		{"Gumbel, by Newton", func(p float64) float64 { x, _ := QtlContinuous(GumbelDist{3, 2}, p); return x }, GumbelCDF(3, 2)},
+		{"Normal, by Newton", func(p float64) float64 { x, _ := QtlContinuous(NormalDist{0, 1}, p); return x }, NormalCDFTail(0, 1, true, false)},
+	}
+	for _, tt := range tests {
+		for _, p := range invertProbs {
+			x := tt.qtl(p)
+			// p within the CDF two ulps around x, where the CDF is steeper than the resolution of x; rounding of the CDF allowed
+			below, above := math.Nextafter(x, math.Inf(-1)), math.Nextafter(x, math.Inf(1))
+			lo, hi := tt.cdf(math.Nextafter(below, math.Inf(-1))), tt.cdf(math.Nextafter(above, math.Inf(1)))
+			if q := tt.cdf(x); math.IsNaN(x) || !(lo-1e-13*p <= p && p <= hi+1e-13*p) {
+				t.Error()
+				fmt.Println(tt.name, p, x, q)
+			}
+		}
+	}
+	x, _ := InvertCDF(ZCDF(), ZPDF(), 0.975, math.Inf(-1), math.Inf(1))
+	if y := ZQtlFor(0.975); math.Abs(x-y) > 1e-14 {
+		t.Error()
+		fmt.Println("Normal", x, y)
+	}
+}
+
+// test that the inversion reports errors rather than NaN
+func TestInvertCDFErrors(t *testing.T) {
+	fmt.Println("test of InvertCDF: errors")
+	cdf := func(x float64) float64 { return 0.5 * ZCDFAt(x) }
+	if _, err := InvertCDF(ZCDF(), nil, 1.5, math.Inf(-1), math.Inf(1)); err != ErrQtlProb {
+		t.Error()
+		fmt.Println(err)
+	}
+	if _, err := InvertCDF(cdf, nil, 0.7, math.Inf(-1), math.Inf(1)); err != ErrQtlBracket {
+		t.Error()
+		fmt.Println(err)
+	}
+	if _, err := InvertCDF(cdf, nil, 0.7, -1, 1); err != ErrQtlBracket {
+		t.Error()
+		fmt.Println(err)
+	}
+	if _, err := InvertCDFDiscrete(func(k int64) float64 { return 0.5 }, 0.7, 0, math.MaxInt64, 3, 1, 0); err != ErrQtlBracket {
+		t.Error()
+		fmt.Println(err)
+	}
+	if _, err := QtlDiscrete(PoissonDist{3}, math.NaN()); err != ErrQtlProb {
+		t.Error()
+		fmt.Println(err)
+	}
+}
+
+// test that the discrete quantiles without a solution report the error, or panic with it
+func TestQtlDiscreteInvalid(t *testing.T) {
+	fmt.Println("test of the discrete quantiles without a solution")
+	tests := []struct {
+		name string
+		qtl  func(p float64) (int64, error)
+		p    float64
+		err  error
+	}{
+		{"Poisson(3)", PoissonQtlErr(3), math.NaN(), ErrQtlProb},
+		{"Poisson(-1)", PoissonQtlErr(-1), 0.5, ErrQtlParam},
+		{"Geometric(0.3)", GeometricQtlErr(0.3), 1.5, ErrQtlProb},
+		{"Geometric(0)", GeometricQtlErr(0), 0.5, ErrQtlParam},
+		{"Yule(1.5)", YuleQtlErr(1.5), -0.5, ErrQtlProb},
+		{"Yule(0)", YuleQtlErr(0), 0.5, ErrQtlParam},
+		{"Zeta(2.5)", ZetaQtlErr(2.5), math.NaN(), ErrQtlProb},
+		{"Zeta(1)", ZetaQtlErr(1), 0.5, ErrQtlParam},
+		{"QtlDiscrete", func(p float64) (int64, error) { return QtlDiscrete(PoissonDist{3}, p) }, 2, ErrQtlProb},
+	}
+	for _, tt := range tests {
+		if k, err := tt.qtl(tt.p); err != tt.err {
+			t.Error()
+			fmt.Println(tt.name, k, err)
+		}
+	}
+	recovered := func(qtl func() int64) (r interface{}) {
+		defer func() { r = recover() }()
+		qtl()
+		return nil
+	}
+	mix := MixtureDiscreteDist{[]float64{0.5, 0.5}, []Discrete{PoissonDist{1}, PoissonDist{10}}}
+	panics := []struct {
+		name string
+		qtl  func() int64
+		err  error
+	}{
+		{"PoissonQtl", func() int64 { return PoissonQtlFor(-1, 0.5) }, ErrQtlParam},
+		{"YuleQtl", func() int64 { return YuleQtlFor(1.5, math.NaN()) }, ErrQtlProb},
+		{"ZetaQtl", func() int64 { return ZetaQtlFor(0.5, 0.5) }, ErrQtlParam},
+		{"GeometricQtlTail", func() int64 { return GeometricQtlTail(0.3, true, true)(0.5) }, ErrQtlProb},
+		{"ZIPoissonQtlTail", func() int64 { return ZIPoissonQtlTail(0.2, -1, true, false)(0.5) }, ErrQtlParam},
+		{"BinomialQtl", func() int64 { return BinomialQtlFor(10, 1.5, 0.5) }, ErrQtlParam},
+		{"BinomialQtlTail", func() int64 { return BinomialQtlTail(10, 1.5, false, false)(0.5) }, ErrQtlParam},
+		{"BetaBinomialQtl", func() int64 { return BetaBinomialQtlFor(10, -2, 3, 0.5) }, ErrQtlParam},
+		{"NegBinomialQtl", func() int64 { return NegBinomialQtlFor(0.4, 5, 1.5) }, ErrQtlProb},
+		{"PolyaQtl", func() int64 { return PolyaQtlFor(1.4, 2.5, 0.5) }, ErrQtlParam},
+		{"Yule, QtlTail", func() int64 { return YuleDist{2}.QtlTail(math.NaN(), false, false) }, ErrQtlProb},
+		{"Hypergeometric, Qtl", func() int64 { return HypergeometricDist{50, 60, 10}.Qtl(0.5) }, ErrQtlParam},
+		{"Mixture, Qtl", func() int64 { return mix.Qtl(-1) }, ErrQtlProb},
+		{"Choice, Qtl", func() int64 { return ChoiceDist{[]float64{0.5, 0.5}}.Qtl(2) }, ErrQtlProb},
+	}
+	for _, tt := range panics {
+		if r := recovered(tt.qtl); r != tt.err {
+			t.Error()
+			fmt.Println(tt.name, r)
+		}
+	}
+}
+
+// test that the discrete quantiles are the smallest k with CDF(k) ≥ p, and agree with the former search
+func TestInvertCDFDiscrete(t *testing.T) {
+	fmt.Println("test of InvertCDFDiscrete")
+	tests := []struct {
+		name string
+		qtl  func(p float64) int64
+		cdf  func(k int64) float64
+		a    int64
+	}{
+		{"Poisson(3.5)", PoissonQtl(3.5), PoissonCDFTail(3.5, true, false), 0},
+		{"Poisson(1e4)", PoissonQtl(1e4), PoissonCDFTail(1e4, true, false), 0},
+		{"Geometric(0.3)", GeometricQtl(0.3), GeometricCDFTail(0.3, true, false), 0},
+		{"Geometric(1e-9)", GeometricQtl(1e-9), GeometricCDFTail(1e-9, true, false), 0},
+		{"Yule(1.5)", YuleQtl(1.5), YuleCDFTail(1.5, true, false), 1},
+		{"Yule(4.5)", YuleQtl(4.5), YuleCDFTail(4.5, true, false), 1},
+		{"Zeta(2.5)", ZetaQtl(2.5), ZetaCDFTail(2.5, true, false), 1},
+		{"Zeta(6)", ZetaQtl(6), ZetaCDFTail(6, true, false), 1},
+		{"Hypergeometric(500, 120, 80)", func(p float64) int64 { return int64(HypergeometricQtlFor(500, 120, 80, p)) }, HypergeometricCDFTail(500, 120, 80, true, false), 0},
+		{"Poisson, by QtlDiscrete", func(p float64) int64 { k, _ := QtlDiscrete(PoissonDist{20}, p); return k }, PoissonCDFTail(20, true, false), 0},
+	}
+	for _, tt := range tests {
+		for _, p := range invertProbs[1:] {
+			k := tt.qtl(p)
+			fuzz := p * (1 - 1e-12)
+			if tt.cdf(k) < fuzz || (k > tt.a && tt.cdf(k-1) >= p) {
+				t.Error()
+				fmt.Println(tt.name, p, k, tt.cdf(k))
+			}
+		}
+	}
+	// the exact values of the CDF of the Geometric distribution, where the closed form rounds across the integers
+	for k := int64(0); k < 200; k++ {
+		if q := GeometricQtlFor(0.1, GeometricCDFAt(0.1, k)); q != k {
+			t.Error()
+			fmt.Println("Geometric", k, q)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// test of the parameters from the mean, the mode and the quantiles
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test that the distributions have the given mean and standard deviation
+func TestFromMeanStd(t *testing.T) {
+	fmt.Println("test of parameters from mean and standard deviation")
+	type tc struct {
+		name string
+		d    Continuous
+		μ, σ float64
+	}
+	tests := []tc{
+		{"Normal", NormalFromMeanStd(1, 2), 1, 2},
+		{"LogNormal", LogNormalFromMeanStd(3, 2), 3, 2},
+		{"Gamma", GammaFromMeanStd(3, 2), 3, 2},
+		{"InvGamma", InvGammaFromMeanStd(3, 2), 3, 2},
+		{"Beta", BetaFromMeanStd(0.3, 0.1), 0.3, 0.1},
+		{"Betaμν", BetaμνFromMeanStd(0.3, 0.1), 0.3, 0.1},
+		{"Uniform", UniformFromMeanStd(3, 2), 3, 2},
+		{"Weibull", WeibullFromMeanStd(3, 2), 3, 2},
+		{"Weibull", WeibullFromMeanStd(3, 6), 3, 6},
+		{"Gumbel", GumbelFromMeanStd(3, 2), 3, 2},
+		{"GumbelMin", GumbelMinFromMeanStd(3, 2), 3, 2},
+		{"Logistic", LogisticFromMeanStd(3, 2), 3, 2},
+		{"Pareto", ParetoFromMeanStd(3, 2), 3, 2},
+		{"ParetoSing", ParetoSingFromMeanStd(3, 2), 3, 2},
+		{"ParetoII", ParetoIIFromMeanStd(3, 4), 3, 4},
+		{"NoncentralChiSquare", NoncentralChiSquareFromMeanStd(5, 4), 5, 4},
+	}
+	for _, tt := range tests {
+		if !check(tt.d.Mean(), tt.μ) || !check(math.Sqrt(tt.d.Var()), tt.σ) {
+			t.Error()
+			fmt.Println(tt.name, tt.d, tt.d.Mean(), math.Sqrt(tt.d.Var()))
+		}
+	}
+	for _, d := range []Discrete{PolyaFromMeanStd(3, 2), BetaBinomialFromMeanStd(10, 3, 2)} {
+		if !check(d.Mean(), 3) || !check(math.Sqrt(d.Var()), 2) {
+			t.Error()
+			fmt.Println(d, d.Mean(), math.Sqrt(d.Var()))
+		}
+	}
+	// no member matches
+	if !math.IsNaN(BetaFromMeanStd(0.5, 0.6).Alpha) || !math.IsNaN(ParetoIIFromMeanStd(3, 2).Alpha) || !math.IsNaN(PolyaFromMeanStd(3, 1).R) {
+		t.Error()
+	}
+}
+
+// test that the distributions have the given mode and standard deviation
+func TestFromModeStd(t *testing.T) {
+	fmt.Println("test of parameters from mode and standard deviation")
+	ln := LogNormalFromModeStd(2, 1.5)
+	g := GammaFromModeStd(2, 1.5)
+	ig := InvGammaFromModeStd(2, 1.5)
+	b := BetaFromModeStd(0.2, 0.1)
+	bu := BetaFromModeStd(0.02, 0.25)
+	w := WeibullFromModeStd(2, 1.5)
+	wl := WeibullFromModeStd(2, 10)
+	p := ParetoFromModeStd(2, 1.5)
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{LogNormalMode(ln.Mu, ln.Sigma), 2},
+		{math.Sqrt(ln.Var()), 1.5},
+		{GammaMode(g.Alpha, g.Theta), 2},
+		{math.Sqrt(g.Var()), 1.5},
+		{InvGammaMode(ig.Alpha, ig.Beta), 2},
+		{math.Sqrt(ig.Var()), 1.5},
+		{BetaMode(b.Alpha, b.Beta), 0.2},
+		{math.Sqrt(b.Var()), 0.1},
+		{BetaMode(bu.Alpha, bu.Beta), 0.02},
+		{math.Sqrt(bu.Var()), 0.25},
+		{WeibullMode(w.Kappa, w.Lambda), 2},
+		{math.Sqrt(w.Var()), 1.5},
+		{WeibullMode(wl.Kappa, wl.Lambda), 2},
+		{math.Sqrt(wl.Var()), 10},
+		{ParetoMode(p.Theta, p.Alpha), 2},
+		{math.Sqrt(p.Var()), 1.5},
+		{GumbelFromModeStd(2, 1.5).Mu, 2},
+		{LogisticFromModeStd(2, 1.5).Mean(), 2},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+	// the Uniform distribution has the largest standard deviation of the Beta distributions with a mode
+	if !math.IsNaN(BetaFromModeStd(0.5, 0.3).Alpha) {
+		t.Error()
+	}
+}
+
+// test that the quantile matching recovers the distributions from as many of their quantiles as they have parameters
+func TestMatchQtls(t *testing.T) {
+	fmt.Println("test of parameters from quantiles")
+	p2 := []float64{0.1, 0.9}
+	p3 := []float64{0.1, 0.5, 0.9}
+	p4 := []float64{0.05, 0.3, 0.7, 0.95}
+	type tc struct {
+		d     Continuous
+		match func(p, x []float64) Continuous
+		p     []float64
+	}
+	tests := []tc{
+		{NormalDist{1, 2}, func(p, x []float64) Continuous { return NormalMatchQtls(p, x) }, p2},
+		{LogNormalDist{1, 0.5}, func(p, x []float64) Continuous { return LogNormalMatchQtls(p, x) }, p2},
+		{GammaDist{2, 3}, func(p, x []float64) Continuous { return GammaMatchQtls(p, x) }, p2},
+		{InvGammaDist{3, 2}, func(p, x []float64) Continuous { return InvGammaMatchQtls(p, x) }, p2},
+		{BetaDist{2.67, 7.37}, func(p, x []float64) Continuous { return BetaMatchQtls(p, x) }, []float64{0.5, 0.9}},
+		{BetaμσDist{0.3, 0.1}, func(p, x []float64) Continuous { return BetaμσMatchQtls(p, x) }, p2},
+		{UniformDist{1, 3}, func(p, x []float64) Continuous { return UniformMatchQtls(p, x) }, p2},
+		{WeibullDist{1.5, 3}, func(p, x []float64) Continuous { return WeibullMatchQtls(p, x) }, p2},
+		{GumbelMinDist{1, 2}, func(p, x []float64) Continuous { return GumbelMinMatchQtls(p, x) }, p2},
+		{CauchyDist{1, 2}, func(p, x []float64) Continuous { return CauchyMatchQtls(p, x) }, p2},
+		{LevyDist{1, 2}, func(p, x []float64) Continuous { return LevyMatchQtls(p, x) }, p2},
+		{ExponentialDist{2}, func(p, x []float64) Continuous { return ExponentialMatchQtls(p, x) }, p2},
+		{StudentsTDist{4}, func(p, x []float64) Continuous { return StudentsTMatchQtls(p, x) }, p2},
+		{ParetoIIDist{2, 4}, func(p, x []float64) Continuous { return ParetoIIMatchQtls(p, x) }, p2},
+		{NoncentralChiSquareDist{4, 2}, func(p, x []float64) Continuous { return NoncentralChiSquareMatchQtls(p, x) }, p2},
+		{Beta4Dist{2, 3, 1, 5}, func(p, x []float64) Continuous { return Beta4MatchQtls(p, x) }, p4},
+		{Weibull3Dist{2, 3, 1}, func(p, x []float64) Continuous { return Weibull3MatchQtls(p, x) }, p3},
+		{GEVDist{1, 2, 0.2}, func(p, x []float64) Continuous { return GEVMatchQtls(p, x) }, p3},
+		{SkewNormalDist{1, 2, 3}, func(p, x []float64) Continuous { return SkewNormalMatchQtls(p, x) }, p3},
+	}
+	for i, tt := range tests {
+		x := make([]float64, len(tt.p))
+		for j, p := range tt.p {
+			x[j] = tt.d.Qtl(p)
+		}
+		// the quantiles between those matched
+		d := tt.match(tt.p, x)
+		if !check(d.Qtl(0.25), tt.d.Qtl(0.25)) || !check(d.Qtl(0.75), tt.d.Qtl(0.75)) {
+			t.Error()
+			fmt.Println(i, tt.d, d)
+		}
+	}
+	// more quantiles than parameters, from the same distribution, and too few
+	p := []float64{0.05, 0.25, 0.5, 0.75, 0.95}
+	x := make([]float64, len(p))
+	for i, v := range p {
+		x[i] = GammaQtlFor(2, 3, v)
+	}
+	if d := GammaMatchQtls(p, x); !check(d.Alpha, 2) || !check(d.Theta, 3) {
+		t.Error()
+		fmt.Println(d)
+	}
+	if !math.IsNaN(NormalMatchQtls([]float64{0.5}, []float64{1}).Mu) {
+		t.Error()
+	}
+}
@@ -0,0 +1,52 @@
+// test of the mixture distributions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against the weighted sums of the components
+func TestMixture(t *testing.T) {
+	fmt.Println("test of mixture distributions")
+	n1, n2 := NormalDist{-2, 1}, NormalDist{3, 0.5}
+	m := MixtureDist{[]float64{3, 7}, []Continuous{n1, n2}}
+	p1, p2 := PoissonDist{1}, PoissonDist{10}
+	md := MixtureDiscreteDist{[]float64{0.5, 0.5}, []Discrete{p1, p2}}
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{m.PDF(0.5), 0.3*n1.PDF(0.5) + 0.7*n2.PDF(0.5)},
+		{m.CDF(0.5), 0.3*n1.CDF(0.5) + 0.7*n2.CDF(0.5)},
+		{m.Mean(), 0.3*-2 + 0.7*3},
+		{m.Var(), 0.3*(1+4) + 0.7*(0.25+9) - 1.5*1.5},
+		// far in the tails, where the densities underflow
+		{m.LnPDF(-60), math.Log(0.3) + n1.LnPDF(-60)},
+		{m.LnCDF(-60), math.Log(0.3) + n1.LnCDF(-60)},
+		{m.QtlTail(math.Log(0.3)+n1.LnCDF(-60), true, true), -60},
+		// a single component
+		{MixtureDist{[]float64{1}, []Continuous{n1}}.Qtl(0.3), n1.Qtl(0.3)},
+		{md.PMF(3), 0.5*p1.PMF(3) + 0.5*p2.PMF(3)},
+		{md.Var(), 0.5*(1+1) + 0.5*(10+100) - 5.5*5.5},
+		{md.ExKurt(), MixtureDiscreteDist{[]float64{0.5, 0.5}, []Discrete{p2, p1}}.ExKurt()},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// the moments of a mixture should match those computed from its density
+func TestMixtureMoments(t *testing.T) {
+	fmt.Println("test of mixture distributions: moments")
+	m := MixtureDist{[]float64{0.3, 0.7}, []Continuous{GammaDist{2, 1}, NormalDist{6, 2}}}
+	d := TruncDist{m, math.Inf(-1), math.Inf(1)}
+	if !check(m.Mean(), d.Mean()) || !check(m.Var(), d.Var()) || !check(m.Skew(), d.Skew()) || !check(m.ExKurt(), d.ExKurt()) {
+		t.Error()
+		fmt.Println(m.Mean(), d.Mean(), m.Var(), d.Var(), m.Skew(), d.Skew(), m.ExKurt(), d.ExKurt())
+	}
+}
@@ -0,0 +1,119 @@
+// test of the Multivariate normal and Student's t distributions
+package dst
+
+import (
+	"fmt"
+	. "github.com/skelterjohn/go.matrix"
+	"math"
+	"testing"
+)
+
+// test the log-densities against the closed forms in two dimensions, and against the univariate distributions
+func TestMVLnPDF(t *testing.T) {
+	fmt.Println("test of Multivariate normal and Student's t distributions: LnPDF")
+	μ := MakeDenseMatrix([]float64{1, -1}, 2, 1)
+	Σ := MakeDenseMatrix([]float64{2, 0.6, 0.6, 1}, 2, 2)
+	x := MakeDenseMatrix([]float64{0.3, 0.2}, 2, 1)
+	// (x-μ)ᵀ Σ⁻¹ (x-μ), det Σ = 1.64
+	d0, d1 := 0.3-1, 0.2+1
+	q := (d0*d0*1 - 2*0.6*d0*d1 + d1*d1*2) / 1.64
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{MVNormalLnPDF(μ, Σ)(x), -math.Log(2*math.Pi) - math.Log(1.64)/2 - q/2},
+		{MVNormalPDFAt(μ, Σ, x), math.Exp(-q/2) / (2 * math.Pi * math.Sqrt(1.64))},
+		// bivariate Cauchy
+		{MVStudentsTPDFAt(μ, Σ, 1, x), math.Pow(1+q, -1.5) / (2 * math.Pi * math.Sqrt(1.64))},
+		// Γ(7/2) / Γ(5/2) / 5 = 1/2
+		{MVStudentsTLnPDF(μ, Σ, 5)(x), math.Log(math.Pow(1+q/5, -3.5) / (2 * math.Pi * math.Sqrt(1.64)))},
+		// one dimension
+		{MVStudentsTPDFAt(MakeDenseMatrix([]float64{2}, 1, 1), MakeDenseMatrix([]float64{9}, 1, 1), 4.5, MakeDenseMatrix([]float64{-1}, 1, 1)), StudentsTPDF(4.5)(-1) / 3},
+		{MVNormalLnPDF(MakeDenseMatrix([]float64{2}, 1, 1), MakeDenseMatrix([]float64{9}, 1, 1))(MakeDenseMatrix([]float64{-1}, 1, 1)), NormalDist{2, 3}.LnPDF(-1)},
+		// Multivariate normal as ν → ∞
+		{MVStudentsTLnPDF(μ, Σ, 1e9)(x), MVNormalLnPDF(μ, Σ)(x)},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// test the probabilities of rectangles against the orthant probabilities and products of the univariate probabilities
+func TestMVNormalCDF(t *testing.T) {
+	fmt.Println("test of Multivariate normal distribution: CDF")
+	inf := math.Inf(1)
+	type tc struct {
+		x, y float64
+	}
+	var tests []tc
+	for _, ρ := range []float64{-0.3, 0.5, 0.9} {
+		Σ := MakeDenseMatrix([]float64{1, ρ, ρ, 1}, 2, 2)
+		tests = append(tests, tc{MVNormalCDFAt(Zeros(2, 1), Σ, Zeros(2, 1)), 0.25 + math.Asin(ρ)/(2*math.Pi)})
+		Σ = MakeDenseMatrix([]float64{1, ρ, ρ, ρ, 1, ρ, ρ, ρ, 1}, 3, 3)
+		tests = append(tests, tc{MVNormalCDFAt(Zeros(3, 1), Σ, Zeros(3, 1)), 0.125 + 3*math.Asin(ρ)/(4*math.Pi)})
+	}
+	// independent components, shifted and scaled
+	μ := MakeDenseMatrix([]float64{1, -2, 0.5}, 3, 1)
+	Σ := MakeDenseMatrix([]float64{4, 0, 0, 0, 1, 0, 0, 0, 0.25}, 3, 3)
+	a := MakeDenseMatrix([]float64{-1, -inf, 0}, 3, 1)
+	b := MakeDenseMatrix([]float64{2, -1.5, inf}, 3, 1)
+	p := (NormalDist{1, 2}.CDF(2) - NormalDist{1, 2}.CDF(-1)) * NormalDist{-2, 1}.CDF(-1.5) * NormalDist{0.5, 0.5}.Surv(0)
+	tests = append(tests, tc{MVNormalRectCDF(μ, Σ)(a, b), p})
+	// equicorrelated with ρ = 1/2, P[X ≤ 0] = 1 / (k+1)
+	el := make([]float64, 36)
+	for i := range el {
+		el[i] = 0.5
+		if i%7 == 0 {
+			el[i] = 1
+		}
+	}
+	tests = append(tests, tc{MVNormalCDFAt(Zeros(6, 1), MakeDenseMatrix(el, 6, 6), Zeros(6, 1)), 1.0 / 7})
+	for i, tt := range tests {
+		if math.Abs(tt.x-tt.y) > 1e-4 {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+	_, e := MVNormalRectCDFErr(μ, Σ, a, b)
+	if e > 1e-5 {
+		t.Error()
+		fmt.Println(e)
+	}
+}
+
+// test the mean and covariance of the samples of the Multivariate Student's t distribution
+func TestMVStudentsTNext(t *testing.T) {
+	fmt.Println("test of Multivariate Student's t distribution: Next")
+	μ := MakeDenseMatrix([]float64{1, -1}, 2, 1)
+	Σ := MakeDenseMatrix([]float64{2, 0.6, 0.6, 1}, 2, 2)
+	ν := 8.0
+	next := MVStudentsT(μ, Σ, ν)
+	const n = 200000
+	var m [2]float64
+	var c [2][2]float64
+	for k := 0; k < n; k++ {
+		x := next()
+		for i := 0; i < 2; i++ {
+			m[i] += x.Get(i, 0)
+			for j := 0; j < 2; j++ {
+				c[i][j] += (x.Get(i, 0) - μ.Get(i, 0)) * (x.Get(j, 0) - μ.Get(j, 0))
+			}
+		}
+	}
+	V := MVStudentsTVar(μ, Σ, ν)
+	for i := 0; i < 2; i++ {
+		if math.Abs(m[i]/n-μ.Get(i, 0)) > 0.02 {
+			t.Error()
+			fmt.Println(i, m[i]/n, μ.Get(i, 0))
+		}
+		for j := 0; j < 2; j++ {
+			if math.Abs(c[i][j]/n-V.Get(i, j)) > 0.05*V.Get(i, i) {
+				t.Error()
+				fmt.Println(i, j, c[i][j]/n, V.Get(i, j))
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// test of the noncentral distributions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values and identities
+func TestNoncentral(t *testing.T) {
+	fmt.Println("test of noncentral distributions")
+	Φ := NormalDist{0, 1}.CDF
+	chi := NoncentralChiSquareDist{1, 4}
+	nt := NoncentralStudentsTDist{10, 1.5}
+	nf := NoncentralFDist{3, 12, 5}
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		// (Z + 2)²
+		{chi.CDF(5), Φ(math.Sqrt(5)-2) - Φ(-math.Sqrt(5)-2)},
+		{chi.Surv(100), NormalDist{0, 1}.Surv(8) + NormalDist{0, 1}.Surv(12)},
+		{chi.LnCDF(1e-12), math.Log(2e-6 * NormalDist{0, 1}.PDF(2))},
+		{NoncentralChiSquareDist{5, 1e4}.Mean(), 10005},
+		// by numerical integration over the Chi-Squared denominator
+		{nt.CDF(-2), 0.0007347489962542887},
+		{nt.CDF(1), 0.30410932892284553},
+		{nt.PDF(1), 0.3410749319260578},
+		{nt.PDF(3), 0.1383875359798328},
+		{nt.CDF(0), Φ(-1.5)},
+		{nt.Mean(), 1.5 * math.Sqrt(5) * math.Gamma(4.5) / math.Gamma(5)},
+		// F(1, ν, δ²) is the square of t(ν, δ)
+		{NoncentralFDist{1, 10, 2.25}.CDF(4), nt.CDF(2) - nt.CDF(-2)},
+		// ν1 X / (ν1 X + ν2) is noncentral Beta
+		{nf.CDF(2), NoncentralBetaDist{1.5, 6, 5}.CDF(6.0 / 18)},
+		{nf.LnSurv(1e3), NoncentralBetaDist{1.5, 6, 5}.LnSurv(3e3 / (3e3 + 12))},
+		{nf.Mean(), 3.2},
+		{nf.Var(), 7.76},
+		// no noncentrality
+		{NoncentralChiSquareDist{4, 0}.CDF(3), ChiSquareDist{4}.CDF(3)},
+		{NoncentralStudentsTDist{5, 0}.CDF(1.3), StudentsTDist{5}.CDF(1.3)},
+		{NoncentralFDist{3, 12, 0}.Surv(2), FDist{3, 12}.Surv(2)},
+		{NoncentralBetaDist{2, 3, 0}.PDF(0.4), BetaDist{2, 3}.PDF(0.4)},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// test of the tails of the noncentral Student's t distribution, computed directly
+func TestNoncentralStudentsTTail(t *testing.T) {
+	fmt.Println("test of noncentral Student's t tails")
+	nt := NoncentralStudentsTDist{10, 1.5}
+	// P[T < -x] ~ C x^-ν E[(Z - δ)₊^ν] as x → ∞, with C = (ν/2)^(ν/2) / Γ(ν/2 + 1)
+	lnTail := func(ν, δ, x float64) float64 {
+		const n = 4000
+		h := 40.0 / n
+		e := 0.0
+		for i := 0; i <= n; i++ {
+			u := float64(i) * h
+			w := 2.0 + 2*float64(i%2)
+			if i == 0 || i == n {
+				w = 1
+			}
+			e += w * math.Pow(u, ν) * NormalDist{0, 1}.PDF(u+δ)
+		}
+		lnC, _ := math.Lgamma(ν/2 + 1)
+		return ν/2*math.Log(ν/2) - lnC - ν*math.Log(x) + math.Log(e*h/3)
+	}
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{nt.LnCDF(-1e5), lnTail(10, 1.5, 1e5)},
+		{nt.LnSurv(1e5), lnTail(10, -1.5, 1e5)},
+		{nt.LnPDF(-1e5), math.Log(10/1e5) + lnTail(10, 1.5, 1e5)},
+		// by numerical integration over the Chi-Squared denominator
+		{nt.LnCDF(-1e3), -65.14150427038638},
+		{nt.LnCDF(-40), -32.97118417247035},
+		{nt.LnSurv(30), -20.342135737842465},
+		{NoncentralStudentsTDist{1, 10}.LnCDF(0.5), -42.42893927540449},
+		{NoncentralStudentsTDist{1, 10}.LnPDF(-50), -63.602970633416575},
+		{NoncentralStudentsTDist{0.5, -3}.LnSurv(50), -9.555473822768043},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+	for _, d := range []NoncentralStudentsTDist{nt, {1, 10}, {0.5, -3}} {
+		for _, lowerTail := range []bool{true, false} {
+			x := d.QtlTail(-40, lowerTail, true)
+			if p := NoncentralStudentsTCDFTail(d.Nu, d.Delta, lowerTail, true)(x); !check(p, -40) {
+				t.Error()
+				fmt.Println(d, lowerTail, x, p)
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// test of the quasi-random sequences
+package dst
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// test the first points against those of the Sobol sequence of Joe and Kuo (as scipy.stats.qmc.Sobol) and of the Halton sequence
+func TestQuasiPoints(t *testing.T) {
+	fmt.Println("test of quasi-random sequences: points")
+	sobol := [][]float64{
+		{0.5, 0.5, 0.5},
+		{0.75, 0.25, 0.25},
+		{0.25, 0.75, 0.75},
+		{0.375, 0.375, 0.625},
+		{0.875, 0.875, 0.125},
+		{0.625, 0.125, 0.875},
+		{0.125, 0.625, 0.375},
+	}
+	halton := [][]float64{
+		{0.5, 1.0 / 3, 0.2},
+		{0.25, 2.0 / 3, 0.4},
+		{0.75, 1.0 / 9, 0.6},
+		{0.125, 4.0 / 9, 0.8},
+		{0.625, 7.0 / 9, 0.04},
+	}
+	for name, tt := range map[string]struct {
+		next func() []float64
+		want [][]float64
+	}{"Sobol": {Sobol(3), sobol}, "Halton": {Halton(3), halton}} {
+		for i, w := range tt.want {
+			u := tt.next()
+			for j := range w {
+				if !check(u[j], w[j]) {
+					t.Error()
+					fmt.Println(name, i, j, u[j], w[j])
+				}
+			}
+		}
+	}
+}
+
+// test that the first 2^m scrambled Sobol points stay a (0, m, 2)-net in their first two coordinates:
+// every box [i/2^k, (i+1)/2^k) × [j/2^(m-k), (j+1)/2^(m-k)) holds one point
+func TestSobolOwenNet(t *testing.T) {
+	fmt.Println("test of quasi-random sequences: scrambled net")
+	const m = 8
+	next := SobolOwen(rand.New(rand.NewSource(1)), 2)
+	var u [][]float64
+	for i := 0; i < 1<<m; i++ {
+		u = append(u, next())
+	}
+	for k := uint(0); k <= m; k++ {
+		boxes := make(map[[2]int]bool)
+		for _, x := range u {
+			boxes[[2]int{int(x[0] * float64(int(1)<<k)), int(x[1] * float64(int(1)<<(m-k)))}] = true
+		}
+		if len(boxes) != 1<<m {
+			t.Error()
+			fmt.Println(k, len(boxes))
+		}
+	}
+}
+
+// test the quasi-Monte Carlo integration of E[exp(Σ zi/√d)] = √e over independent standard normal zi,
+// and of E[x y] = α/(α+β) k θ over x ~ Beta(α, β) and y ~ Gamma(k, θ)
+func TestQuasiQtl(t *testing.T) {
+	fmt.Println("test of quasi-random sequences: integration")
+	const (
+		d = 5
+		n = 1 << 14
+	)
+	// the Monte Carlo error would be about 0.013; the unscrambled Halton points converge the slowest
+	for name, tt := range map[string]struct {
+		next func() []float64
+		tol  float64
+	}{
+		"Sobol":      {Sobol(d), 0.003},
+		"Halton":     {Halton(d), 0.013},
+		"SobolOwen":  {SobolOwen(rand.New(rand.NewSource(1)), d), 0.003},
+		"HaltonOwen": {HaltonOwen(rand.New(rand.NewSource(2)), d), 0.003},
+	} {
+		z := QuasiQtl(tt.next, NormalQtl(0, 1))
+		s := 0.0
+		for i := 0; i < n; i++ {
+			x := z()
+			v := 0.0
+			for _, zi := range x {
+				v += zi
+			}
+			s += math.Exp(v / math.Sqrt(d))
+		}
+		if e := math.Abs(s/n - math.Sqrt(math.E)); e > tt.tol {
+			t.Error()
+			fmt.Println(name, e)
+		}
+	}
+	xy := QuasiQtl(SobolOwen(rand.New(rand.NewSource(3)), 2), BetaQtl(2, 3), GammaQtl(3, 1.5))
+	s := 0.0
+	for i := 0; i < n; i++ {
+		x := xy()
+		s += x[0] * x[1]
+	}
+	if e := math.Abs(s/n - 0.4*4.5); e > 0.002 {
+		t.Error()
+		fmt.Println(e)
+	}
+}
@@ -0,0 +1,215 @@
+// test of the ziggurat, Marsaglia–Tsang and Cheng samplers
+package dst
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// chi2Bins returns the chi-square statistic of the sample x over 100 bins of equal probability of the CDF cdf; its degrees of freedom are 99
+func chi2Bins(x []float64, cdf func(x float64) float64) float64 {
+	const nb = 100
+	var count [nb]float64
+	for _, v := range x {
+		i := int(cdf(v) * nb)
+		if i >= nb {
+			i = nb - 1
+		}
+		count[i]++
+	}
+	e := float64(len(x)) / nb
+	chi2 := 0.0
+	for _, c := range count {
+		chi2 += (c - e) * (c - e) / e
+	}
+	return chi2
+}
+
+// ks2 returns the two-sample Kolmogorov–Smirnov statistic D of x and y, times sqrt(n m / (n + m))
+func ks2(x, y []float64) float64 {
+	sort.Float64s(x)
+	sort.Float64s(y)
+	d := 0.0
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		if x[i] <= y[j] {
+			i++
+		} else {
+			j++
+		}
+		d = math.Max(d, math.Abs(float64(i)/float64(len(x))-float64(j)/float64(len(y))))
+	}
+	n, m := float64(len(x)), float64(len(y))
+	return d * math.Sqrt(n*m/(n+m))
+}
+
+// test the samplers against their CDFs, and against the methods they replace: the ziggurats of math/rand, sums of Exponential
+// and squared Normal variates, and ratios of Gamma variates for Beta
+func TestSamplers(t *testing.T) {
+	fmt.Println("test of samplers: ziggurat, Marsaglia–Tsang, Cheng")
+	const n = 100000
+	src := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name      string
+		next, old func() float64
+		cdf       func(x float64) float64
+	}{
+		{"Normal", func() float64 { return NormalNextR(src, 1, 2) }, func() float64 { return 1 + 2*src.NormFloat64() }, NormalCDF(1, 2)},
+		{"Exponential", func() float64 { return ExponentialNextR(src, 3) }, func() float64 { return src.ExpFloat64() / 3 }, ExponentialCDF(3)},
+		{"Gamma(3)", func() float64 { return GammaNextR(src, 3, 2) },
+			func() float64 { return 2 * (src.ExpFloat64() + src.ExpFloat64() + src.ExpFloat64()) }, GammaCDF(3, 2)},
+		{"Gamma(0.3)", func() float64 { return GammaNextR(src, 0.3, 1) }, nil, GammaCDF(0.3, 1)},
+		{"Gamma(2.5)", func() float64 { return GammaNextR(src, 2.5, 1) }, nil, GammaCDF(2.5, 1)},
+		{"ChiSquare(5)", func() float64 { return ChiSquareNextR(src, 5) }, func() float64 {
+			x := 0.0
+			for i := 0; i < 5; i++ {
+				z := src.NormFloat64()
+				x += z * z
+			}
+			return x
+		}, ChiSquareCDF(5)},
+		{"Beta(0.5, 0.5)", func() float64 { return BetaNextR(src, 0.5, 0.5) }, nil, BetaCDF(0.5, 0.5)},
+		{"Beta(0.3, 4)", func() float64 { return BetaNextR(src, 0.3, 4) }, func() float64 {
+			x, y := GammaNextR(src, 0.3, 1), GammaNextR(src, 4, 1)
+			return x / (x + y)
+		}, BetaCDF(0.3, 4)},
+		{"Beta(6, 1.5)", func() float64 { return BetaNextR(src, 6, 1.5) }, func() float64 {
+			x, y := GammaNextR(src, 6, 1), GammaNextR(src, 1.5, 1)
+			return x / (x + y)
+		}, BetaCDF(6, 1.5)},
+		{"Beta(2, 2)", func() float64 { return BetaNextR(src, 2, 2) }, func() float64 {
+			x, y := GammaNextR(src, 2, 1), GammaNextR(src, 2, 1)
+			return x / (x + y)
+		}, BetaCDF(2, 2)},
+		{"Beta(300, 1.2)", func() float64 { return BetaNextR(src, 300, 1.2) }, func() float64 {
+			x, y := GammaNextR(src, 300, 1), GammaNextR(src, 1.2, 1)
+			return x / (x + y)
+		}, BetaCDF(300, 1.2)},
+	}
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for _, tt := range tests {
+		for i := range x {
+			x[i] = tt.next()
+		}
+		// 5 standard deviations above the mean
+		if chi2 := chi2Bins(x, tt.cdf); chi2 > 99+5*math.Sqrt(2*99) {
+			t.Error()
+			fmt.Println(tt.name, "chi2", chi2)
+		}
+		if tt.old == nil {
+			continue
+		}
+		for i := range y {
+			y[i] = tt.old()
+		}
+		// the 0.001 critical value
+		if d := ks2(x, y); d > 1.95 {
+			t.Error()
+			fmt.Println(tt.name, "KS", d)
+		}
+	}
+}
+
+// benchmarks of the samplers, per variate; NormFloat64 and ExpFloat64 are the ziggurats of math/rand
+
+func BenchmarkNormFloat64(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		src.NormFloat64()
+	}
+}
+
+func BenchmarkNormalNextR(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		NormalNextR(src, 0, 1)
+	}
+}
+
+func BenchmarkExpFloat64(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		src.ExpFloat64()
+	}
+}
+
+func BenchmarkExponentialNextR(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		ExponentialNextR(src, 1)
+	}
+}
+
+func BenchmarkGammaNextR03(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		GammaNextR(src, 0.3, 1)
+	}
+}
+
+func BenchmarkGammaNextR3(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		GammaNextR(src, 3, 1)
+	}
+}
+
+func BenchmarkGammaNextR25(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		GammaNextR(src, 2.5, 1)
+	}
+}
+
+func BenchmarkGammaNextR100(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		GammaNextR(src, 100, 1)
+	}
+}
+
+func BenchmarkBetaNextR05(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		BetaNextR(src, 0.5, 0.5)
+	}
+}
+
+func BenchmarkBetaNextR23(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		BetaNextR(src, 2, 3)
+	}
+}
+
+func BenchmarkChiSquareNextR10(b *testing.B) {
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		ChiSquareNextR(src, 10)
+	}
+}
+
+func BenchmarkNoncentralStudentsTLnPDF(b *testing.B) {
+	f := NoncentralStudentsTLnPDF(10, 1.5)
+	for i := 0; i < b.N; i++ {
+		f(-3 + float64(i%1000)/100)
+	}
+}
+
+func BenchmarkNoncentralStudentsTCDF(b *testing.B) {
+	f := NoncentralStudentsTCDF(10, 1.5)
+	for i := 0; i < b.N; i++ {
+		f(-3 + float64(i%1000)/100)
+	}
+}
+
+func BenchmarkNoncentralStudentsTQtl(b *testing.B) {
+	f := NoncentralStudentsTQtl(10, 1.5)
+	for i := 0; i < b.N; i++ {
+		f(float64(i%999+1) / 1000)
+	}
+}
@@ -0,0 +1,112 @@
+// test of the Skew-normal and Skew-t distributions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values
+func TestSkewNormal(t *testing.T) {
+	fmt.Println("test of Skew-normal distribution")
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		// Φ(z)² if α = 1
+		{SkewNormalCDFAt(0, 1, 1, 0.7), 0.5746191045509822},
+		{SkewNormalDist{0, 1, 1}.LnCDF(-30), 2 * NormalDist{0, 1}.LnCDF(-30)},
+		// 1/2 - atan(α) / π at the location
+		{SkewNormalCDFAt(2, 3, 3, 2), 0.10241638234956674},
+		{SkewNormalCDFAt(1, 2, 0, 0.3), NormalCDFAt(1, 2, 0.3)},
+		{SkewNormalPDFAt(1, 2, 0, 0.3), NormalPDFAt(1, 2, 0.3)},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+
+	// the derivative of the MGF at 0 is the mean
+	h := 1e-4
+	x := (SkewNormalMGF(1, 2, 4, h) - SkewNormalMGF(1, 2, 4, -h)) / (2 * h)
+	y := SkewNormalMean(1, 2, 4)
+	if !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+}
+
+// the two mirror images add up to the Student's t distribution
+func TestSkewT(t *testing.T) {
+	fmt.Println("test of Skew-t distribution")
+	for _, z := range []float64{-3, -0.5, 2} {
+		x := SkewTCDFAt(0, 1, 2, 5, z) + SkewTCDFAt(0, 1, -2, 5, z)
+		y := 2 * StudentsTCDF(5)(z)
+		if !check(x, y) {
+			t.Error()
+			fmt.Println(z, x, y)
+		}
+		x = SkewTCDFAt(0, 1, 0, 5, z)
+		y = StudentsTCDF(5)(z)
+		if !check(x, y) {
+			t.Error()
+			fmt.Println(z, x, y)
+		}
+	}
+}
+
+// the far tails, against the closed form of the Skew-Cauchy CDF, ν = 1: (atan z + acos(δ / √(1 + z²))) / π, δ = α / √(1 + α²)
+func TestSkewTTail(t *testing.T) {
+	fmt.Println("test of Skew-t distribution: tails")
+	δ := 3 / math.Sqrt(10)
+	for _, z := range []float64{-1e5, -30, -2} {
+		x := SkewTDist{0, 1, 3, 1}.LnCDF(z)
+		y := math.Log((math.Atan(-1/z) - math.Asin(δ/math.Sqrt(1+z*z))) / math.Pi)
+		if !check(x, y) {
+			t.Error()
+			fmt.Println(z, x, y)
+		}
+		x = SkewTDist{0, 1, -3, 1}.LnSurv(-z)
+		if !check(x, y) {
+			t.Error()
+			fmt.Println(-z, x, y)
+		}
+	}
+	// the Student's t distribution if α = 0
+	if x, y := (SkewTDist{1, 2, 0, 8}).LnCDF(-1e5), (StudentsTDist{8}).LnCDF((-1e5-1)/2); !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+	// the quantiles of probabilities far in both tails
+	for _, d := range []SkewTDist{{0.5, 2, 3, 8}, {0, 1, -2, 10}} {
+		for _, lowerTail := range []bool{true, false} {
+			x := d.QtlTail(-100, lowerTail, true)
+			y := SkewTCDFTail(d.Xi, d.Omega, d.Alpha, d.Nu, lowerTail, true)(x)
+			if !check(y, -100) {
+				t.Error()
+				fmt.Printf("%#v %v %v %v\n", d, lowerTail, x, y)
+			}
+		}
+	}
+}
+
+// the density should be largest at the mode
+func TestSkewMode(t *testing.T) {
+	fmt.Println("test of Skew-normal and Skew-t distributions: Mode")
+	for _, d := range []Continuous{SkewNormalDist{1, 2, 4}, SkewNormalDist{1, 2, -0.5}, SkewTDist{1, 2, 4, 5}, SkewTDist{1, 2, -0.5, 3}} {
+		var m float64
+		switch d := d.(type) {
+		case SkewNormalDist:
+			m = SkewNormalMode(d.Xi, d.Omega, d.Alpha)
+		case SkewTDist:
+			m = SkewTMode(d.Xi, d.Omega, d.Alpha, d.Nu)
+		}
+		if d.PDF(m) < d.PDF(m-1e-3) || d.PDF(m) < d.PDF(m+1e-3) {
+			t.Error()
+			fmt.Printf("%#v %v\n", d, m)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// test of the samplers with a user-supplied random source
+package dst
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// Two sources seeded alike must give the same draws.
+func TestSourceReproducible(t *testing.T) {
+	fmt.Println("test of reproducibility with a random source")
+	draw := func(src *rand.Rand) []float64 {
+		x := []float64{
+			NormalNextR(src, 0, 1),
+			GammaNextR(src, 0.5, 2),
+			GammaNextR(src, 3, 2),
+			BetaNextR(src, 2, 3),
+			StudentsTNextR(src, 5),
+			float64(PoissonNextR(src, 3.5)),
+			float64(BinomialNextR(src, 20, 0.3)),
+			float64(ChoiceNextR(src, []float64{0.2, 0.3, 0.5})),
+		}
+		gen := ExponentialR(src, 2)
+		x = append(x, gen(), gen())
+		return x
+	}
+	x := draw(rand.New(rand.NewSource(1)))
+	y := draw(rand.New(rand.NewSource(1)))
+	z := draw(rand.New(rand.NewSource(2)))
+	same := true
+	for i := range x {
+		if x[i] != y[i] {
+			fmt.Println(i, x[i], y[i])
+			t.Error()
+		}
+		if x[i] != z[i] {
+			same = false
+		}
+	}
+	if same {
+		fmt.Println("different seeds gave the same draws")
+		t.Error()
+	}
+}
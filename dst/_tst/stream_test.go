@@ -0,0 +1,93 @@
+// test of the parallel random streams
+package dst
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// test the generator against the reference implementation of xoshiro256** and of its jump, seeded by SplitMix64
+func TestXoshiro(t *testing.T) {
+	fmt.Println("test of random streams: xoshiro256**")
+	x := Xoshiro{[4]uint64{1, 2, 3, 4}}
+	for i, w := range []uint64{11520, 0, 1509978240} {
+		if u := x.Uint64(); u != w {
+			t.Error()
+			fmt.Println(i, u, w)
+		}
+	}
+	x = *NewXoshiro(42)
+	if x.s != [4]uint64{0xbdd732262feb6e95, 0x28efe333b266f103, 0x47526757130f9f52, 0x581ce1ff0e4ae394} {
+		t.Error()
+		fmt.Println(x.s)
+	}
+	if u := x.Uint64(); u != 1546998764402558742 {
+		t.Error()
+		fmt.Println(u)
+	}
+	x.Seed(42)
+	x.Jump()
+	if u := x.Uint64(); u != 5766981335298035530 {
+		t.Error()
+		fmt.Println(u)
+	}
+}
+
+// draw a task of a simulation from the stream src
+func streamTask(src *rand.Rand) float64 {
+	x := make([]float64, 100)
+	GammaFillR(src, 0.7, 2, x)
+	d := MixtureDist{[]float64{0.3, 0.7}, []Continuous{NormalDist{-2, 1}, TruncDist{ExponentialDist{1}, 1, 3}}}
+	s := 0.0
+	for _, v := range x {
+		s += v + d.RandR(src) + float64(PoissonNextR(src, 40))
+	}
+	return s
+}
+
+// test that tasks run in parallel on the streams give the same results as run sequentially, and that the streams differ
+func TestStreams(t *testing.T) {
+	fmt.Println("test of random streams: parallel tasks")
+	const n = 16
+	var par [n]float64
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			par[i] = streamTask(Stream(7, i))
+		}(i)
+	}
+	wg.Wait()
+	s := NewStreams(7)
+	seen := make(map[float64]bool)
+	for i := 0; i < n; i++ {
+		if v := streamTask(s.Next()); v != par[i] || seen[v] {
+			t.Error()
+			fmt.Println(i, v, par[i])
+		}
+		seen[par[i]] = true
+	}
+
+	// the same splits of the same factory give the same streams, different from those of the parent and of the other splits
+	a, b := NewStreams(7), NewStreams(7)
+	a1, b1 := a.Split(), b.Split()
+	a2, b2 := a.Split(), b.Split()
+	a21 := a2.Split()
+	u := []int64{a.Next().Int63(), a1.Next().Int63(), a2.Next().Int63(), a21.Next().Int63()}
+	v := []int64{b.Next().Int63(), b1.Next().Int63(), b2.Next().Int63(), b2.Split().Next().Int63()}
+	for i := range u {
+		if u[i] != v[i] {
+			t.Error()
+			fmt.Println(i, u[i], v[i])
+		}
+		for j := 0; j < i; j++ {
+			if u[i] == u[j] {
+				t.Error()
+				fmt.Println(i, j, u[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// test of the upper tails and log-probabilities
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// the tails should add up to one, and the logarithms agree with the probabilities
+func TestContinuousTail(t *testing.T) {
+	fmt.Println("test of Continuous: Surv, LnCDF, LnSurv")
+	dists := append(continuousDists, CauchyDist{1, 2}, LevyDist{1, 2}, ParetoGDist{2, 3, 1.5})
+	for _, d := range dists {
+		for _, p := range []float64{0.05, 0.5, 0.95} {
+			x := d.Qtl(p)
+			cdf, surv := d.CDF(x), d.Surv(x)
+			if !check(cdf+surv, 1) || !check(d.LnCDF(x), math.Log(cdf)) || !check(d.LnSurv(x), math.Log(surv)) {
+				t.Error()
+				fmt.Printf("%#v %v %v %v %v %v\n", d, x, cdf, surv, d.LnCDF(x), d.LnSurv(x))
+			}
+		}
+	}
+}
+
+// QtlTail should invert the CDF on every tail and scale
+func TestContinuousQtlTail(t *testing.T) {
+	fmt.Println("test of Continuous: QtlTail")
+	dists := append(continuousDists, CauchyDist{1, 2}, LevyDist{1, 2}, ParetoGDist{2, 3, 1.5})
+	for _, d := range dists {
+		for _, p := range []float64{0.05, 0.5, 0.95} {
+			x := d.Qtl(p)
+			y1 := d.QtlTail(d.Surv(x), false, false)
+			y2 := d.QtlTail(d.LnCDF(x), true, true)
+			y3 := d.QtlTail(d.LnSurv(x), false, true)
+			if !check(y1, x) || !check(y2, x) || !check(y3, x) {
+				t.Error()
+				fmt.Printf("%#v %v %v %v %v\n", d, x, y1, y2, y3)
+			}
+		}
+	}
+}
+
+// far in the tails, where 1 - CDF underflows
+func TestFarTail(t *testing.T) {
+	fmt.Println("test of far tails")
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{NormalDist{0, 1}.Surv(10), 7.619853024160527e-24},
+		{NormalDist{0, 1}.LnCDF(-40), -804.6084420137538},
+		{NormalDist{0, 1}.LnSurv(NormalDist{0, 1}.QtlTail(-1e5, false, true)), -1e5},
+		{GammaDist{3, 2}.LnSurv(2000), math.Log(1+1000+1000*1000/2) - 1000},
+		{ExponentialDist{2}.LnSurv(1000), -2000},
+		{LogisticDist{0, 1}.Surv(100), 3.720075976020836e-44},
+		{CauchyDist{0, 1}.Surv(1e10), 3.183098861837907e-11},
+		{StudentsTDist{3}.Surv(1e5), 1.1026577908435e-15},
+		{BetaDist{2, 3}.Surv(1 - 1e-6), 4e-18 - 3e-24},
+		{ParetoDist{1, 5}.QtlTail(-500, false, true), math.Exp(100)},
+		// the CDF where 1 - Surv underflows
+		{NormalDist{0, 1}.CDF(-15), 3.6709661993127514e-51},
+		{ExponentialDist{2}.CDF(5e-31), 1e-30},
+		{LogNormalDist{0, 1}.CDF(1e-10), 1.2841756306435182e-117},
+		{InvGammaDist{3, 2}.CDF(0.01), 2.7956093736608872e-83},
+		{ParetoIIDist{2, 3}.CDF(1e-20), 1.5e-20},
+		{LevyDist{1, 2}.CDF(1.01), 2.0884875837627403e-45},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// Surv and CDF should add up to one; QtlTail should be the smallest k with P[X > k] <= p
+func TestDiscreteTail(t *testing.T) {
+	fmt.Println("test of Discrete: Surv, QtlTail")
+	for _, d := range discreteDists {
+		for _, p := range []float64{0.05, 0.5, 0.95} {
+			k := d.Qtl(p)
+			cdf, surv := d.CDF(k), d.Surv(k)
+			if !check(cdf+surv, 1) || !check(d.LnCDF(k), math.Log(cdf)) || (surv > 0 && !check(d.LnSurv(k), math.Log(surv))) {
+				t.Error()
+				fmt.Printf("%#v %v %v %v\n", d, k, cdf, surv)
+			}
+			if d.QtlTail(math.Log(p), true, true) != k {
+				t.Error()
+				fmt.Printf("%#v %v %v %v\n", d, p, k, d.QtlTail(math.Log(p), true, true))
+			}
+			q := 1 - p
+			k = d.QtlTail(q, false, false)
+			if d.Surv(k) > q*(1+1e-12) || d.Surv(k-1) <= q*(1-1e-12) {
+				t.Error()
+				fmt.Printf("%#v %v %v %v %v\n", d, q, k, d.Surv(k-1), d.Surv(k))
+			}
+		}
+	}
+}
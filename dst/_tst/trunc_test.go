@@ -0,0 +1,60 @@
+// test of the truncated distributions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values
+func TestTrunc(t *testing.T) {
+	fmt.Println("test of truncated distributions")
+	n := NormalDist{0, 1}
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		// half-normal
+		{TruncDist{n, 0, math.Inf(1)}.Mean(), 0.7978845608028654},
+		{TruncDist{n, 0, math.Inf(1)}.Var(), 0.3633802276324186},
+		{TruncDist{n, 0, math.Inf(1)}.PDF(1), 2 * n.PDF(1)},
+		// (φ(1) - φ(2)) / (Φ(2) - Φ(1))
+		{TruncDist{n, 1, 2}.Mean(), 1.3831690466315525},
+		{TruncDist{n, 1, 2}.Var(), 0.07274288610060164},
+		// far in the upper tail
+		{TruncDist{n, 30, math.Inf(1)}.CDF(30.01), -math.Expm1(n.LnSurv(30.01) - n.LnSurv(30))},
+		{TruncDist{n, -math.Inf(1), -30}.QtlTail(0.5, false, false), n.QtlTail(n.LnCDF(-30)-math.Ln2, true, true)},
+		// zero-truncated Poisson
+		{TruncDiscreteDist{PoissonDist{3.5}, 1, math.MaxInt64}.Mean(), 3.6089818074022992},
+		{TruncDiscreteDist{PoissonDist{3.5}, 1, math.MaxInt64}.PMF(1), PoissonDist{3.5}.PMF(1) / (1 - math.Exp(-3.5))},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// Robert's algorithm should stay within the bounds and match the moments
+func TestTruncNormalNext(t *testing.T) {
+	fmt.Println("test of truncated normal distribution: Next")
+	const n = 100000
+	for _, ab := range [][2]float64{{-1, 1}, {-0.1, 5}, {0.5, 0.7}, {3, 10}, {40, math.Inf(1)}, {math.Inf(-1), -7}} {
+		d := TruncDist{NormalDist{1, 2}, 1 + 2*ab[0], 1 + 2*ab[1]}
+		var s float64
+		for i := 0; i < n; i++ {
+			x := TruncNormalNext(1, 2, d.A, d.B)
+			if x < d.A || x > d.B {
+				t.Error()
+				fmt.Println(ab, x)
+			}
+			s += x
+		}
+		if math.Abs(s/n-d.Mean()) > 5*math.Sqrt(d.Var()/n) {
+			t.Error()
+			fmt.Println(ab, s/n, d.Mean())
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// test of Weibull distribution against R:dweibull()
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values
+func TestWeibull(t *testing.T) {
+	fmt.Println("test of Weibull distribution: PDF")
+	fn := WeibullPDF(2, 1)
+	x := fn(1.5)
+	y := 0.3161977
+	if !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+
+	fmt.Println("test of Weibull distribution: CDF")
+	fn = WeibullCDF(2, 1)
+	x = fn(1.5)
+	y = 0.8946008
+	if !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+
+	fmt.Println("test of Weibull distribution: Qtl")
+	qtl := WeibullQtl(2, 1)
+	x = qtl(0.5)
+	y = 0.8325546
+	if !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+
+	fmt.Println("test of Weibull distribution: Hazard")
+	fn = WeibullHazard(2, 1)
+	x = fn(1.5)
+	y = 3
+	if !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+
+	fmt.Println("test of Weibull distribution: PDF at 0")
+	tests := []struct {
+		κ, pdf, lnPDF float64
+	}{
+		{1, 0.5, -math.Log(2)},
+		{0.5, math.Inf(1), math.Inf(1)},
+		{2, 0, math.Inf(-1)},
+	}
+	for _, tt := range tests {
+		if x, l := WeibullPDF(tt.κ, 2)(0), WeibullLnPDF(tt.κ, 2)(0); x != tt.pdf || l != tt.lnPDF {
+			t.Error()
+			fmt.Println(tt.κ, x, l)
+		}
+	}
+
+	fmt.Println("test of Weibull distribution: three-parameter")
+	x = Weibull3CDFAt(2, 1, 10, 11.5)
+	y = 0.8946008
+	if !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+}
+
+// κ = 1 is the Exponential distribution; for κ = 2 the MGF has a closed form
+func TestWeibullMGF(t *testing.T) {
+	fmt.Println("test of Weibull distribution: MGF")
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{WeibullMGF(1, 2, 0.3), 1 / (1 - 0.3*2)},
+		{WeibullMGF(1, 2, -1), 1 / (1 + 2.0)},
+		{WeibullMGF(2, 1, -1), 1 - math.Sqrt(math.Pi)/2*math.Exp(0.25)*math.Erfc(0.5)},
+		{WeibullMGF(2, 1, 0.5), 1 + math.Sqrt(math.Pi)/4*math.Exp(0.0625)*math.Erfc(-0.25)},
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// test of the zero-inflated and hurdle count distributions
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// moments of d summed over {0, ..., n}
+func sumMoments(d Discrete, n int64) (μ, σ2, skew, kurt float64) {
+	for k := int64(0); k <= n; k++ {
+		μ += float64(k) * d.PMF(k)
+	}
+	for k := int64(0); k <= n; k++ {
+		x := float64(k) - μ
+		σ2 += x * x * d.PMF(k)
+		skew += x * x * x * d.PMF(k)
+		kurt += x * x * x * x * d.PMF(k)
+	}
+	skew /= σ2 * math.Sqrt(σ2)
+	kurt = kurt/(σ2*σ2) - 3
+	return
+}
+
+// test against known values and identities
+func TestZeroInflated(t *testing.T) {
+	fmt.Println("test of zero-inflated and hurdle distributions")
+	λ := 1e-10
+	type tc struct {
+		x, y float64
+	}
+	tests := []tc{
+		{ZIPoissonPMFAt(0.3, 2.5, 0), 0.3 + 0.7*math.Exp(-2.5)},
+		{ZIPoissonPMFAt(0.3, 2.5, 3), 0.7 * PoissonPMFAt(2.5, 3)},
+		{ZIPoissonCDFAt(0, 2.5, 4), PoissonCDFAt(2.5, 4)},
+		{ZIPoissonDist{0.3, 2.5}.Surv(6), 0.7 * PoissonDist{2.5}.Surv(6)},
+		{ZINegBinomialPMFAt(0.25, 0.4, 3, 0), 0.412},
+		{ZINegBinomialPMFAt(0.25, 0.4, 3, 2), 0.15552},
+		{HurdlePoissonPMFAt(0.4, 2.5, 0), 0.4},
+		{HurdlePoissonPMFAt(0.4, 2.5, 2), 0.16767279343847252},
+		{HurdleNegBinomialPMFAt(0.2, 0.4, 3, 1), 0.2644897959183674},
+		// far in the upper tail, and with the Poisson almost always zero before truncation
+		{ZIPoissonDist{0.3, 2.5}.LnSurv(40), math.Log(0.7) + PoissonDist{2.5}.LnSurv(40)},
+		{HurdlePoissonPMFAt(0.4, λ, 1), 0.6},
+		{HurdlePoissonPMFAt(0.4, λ, 2), 0.6 * λ / 2},
+		{HurdlePoissonDist{0.4, λ}.Surv(1), 0.6 * λ / 2},
+		{float64(HurdlePoissonDist{0.4, λ}.Qtl(0.5)), 1},
+	}
+	for _, d := range []Discrete{
+		ZIPoissonDist{0.3, 2.5},
+		ZINegBinomialDist{0.25, 0.4, 3},
+		HurdlePoissonDist{0.4, 2.5},
+		HurdlePoissonDist{0.8, 0.3},
+		HurdleNegBinomialDist{0.2, 0.4, 3},
+	} {
+		μ, σ2, skew, kurt := sumMoments(d, 200)
+		tests = append(tests, tc{d.Mean(), μ}, tc{d.Var(), σ2}, tc{d.Skew(), skew}, tc{d.ExKurt(), kurt})
+		tests = append(tests, tc{d.CDF(3), d.PMF(0) + d.PMF(1) + d.PMF(2) + d.PMF(3)})
+	}
+	for i, tt := range tests {
+		if !check(tt.x, tt.y) {
+			t.Error()
+			fmt.Println(i, tt.x, tt.y)
+		}
+	}
+}
+
+// the zero-truncated draws of the hurdle distribution, by rejection and by inversion
+func TestHurdleRand(t *testing.T) {
+	fmt.Println("test of hurdle distributions: Rand")
+	for _, d := range []Discrete{HurdlePoissonDist{0.4, 3}, HurdlePoissonDist{0.4, 0.05}, HurdleNegBinomialDist{0.4, 0.1, 2}} {
+		const n = 100000
+		var s float64
+		for i := 0; i < n; i++ {
+			s += float64(d.Rand())
+		}
+		if math.Abs(s/n-d.Mean()) > 5*math.Sqrt(d.Var()/n) {
+			t.Error()
+			fmt.Printf("%#v %v %v\n", d, s/n, d.Mean())
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Prepared samplers of discrete distributions with finite support.
+// Alias draws in constant time by the alias method of Walker, with the tables built in linear time by the algorithm of Vose;
+// Guide draws by inversion of the CDF, in constant expected time, by the guide table of Chen and Asau.
+// Inversion keeps the order of the uniform numbers, hence their common random numbers or quasi-random points; the alias method does not.
+// Both take O(n) time and memory to prepare, so that they pay off from about n draws on.
+// Ref.: Vose, M. D. (1991). A linear algorithm for generating random numbers with a given distribution. IEEE Transactions on Software Engineering 17(9), 972–975.
+// Ref.: Devroye, L. (1986). Non-Uniform Random Variate Generation. Springer, New York: 96–98, 107–111.
+
+import (
+	"math/rand"
+)
+
+// guideMax is the largest support for which the families prepare a table of their PMF, rather than drawing by their own method.
+const guideMax = 1 << 20
+
+// Alias is the alias table of a discrete distribution on {0, ..., n-1}.
+type Alias struct {
+	prob  []float64 // probability of keeping the column i rather than taking its alias
+	alias []int64
+}
+
+// NewAlias returns the alias table of the distribution on {0, ..., len(w)-1} with the weights w ≥ 0, which need not sum to one.
+func NewAlias(w []float64) *Alias {
+	n := len(w)
+	sum := fZero
+	for _, v := range w {
+		if !(v >= 0) || isInf(v, 1) {
+			panic("bad parameters")
+		}
+		sum += v
+	}
+	if n == 0 || sum == 0 {
+		panic("bad parameters")
+	}
+	a := &Alias{prob: make([]float64, n), alias: make([]int64, n)}
+	// the weights scaled to mean 1, split into the columns below and above 1
+	small := make([]int64, 0, n)
+	large := make([]int64, 0, n)
+	for i, v := range w {
+		a.prob[i] = v * float64(n) / sum
+		if a.prob[i] < 1 {
+			small = append(small, int64(i))
+		} else {
+			large = append(large, int64(i))
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		// the column s is filled up from l
+		a.alias[s] = l
+		a.prob[l] -= 1 - a.prob[s]
+		if a.prob[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// the columns left over are full, up to rounding
+	for _, i := range large {
+		a.prob[i] = 1
+	}
+	for _, i := range small {
+		a.prob[i] = 1
+	}
+	return a
+}
+
+// Next returns random number drawn from the distribution of the alias table.
+func (a *Alias) Next() int64 {
+	return a.NextR(GlobalRand)
+}
+
+// NextR returns random number drawn from the distribution of the alias table, using the random source src;
+// the column and the choice within it are taken from the integer and fractional parts of one uniform number.
+func (a *Alias) NextR(src *rand.Rand) int64 {
+	u := src.Float64() * float64(len(a.prob))
+	i := int64(u)
+	if u-float64(i) < a.prob[i] {
+		return i
+	}
+	return a.alias[i]
+}
+
+// Fill fills x with random numbers drawn from the distribution of the alias table.
+func (a *Alias) Fill(x []int64) {
+	a.FillR(GlobalRand, x)
+}
+
+// FillR fills x with random numbers drawn from the distribution of the alias table, using the random source src.
+func (a *Alias) FillR(src *rand.Rand, x []int64) {
+	for i := range x {
+		x[i] = a.NextR(src)
+	}
+}
+
+// Guide is the guide table of the CDF of a discrete distribution on {a, ..., a+n-1}.
+type Guide struct {
+	a     int64
+	cum   []float64 // cum[i], the sum of the weights of a, ..., a+i
+	guide []int     // guide[j], the smallest i with cum[i] > j/n of the total
+}
+
+// NewGuide returns the guide table of the distribution on {a, ..., a+len(w)-1} with the weights w ≥ 0, which need not sum to one.
+func NewGuide(a int64, w []float64) *Guide {
+	for _, v := range w {
+		if !(v >= 0) || isInf(v, 1) {
+			panic("bad parameters")
+		}
+	}
+	// without the values of weight 0 above the support, which rounding could otherwise draw
+	n := len(w)
+	for n > 0 && w[n-1] == 0 {
+		n--
+	}
+	if n == 0 {
+		panic("bad parameters")
+	}
+	g := &Guide{a: a, cum: make([]float64, n), guide: make([]int, n)}
+	sum := fZero
+	for i, v := range w[:n] {
+		sum += v
+		g.cum[i] = sum
+	}
+	i := 0
+	for j := range g.guide {
+		t := float64(j) / float64(n) * sum
+		for g.cum[i] <= t && i < n-1 {
+			i++
+		}
+		g.guide[j] = i
+	}
+	return g
+}
+
+// NewGuideDist returns the guide table of the PMF of the distribution d on {a, ..., b}.
+func NewGuideDist(d Discrete, a, b int64) *Guide {
+	return NewGuide(a, pmfTable(d.PMF, a, b))
+}
+
+// Next returns random number drawn from the distribution of the guide table.
+func (g *Guide) Next() int64 {
+	return g.NextR(GlobalRand)
+}
+
+// NextR returns random number drawn from the distribution of the guide table, using the random source src.
+func (g *Guide) NextR(src *rand.Rand) int64 {
+	return g.Qtl(src.Float64())
+}
+
+// Qtl returns the quantile of the distribution of the guide table for probability p: the smallest k whose CDF exceeds p,
+// so that Qtl(U) is drawn from the distribution for U uniform on [0, 1).
+func (g *Guide) Qtl(p float64) int64 {
+	n := len(g.cum)
+	u := p * g.cum[n-1]
+	j := int(p * float64(n))
+	if j >= n {
+		j = n - 1
+	} else if j < 0 {
+		j = 0
+	}
+	i := g.guide[j]
+	for i > 0 && g.cum[i-1] > u {
+		// j/n of the total rounded above u
+		i--
+	}
+	for g.cum[i] <= u && i < n-1 {
+		i++
+	}
+	return g.a + int64(i)
+}
+
+// Fill fills x with random numbers drawn from the distribution of the guide table.
+func (g *Guide) Fill(x []int64) {
+	g.FillR(GlobalRand, x)
+}
+
+// FillR fills x with random numbers drawn from the distribution of the guide table, using the random source src.
+func (g *Guide) FillR(src *rand.Rand, x []int64) {
+	for i := range x {
+		x[i] = g.NextR(src)
+	}
+}
+
+// pmfTable returns the values of pmf on {a, ..., b}.
+func pmfTable(pmf func(k int64) float64, a, b int64) []float64 {
+	if b < a {
+		panic("bad parameters")
+	}
+	w := make([]float64, b-a+1)
+	for i := range w {
+		w[i] = pmf(a + int64(i))
+	}
+	return w
+}
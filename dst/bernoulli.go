@@ -4,6 +4,10 @@ package dst
 
 // Bernoulli distribution.
 
+import (
+	"math/rand"
+)
+
 // BernoulliPMF returns the PMF of the Bernoulli distribution. 
 func BernoulliPMF(ρ float64) func(k int64) float64 {
 	return func(k int64) float64 {
@@ -52,13 +56,177 @@ func BernoulliCDFAt(ρ float64, k int64) float64 {
 	return cdf(k)
 }
 
+// BernoulliCDFTail returns the CDF of the Bernoulli distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func BernoulliCDFTail(ρ float64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		switch {
+		case k < 0:
+			return pTailBounds(false, lowerTail, logP)
+		case k >= 1:
+			return pTailBounds(true, lowerTail, logP)
+		}
+		return pTail2(1-ρ, ρ, lowerTail, logP)
+	}
+}
+
+// BernoulliQtlTail returns the inverse of BernoulliCDFTail (quantile) of the Bernoulli distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func BernoulliQtlTail(ρ float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := BernoulliCDFTail(ρ, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ρ >= 0 && ρ <= 1) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, 1, lowerTail, logP)
+	}
+}
+
 // BernoulliNext returns random number drawn from the Bernoulli distribution. 
 func BernoulliNext(ρ float64) int64 {
-	if UniformNext(0, 1) < ρ {
+	return BernoulliNextR(GlobalRand, ρ)
+}
+
+// BernoulliNextR returns random number drawn from the Bernoulli distribution, using the random source src.
+func BernoulliNextR(src *rand.Rand, ρ float64) int64 {
+	if UniformNextR(src, 0, 1) < ρ {
 		return 1
 	}
 	return 0
 }
 
 // Bernoulli returns the random number generator with  Bernoulli distribution. 
-func Bernoulli(ρ float64) func() int64 { return func() int64 { return BernoulliNext(ρ) } }
+func Bernoulli(ρ float64) func() int64 { return BernoulliR(GlobalRand, ρ) }
+
+// BernoulliR returns the random number generator with  Bernoulli distribution, using the random source src.
+func BernoulliR(src *rand.Rand, ρ float64) func() int64 {
+	return func() int64 { return BernoulliNextR(src, ρ) }
+}
+
+// BernoulliFill fills x with random numbers drawn from the Bernoulli distribution.
+func BernoulliFill(ρ float64, x []int64) {
+	BernoulliFillR(GlobalRand, ρ, x)
+}
+
+// BernoulliFillR fills x with random numbers drawn from the Bernoulli distribution, using the random source src.
+func BernoulliFillR(src *rand.Rand, ρ float64, x []int64) {
+	for i := range x {
+		x[i] = BernoulliNextR(src, ρ)
+	}
+}
+
+// BernoulliEntropy returns the Shannon entropy of the Bernoulli distribution.
+func BernoulliEntropy(ρ float64) float64 {
+	if ρ == 0 || ρ == 1 {
+		return 0
+	}
+	return -ρ*log(ρ) - (1-ρ)*log1p(-ρ)
+}
+
+// BernoulliCF returns the characteristic function of the Bernoulli distribution.
+func BernoulliCF(ρ, t float64) complex128 {
+	return complex(1-ρ, 0) + complex(ρ, 0)*cexp(complex(0, t))
+}
+
+// BernoulliCGF returns the cumulant generating function of the Bernoulli distribution.
+func BernoulliCGF(ρ, t float64) float64 {
+	if t > 0 {
+		return t + log(ρ+(1-ρ)*exp(-t))
+	}
+	return log1p(ρ * expm1(t))
+}
+
+// BernoulliFit returns the maximum-likelihood estimate of ρ of the Bernoulli distribution from the sample k: its mean.
+func BernoulliFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return BernoulliLnPMF(θ[0]) })
+	μ, _ := mleMeanVarInt(k)
+	return mleAt(lnL, []float64{μ})
+}
+
+// BernoulliDist is the Bernoulli distribution with probability of success ρ = Rho. It implements Discrete.
+type BernoulliDist struct {
+	Rho float64
+}
+
+// PMF returns the value of PMF of the Bernoulli distribution at k.
+func (d BernoulliDist) PMF(k int64) float64 {
+	if k < 0 || k > 1 {
+		return 0
+	}
+	return BernoulliPMFAt(d.Rho, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Bernoulli distribution at k.
+func (d BernoulliDist) LnPMF(k int64) float64 {
+	if k < 0 || k > 1 {
+		return negInf
+	}
+	return BernoulliLnPMF(d.Rho)(k)
+}
+
+// CDF returns the value of CDF of the Bernoulli distribution at k.
+func (d BernoulliDist) CDF(k int64) float64 {
+	switch {
+	case k < 0:
+		return 0
+	case k > 1:
+		return 1
+	}
+	return BernoulliCDFAt(d.Rho, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Bernoulli distribution at k.
+func (d BernoulliDist) Surv(k int64) float64 { return BernoulliCDFTail(d.Rho, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Bernoulli distribution at k.
+func (d BernoulliDist) LnCDF(k int64) float64 { return BernoulliCDFTail(d.Rho, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Bernoulli distribution at k.
+func (d BernoulliDist) LnSurv(k int64) float64 { return BernoulliCDFTail(d.Rho, false, true)(k) }
+
+// Qtl returns the quantile of the Bernoulli distribution for probability p; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func (d BernoulliDist) Qtl(p float64) int64 {
+	switch {
+	case !(d.Rho >= 0 && d.Rho <= 1):
+		panic(ErrQtlParam)
+	case !(p >= 0 && p <= 1):
+		panic(ErrQtlProb)
+	}
+	if p <= 1-d.Rho {
+		return 0
+	}
+	return 1
+}
+
+// QtlTail returns the quantile of the Bernoulli distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d BernoulliDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return BernoulliQtlTail(d.Rho, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Bernoulli distribution.
+func (d BernoulliDist) Rand() int64 { return BernoulliNext(d.Rho) }
+
+// RandR returns random number drawn from the Bernoulli distribution, using the random source src.
+func (d BernoulliDist) RandR(src *rand.Rand) int64 { return BernoulliNextR(src, d.Rho) }
+
+// Mean returns the mean of the Bernoulli distribution.
+func (d BernoulliDist) Mean() float64 { return d.Rho }
+
+// Var returns the variance of the Bernoulli distribution.
+func (d BernoulliDist) Var() float64 { return d.Rho * (1 - d.Rho) }
+
+// Skew returns the skewness of the Bernoulli distribution.
+func (d BernoulliDist) Skew() float64 { return (1 - 2*d.Rho) / sqrt(d.Rho*(1-d.Rho)) }
+
+// ExKurt returns the excess kurtosis of the Bernoulli distribution.
+func (d BernoulliDist) ExKurt() float64 { return (1 - 6*d.Rho*(1-d.Rho)) / (d.Rho * (1 - d.Rho)) }
+
+// Entropy returns the Shannon entropy of the Bernoulli distribution.
+func (d BernoulliDist) Entropy() float64 { return BernoulliEntropy(d.Rho) }
+
+// CF returns the characteristic function of the Bernoulli distribution at t.
+func (d BernoulliDist) CF(t float64) complex128 { return BernoulliCF(d.Rho, t) }
+
+// CGF returns the cumulant generating function of the Bernoulli distribution at t.
+func (d BernoulliDist) CGF(t float64) float64 { return BernoulliCGF(d.Rho, t) }
+
+// Support returns the support of the Bernoulli distribution.
+func (d BernoulliDist) Support() (a, b int64) { return 0, 1 }
@@ -0,0 +1,69 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Modified Bessel functions of the first and second kind.
+
+// besselIe returns the exponentially scaled modified Bessel function of the first kind e^-x I_ν(x), for ν ≥ 0 and x ≥ 0.
+// Abramowitz, M., Stegun, I. A. (1964). Handbook of Mathematical Functions. 9.6.10 and 9.7.1.
+func besselIe(ν, x float64) float64 {
+	switch {
+	case isNaN(ν) || isNaN(x) || ν < 0 || x < 0:
+		return NaN
+	case x == 0:
+		if ν == 0 {
+			return 1
+		}
+		return 0
+	case isInf(x, 1):
+		return 0
+	}
+	if x > 30 && x > ν*ν {
+		// asymptotic expansion; its terms decrease up to k ≈ 2x
+		μ := 4 * ν * ν
+		sum, term := 1.0, 1.0
+		for k := 1.0; k < 2*x; k++ {
+			term *= -(μ - (2*k-1)*(2*k-1)) / (8 * k * x)
+			sum += term
+			if abs(term) < eps64*abs(sum) {
+				break
+			}
+		}
+		return sum / sqrt(2*π*x)
+	}
+	// power series, all terms positive, scaled from the first one on
+	y := x * x / 4
+	term := exp(ν*log(x/2) - LnΓ(ν+1) - x)
+	sum := term
+	for k := 1.0; k < 2000; k++ {
+		term *= y / (k * (k + ν))
+		sum += term
+		if term < eps64*sum {
+			break
+		}
+	}
+	return sum
+}
+
+// besselKLn returns the logarithm of the modified Bessel function of the second kind K_ν(x), for x > 0,
+// by numerical integration of K_ν(x) = ∫_0^∞ e^{-x cosh u} cosh(νu) du.
+// Abramowitz, M., Stegun, I. A. (1964). Handbook of Mathematical Functions. 9.6.24.
+func besselKLn(ν, x float64) float64 {
+	if isNaN(ν) || isNaN(x) || x < 0 {
+		return NaN
+	}
+	if x == 0 {
+		return posInf
+	}
+	ν = abs(ν)
+	// the logarithm -x cosh u + νu of the integrand, but for the factor (1 + e^{-2νu})/2, is concave with its maximum at u0 = asinh(ν/x)
+	g := func(u float64) float64 { return -x*(exp(u)+exp(-u))/2 + ν*u }
+	u0 := log(ν/x + sqrt(ν*ν/(x*x)+1))
+	g0 := g(u0)
+	hi := u0 + 1
+	for g(hi) > g0-50 {
+		hi += hi - u0
+	}
+	s := integrateRel(func(u float64) float64 { return exp(g(u)-g0) * (1 + exp(-2*ν*u)) / 2 }, 0, hi)
+	return g0 + log(s)
+}
@@ -5,6 +5,10 @@ package dst
 // Beta distribution reparametrized using mean (μ) and sample size (ν). 
 // Kruschke, J. K. (2011). Doing Bayesian data analysis: A tutorial with R and BUGS. p. 83: Academic Press / Elsevier. ISBN 978-0123814852.
 
+import (
+	"math/rand"
+)
+
 // BetaμνPDF returns the PDF of the Beta distribution reparametrized using mean and sample size. 
 func BetaμνPDF(μ, ν float64) func(x float64) float64 {
 	α := μ * ν
@@ -21,19 +25,46 @@ func BetaμνLnPDF(μ, ν float64) func(x float64) float64 {
 
 // BetaμνNext returns random number drawn from the  Beta distribution reparametrized using mean and sample size. 
 func BetaμνNext(μ, ν float64) float64 {
+	return BetaμνNextR(GlobalRand, μ, ν)
+}
+
+// BetaμνNextR returns random number drawn from the  Beta distribution reparametrized using mean and sample size, using the random source src.
+func BetaμνNextR(src *rand.Rand, μ, ν float64) float64 {
 	α := μ * ν
 	β := (1 - μ) * ν
 	if ν <= 0 {
 		return NaN
 	}
-	return BetaNext(α, β)
+	return BetaNextR(src, α, β)
 }
 
 // Betaμν returns the random number generator with  Beta distribution reparametrized using mean and sample size. 
 func Betaμν(μ, ν float64) func() float64 {
+	return BetaμνR(GlobalRand, μ, ν)
+}
+
+// BetaμνR returns the random number generator with  Beta distribution reparametrized using mean and sample size, using the random source src.
+func BetaμνR(src *rand.Rand, μ, ν float64) func() float64 {
 	α := μ * ν
 	β := (1 - μ) * ν
-	return func() float64 { return BetaNext(α, β) }
+	if ν <= 0 {
+		return func() float64 { return NaN }
+	}
+	return BetaR(src, α, β)
+}
+
+// BetaμνFill fills x with random numbers drawn from the Beta distribution reparametrized using mean and sample size.
+func BetaμνFill(μ, ν float64, x []float64) {
+	BetaμνFillR(GlobalRand, μ, ν, x)
+}
+
+// BetaμνFillR fills x with random numbers drawn from the Beta distribution reparametrized using mean and sample size, using the random source src;
+// the Beta sampler is set up once for all the draws.
+func BetaμνFillR(src *rand.Rand, μ, ν float64, x []float64) {
+	next := BetaμνR(src, μ, ν)
+	for i := range x {
+		x[i] = next()
+	}
 }
 
 // BetaμνPDFAt returns the value of PDF of Beta distribution at x. 
@@ -51,7 +82,7 @@ func BetaμνCDF(μ, ν float64) func(x float64) float64 {
 
 // BetaμνCDFAt returns the value of CDF of the Beta distribution reparametrized using mean and sample size, at x. 
 func BetaμνCDFAt(μ, ν, x float64) float64 {
-	cdf := BetaCDF(μ, ν)
+	cdf := BetaμνCDF(μ, ν)
 	return cdf(x)
 }
 
@@ -68,3 +99,94 @@ func BetaμνQtlFor(μ, ν, p float64) float64 {
 	cdf := BetaμνQtl(μ, ν)
 	return cdf(p)
 }
+
+// BetaμνFromMeanStd returns the Beta distribution reparametrized using mean and sample size, with mean μ and standard deviation σ.
+func BetaμνFromMeanStd(μ, σ float64) BetaμνDist {
+	return betaμν(BetaFromMeanStd(μ, σ))
+}
+
+// BetaμνFromModeStd returns the Beta distribution reparametrized using mean and sample size, with mode m and standard deviation σ.
+func BetaμνFromModeStd(m, σ float64) BetaμνDist {
+	return betaμν(BetaFromModeStd(m, σ))
+}
+
+// BetaμνMatchQtls returns the Beta distribution reparametrized using mean and sample size whose quantiles for the probabilities p best match x.
+func BetaμνMatchQtls(p, x []float64) BetaμνDist {
+	return betaμν(BetaMatchQtls(p, x))
+}
+
+// betaμν returns the Beta distribution d reparametrized using mean and sample size.
+func betaμν(d BetaDist) BetaμνDist {
+	return BetaμνDist{d.Alpha / (d.Alpha + d.Beta), d.Alpha + d.Beta}
+}
+
+// BetaμνFit returns the maximum-likelihood estimates of μ, ν of the Beta distribution reparametrized using mean and sample size, from the sample x.
+func BetaμνFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return BetaμνLnPDF(θ[0], θ[1]) })
+	e := BetaFit(x).Est
+	return mleAt(lnL, []float64{e[0] / (e[0] + e[1]), e[0] + e[1]})
+}
+
+// BetaμνDist is the Beta distribution reparametrized using mean μ = Mu and sample size ν = Nu. It implements Continuous.
+type BetaμνDist struct {
+	Mu, Nu float64
+}
+
+// beta returns the equivalent Beta distribution with shape parameters α, β.
+func (d BetaμνDist) beta() BetaDist { return BetaDist{d.Mu * d.Nu, (1 - d.Mu) * d.Nu} }
+
+// PDF returns the value of PDF of the Beta distribution at x.
+func (d BetaμνDist) PDF(x float64) float64 { return BetaμνPDFAt(d.Mu, d.Nu, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Beta distribution at x.
+func (d BetaμνDist) LnPDF(x float64) float64 { return BetaμνLnPDF(d.Mu, d.Nu)(x) }
+
+// CDF returns the value of CDF of the Beta distribution at x.
+func (d BetaμνDist) CDF(x float64) float64 { return BetaμνCDFAt(d.Mu, d.Nu, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Beta distribution at x.
+func (d BetaμνDist) Surv(x float64) float64 { return d.beta().Surv(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Beta distribution at x.
+func (d BetaμνDist) LnCDF(x float64) float64 { return d.beta().LnCDF(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Beta distribution at x.
+func (d BetaμνDist) LnSurv(x float64) float64 { return d.beta().LnSurv(x) }
+
+// Qtl returns the quantile of the Beta distribution for probability p.
+func (d BetaμνDist) Qtl(p float64) float64 { return BetaμνQtlFor(d.Mu, d.Nu, p) }
+
+// QtlTail returns the quantile of the Beta distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d BetaμνDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return d.beta().QtlTail(p, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the Beta distribution.
+func (d BetaμνDist) Rand() float64 { return BetaμνNext(d.Mu, d.Nu) }
+
+// RandR returns random number drawn from the Beta distribution, using the random source src.
+func (d BetaμνDist) RandR(src *rand.Rand) float64 { return BetaμνNextR(src, d.Mu, d.Nu) }
+
+// Mean returns the mean of the Beta distribution.
+func (d BetaμνDist) Mean() float64 { return d.Mu }
+
+// Var returns the variance of the Beta distribution.
+func (d BetaμνDist) Var() float64 { return d.beta().Var() }
+
+// Skew returns the skewness of the Beta distribution.
+func (d BetaμνDist) Skew() float64 { return d.beta().Skew() }
+
+// ExKurt returns the excess kurtosis of the Beta distribution.
+func (d BetaμνDist) ExKurt() float64 { return d.beta().ExKurt() }
+
+// Entropy returns the differential entropy of the Beta distribution.
+func (d BetaμνDist) Entropy() float64 { return d.beta().Entropy() }
+
+// CF returns the characteristic function of the Beta distribution at t.
+func (d BetaμνDist) CF(t float64) complex128 { return d.beta().CF(t) }
+
+// CGF returns the cumulant generating function of the Beta distribution at t.
+func (d BetaμνDist) CGF(t float64) float64 { return d.beta().CGF(t) }
+
+// Support returns the support of the Beta distribution.
+func (d BetaμνDist) Support() (a, b float64) { return 0, 1 }
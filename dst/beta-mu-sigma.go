@@ -4,6 +4,10 @@ package dst
 
 // Beta distribution reparametrized using mean and standard deviation. 
 
+import (
+	"math/rand"
+)
+
 // BetaμσPDF returns the PDF of the Beta distribution reparametrized using mean and standard deviation. 
 func BetaμσPDF(μ, σ float64) func(x float64) float64 {
 	α := μ * (μ*(1-μ)/(σ*σ) - 1)
@@ -20,16 +24,38 @@ func BetaμσLnPDF(μ, σ float64) func(x float64) float64 {
 
 // BetaμσNext returns random number drawn from the  Beta distribution reparametrized using mean and standard deviation. 
 func BetaμσNext(μ, σ float64) float64 {
+	return BetaμσNextR(GlobalRand, μ, σ)
+}
+
+// BetaμσNextR returns random number drawn from the  Beta distribution reparametrized using mean and standard deviation, using the random source src.
+func BetaμσNextR(src *rand.Rand, μ, σ float64) float64 {
 	α := μ * (μ*(1-μ)/(σ*σ) - 1)
 	β := (1 - μ) * (μ*(1-μ)/(σ*σ) - 1)
-	return BetaNext(α, β)
+	return BetaNextR(src, α, β)
 }
 
 // Betaμσ returns the random number generator with  Beta distribution reparametrized using mean and standard deviation. 
 func Betaμσ(μ, σ float64) func() float64 {
+	return BetaμσR(GlobalRand, μ, σ)
+}
+
+// BetaμσR returns the random number generator with  Beta distribution reparametrized using mean and standard deviation, using the random source src.
+func BetaμσR(src *rand.Rand, μ, σ float64) func() float64 {
 	α := μ * (μ*(1-μ)/(σ*σ) - 1)
 	β := (1 - μ) * (μ*(1-μ)/(σ*σ) - 1)
-	return func() float64 { return BetaNext(α, β) }
+	return func() float64 { return BetaNextR(src, α, β) }
+}
+
+// BetaμσFill fills x with random numbers drawn from the Beta distribution reparametrized using mean and standard deviation.
+func BetaμσFill(μ, σ float64, x []float64) {
+	BetaμσFillR(GlobalRand, μ, σ, x)
+}
+
+// BetaμσFillR fills x with random numbers drawn from the Beta distribution reparametrized using mean and standard deviation, using the random source src.
+func BetaμσFillR(src *rand.Rand, μ, σ float64, x []float64) {
+	for i := range x {
+		x[i] = BetaμσNextR(src, μ, σ)
+	}
 }
 
 // BetaμσPDFAt returns the value of PDF of Beta distribution at x. 
@@ -47,7 +73,7 @@ func BetaμσCDF(μ, σ float64) func(x float64) float64 {
 
 // BetaμσCDFAt returns the value of CDF of the Beta distribution reparametrized using mean and standard deviation, at x. 
 func BetaμσCDFAt(μ, σ, x float64) float64 {
-	cdf := BetaCDF(μ, σ)
+	cdf := BetaμσCDF(μ, σ)
 	return cdf(x)
 }
 
@@ -64,3 +90,83 @@ func BetaμσQtlFor(μ, σ, p float64) float64 {
 	cdf := BetaμσQtl(μ, σ)
 	return cdf(p)
 }
+
+// BetaμσMatchQtls returns the Beta distribution reparametrized using mean and standard deviation whose quantiles for the probabilities p best match x.
+func BetaμσMatchQtls(p, x []float64) BetaμσDist {
+	d := BetaMatchQtls(p, x)
+	return BetaμσDist{d.Mean(), sqrt(d.Var())}
+}
+
+// BetaμσFit returns the maximum-likelihood estimates of μ, σ of the Beta distribution reparametrized using mean and standard deviation, from the sample x.
+func BetaμσFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return BetaμσLnPDF(θ[0], θ[1]) })
+	e := BetaFit(x).Est
+	return mleAt(lnL, []float64{BetaMean(e[0], e[1]), BetaStd(e[0], e[1])})
+}
+
+// BetaμσDist is the Beta distribution reparametrized using mean μ = Mu and standard deviation σ = Sigma. It implements Continuous.
+type BetaμσDist struct {
+	Mu, Sigma float64
+}
+
+// beta returns the equivalent Beta distribution with shape parameters α, β.
+func (d BetaμσDist) beta() BetaDist {
+	c := d.Mu*(1-d.Mu)/(d.Sigma*d.Sigma) - 1
+	return BetaDist{d.Mu * c, (1 - d.Mu) * c}
+}
+
+// PDF returns the value of PDF of the Beta distribution at x.
+func (d BetaμσDist) PDF(x float64) float64 { return BetaμσPDFAt(d.Mu, d.Sigma, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Beta distribution at x.
+func (d BetaμσDist) LnPDF(x float64) float64 { return BetaμσLnPDF(d.Mu, d.Sigma)(x) }
+
+// CDF returns the value of CDF of the Beta distribution at x.
+func (d BetaμσDist) CDF(x float64) float64 { return BetaμσCDFAt(d.Mu, d.Sigma, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Beta distribution at x.
+func (d BetaμσDist) Surv(x float64) float64 { return d.beta().Surv(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Beta distribution at x.
+func (d BetaμσDist) LnCDF(x float64) float64 { return d.beta().LnCDF(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Beta distribution at x.
+func (d BetaμσDist) LnSurv(x float64) float64 { return d.beta().LnSurv(x) }
+
+// Qtl returns the quantile of the Beta distribution for probability p.
+func (d BetaμσDist) Qtl(p float64) float64 { return BetaμσQtlFor(d.Mu, d.Sigma, p) }
+
+// QtlTail returns the quantile of the Beta distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d BetaμσDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return d.beta().QtlTail(p, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the Beta distribution.
+func (d BetaμσDist) Rand() float64 { return BetaμσNext(d.Mu, d.Sigma) }
+
+// RandR returns random number drawn from the Beta distribution, using the random source src.
+func (d BetaμσDist) RandR(src *rand.Rand) float64 { return BetaμσNextR(src, d.Mu, d.Sigma) }
+
+// Mean returns the mean of the Beta distribution.
+func (d BetaμσDist) Mean() float64 { return d.Mu }
+
+// Var returns the variance of the Beta distribution.
+func (d BetaμσDist) Var() float64 { return d.Sigma * d.Sigma }
+
+// Skew returns the skewness of the Beta distribution.
+func (d BetaμσDist) Skew() float64 { return d.beta().Skew() }
+
+// ExKurt returns the excess kurtosis of the Beta distribution.
+func (d BetaμσDist) ExKurt() float64 { return d.beta().ExKurt() }
+
+// Entropy returns the differential entropy of the Beta distribution.
+func (d BetaμσDist) Entropy() float64 { return d.beta().Entropy() }
+
+// CF returns the characteristic function of the Beta distribution at t.
+func (d BetaμσDist) CF(t float64) complex128 { return d.beta().CF(t) }
+
+// CGF returns the cumulant generating function of the Beta distribution at t.
+func (d BetaμσDist) CGF(t float64) float64 { return d.beta().CGF(t) }
+
+// Support returns the support of the Beta distribution.
+func (d BetaμσDist) Support() (a, b float64) { return 0, 1 }
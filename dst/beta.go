@@ -10,26 +10,9 @@ package dst
 
 import (
 	"fmt"
+	"math/rand"
 )
 
-func bisect(x, p, a, b, xtol, ptol float64) float64 {
-	var x0, x1, px float64
-	cdf := BetaPDF(a, b)
-	for abs(x1-x0) > xtol {
-		px = cdf(x)
-		switch {
-		case abs(px-p) < ptol:
-			return x
-		case px < p:
-			x0 = x
-		case px > p:
-			x1 = x
-		}
-		x = 0.5 * (x0 + x1)
-	}
-	return x
-}
-
 func betaContinuedFraction(α, β, x float64) float64 {
 	var aa, del, res, qab, qap, qam, c, d, m2, m, acc float64
 	var i int64
@@ -128,9 +111,9 @@ func BetaCDF(α, β float64) func(x float64) float64 {
 		var y, res float64
 		y = exp(LnΓ(α+β) - LnΓ(α) - LnΓ(β) + α*log(x) + β*log(1.0-x))
 		switch {
-		case x == 0:
+		case x <= 0:
 			res = 0.0
-		case x == 1.0:
+		case x >= 1.0:
 			res = 1.0
 		case x < (α+1.0)/(α+β+2.0):
 			res = y * betaContinuedFraction(α, β, x) / α
@@ -148,35 +131,36 @@ func BetaCDFAt(α, β, x float64) float64 {
 	return cdf(x)
 }
 
+// BetaCDFTail returns the CDF of the Beta distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func BetaCDFTail(α, β float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case x <= 0:
+			return pTailBounds(false, lowerTail, logP)
+		case x >= 1:
+			return pTailBounds(true, lowerTail, logP)
+		}
+		// the continued fraction converges rapidly on the smaller tail, which is kept in log scale
+		lny := LnΓ(α+β) - LnΓ(α) - LnΓ(β) + α*log(x) + β*log1p(-x)
+		if x < (α+1)/(α+β+2) {
+			return pTailLnLower(lny+log(betaContinuedFraction(α, β, x)/α), lowerTail, logP)
+		}
+		return pTailLnUpper(lny+log(betaContinuedFraction(β, α, 1-x)/β), lowerTail, logP)
+	}
+}
+
 // BetaQtl returns the inverse of the CDF (quantile) of the Beta distribution. 
 func BetaQtl(α, β float64) func(p float64) float64 {
 	// p: probability for which the quantile is evaluated
+	cdf, pdf := BetaCDF(α, β), BetaPDF(α, β)
 	return func(p float64) float64 {
-		var x float64 = 0
-		var a float64 = 0
-		var b float64 = 1
-		var precision float64 = 1e-9
-		if p < 0.0 {
-			return NaN
-		}
-		if p > 1.0 {
-			return NaN
-		}
-		if α < 0.0 {
+		if !(α > 0) || !(β > 0) {
 			return NaN
 		}
-		if β < 0.0 {
+		x, err := invertCDF(cdf, pdf, p, 0, 1, α/(α+β))
+		if err != nil {
 			return NaN
 		}
-
-		for (b - a) > precision {
-			x = (a + b) / 2
-			if iBr(α, β, x) > p {
-				b = x
-			} else {
-				a = x
-			}
-		}
 		return x
 	}
 }
@@ -187,21 +171,68 @@ func BetaQtlFor(α, β, p float64) float64 {
 	return cdf(p)
 }
 
+// BetaQtlTail returns the inverse of BetaCDFTail (quantile) of the Beta distribution.
+func BetaQtlTail(α, β float64, lowerTail, logP bool) func(p float64) float64 {
+	if !lowerTail {
+		// 1 - X is Beta(β, α); its lower tail keeps the precision near 1
+		qtl := BetaQtlTail(β, α, true, logP)
+		return func(p float64) float64 {
+			return 1 - qtl(p)
+		}
+	}
+	cdf := BetaCDFTail(α, β, true, logP)
+	return func(p float64) float64 {
+		if α < 0 || β < 0 {
+			return NaN
+		}
+		return qtlTail(cdf, p, 0, 1, true, logP)
+	}
+}
+
 // BetaNext returns random number drawn from the Beta distribution. 
 func BetaNext(α, β float64) float64 {
+	return BetaNextR(GlobalRand, α, β)
+}
+
+// BetaNextR returns random number drawn from the Beta distribution, using the random source src.
+func BetaNextR(src *rand.Rand, α, β float64) float64 {
 	if α == 1 && β == 1 { // uniform case
-		return UniformNext(0, 1)
+		return UniformNextR(src, 0, 1)
 	}
-	dα := []float64{α, β}
-	return DirichletNext(dα)[0]
+	if α > 1 && β > 1 && !isInf(α, 1) && !isInf(β, 1) {
+		// without the setup of algorithm BC, as the sampler would do
+		x := GammaNextR(src, α, 1)
+		return x / (x + GammaNextR(src, β, 1))
+	}
+	bs := newBetaSampler(α, β)
+	return bs.next(src)
 }
 
 // Beta returns the random number generator with  Beta distribution. 
 func Beta(α, β float64) func() float64 {
+	return BetaR(GlobalRand, α, β)
+}
+
+// BetaR returns the random number generator with  Beta distribution, using the random source src.
+func BetaR(src *rand.Rand, α, β float64) func() float64 {
 	if α == 1 && β == 1 { // uniform case
-		return Uniform(0, 1)
+		return UniformR(src, 0, 1)
+	}
+	bs := newBetaSampler(α, β)
+	return func() float64 { return bs.next(src) }
+}
+
+// BetaFill fills x with random numbers drawn from the Beta distribution.
+func BetaFill(α, β float64, x []float64) {
+	BetaFillR(GlobalRand, α, β, x)
+}
+
+// BetaFillR fills x with random numbers drawn from the Beta distribution, using the random source src.
+func BetaFillR(src *rand.Rand, α, β float64, x []float64) {
+	next := BetaR(src, α, β)
+	for i := range x {
+		x[i] = next()
 	}
-	return func() float64 { return BetaNext(α, β) }
 }
 
 // BetaMean returns the mean of the Beta distribution. 
@@ -282,10 +313,43 @@ func BetaSkew(α, β float64) (s float64) {
 // BetaExKurt returns the excess kurtosis of the Beta distribution. 
 func BetaExKurt(α, β float64) float64 {
 	num := 6 * ((α-β)*(α-β)*(α+β+1) - α*β*(α+β+2))
-	den := α * β * (α + β + 2) * (α + β + 2)
+	den := α * β * (α + β + 2) * (α + β + 3)
 	return num / den
 }
 
+// BetaEntropy returns the differential entropy of the Beta distribution.
+func BetaEntropy(α, β float64) float64 {
+	return logB(α, β) - (α-1)*digamma(α) - (β-1)*digamma(β) + (α+β-2)*digamma(α+β)
+}
+
+// BetaCF returns the characteristic function of the Beta distribution.
+func BetaCF(α, β, t float64) complex128 {
+	// Kummer's ₁F₁(α; α+β; it), whose series cancels for |t| large
+	if abs(t) <= 10 {
+		return kummerM(α, α+β, complex(0, t))
+	}
+	return cfLnPDF(BetaLnPDF(α, β), BetaQtl(α, β), 0, 1, t)
+}
+
+// BetaCGF returns the cumulant generating function of the Beta distribution.
+func BetaCGF(α, β, t float64) float64 {
+	// ln ₁F₁(α; α+β; t), of positive terms for t > 0, and by Kummer's transformation e^t ₁F₁(β; α+β; -t) for t < 0
+	switch {
+	case t == 0:
+		return 0
+	case abs(t) > 700:
+		return cgfLnPDF(BetaLnPDF(α, β), BetaQtl(α, β), 0, 1, t)
+	case t > 0:
+		return log(real(kummerM(α, α+β, complex(t, 0))))
+	}
+	return t + log(real(kummerM(β, α+β, complex(-t, 0))))
+}
+
+// BetaKL returns the Kullback–Leibler divergence of the Beta distribution with α2, β2 from the one with α1, β1.
+func BetaKL(α1, β1, α2, β2 float64) float64 {
+	return logB(α2, β2) - logB(α1, β1) + (α1-α2)*digamma(α1) + (β1-β2)*digamma(β1) + (α2-α1+β2-β1)*digamma(α1+β1)
+}
+
 // BetaReparamMeanStd returns the parameters α, β of the Beta distribution calculated from desired mean and standard deviation. 
 // To be used to reparametrize the Beta distribution. 
 func BetaReparamMeanStd(μ, σ float64) (α, β float64) {
@@ -298,59 +362,121 @@ func BetaReparamMeanStd(μ, σ float64) (α, β float64) {
 	return
 }
 
-// BetaReparamModStd returns the parameters α, β of the Beta distribution calculated from modus and standard deviation. 
-// To be used to reparametrize the Beta distribution. 
-// To be implemented
-/* func BetaReparamModStd(μ, σ float64) (α, β float64) {
-	if σ*σ >= μ*(1-μ) {
-			return NaN
+// BetaReparamModeStd returns the parameters α, β > 1 of the Beta distribution calculated from mode and standard deviation,
+// NaN if σ is not less than the standard deviation 1/sqrt(12) of the Uniform distribution.
+// To be used to reparametrize the Beta distribution.
+func BetaReparamModeStd(mode, σ float64) (α, β float64) {
+	if mode <= 0 || mode >= 1 || σ <= 0 || σ*σ >= 1.0/12 {
+		return NaN, NaN
+	}
+	// with α = 1 + mode (ν-2) and β = 1 + (1-mode) (ν-2), the standard deviation decreases in ν = α + β > 2
+	ν := bisectFn(func(ν float64) float64 { return -BetaStd(1+mode*(ν-2), 1+(1-mode)*(ν-2)) }, -σ, 2, posInf)
+	α = 1 + mode*(ν-2)
+	β = 1 + (1-mode)*(ν-2)
+	return
+}
+
+// Beta4Transform transforms Beta Distribution with the support [0,1]  to a Beta Distribution with the support [a, b].
+func Beta4Transform(a, b, x float64) float64 {
+	return (b-a)*x + a
+}
+
+// BetaFromMeanStd returns the Beta distribution with mean μ and standard deviation σ.
+func BetaFromMeanStd(μ, σ float64) BetaDist {
+	if σ <= 0 {
+		return BetaDist{NaN, NaN}
+	}
+	α, β := BetaReparamMeanStd(μ, σ)
+	return BetaDist{α, β}
+}
+
+// BetaFromModeStd returns the Beta distribution with mode m and standard deviation σ.
+func BetaFromModeStd(m, σ float64) BetaDist {
+	α, β := BetaReparamModeStd(m, σ)
+	return BetaDist{α, β}
+}
+
+// BetaMatchQtls returns the Beta distribution whose quantiles for the probabilities p best match x.
+func BetaMatchQtls(p, x []float64) BetaDist {
+	α, β := BetaReparamMeanStd(qtlMeanStd(p, x))
+	e := matchQtls(func(θ []float64) Continuous { return BetaDist{θ[0], θ[1]} }, p, x, []float64{α, β}, []mleBound{mlePos, mlePos})
+	return BetaDist{e[0], e[1]}
+}
+
+// BetaFit returns the maximum-likelihood estimates of α, β of the Beta distribution from the sample x.
+func BetaFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return BetaLnPDF(θ[0], θ[1]) })
+	α, β := betaStart(x)
+	return mleFit(lnL, []float64{α, β}, []mleBound{mlePos, mlePos})
+}
+
+// betaStart returns the estimates of α, β of the Beta distribution by the method of moments, α = β = 1 if the variance is too large.
+func betaStart(x []float64) (α, β float64) {
+	μ, σ2 := mleMeanVar(x)
+	α, β = BetaReparamMeanStd(μ, sqrt(σ2))
+	if isNaN(α) || α <= 0 || β <= 0 {
+		return 1, 1
 	}
-	α = 
-	β = 
 	return
 }
 
-μ=(α - 1) / (α + β - 2)
-μ*(α+β-2)-(α-1)=0
-μ*α+μ*β-2*μ-α+1=0
-μ*α-α=-μ*β+2*μ-1
-α*(μ-1)=-μ*β+2*μ-1
-α=(-μ*β+2*μ-1)  /(μ-1)
+// BetaDist is the Beta distribution with shape parameters α = Alpha and β = Beta. It implements Continuous.
+type BetaDist struct {
+	Alpha, Beta float64
+}
 
-(σ*σ)=(α * β) /((α + β) * (α + β) * (α + β + 1))
-(σ*σ)*((α + β) * (α + β) * (α + β + 1))  - (α * β) =0
-	(α + β) * (α + β) =(α*α+2*α*β+β*β)
-		(α*α+2*α*β+β*β) * (α + β + 1) = (α*α*α+2*α*α*β+α*β*β+α*α*β+2*α*β*β+β*β*β+ α*α+2*α*β+β*β) =
-			(σ*σ)*... = (α*α*α*σ*σ+2*α*α*β*σ*σ+α*β*β*σ*σ+α*α*β*σ*σ+2*α*β*β*σ*σ+β*β*β*σ*σ+ α*α*σ*σ+2*α*β*σ*σ+β*β*σ*σ)
-(α*α*α*σ*σ+2*α*α*β*σ*σ+α*β*β*σ*σ+α*α*β*σ*σ+2*α*β*β*σ*σ+β*β*β*σ*σ+ α*α*σ*σ+2*α*β*σ*σ+β*β*σ*σ -α*β) 
-+2*α*α*β*σ*σ  +α*β*β*σ*σ  +α*α*β*σ*σ  +2*α*β*β*σ*σ +β*β*β*σ*σ +2*α*β*σ*σ  +β*β*σ*σ -α*β)+α*α*α*σ*σ+ α*α*σ*σ=0
+// PDF returns the value of PDF of the Beta distribution at x.
+func (d BetaDist) PDF(x float64) float64 { return BetaPDFAt(d.Alpha, d.Beta, x) }
 
-β*β*β*σ*σ +  β*β*(α*σ*σ+2*α*σ*σ+σ*σ) +   β*(+2*α*α*σ*σ +α*α*σ*σ+2*α*σ*σ -α)+α*α*α*σ*σ+ α*α*σ*σ=0
+// LnPDF returns the natural logarithm of the PDF of the Beta distribution at x.
+func (d BetaDist) LnPDF(x float64) float64 { return BetaLnPDF(d.Alpha, d.Beta)(x) }
 
-a=σ*σ
-b=(α*σ*σ+2*α*σ*σ+σ*σ)
-c=(2*α*α*σ*σ +α*α*σ*σ+2*α*σ*σ -α)
-d=(α*α*α*σ*σ+ α*α*σ*σ)
-Δ=18*a*b*c*d - 4*b*b*b*d +b*b*c*c -4*a*c*c*c - 27*a*a*d*d
-================
-mod=(α - 1) / (α + β - 2)
-mod*(α + β - 2)=(α - 1)
+// CDF returns the value of CDF of the Beta distribution at x.
+func (d BetaDist) CDF(x float64) float64 { return BetaCDFAt(d.Alpha, d.Beta, x) }
 
-μ = α / (α + β)
-	α := μ*(μ*(1-μ)/(σ*σ)-1)
-	β := (1-μ)*(μ*(1-μ)/(σ*σ)-1)
+// Surv returns the value of the survival function 1 - CDF of the Beta distribution at x.
+func (d BetaDist) Surv(x float64) float64 { return BetaCDFTail(d.Alpha, d.Beta, false, false)(x) }
 
-mod=(α - 1)/(α + β - 2)
-mod=((μ*(μ*(1-μ)/(σ*σ)-1)) - 1)/((μ*(μ*(1-μ)/(σ*σ)-1)) + (1-μ)*(μ*(1-μ)/(σ*σ)-1) - 2)
-mod=((A) - 1)/((μ*(μ*(1-μ)/(σ*σ)-1)) + (1-μ)*(μ*(1-μ)/(σ*σ)-1) - 2)
-A = μ*(μ*(1-μ)/(σ*σ)-1) = (μ*μ*(1-μ)/(σ*σ)-μ) = (μ*μ-μ*μ*μ)/(σ*σ-μ) 
-B = 
+// LnCDF returns the natural logarithm of the CDF of the Beta distribution at x.
+func (d BetaDist) LnCDF(x float64) float64 { return BetaCDFTail(d.Alpha, d.Beta, true, true)(x) }
 
+// LnSurv returns the natural logarithm of the survival function of the Beta distribution at x.
+func (d BetaDist) LnSurv(x float64) float64 { return BetaCDFTail(d.Alpha, d.Beta, false, true)(x) }
 
-...
-*/
+// Qtl returns the quantile of the Beta distribution for probability p.
+func (d BetaDist) Qtl(p float64) float64 { return BetaQtlFor(d.Alpha, d.Beta, p) }
 
-// Beta4Transform transforms Beta Distribution with the support [0,1]  to a Beta Distribution with the support [a, b].
-func Beta4Transform(a, b, x float64) float64 {
-	return (b-a)*x + a
+// QtlTail returns the quantile of the Beta distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d BetaDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return BetaQtlTail(d.Alpha, d.Beta, lowerTail, logP)(p)
 }
+
+// Rand returns random number drawn from the Beta distribution.
+func (d BetaDist) Rand() float64 { return BetaNext(d.Alpha, d.Beta) }
+
+// RandR returns random number drawn from the Beta distribution, using the random source src.
+func (d BetaDist) RandR(src *rand.Rand) float64 { return BetaNextR(src, d.Alpha, d.Beta) }
+
+// Mean returns the mean of the Beta distribution.
+func (d BetaDist) Mean() float64 { return BetaMean(d.Alpha, d.Beta) }
+
+// Var returns the variance of the Beta distribution.
+func (d BetaDist) Var() float64 { return BetaVar(d.Alpha, d.Beta) }
+
+// Skew returns the skewness of the Beta distribution.
+func (d BetaDist) Skew() float64 { return BetaSkew(d.Alpha, d.Beta) }
+
+// ExKurt returns the excess kurtosis of the Beta distribution.
+func (d BetaDist) ExKurt() float64 { return BetaExKurt(d.Alpha, d.Beta) }
+
+// Entropy returns the differential entropy of the Beta distribution.
+func (d BetaDist) Entropy() float64 { return BetaEntropy(d.Alpha, d.Beta) }
+
+// CF returns the characteristic function of the Beta distribution at t.
+func (d BetaDist) CF(t float64) complex128 { return BetaCF(d.Alpha, d.Beta, t) }
+
+// CGF returns the cumulant generating function of the Beta distribution at t.
+func (d BetaDist) CGF(t float64) float64 { return BetaCGF(d.Alpha, d.Beta, t) }
+
+// Support returns the support of the Beta distribution.
+func (d BetaDist) Support() (a, b float64) { return 0, 1 }
@@ -13,6 +13,10 @@ package dst
 //		x=(y-a)/(c-a)
 //
 
+import (
+	"math/rand"
+)
+
 // Beta4PDF returns the PDF of the four-parameter Beta distribution. 
 func Beta4PDF(α, β, a, c float64) func(y float64) float64 {
 	return func(y float64) float64 {
@@ -32,17 +36,45 @@ func Beta4PDF(α, β, a, c float64) func(y float64) float64 {
 
 // Beta4Next returns random number drawn from the  four-parameter Beta distribution. 
 func Beta4Next(α, β, a, c float64) float64 {
+	return Beta4NextR(GlobalRand, α, β, a, c)
+}
+
+// Beta4NextR returns random number drawn from the  four-parameter Beta distribution, using the random source src.
+func Beta4NextR(src *rand.Rand, α, β, a, c float64) float64 {
 	if a >= c {
 		return NaN
 	}
-	x := BetaNext(α, β)
+	x := BetaNextR(src, α, β)
 	y := x*(c-a) + a
 	return y
 }
 
 // Beta4 returns the random number generator with  four-parameter Beta distribution. 
 func Beta4(α, β, a, c float64) func() float64 {
-	return func() float64 { return Beta4Next(α, β, a, c) }
+	return Beta4R(GlobalRand, α, β, a, c)
+}
+
+// Beta4R returns the random number generator with  four-parameter Beta distribution, using the random source src.
+func Beta4R(src *rand.Rand, α, β, a, c float64) func() float64 {
+	if a >= c {
+		return func() float64 { return NaN }
+	}
+	next := BetaR(src, α, β)
+	return func() float64 { return next()*(c-a) + a }
+}
+
+// Beta4Fill fills x with random numbers drawn from the four-parameter Beta distribution.
+func Beta4Fill(α, β, a, c float64, x []float64) {
+	Beta4FillR(GlobalRand, α, β, a, c, x)
+}
+
+// Beta4FillR fills x with random numbers drawn from the four-parameter Beta distribution, using the random source src;
+// the Beta sampler is set up once for all the draws.
+func Beta4FillR(src *rand.Rand, α, β, a, c float64, x []float64) {
+	next := Beta4R(src, α, β, a, c)
+	for i := range x {
+		x[i] = next()
+	}
 }
 
 // Beta4PDFAt returns the value of PDF of four-parameter Beta distribution at x. 
@@ -61,9 +93,9 @@ func Beta4CDF(α, β, a, c float64) func(y float64) float64 {
 		x := (y - a) / (c - a)
 		z := exp(LnΓ(α+β) - LnΓ(α) - LnΓ(β) + α*log(x) + β*log(1.0-x))
 		switch {
-		case x == 0:
+		case x <= 0:
 			res = 0.0
-		case x == 1.0:
+		case x >= 1.0:
 			res = 1.0
 		case x < (α+1.0)/(α+β+2.0):
 			res = z * betaContinuedFraction(α, β, x) / α
@@ -71,7 +103,7 @@ func Beta4CDF(α, β, a, c float64) func(y float64) float64 {
 			res = 1.0 - z*betaContinuedFraction(β, α, 1.0-x)/β
 
 		}
-		return res / (c - a)
+		return res
 	}
 }
 
@@ -81,45 +113,157 @@ func Beta4CDFAt(α, β, a, c, x float64) float64 {
 	return cdf(x)
 }
 
+// Beta4CDFTail returns the CDF of the four-parameter Beta distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func Beta4CDFTail(α, β, a, c float64, lowerTail, logP bool) func(y float64) float64 {
+	lower := BetaCDFTail(α, β, true, logP)
+	upper := BetaCDFTail(β, α, true, logP)
+	return func(y float64) float64 {
+		if a >= c {
+			return NaN
+		}
+		if lowerTail {
+			return lower((y - a) / (c - a))
+		}
+		return upper((c - y) / (c - a))
+	}
+}
+
 // Beta4Qtl returns the inverse of the CDF (quantile) of the four-parameter Beta distribution. 
 func Beta4Qtl(α, β, a, c float64) func(p float64) float64 {
 	// p: probability for which the quantile is evaluated
+	qtl := BetaQtl(α, β)
 	return func(p float64) float64 {
-		var x float64 = 0
-		var a float64 = 0
-		var b float64 = 1
-		var precision float64 = 1e-9
 		if a >= c {
 			return NaN
 		}
-		if p < 0.0 {
-			return NaN
-		}
-		if p > 1.0 {
-			return NaN
-		}
-		if α < 0.0 {
+		return a + qtl(p)*(c-a)
+	}
+}
+
+// Beta4QtlFor returns the inverse of the CDF (quantile) of the four-parameter Beta distribution, for a given probability.
+func Beta4QtlFor(α, β, a, c, p float64) float64 {
+	cdf := Beta4Qtl(α, β, a, c)
+	return cdf(p)
+}
+
+// Beta4QtlTail returns the inverse of Beta4CDFTail (quantile) of the four-parameter Beta distribution.
+func Beta4QtlTail(α, β, a, c float64, lowerTail, logP bool) func(p float64) float64 {
+	lower := BetaQtlTail(α, β, true, logP)
+	upper := BetaQtlTail(β, α, true, logP)
+	return func(p float64) float64 {
+		if a >= c {
 			return NaN
 		}
-		if β < 0.0 {
-			return NaN
+		if lowerTail {
+			return a + lower(p)*(c-a)
 		}
+		return c - upper(p)*(c-a)
+	}
+}
 
-		for (b - a) > precision {
-			x = (a + b) / 2
-			if iBr(α, β, x) > p {
-				b = x
-			} else {
-				a = x
-			}
-		}
+// Beta4Entropy returns the differential entropy of the four-parameter Beta distribution.
+func Beta4Entropy(α, β, a, c float64) float64 {
+	return BetaEntropy(α, β) + log(c-a)
+}
+
+// Beta4CF returns the characteristic function of the four-parameter Beta distribution.
+func Beta4CF(α, β, a, c, t float64) complex128 {
+	return cexp(complex(0, a*t)) * BetaCF(α, β, (c-a)*t)
+}
+
+// Beta4CGF returns the cumulant generating function of the four-parameter Beta distribution.
+func Beta4CGF(α, β, a, c, t float64) float64 {
+	return a*t + BetaCGF(α, β, (c-a)*t)
+}
+
+// Beta4MatchQtls returns the four-parameter Beta distribution whose quantiles for the probabilities p best match x.
+func Beta4MatchQtls(p, x []float64) Beta4Dist {
+	lo, hi := mleMinMax(x)
+	a, c := lo-(hi-lo)/10, hi+(hi-lo)/10
+	μ, σ := qtlMeanStd(p, x)
+	α, β := BetaReparamMeanStd((μ-a)/(c-a), σ/(c-a))
+	e := matchQtls(func(θ []float64) Continuous { return Beta4Dist{θ[0], θ[1], θ[2], θ[3]} }, p, x, []float64{α, β, a, c}, []mleBound{mlePos, mlePos, mleReal, mleReal})
+	return Beta4Dist{e[0], e[1], e[2], e[3]}
+}
 
-		return x*(c-a) + a
+// Beta4Fit returns the maximum-likelihood estimates of α, β, a, c of the four-parameter Beta distribution from the sample x, with a below its minimum and c above its maximum.
+func Beta4Fit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return Beta4Dist{θ[0], θ[1], θ[2], θ[3]}.LnPDF })
+	lo, hi := mleMinMax(x)
+	a, c := lo-(hi-lo)/10, hi+(hi-lo)/10
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = (v - a) / (c - a)
 	}
+	α, β := betaStart(y)
+	return mleFit(lnL, []float64{α, β, a, c}, []mleBound{mlePos, mlePos, {negInf, lo}, {hi, posInf}})
 }
 
-// Beta4QtlFor returns the inverse of the CDF (quantile) of the four-parameter Beta distribution, for a given probability.
-func Beta4QtlFor(α, β, a, c, p float64) float64 {
-	cdf := Beta4Qtl(α, β, a, c)
-	return cdf(p)
+// Beta4Dist is the four-parameter Beta distribution with shape parameters α = Alpha, β = Beta and support [A, C]. It implements Continuous.
+type Beta4Dist struct {
+	Alpha, Beta, A, C float64
+}
+
+// PDF returns the value of PDF of the four-parameter Beta distribution at x.
+func (d Beta4Dist) PDF(x float64) float64 { return Beta4PDFAt(d.Alpha, d.Beta, d.A, d.C, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the four-parameter Beta distribution at x.
+func (d Beta4Dist) LnPDF(x float64) float64 {
+	return BetaLnPDF(d.Alpha, d.Beta)((x-d.A)/(d.C-d.A)) - log(d.C-d.A)
+}
+
+// CDF returns the value of CDF of the four-parameter Beta distribution at x.
+func (d Beta4Dist) CDF(x float64) float64 { return Beta4CDFAt(d.Alpha, d.Beta, d.A, d.C, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the four-parameter Beta distribution at x.
+func (d Beta4Dist) Surv(x float64) float64 {
+	return Beta4CDFTail(d.Alpha, d.Beta, d.A, d.C, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the four-parameter Beta distribution at x.
+func (d Beta4Dist) LnCDF(x float64) float64 {
+	return Beta4CDFTail(d.Alpha, d.Beta, d.A, d.C, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the four-parameter Beta distribution at x.
+func (d Beta4Dist) LnSurv(x float64) float64 {
+	return Beta4CDFTail(d.Alpha, d.Beta, d.A, d.C, false, true)(x)
 }
+
+// Qtl returns the quantile of the four-parameter Beta distribution for probability p.
+func (d Beta4Dist) Qtl(p float64) float64 { return Beta4QtlFor(d.Alpha, d.Beta, d.A, d.C, p) }
+
+// QtlTail returns the quantile of the four-parameter Beta distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d Beta4Dist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return Beta4QtlTail(d.Alpha, d.Beta, d.A, d.C, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the four-parameter Beta distribution.
+func (d Beta4Dist) Rand() float64 { return Beta4Next(d.Alpha, d.Beta, d.A, d.C) }
+
+// RandR returns random number drawn from the four-parameter Beta distribution, using the random source src.
+func (d Beta4Dist) RandR(src *rand.Rand) float64 { return Beta4NextR(src, d.Alpha, d.Beta, d.A, d.C) }
+
+// Mean returns the mean of the four-parameter Beta distribution.
+func (d Beta4Dist) Mean() float64 { return d.A + (d.C-d.A)*BetaMean(d.Alpha, d.Beta) }
+
+// Var returns the variance of the four-parameter Beta distribution.
+func (d Beta4Dist) Var() float64 { return (d.C - d.A) * (d.C - d.A) * BetaVar(d.Alpha, d.Beta) }
+
+// Skew returns the skewness of the four-parameter Beta distribution.
+func (d Beta4Dist) Skew() float64 { return BetaSkew(d.Alpha, d.Beta) }
+
+// ExKurt returns the excess kurtosis of the four-parameter Beta distribution.
+func (d Beta4Dist) ExKurt() float64 { return BetaExKurt(d.Alpha, d.Beta) }
+
+// Entropy returns the differential entropy of the four-parameter Beta distribution.
+func (d Beta4Dist) Entropy() float64 { return Beta4Entropy(d.Alpha, d.Beta, d.A, d.C) }
+
+// CF returns the characteristic function of the four-parameter Beta distribution at t.
+func (d Beta4Dist) CF(t float64) complex128 { return Beta4CF(d.Alpha, d.Beta, d.A, d.C, t) }
+
+// CGF returns the cumulant generating function of the four-parameter Beta distribution at t.
+func (d Beta4Dist) CGF(t float64) float64 { return Beta4CGF(d.Alpha, d.Beta, d.A, d.C, t) }
+
+// Support returns the support of the four-parameter Beta distribution.
+func (d Beta4Dist) Support() (a, b float64) { return d.A, d.C }
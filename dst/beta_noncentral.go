@@ -0,0 +1,319 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Noncentral Beta distribution.
+// The distribution of U / (U + V), with U noncentral Chi-Squared with 2α degrees of freedom and noncentrality λ, and V Chi-Squared with 2β degrees of freedom:
+// the Poisson mixture of the Beta distributions with shapes α + i and β, i ~ Poisson(λ/2). It is the Beta distribution if λ = 0.
+//
+// Parameters:
+// α > 0		shape
+// β > 0		shape
+// λ ≥ 0		noncentrality
+//
+// Support:
+// x ∈ [0; 1]
+
+import (
+	"math/rand"
+)
+
+// NoncentralBetaPDF returns the PDF of the noncentral Beta distribution.
+func NoncentralBetaPDF(α, β, λ float64) func(x float64) float64 {
+	lnPDF := NoncentralBetaLnPDF(α, β, λ)
+	return func(x float64) float64 {
+		return exp(lnPDF(x))
+	}
+}
+
+// NoncentralBetaLnPDF returns the natural logarithm of the PDF of the noncentral Beta distribution.
+func NoncentralBetaLnPDF(α, β, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case α <= 0 || β <= 0 || λ < 0:
+			return NaN
+		case x < 0 || x > 1:
+			return negInf
+		case x == 0:
+			// only the central term is left
+			if α < 1 {
+				return posInf
+			}
+			if α == 1 {
+				return log(β) - λ/2
+			}
+			return negInf
+		case x == 1:
+			// Σ Pois(i; λ/2) (α + i) if β = 1
+			if β < 1 {
+				return posInf
+			}
+			if β == 1 {
+				return log(α + λ/2)
+			}
+			return negInf
+		}
+		return ncBetaLnPDF(α, β, λ, x, 1-x)
+	}
+}
+
+// NoncentralBetaPDFAt returns the value of PDF of noncentral Beta distribution at x.
+func NoncentralBetaPDFAt(α, β, λ, x float64) float64 {
+	pdf := NoncentralBetaPDF(α, β, λ)
+	return pdf(x)
+}
+
+// NoncentralBetaCDF returns the CDF of the noncentral Beta distribution.
+func NoncentralBetaCDF(α, β, λ float64) func(x float64) float64 {
+	return NoncentralBetaCDFTail(α, β, λ, true, false)
+}
+
+// NoncentralBetaCDFAt returns the value of CDF of the noncentral Beta distribution, at x.
+func NoncentralBetaCDFAt(α, β, λ, x float64) float64 {
+	cdf := NoncentralBetaCDF(α, β, λ)
+	return cdf(x)
+}
+
+// NoncentralBetaCDFTail returns the CDF of the noncentral Beta distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func NoncentralBetaCDFTail(α, β, λ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if α <= 0 || β <= 0 || λ < 0 {
+			return NaN
+		}
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		if x >= 1 {
+			return pTailBounds(true, lowerTail, logP)
+		}
+		p := min(0, ncBetaLnCDF(α, β, λ, x, 1-x, lowerTail))
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// NoncentralBetaQtl returns the inverse of the CDF (quantile) of the noncentral Beta distribution.
+func NoncentralBetaQtl(α, β, λ float64) func(p float64) float64 {
+	return NoncentralBetaQtlTail(α, β, λ, true, false)
+}
+
+// NoncentralBetaQtlFor returns the inverse of the CDF (quantile) of the noncentral Beta distribution, for given probability.
+func NoncentralBetaQtlFor(α, β, λ, p float64) float64 {
+	qtl := NoncentralBetaQtl(α, β, λ)
+	return qtl(p)
+}
+
+// NoncentralBetaQtlTail returns the inverse of NoncentralBetaCDFTail (quantile) of the noncentral Beta distribution.
+func NoncentralBetaQtlTail(α, β, λ float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := NoncentralBetaCDFTail(α, β, λ, lowerTail, logP)
+	return func(p float64) float64 {
+		if α <= 0 || β <= 0 || λ < 0 {
+			return NaN
+		}
+		return qtlTail(cdf, p, 0, 1, lowerTail, logP)
+	}
+}
+
+// NoncentralBetaNext returns random number drawn from the noncentral Beta distribution.
+func NoncentralBetaNext(α, β, λ float64) float64 {
+	return NoncentralBetaNextR(GlobalRand, α, β, λ)
+}
+
+// NoncentralBetaNextR returns random number drawn from the noncentral Beta distribution, using the random source src.
+func NoncentralBetaNextR(src *rand.Rand, α, β, λ float64) float64 {
+	u := NoncentralChiSquareNextR(src, 2*α, λ)
+	return u / (u + GammaNextR(src, β, 2))
+}
+
+// NoncentralBeta returns the random number generator with  noncentral Beta distribution.
+func NoncentralBeta(α, β, λ float64) func() float64 {
+	return NoncentralBetaR(GlobalRand, α, β, λ)
+}
+
+// NoncentralBetaR returns the random number generator with  noncentral Beta distribution, using the random source src.
+func NoncentralBetaR(src *rand.Rand, α, β, λ float64) func() float64 {
+	cs := newNoncentralChiSquareSampler(2*α, λ)
+	gs := newGammaSampler(β, 2)
+	return func() float64 {
+		u := cs.next(src)
+		return u / (u + gs.next(src))
+	}
+}
+
+// NoncentralBetaFill fills x with random numbers drawn from the noncentral Beta distribution.
+func NoncentralBetaFill(α, β, λ float64, x []float64) {
+	NoncentralBetaFillR(GlobalRand, α, β, λ, x)
+}
+
+// NoncentralBetaFillR fills x with random numbers drawn from the noncentral Beta distribution, using the random source src;
+// the samplers are set up once for all the draws, but for the Gamma variate of the Poisson count, whose shape varies.
+func NoncentralBetaFillR(src *rand.Rand, α, β, λ float64, x []float64) {
+	next := NoncentralBetaR(src, α, β, λ)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// noncentralBetaRaw returns the raw moments E[X^k], k = 1, ..., 4, of the noncentral Beta distribution,
+// Σ Pois(i; λ/2) E[B_i^k], with B_i Beta-distributed with shapes α + i and β; the series is summed
+// until the Poisson weights left, which bound the rest, are negligible.
+func noncentralBetaRaw(α, β, λ float64) (m [5]float64) {
+	μ := λ / 2
+	m[0] = 1
+	for i := 0.0; ; i++ {
+		w := exp(dpois_raw_ln(i, μ))
+		b := 1.0
+		for k := 1; k <= 4; k++ {
+			b *= (α + i + float64(k-1)) / (α + β + i + float64(k-1))
+			m[k] += w * b
+		}
+		if r := μ / (i + 1); r < 1 && w*r < eps64*m[4]*(1-r) {
+			break
+		}
+	}
+	return
+}
+
+// NoncentralBetaMean returns the mean of the noncentral Beta distribution.
+func NoncentralBetaMean(α, β, λ float64) float64 {
+	return noncentralBetaRaw(α, β, λ)[1]
+}
+
+// NoncentralBetaVar returns the variance of the noncentral Beta distribution.
+func NoncentralBetaVar(α, β, λ float64) float64 {
+	m := noncentralBetaRaw(α, β, λ)
+	return m[2] - m[1]*m[1]
+}
+
+// NoncentralBetaStd returns the standard deviation of the noncentral Beta distribution.
+func NoncentralBetaStd(α, β, λ float64) float64 {
+	return sqrt(NoncentralBetaVar(α, β, λ))
+}
+
+// NoncentralBetaSkew returns the skewness of the noncentral Beta distribution.
+func NoncentralBetaSkew(α, β, λ float64) float64 {
+	m := noncentralBetaRaw(α, β, λ)
+	_, _, skew, _ := rawMoments(m[1], m[2], m[3], m[4])
+	return skew
+}
+
+// NoncentralBetaExKurt returns the excess kurtosis of the noncentral Beta distribution.
+func NoncentralBetaExKurt(α, β, λ float64) float64 {
+	m := noncentralBetaRaw(α, β, λ)
+	_, _, _, kurt := rawMoments(m[1], m[2], m[3], m[4])
+	return kurt
+}
+
+// NoncentralBetaEntropy returns the differential entropy of the noncentral Beta distribution, by numerical integration.
+func NoncentralBetaEntropy(α, β, λ float64) float64 {
+	return entropyLnPDF(NoncentralBetaLnPDF(α, β, λ), NoncentralBetaQtl(α, β, λ), 0, 1)
+}
+
+// NoncentralBetaCF returns the characteristic function of the noncentral Beta distribution, by numerical integration.
+func NoncentralBetaCF(α, β, λ, t float64) complex128 {
+	return cfLnPDF(NoncentralBetaLnPDF(α, β, λ), NoncentralBetaQtl(α, β, λ), 0, 1, t)
+}
+
+// NoncentralBetaCGF returns the cumulant generating function of the noncentral Beta distribution, by numerical integration.
+func NoncentralBetaCGF(α, β, λ, t float64) float64 {
+	return cgfLnPDF(NoncentralBetaLnPDF(α, β, λ), NoncentralBetaQtl(α, β, λ), 0, 1, t)
+}
+
+// NoncentralBetaMatchQtls returns the noncentral Beta distribution whose quantiles for the probabilities p best match x.
+func NoncentralBetaMatchQtls(p, x []float64) NoncentralBetaDist {
+	α, β := BetaReparamMeanStd(qtlMeanStd(p, x))
+	e := matchQtls(func(θ []float64) Continuous { return NoncentralBetaDist{θ[0], θ[1], θ[2]} }, p, x, []float64{α, β, 1}, []mleBound{mlePos, mlePos, mlePos})
+	return NoncentralBetaDist{e[0], e[1], e[2]}
+}
+
+// NoncentralBetaFit returns the maximum-likelihood estimates of α, β, λ of the Noncentral Beta distribution from the sample x.
+func NoncentralBetaFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return NoncentralBetaLnPDF(θ[0], θ[1], θ[2]) })
+	α, β := betaStart(x)
+	return mleFit(lnL, []float64{α, β, 1}, []mleBound{mlePos, mlePos, mlePos})
+}
+
+// NoncentralBetaDist is the noncentral Beta distribution with shapes α = Alpha, β = Beta and noncentrality λ = Lambda. It implements Continuous.
+type NoncentralBetaDist struct {
+	Alpha, Beta, Lambda float64
+}
+
+// PDF returns the value of PDF of the noncentral Beta distribution at x.
+func (d NoncentralBetaDist) PDF(x float64) float64 {
+	return NoncentralBetaPDFAt(d.Alpha, d.Beta, d.Lambda, x)
+}
+
+// LnPDF returns the natural logarithm of the PDF of the noncentral Beta distribution at x.
+func (d NoncentralBetaDist) LnPDF(x float64) float64 {
+	return NoncentralBetaLnPDF(d.Alpha, d.Beta, d.Lambda)(x)
+}
+
+// CDF returns the value of CDF of the noncentral Beta distribution at x.
+func (d NoncentralBetaDist) CDF(x float64) float64 {
+	return NoncentralBetaCDFAt(d.Alpha, d.Beta, d.Lambda, x)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the noncentral Beta distribution at x.
+func (d NoncentralBetaDist) Surv(x float64) float64 {
+	return NoncentralBetaCDFTail(d.Alpha, d.Beta, d.Lambda, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the noncentral Beta distribution at x.
+func (d NoncentralBetaDist) LnCDF(x float64) float64 {
+	return NoncentralBetaCDFTail(d.Alpha, d.Beta, d.Lambda, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the noncentral Beta distribution at x.
+func (d NoncentralBetaDist) LnSurv(x float64) float64 {
+	return NoncentralBetaCDFTail(d.Alpha, d.Beta, d.Lambda, false, true)(x)
+}
+
+// Qtl returns the quantile of the noncentral Beta distribution for probability p.
+func (d NoncentralBetaDist) Qtl(p float64) float64 {
+	return NoncentralBetaQtlFor(d.Alpha, d.Beta, d.Lambda, p)
+}
+
+// QtlTail returns the quantile of the noncentral Beta distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d NoncentralBetaDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return NoncentralBetaQtlTail(d.Alpha, d.Beta, d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the noncentral Beta distribution.
+func (d NoncentralBetaDist) Rand() float64 { return NoncentralBetaNext(d.Alpha, d.Beta, d.Lambda) }
+
+// RandR returns random number drawn from the noncentral Beta distribution, using the random source src.
+func (d NoncentralBetaDist) RandR(src *rand.Rand) float64 {
+	return NoncentralBetaNextR(src, d.Alpha, d.Beta, d.Lambda)
+}
+
+// Mean returns the mean of the noncentral Beta distribution.
+func (d NoncentralBetaDist) Mean() float64 { return NoncentralBetaMean(d.Alpha, d.Beta, d.Lambda) }
+
+// Var returns the variance of the noncentral Beta distribution.
+func (d NoncentralBetaDist) Var() float64 { return NoncentralBetaVar(d.Alpha, d.Beta, d.Lambda) }
+
+// Skew returns the skewness of the noncentral Beta distribution.
+func (d NoncentralBetaDist) Skew() float64 { return NoncentralBetaSkew(d.Alpha, d.Beta, d.Lambda) }
+
+// ExKurt returns the excess kurtosis of the noncentral Beta distribution.
+func (d NoncentralBetaDist) ExKurt() float64 { return NoncentralBetaExKurt(d.Alpha, d.Beta, d.Lambda) }
+
+// Entropy returns the differential entropy of the noncentral Beta distribution.
+func (d NoncentralBetaDist) Entropy() float64 {
+	return NoncentralBetaEntropy(d.Alpha, d.Beta, d.Lambda)
+}
+
+// CF returns the characteristic function of the noncentral Beta distribution at t.
+func (d NoncentralBetaDist) CF(t float64) complex128 {
+	return NoncentralBetaCF(d.Alpha, d.Beta, d.Lambda, t)
+}
+
+// CGF returns the cumulant generating function of the noncentral Beta distribution at t.
+func (d NoncentralBetaDist) CGF(t float64) float64 {
+	return NoncentralBetaCGF(d.Alpha, d.Beta, d.Lambda, t)
+}
+
+// Support returns the support of the noncentral Beta distribution.
+func (d NoncentralBetaDist) Support() (a, b float64) { return 0, 1 }
@@ -0,0 +1,137 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Random variates from the Beta distribution.
+// rewritten from  Mathlib : A C Library of Special Functions
+// Cheng, R. C. H. (1978). Generating beta variates with nonintegral shape parameters. Communications of the ACM 21, 317-322.
+// Algorithm BB if both shapes are > 1, algorithm BC otherwise; both work on the scale of the log-odds,
+// where X / (X + Y) of Gamma variates X and Y would underflow to 0/0.
+
+import (
+	"math"
+	"math/rand"
+)
+
+// betaSampler holds the constants of the sampler for α and β, which R keeps in static variables between the calls.
+type betaSampler struct {
+	aa, bb     float64 // α, β
+	a, b       float64 // the smaller and the larger shape
+	alpha      float64 // a + b
+	beta       float64 // algorithms BB and BC
+	gamma      float64 // algorithm BB
+	k1, k2     float64 // algorithm BC
+	degenerate bool    // a shape 0 or +Inf
+}
+
+// newBetaSampler returns the sampler of the Beta distribution with shapes α and β.
+func newBetaSampler(α, β float64) betaSampler {
+	if !(α >= 0) || !(β >= 0) {
+		panic("bad parameters")
+	}
+	bs := betaSampler{aa: α, bb: β, a: α, b: β}
+	if β < α {
+		bs.a, bs.b = β, α
+	}
+	bs.alpha = bs.a + bs.b
+	switch {
+	case bs.a == 0 || isInf(bs.b, 1):
+		bs.degenerate = true
+	case bs.a <= 1:
+		bs.beta = 1 / bs.a
+		delta := 1 + bs.b - bs.a
+		bs.k1 = delta * (0.0138889 + 0.0416667*bs.a) / (bs.b*bs.beta - 0.777778)
+		bs.k2 = 0.25 + (0.5+0.25/delta)*bs.a
+	default:
+		bs.beta = sqrt((bs.alpha - 2) / (2*bs.a*bs.b - bs.alpha))
+		bs.gamma = bs.a + 1/bs.beta
+	}
+	return bs
+}
+
+// vw returns v = beta log(u1 / (1 - u1)) and w = c exp(v), bounded by the largest float64.
+func (bs *betaSampler) vw(u1, c float64) (v, w float64) {
+	v = bs.beta * log(u1/(1-u1))
+	if v <= maxExp*Ln2 {
+		w = c * exp(v)
+		if isInf(w, 1) {
+			w = math.MaxFloat64
+		}
+	} else {
+		w = math.MaxFloat64
+	}
+	return v, w
+}
+
+// next returns random number drawn from the Beta distribution, using the random source src.
+func (bs *betaSampler) next(src *rand.Rand) float64 {
+	a, b, alpha := bs.a, bs.b, bs.alpha
+	if bs.degenerate {
+		switch {
+		case isInf(bs.aa, 1) && isInf(bs.bb, 1):
+			return 0.5
+		case bs.aa == 0 && bs.bb == 0:
+			// point masses at 0 and 1, of probability 1/2
+			if src.Float64() < 0.5 {
+				return 0
+			}
+			return 1
+		case isInf(bs.aa, 1) || bs.bb == 0:
+			return 1
+		}
+		return 0
+	}
+	var v, w float64
+	if bs.a > 1 {
+		// algorithm BB
+		for {
+			u1 := src.Float64()
+			u2 := src.Float64()
+			v, w = bs.vw(u1, a)
+			z := u1 * u1 * u2
+			r := bs.gamma*v - 1.3862944
+			s := a + r - w
+			if s+2.609438 >= 5*z {
+				break
+			}
+			t := log(z)
+			if s > t || r+alpha*log(alpha/(b+w)) >= t {
+				break
+			}
+		}
+		if bs.aa != a {
+			return b / (b + w)
+		}
+		return w / (b + w)
+	}
+	// algorithm BC
+	for {
+		u1 := src.Float64()
+		u2 := src.Float64()
+		var z float64
+		if u1 < 0.5 {
+			y := u1 * u2
+			z = u1 * y
+			if 0.25*u2+z-y >= bs.k1 {
+				continue
+			}
+		} else {
+			z = u1 * u1 * u2
+			if z <= 0.25 {
+				v, w = bs.vw(u1, b)
+				break
+			}
+			if z >= bs.k2 {
+				continue
+			}
+		}
+		v, w = bs.vw(u1, b)
+		if alpha*(log(alpha/(a+w))+v)-1.3862944 >= log(z) {
+			break
+		}
+	}
+	if bs.aa == a {
+		return a / (a + w)
+	}
+	return w / (a + w)
+}
@@ -0,0 +1,211 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Beta-binomial distribution reparametrized using the mean μ = α / (α + β) of the probability of success and the sample size ν = α + β.
+// The smaller ν, the stronger the overdispersion relative to the Binomial distribution: the variance is n μ (1 - μ) (ν + n) / (ν + 1).
+// Kruschke, J. K. (2011). Doing Bayesian data analysis: A tutorial with R and BUGS. p. 83: Academic Press / Elsevier. ISBN 978-0123814852.
+//
+// Parameters:
+// n ∈ N0		number of trials
+// μ ∈ (0, 1)	mean probability of success
+// ν > 0		sample size
+//
+// Support:
+// k ∈ {0, ... , n}
+
+import (
+	"math/rand"
+)
+
+// BetaBinomialμνPMF returns the PMF of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνPMF(n int64, μ, ν float64) func(k int64) float64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialPMF(n, α, β)
+}
+
+// BetaBinomialμνLnPMF returns the natural logarithm of the PMF of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνLnPMF(n int64, μ, ν float64) func(k int64) float64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialLnPMF(n, α, β)
+}
+
+// BetaBinomialμνPMFAt returns the value of PMF of the Beta-binomial distribution reparametrized using mean and sample size at k.
+func BetaBinomialμνPMFAt(n int64, μ, ν float64, k int64) float64 {
+	pmf := BetaBinomialμνPMF(n, μ, ν)
+	return pmf(k)
+}
+
+// BetaBinomialμνCDF returns the CDF of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνCDF(n int64, μ, ν float64) func(k int64) float64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialCDF(n, α, β)
+}
+
+// BetaBinomialμνCDFAt returns the value of CDF of the Beta-binomial distribution reparametrized using mean and sample size, at k.
+func BetaBinomialμνCDFAt(n int64, μ, ν float64, k int64) float64 {
+	cdf := BetaBinomialμνCDF(n, μ, ν)
+	return cdf(k)
+}
+
+// BetaBinomialμνCDFTail returns the CDF of the Beta-binomial distribution reparametrized using mean and sample size if lowerTail, the survival function otherwise; their logarithm if logP.
+func BetaBinomialμνCDFTail(n int64, μ, ν float64, lowerTail, logP bool) func(k int64) float64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialCDFTail(n, α, β, lowerTail, logP)
+}
+
+// BetaBinomialμνQtl returns the inverse of the CDF (quantile) of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνQtl(n int64, μ, ν float64) func(p float64) int64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialQtl(n, α, β)
+}
+
+// BetaBinomialμνQtlFor returns the inverse of the CDF (quantile) of the Beta-binomial distribution reparametrized using mean and sample size, for a given probability.
+func BetaBinomialμνQtlFor(n int64, μ, ν, p float64) int64 {
+	qtl := BetaBinomialμνQtl(n, μ, ν)
+	return qtl(p)
+}
+
+// BetaBinomialμνQtlTail returns the inverse of BetaBinomialμνCDFTail (quantile) of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνQtlTail(n int64, μ, ν float64, lowerTail, logP bool) func(p float64) int64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialQtlTail(n, α, β, lowerTail, logP)
+}
+
+// BetaBinomialμνNext returns random number drawn from the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνNext(n int64, μ, ν float64) int64 {
+	return BetaBinomialμνNextR(GlobalRand, n, μ, ν)
+}
+
+// BetaBinomialμνNextR returns random number drawn from the Beta-binomial distribution reparametrized using mean and sample size, using the random source src.
+func BetaBinomialμνNextR(src *rand.Rand, n int64, μ, ν float64) int64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialNextR(src, n, α, β)
+}
+
+// BetaBinomialμν returns the random number generator with  Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμν(n int64, μ, ν float64) func() int64 {
+	return BetaBinomialμνR(GlobalRand, n, μ, ν)
+}
+
+// BetaBinomialμνR returns the random number generator with  Beta-binomial distribution reparametrized using mean and sample size, using the random source src.
+func BetaBinomialμνR(src *rand.Rand, n int64, μ, ν float64) func() int64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialR(src, n, α, β)
+}
+
+// BetaBinomialμνFill fills x with random numbers drawn from the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνFill(n int64, μ, ν float64, x []int64) {
+	BetaBinomialμνFillR(GlobalRand, n, μ, ν, x)
+}
+
+// BetaBinomialμνFillR fills x with random numbers drawn from the Beta-binomial distribution reparametrized using mean and sample size, using the random source src;
+// the Beta sampler of the probability is set up once; the Binomial draws depend on it.
+func BetaBinomialμνFillR(src *rand.Rand, n int64, μ, ν float64, x []int64) {
+	next := BetaBinomialμνR(src, n, μ, ν)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// BetaBinomialμνMean returns the mean of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνMean(n int64, μ, ν float64) float64 {
+	return float64(n) * μ
+}
+
+// BetaBinomialμνVar returns the variance of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνVar(n int64, μ, ν float64) float64 {
+	nf := float64(n)
+	return nf * μ * (1 - μ) * (ν + nf) / (ν + 1)
+}
+
+// BetaBinomialμνStd returns the standard deviation of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνStd(n int64, μ, ν float64) float64 {
+	return sqrt(BetaBinomialμνVar(n, μ, ν))
+}
+
+// BetaBinomialμνSkew returns the skewness of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνSkew(n int64, μ, ν float64) float64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialSkew(n, α, β)
+}
+
+// BetaBinomialμνExKurt returns the excess kurtosis of the Beta-binomial distribution reparametrized using mean and sample size.
+func BetaBinomialμνExKurt(n int64, μ, ν float64) float64 {
+	α, β := μ*ν, (1-μ)*ν
+	return BetaBinomialExKurt(n, α, β)
+}
+
+// BetaBinomialμνFit returns the maximum-likelihood estimates of μ, ν of the Beta-binomial distribution reparametrized using mean and sample size, with n trials, from the sample k.
+func BetaBinomialμνFit(n int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return BetaBinomialμνLnPMF(n, θ[0], θ[1]) })
+	e := BetaBinomialFit(n, k).Est
+	return mleAt(lnL, []float64{e[0] / (e[0] + e[1]), e[0] + e[1]})
+}
+
+// BetaBinomialμνDist is the Beta-binomial distribution with N trials, reparametrized using mean μ = Mu and sample size ν = Nu. It implements Discrete.
+type BetaBinomialμνDist struct {
+	N      int64
+	Mu, Nu float64
+}
+
+// betaBinomial returns the equivalent Beta-binomial distribution with shape parameters α, β.
+func (d BetaBinomialμνDist) betaBinomial() BetaBinomialDist {
+	return BetaBinomialDist{d.N, d.Mu * d.Nu, (1 - d.Mu) * d.Nu}
+}
+
+// PMF returns the value of PMF of the Beta-binomial distribution at k.
+func (d BetaBinomialμνDist) PMF(k int64) float64 { return d.betaBinomial().PMF(k) }
+
+// LnPMF returns the natural logarithm of the PMF of the Beta-binomial distribution at k.
+func (d BetaBinomialμνDist) LnPMF(k int64) float64 { return d.betaBinomial().LnPMF(k) }
+
+// CDF returns the value of CDF of the Beta-binomial distribution at k.
+func (d BetaBinomialμνDist) CDF(k int64) float64 { return d.betaBinomial().CDF(k) }
+
+// Surv returns the value of the survival function 1 - CDF of the Beta-binomial distribution at k.
+func (d BetaBinomialμνDist) Surv(k int64) float64 { return d.betaBinomial().Surv(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Beta-binomial distribution at k.
+func (d BetaBinomialμνDist) LnCDF(k int64) float64 { return d.betaBinomial().LnCDF(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Beta-binomial distribution at k.
+func (d BetaBinomialμνDist) LnSurv(k int64) float64 { return d.betaBinomial().LnSurv(k) }
+
+// Qtl returns the quantile of the Beta-binomial distribution for probability p.
+func (d BetaBinomialμνDist) Qtl(p float64) int64 { return d.betaBinomial().Qtl(p) }
+
+// QtlTail returns the quantile of the Beta-binomial distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d BetaBinomialμνDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return d.betaBinomial().QtlTail(p, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the Beta-binomial distribution.
+func (d BetaBinomialμνDist) Rand() int64 { return d.betaBinomial().Rand() }
+
+// RandR returns random number drawn from the Beta-binomial distribution, using the random source src.
+func (d BetaBinomialμνDist) RandR(src *rand.Rand) int64 { return d.betaBinomial().RandR(src) }
+
+// Mean returns the mean of the Beta-binomial distribution.
+func (d BetaBinomialμνDist) Mean() float64 { return float64(d.N) * d.Mu }
+
+// Var returns the variance of the Beta-binomial distribution.
+func (d BetaBinomialμνDist) Var() float64 { return d.betaBinomial().Var() }
+
+// Skew returns the skewness of the Beta-binomial distribution.
+func (d BetaBinomialμνDist) Skew() float64 { return d.betaBinomial().Skew() }
+
+// ExKurt returns the excess kurtosis of the Beta-binomial distribution.
+func (d BetaBinomialμνDist) ExKurt() float64 { return d.betaBinomial().ExKurt() }
+
+// Entropy returns the Shannon entropy of the Beta-binomial distribution.
+func (d BetaBinomialμνDist) Entropy() float64 { return d.betaBinomial().Entropy() }
+
+// CF returns the characteristic function of the Beta-binomial distribution at t.
+func (d BetaBinomialμνDist) CF(t float64) complex128 { return d.betaBinomial().CF(t) }
+
+// CGF returns the cumulant generating function of the Beta-binomial distribution at t.
+func (d BetaBinomialμνDist) CGF(t float64) float64 { return d.betaBinomial().CGF(t) }
+
+// Support returns the support of the Beta-binomial distribution.
+func (d BetaBinomialμνDist) Support() (a, b int64) { return 0, d.N }
@@ -0,0 +1,290 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Beta-binomial distribution.
+// The number of successes in n trials with the probability of success drawn from the Beta distribution with shapes α, β:
+// the Binomial distribution overdispersed by the variation of the probability of success between groups of trials.
+// It approaches the Binomial distribution with p = α / (α + β) as α + β → ∞, and is the discrete uniform distribution if α = β = 1.
+//
+// Parameters:
+// n ∈ N0		number of trials
+// α > 0		shape
+// β > 0		shape
+//
+// Support:
+// k ∈ {0, ... , n}
+
+import (
+	"math/rand"
+)
+
+// BetaBinomialPMF returns the PMF of the Beta-binomial distribution.
+func BetaBinomialPMF(n int64, α, β float64) func(k int64) float64 {
+	lnPMF := BetaBinomialLnPMF(n, α, β)
+	return func(k int64) float64 {
+		return exp(lnPMF(k))
+	}
+}
+
+// BetaBinomialLnPMF returns the natural logarithm of the PMF of the Beta-binomial distribution.
+func BetaBinomialLnPMF(n int64, α, β float64) func(k int64) float64 {
+	lnB := logB(α, β)
+	return func(k int64) float64 {
+		if n < 0 || α <= 0 || β <= 0 {
+			return NaN
+		}
+		if k < 0 || k > n {
+			return negInf
+		}
+		return logBinomCoeff(float64(n), float64(k)) + logB(float64(k)+α, float64(n-k)+β) - lnB
+	}
+}
+
+// BetaBinomialPMFAt returns the value of PMF of Beta-binomial distribution at k.
+func BetaBinomialPMFAt(n int64, α, β float64, k int64) float64 {
+	pmf := BetaBinomialPMF(n, α, β)
+	return pmf(k)
+}
+
+// BetaBinomialCDF returns the CDF of the Beta-binomial distribution.
+func BetaBinomialCDF(n int64, α, β float64) func(k int64) float64 {
+	return BetaBinomialCDFTail(n, α, β, true, false)
+}
+
+// BetaBinomialCDFAt returns the value of CDF of the Beta-binomial distribution, at k.
+func BetaBinomialCDFAt(n int64, α, β float64, k int64) float64 {
+	cdf := BetaBinomialCDF(n, α, β)
+	return cdf(k)
+}
+
+// BetaBinomialCDFTail returns the CDF of the Beta-binomial distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func BetaBinomialCDFTail(n int64, α, β float64, lowerTail, logP bool) func(k int64) float64 {
+	lnPMF := BetaBinomialLnPMF(n, α, β)
+	return func(k int64) float64 {
+		if n < 0 || α <= 0 || β <= 0 {
+			return NaN
+		}
+		switch {
+		case k < 0:
+			return pTailBounds(false, lowerTail, logP)
+		case k >= n:
+			return pTailBounds(true, lowerTail, logP)
+		}
+		// the tail in question is summed directly, on the log scale
+		a, b := int64(0), k
+		if !lowerTail {
+			a, b = k+1, n
+		}
+		p := negInf
+		for j := a; j <= b; j++ {
+			p = logspace_add(p, lnPMF(j))
+		}
+		p = min(0, p)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// BetaBinomialQtl returns the inverse of the CDF (quantile) of the Beta-binomial distribution.
+func BetaBinomialQtl(n int64, α, β float64) func(p float64) int64 {
+	return BetaBinomialQtlTail(n, α, β, true, false)
+}
+
+// BetaBinomialQtlFor returns the inverse of the CDF (quantile) of the Beta-binomial distribution, for given probability.
+func BetaBinomialQtlFor(n int64, α, β, p float64) int64 {
+	qtl := BetaBinomialQtl(n, α, β)
+	return qtl(p)
+}
+
+// BetaBinomialQtlTail returns the inverse of BetaBinomialCDFTail (quantile) of the Beta-binomial distribution;
+// it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func BetaBinomialQtlTail(n int64, α, β float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := BetaBinomialCDFTail(n, α, β, lowerTail, logP)
+	return func(p float64) int64 {
+		if n < 0 || !(α > 0 && β > 0) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, n, lowerTail, logP)
+	}
+}
+
+// BetaBinomialNext returns random number drawn from the Beta-binomial distribution.
+func BetaBinomialNext(n int64, α, β float64) int64 {
+	return BetaBinomialNextR(GlobalRand, n, α, β)
+}
+
+// BetaBinomialNextR returns random number drawn from the Beta-binomial distribution, using the random source src.
+func BetaBinomialNextR(src *rand.Rand, n int64, α, β float64) int64 {
+	return BinomialNextR(src, n, BetaNextR(src, α, β))
+}
+
+// BetaBinomial returns the random number generator with  Beta-binomial distribution.
+func BetaBinomial(n int64, α, β float64) func() int64 {
+	return BetaBinomialR(GlobalRand, n, α, β)
+}
+
+// BetaBinomialR returns the random number generator with  Beta-binomial distribution, using the random source src.
+func BetaBinomialR(src *rand.Rand, n int64, α, β float64) func() int64 {
+	next := BetaR(src, α, β)
+	return func() int64 { return BinomialNextR(src, n, next()) }
+}
+
+// BetaBinomialFill fills x with random numbers drawn from the Beta-binomial distribution.
+func BetaBinomialFill(n int64, α, β float64, x []int64) {
+	BetaBinomialFillR(GlobalRand, n, α, β, x)
+}
+
+// BetaBinomialFillR fills x with random numbers drawn from the Beta-binomial distribution, using the random source src;
+// the Beta sampler of the probability is set up once; the Binomial draws depend on it.
+func BetaBinomialFillR(src *rand.Rand, n int64, α, β float64, x []int64) {
+	next := BetaBinomialR(src, n, α, β)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// BetaBinomialMean returns the mean of the Beta-binomial distribution.
+func BetaBinomialMean(n int64, α, β float64) float64 {
+	return float64(n) * α / (α + β)
+}
+
+// BetaBinomialVar returns the variance of the Beta-binomial distribution.
+func BetaBinomialVar(n int64, α, β float64) float64 {
+	nf, s := float64(n), α+β
+	return nf * α * β * (s + nf) / (s * s * (s + 1))
+}
+
+// BetaBinomialStd returns the standard deviation of the Beta-binomial distribution.
+func BetaBinomialStd(n int64, α, β float64) float64 {
+	return sqrt(BetaBinomialVar(n, α, β))
+}
+
+// BetaBinomialSkew returns the skewness of the Beta-binomial distribution.
+func BetaBinomialSkew(n int64, α, β float64) float64 {
+	nf, s := float64(n), α+β
+	return (s + 2*nf) * (β - α) / (s + 2) * sqrt((1+s)/(nf*α*β*(nf+s)))
+}
+
+// BetaBinomialExKurt returns the excess kurtosis of the Beta-binomial distribution.
+func BetaBinomialExKurt(n int64, α, β float64) float64 {
+	nf, s, ab := float64(n), α+β, α*β
+	c := s * s * (1 + s) / (nf * ab * (s + 2) * (s + 3) * (s + nf))
+	return c*(s*(s-1+6*nf)+3*ab*(nf-2)+6*nf*nf-3*ab*nf*(6-nf)/s-18*ab*nf*nf/(s*s)) - 3
+}
+
+// BetaBinomialEntropy returns the Shannon entropy of the Beta-binomial distribution, by numerical summation.
+func BetaBinomialEntropy(n int64, α, β float64) float64 {
+	return entropyDiscrete(BetaBinomialDist{n, α, β})
+}
+
+// BetaBinomialCF returns the characteristic function of the Beta-binomial distribution, by numerical summation.
+func BetaBinomialCF(n int64, α, β, t float64) complex128 {
+	return cfDiscrete(BetaBinomialDist{n, α, β}, t)
+}
+
+// BetaBinomialCGF returns the cumulant generating function of the Beta-binomial distribution, by numerical summation.
+func BetaBinomialCGF(n int64, α, β, t float64) float64 {
+	return cgfDiscrete(BetaBinomialDist{n, α, β}, t)
+}
+
+// BetaBinomialFromMeanStd returns the Beta-binomial distribution with n trials, mean μ and standard deviation σ,
+// whose variance lies between those of the Binomial distribution, nρ(1-ρ), and of the Bernoulli distribution scaled by n, n²ρ(1-ρ), with ρ = μ/n.
+func BetaBinomialFromMeanStd(n int64, μ, σ float64) BetaBinomialDist {
+	nf := float64(n)
+	ρ := μ / nf
+	v := ρ * (1 - ρ)
+	if ρ <= 0 || ρ >= 1 || σ*σ <= nf*v || σ*σ >= nf*nf*v {
+		return BetaBinomialDist{n, NaN, NaN}
+	}
+	// the variance is nρ(1-ρ)(n+s)/(1+s), with s = α + β
+	s := (nf*nf*v - σ*σ) / (σ*σ - nf*v)
+	return BetaBinomialDist{n, ρ * s, (1 - ρ) * s}
+}
+
+// BetaBinomialFit returns the maximum-likelihood estimates of α, β of the Beta-binomial distribution with n trials from the sample k, starting from the method of moments.
+func BetaBinomialFit(n int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return BetaBinomialLnPMF(n, θ[0], θ[1]) })
+	// the variance is n p (1 - p) (1 + (n - 1) ρ), with p = α / (α + β) and ρ = 1 / (α + β + 1)
+	μ, σ2 := mleMeanVarInt(k)
+	fn := float64(n)
+	p := min(max(μ/fn, 0.01), 0.99)
+	ρ := 0.5
+	if n > 1 {
+		ρ = min(max((σ2/(fn*p*(1-p))-1)/(fn-1), 0.01), 0.99)
+	}
+	s := 1/ρ - 1
+	return mleFit(lnL, []float64{p * s, (1 - p) * s}, []mleBound{mlePos, mlePos})
+}
+
+// BetaBinomialDist is the Beta-binomial distribution with N trials and shapes α = Alpha, β = Beta. It implements Discrete.
+type BetaBinomialDist struct {
+	N           int64
+	Alpha, Beta float64
+}
+
+// PMF returns the value of PMF of the Beta-binomial distribution at k.
+func (d BetaBinomialDist) PMF(k int64) float64 { return BetaBinomialPMFAt(d.N, d.Alpha, d.Beta, k) }
+
+// LnPMF returns the natural logarithm of the PMF of the Beta-binomial distribution at k.
+func (d BetaBinomialDist) LnPMF(k int64) float64 { return BetaBinomialLnPMF(d.N, d.Alpha, d.Beta)(k) }
+
+// CDF returns the value of CDF of the Beta-binomial distribution at k.
+func (d BetaBinomialDist) CDF(k int64) float64 { return BetaBinomialCDFAt(d.N, d.Alpha, d.Beta, k) }
+
+// Surv returns the value of the survival function 1 - CDF of the Beta-binomial distribution at k.
+func (d BetaBinomialDist) Surv(k int64) float64 {
+	return BetaBinomialCDFTail(d.N, d.Alpha, d.Beta, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Beta-binomial distribution at k.
+func (d BetaBinomialDist) LnCDF(k int64) float64 {
+	return BetaBinomialCDFTail(d.N, d.Alpha, d.Beta, true, true)(k)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Beta-binomial distribution at k.
+func (d BetaBinomialDist) LnSurv(k int64) float64 {
+	return BetaBinomialCDFTail(d.N, d.Alpha, d.Beta, false, true)(k)
+}
+
+// Qtl returns the quantile of the Beta-binomial distribution for probability p.
+func (d BetaBinomialDist) Qtl(p float64) int64 { return BetaBinomialQtlFor(d.N, d.Alpha, d.Beta, p) }
+
+// QtlTail returns the quantile of the Beta-binomial distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d BetaBinomialDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return BetaBinomialQtlTail(d.N, d.Alpha, d.Beta, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Beta-binomial distribution.
+func (d BetaBinomialDist) Rand() int64 { return BetaBinomialNext(d.N, d.Alpha, d.Beta) }
+
+// RandR returns random number drawn from the Beta-binomial distribution, using the random source src.
+func (d BetaBinomialDist) RandR(src *rand.Rand) int64 {
+	return BetaBinomialNextR(src, d.N, d.Alpha, d.Beta)
+}
+
+// Mean returns the mean of the Beta-binomial distribution.
+func (d BetaBinomialDist) Mean() float64 { return BetaBinomialMean(d.N, d.Alpha, d.Beta) }
+
+// Var returns the variance of the Beta-binomial distribution.
+func (d BetaBinomialDist) Var() float64 { return BetaBinomialVar(d.N, d.Alpha, d.Beta) }
+
+// Skew returns the skewness of the Beta-binomial distribution.
+func (d BetaBinomialDist) Skew() float64 { return BetaBinomialSkew(d.N, d.Alpha, d.Beta) }
+
+// ExKurt returns the excess kurtosis of the Beta-binomial distribution.
+func (d BetaBinomialDist) ExKurt() float64 { return BetaBinomialExKurt(d.N, d.Alpha, d.Beta) }
+
+// Entropy returns the Shannon entropy of the Beta-binomial distribution.
+func (d BetaBinomialDist) Entropy() float64 { return BetaBinomialEntropy(d.N, d.Alpha, d.Beta) }
+
+// CF returns the characteristic function of the Beta-binomial distribution at t.
+func (d BetaBinomialDist) CF(t float64) complex128 { return BetaBinomialCF(d.N, d.Alpha, d.Beta, t) }
+
+// CGF returns the cumulant generating function of the Beta-binomial distribution at t.
+func (d BetaBinomialDist) CGF(t float64) float64 { return BetaBinomialCGF(d.N, d.Alpha, d.Beta, t) }
+
+// Support returns the support of the Beta-binomial distribution.
+func (d BetaBinomialDist) Support() (a, b int64) { return 0, d.N }
@@ -9,6 +9,10 @@ package dst
 // Support: 
 // k ∈ {0, ... , n}
 
+import (
+	"math/rand"
+)
+
 // BinomialPMF returns the PMF of the Binomial distribution. 
 func BinomialPMF(n int64, p float64) func(k int64) float64 {
 	return func(k int64) (x float64) {
@@ -53,20 +57,34 @@ func BinomialCDFAt(n int64, p float64, k int64) float64 {
 	return cdf(k)
 }
 
-// BinomialQtl returns the inverse of the CDF (quantile) of the Binomial distribution.
+// BinomialCDFTail returns the CDF of the Binomial distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func BinomialCDFTail(n int64, ρ float64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		switch {
+		case k < 0:
+			return pTailBounds(false, lowerTail, logP)
+		case k >= n:
+			return pTailBounds(true, lowerTail, logP)
+		}
+		// P[X <= k] = 1 - I_ρ(k+1, n-k)
+		return BetaCDFTail(float64(k+1), float64(n-k), !lowerTail, logP)(ρ)
+	}
+}
+
+// BinomialQtl returns the inverse of the CDF (quantile) of the Binomial distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
 func BinomialQtl(n int64, ρ float64) func(p float64) int64 {
 	return func(p float64) int64 {
 		var q, mu, sigma, gamma, z float64
 		var y int64
 
-		if float64(n) != floor(float64(n)+0.5) {
-			return int64(NaN)
+		if !(ρ >= 0 && ρ <= 1) || n < 0 {
+			panic(ErrQtlParam)
 		}
-		if ρ < 0 || ρ > 1 || n < 0 {
-			return int64(NaN)
+		if !(p >= 0 && p <= 1) {
+			panic(ErrQtlProb)
 		}
 
-		if ρ == 0 || n == 0 {
+		if ρ == 0 || n == 0 || p == 0 {
 			return 0
 		}
 
@@ -118,18 +136,51 @@ func BinomialQtlFor(n int64, ρ, p float64) int64 {
 	return qtl(p)
 }
 
+// BinomialQtlTail returns the inverse of BinomialCDFTail (quantile) of the Binomial distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func BinomialQtlTail(n int64, ρ float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := BinomialCDFTail(n, ρ, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ρ >= 0 && ρ <= 1) || n < 0 {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, n, lowerTail, logP)
+	}
+}
+
 // BinomialNext returns random number drawn from the Binomial distribution. 
 func BinomialNext(n int64, p float64) (x int64) {
-	x = 0
-	for i := int64(0); i <= n; i++ {
-		x += BernoulliNext(p)
-	}
-	return
+	return BinomialNextR(GlobalRand, n, p)
+}
+
+// BinomialNextR returns random number drawn from the Binomial distribution, using the random source src.
+func BinomialNextR(src *rand.Rand, n int64, p float64) (x int64) {
+	bs := newBinomialSampler(n, p)
+	return bs.next(src)
 }
 
 // Binomial returns the random number generator with  Binomial distribution. 
 func Binomial(n int64, p float64) func() int64 {
-	return func() int64 { return BinomialNext(n, p) }
+	return BinomialR(GlobalRand, n, p)
+}
+
+// BinomialR returns the random number generator with  Binomial distribution, using the random source src.
+func BinomialR(src *rand.Rand, n int64, p float64) func() int64 {
+	bs := newBinomialSampler(n, p)
+	return func() int64 { return bs.next(src) }
+}
+
+// BinomialFill fills x with random numbers drawn from the Binomial distribution.
+func BinomialFill(n int64, p float64, x []int64) {
+	BinomialFillR(GlobalRand, n, p, x)
+}
+
+// BinomialFillR fills x with random numbers drawn from the Binomial distribution, using the random source src;
+// the constants of the sampler are computed once for all the draws.
+func BinomialFillR(src *rand.Rand, n int64, p float64, x []int64) {
+	bs := newBinomialSampler(n, p)
+	for i := range x {
+		x[i] = bs.next(src)
+	}
 }
 
 // BinomialMean returns the mean of the Binomial distribution. 
@@ -168,12 +219,27 @@ func BinomialStd(n int64, p float64) float64 {
 
 // BinomialSkew returns the skewness of the Binomial distribution. 
 func BinomialSkew(n int64, p float64) float64 {
-	return 1 - 2*p/sqrt(float64(n)*p*(1-p))
+	return (1 - 2*p) / sqrt(float64(n)*p*(1-p))
 }
 
 // BinomialExKurt returns the excess kurtosis of the Binomial distribution. 
 func BinomialExKurt(n int64, p float64) float64 {
-	return 1 - 6*p*(1-p)/(float64(n)*p*(1-p))
+	return (1 - 6*p*(1-p)) / (float64(n) * p * (1 - p))
+}
+
+// BinomialEntropy returns the Shannon entropy of the Binomial distribution, by numerical summation.
+func BinomialEntropy(n int64, p float64) float64 {
+	return entropyDiscrete(BinomialDist{n, p})
+}
+
+// BinomialCF returns the characteristic function of the Binomial distribution.
+func BinomialCF(n int64, p, t float64) complex128 {
+	return cpow(BernoulliCF(p, t), complex(float64(n), 0))
+}
+
+// BinomialCGF returns the cumulant generating function of the Binomial distribution.
+func BinomialCGF(n int64, p, t float64) float64 {
+	return float64(n) * BernoulliCGF(p, t)
 }
 
 // BinomialMGF returns the moment-generating function of the Binomial distribution. 
@@ -208,3 +274,90 @@ func searchBinomial(p, pr float64, y, n, incr int64, z *float64) int64 {
 	}
 	return y // just to make compiler happy ;-)
 }
+
+// BinomialFit returns the maximum-likelihood estimate of p of the Binomial distribution with n trials from the sample k: its mean over n.
+func BinomialFit(n int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return BinomialLnPMF(n, θ[0]) })
+	μ, _ := mleMeanVarInt(k)
+	return mleAt(lnL, []float64{μ / float64(n)})
+}
+
+// BinomialDist is the Binomial distribution with N trials and probability of success P. It implements Discrete.
+type BinomialDist struct {
+	N int64
+	P float64
+}
+
+// PMF returns the value of PMF of the Binomial distribution at k.
+func (d BinomialDist) PMF(k int64) float64 {
+	if k < 0 || k > d.N {
+		return 0
+	}
+	return BinomialPMFAt(d.N, d.P, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Binomial distribution at k.
+func (d BinomialDist) LnPMF(k int64) float64 {
+	if k < 0 || k > d.N {
+		return negInf
+	}
+	return BinomialLnPMF(d.N, d.P)(k)
+}
+
+// CDF returns the value of CDF of the Binomial distribution at k.
+func (d BinomialDist) CDF(k int64) float64 {
+	switch {
+	case k < 0:
+		return 0
+	case k >= d.N:
+		return 1
+	}
+	return BinomialCDFAt(d.N, d.P, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Binomial distribution at k.
+func (d BinomialDist) Surv(k int64) float64 { return BinomialCDFTail(d.N, d.P, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Binomial distribution at k.
+func (d BinomialDist) LnCDF(k int64) float64 { return BinomialCDFTail(d.N, d.P, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Binomial distribution at k.
+func (d BinomialDist) LnSurv(k int64) float64 { return BinomialCDFTail(d.N, d.P, false, true)(k) }
+
+// Qtl returns the quantile of the Binomial distribution for probability p.
+func (d BinomialDist) Qtl(p float64) int64 { return BinomialQtlFor(d.N, d.P, p) }
+
+// QtlTail returns the quantile of the Binomial distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d BinomialDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return BinomialQtlTail(d.N, d.P, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Binomial distribution.
+func (d BinomialDist) Rand() int64 { return BinomialNext(d.N, d.P) }
+
+// RandR returns random number drawn from the Binomial distribution, using the random source src.
+func (d BinomialDist) RandR(src *rand.Rand) int64 { return BinomialNextR(src, d.N, d.P) }
+
+// Mean returns the mean of the Binomial distribution.
+func (d BinomialDist) Mean() float64 { return BinomialMean(d.N, d.P) }
+
+// Var returns the variance of the Binomial distribution.
+func (d BinomialDist) Var() float64 { return BinomialVar(d.N, d.P) }
+
+// Skew returns the skewness of the Binomial distribution.
+func (d BinomialDist) Skew() float64 { return BinomialSkew(d.N, d.P) }
+
+// ExKurt returns the excess kurtosis of the Binomial distribution.
+func (d BinomialDist) ExKurt() float64 { return BinomialExKurt(d.N, d.P) }
+
+// Entropy returns the Shannon entropy of the Binomial distribution.
+func (d BinomialDist) Entropy() float64 { return BinomialEntropy(d.N, d.P) }
+
+// CF returns the characteristic function of the Binomial distribution at t.
+func (d BinomialDist) CF(t float64) complex128 { return BinomialCF(d.N, d.P, t) }
+
+// CGF returns the cumulant generating function of the Binomial distribution at t.
+func (d BinomialDist) CGF(t float64) float64 { return BinomialCGF(d.N, d.P, t) }
+
+// Support returns the support of the Binomial distribution.
+func (d BinomialDist) Support() (a, b int64) { return 0, d.N }
@@ -0,0 +1,183 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Random variates from the Binomial distribution.
+// rewritten from  Mathlib : A C Library of Special Functions
+// Kachitvichyanukul and Schmeiser (1988). Binomial random variate generation. Communications of the ACM 31, 216-222.
+// Inversion if np < 30, and the BTPE algorithm (triangle, parallelogram, exponential tails) otherwise.
+
+import (
+	"math/rand"
+)
+
+// binomialSampler holds the constants of the sampler for n and p, which R keeps in static variables between the calls.
+type binomialSampler struct {
+	n    int64
+	flip bool // p > 0.5: draws n - X, with X ~ Binomial(n, 1-p)
+	p, q float64
+	r, g float64
+
+	// np < 30
+	small bool
+	qn    float64
+
+	// np >= 30
+	m                   int64
+	fm, npq, xm, xl, xr float64
+	c, xll, xlr         float64
+	p1, p2, p3, p4      float64
+}
+
+// newBinomialSampler returns the sampler of the Binomial distribution with n trials of probability p.
+func newBinomialSampler(n int64, p float64) binomialSampler {
+	if n < 0 || isNaN(p) || p < 0 || p > 1 {
+		panic("bad parameters")
+	}
+	bs := binomialSampler{n: n, flip: p > 0.5}
+	bs.p = fmin2(p, 1-p)
+	bs.q = 1 - bs.p
+	nf := float64(n)
+	np := nf * bs.p
+	bs.r = bs.p / bs.q
+	bs.g = bs.r * (nf + 1)
+	if np < 30.0 {
+		bs.small = true
+		bs.qn = pow(bs.q, nf)
+		return bs
+	}
+	bs.fm = np + bs.p
+	bs.m = int64(bs.fm)
+	bs.npq = np * bs.q
+	bs.p1 = floor(2.195*sqrt(bs.npq)-4.6*bs.q) + 0.5
+	bs.xm = float64(bs.m) + 0.5
+	bs.xl = bs.xm - bs.p1
+	bs.xr = bs.xm + bs.p1
+	bs.c = 0.134 + 20.5/(15.3+float64(bs.m))
+	al := (bs.fm - bs.xl) / (bs.fm - bs.xl*bs.p)
+	bs.xll = al * (1.0 + 0.5*al)
+	al = (bs.xr - bs.fm) / (bs.xr * bs.q)
+	bs.xlr = al * (1.0 + 0.5*al)
+	bs.p2 = bs.p1 * (1.0 + bs.c + bs.c)
+	bs.p3 = bs.p2 + bs.c/bs.xll
+	bs.p4 = bs.p3 + bs.c/bs.xlr
+	return bs
+}
+
+// next returns random number drawn from the Binomial distribution, using the random source src.
+func (bs *binomialSampler) next(src *rand.Rand) int64 {
+	ix := bs.draw(src)
+	if bs.flip {
+		ix = bs.n - ix
+	}
+	return ix
+}
+
+// draw returns random number drawn from the Binomial distribution with p <= 0.5.
+func (bs *binomialSampler) draw(src *rand.Rand) int64 {
+	if bs.n == 0 || bs.p == 0 {
+		return 0
+	}
+	if bs.small {
+		// inversion, by chop-down search from 0
+		for {
+			ix := iZero
+			f := bs.qn
+			u := src.Float64()
+			for {
+				if u < f {
+					return ix
+				}
+				if ix > 110 {
+					break
+				}
+				u -= f
+				ix++
+				f *= bs.g/float64(ix) - bs.r
+			}
+		}
+	}
+
+	n, m := bs.n, bs.m
+	p, q, npq, xm := bs.p, bs.q, bs.npq, bs.xm
+	for {
+		u := src.Float64() * bs.p4
+		v := src.Float64()
+		var ix int64
+		// triangular region
+		if u <= bs.p1 {
+			return int64(xm - bs.p1*v + u)
+		}
+		if u <= bs.p2 {
+			// parallelogram region
+			x := bs.xl + (u-bs.p1)/bs.c
+			v = v*bs.c + 1.0 - abs(xm-x)/bs.p1
+			if v > 1.0 || v <= 0. {
+				continue
+			}
+			ix = int64(x)
+		} else {
+			if u > bs.p3 {
+				// right tail
+				ix = int64(bs.xr - log(v)/bs.xlr)
+				if ix > n {
+					continue
+				}
+				v = v * (u - bs.p3) * bs.xlr
+			} else {
+				// left tail
+				ix = int64(bs.xl + log(v)/bs.xll)
+				if ix < 0 {
+					continue
+				}
+				v = v * (u - bs.p2) * bs.xll
+			}
+		}
+		// determine appropriate way to perform accept/reject test
+		k := ix - m
+		if k < 0 {
+			k = -k
+		}
+		kf := float64(k)
+		if k <= 20 || kf >= npq/2-1 {
+			// explicit evaluation
+			f := 1.0
+			if m < ix {
+				for i := m + 1; i <= ix; i++ {
+					f *= bs.g/float64(i) - bs.r
+				}
+			} else if m > ix {
+				for i := ix + 1; i <= m; i++ {
+					f /= bs.g/float64(i) - bs.r
+				}
+			}
+			if v <= f {
+				return ix
+			}
+			continue
+		}
+		// squeezing using upper and lower bounds on log(f(x))
+		amaxp := (kf / npq) * ((kf*(kf/3.+0.625)+0.1666666666666)/npq + 0.5)
+		ynorm := -kf * kf / (2.0 * npq)
+		alv := log(v)
+		if alv < ynorm-amaxp {
+			return ix
+		}
+		if alv <= ynorm+amaxp {
+			// Stirling's (actually de Moivre's) formula to machine accuracy
+			// for the final acceptance/rejection test
+			x1 := float64(ix + 1)
+			f1 := bs.fm + 1.0
+			z := float64(n) + 1 - bs.fm
+			w := float64(n-ix) + 1.0
+			z2, x2, f2, w2 := z*z, x1*x1, f1*f1, w*w
+			corr := func(a, a2 float64) float64 {
+				return (13860. - (462.-(132.-(99.-140./a2)/a2)/a2)/a2) / a / 166320.
+			}
+			if alv <= xm*log(f1/x1)+(float64(n-m)+0.5)*log(z/w)+float64(ix-m)*log(w*p/(x1*q))+
+				corr(f1, f2)+corr(z, z2)+corr(x1, x2)+corr(w, w2) {
+				return ix
+			}
+		}
+	}
+}
@@ -126,6 +126,37 @@ func CauchyCDFAt(δ, γ, x float64) float64 {
 	return cdf(x)
 }
 
+// CauchyCDFTail returns the CDF of the Cauchy distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func CauchyCDFTail(δ, γ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if isNaN(x) || isNaN(δ) || isNaN(γ) {
+			return x + δ + γ
+		}
+		if γ <= 0 {
+			return NaN
+		}
+		x = (x - δ) / γ
+		if isNaN(x) {
+			return NaN
+		}
+		// the upper tail at x is the lower tail at -x
+		if !lowerTail {
+			x = -x
+		}
+		if isInf(x, 0) {
+			return pTailBounds(x > 0, true, logP)
+		}
+		if abs(x) > 1 {
+			y := atan(1/x) / π
+			if x > 0 {
+				return pTail(y, false, logP)
+			}
+			return pTail(-y, true, logP)
+		}
+		return pTail(0.5+atan(x)/π, true, logP)
+	}
+}
+
 // CauchyQtl returns the inverse of the CDF (quantile) of the Cauchy distribution. 
 func CauchyQtl(δ, γ float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -152,16 +183,76 @@ func CauchyQtlFor(δ, γ, p float64) float64 {
 	return qtl(p)
 }
 
+// CauchyQtlTail returns the inverse of CauchyCDFTail (quantile) of the Cauchy distribution.
+func CauchyQtlTail(δ, γ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || isNaN(δ) || isNaN(γ) {
+			return p + δ + γ
+		}
+		if γ < 0 || isInf(γ, 0) || !pValid(p, logP) {
+			return NaN
+		}
+		if γ == 0 {
+			return δ
+		}
+		lt := lowerTail
+		// reduce to p <= 1/2 on the linear scale, switching tails if needed
+		if logP {
+			if p > -1 {
+				lt = !lt
+				p = -expm1(p)
+			} else {
+				p = exp(p)
+			}
+		} else if p > 0.5 {
+			lt = !lt
+			p = 0.5 - p + 0.5
+		}
+		switch {
+		case p == 0.5:
+			return δ
+		case p == 0 && lt:
+			return negInf
+		case p == 0:
+			return posInf
+		}
+		if lt {
+			return δ - γ/tan(π*p)
+		}
+		return δ + γ/tan(π*p)
+	}
+}
+
 // CauchyNext returns random number drawn from the Cauchy distribution. 
 func CauchyNext(δ, γ float64) float64 {
-	//	p := UniformNext(0, 1)
-	//	return CauchyQtlFor(δ, γ, p)
-	return γ*tan(π*(rand.Float64()-0.5)) + δ // Nolan 2009: 21, Eq. 1.11
+	return CauchyNextR(GlobalRand, δ, γ)
+}
+
+// CauchyNextR returns random number drawn from the Cauchy distribution, using the random source src.
+func CauchyNextR(src *rand.Rand, δ, γ float64) float64 {
+	return γ*tan(π*(src.Float64()-0.5)) + δ // Nolan 2009: 21, Eq. 1.11
 }
 
 // Cauchy returns the random number generator with  Cauchy distribution. 
 func Cauchy(δ, γ float64) func() float64 {
-	return func() float64 { return CauchyNext(δ, γ) }
+	return CauchyR(GlobalRand, δ, γ)
+}
+
+// CauchyR returns the random number generator with  Cauchy distribution, using the random source src.
+func CauchyR(src *rand.Rand, δ, γ float64) func() float64 {
+	return func() float64 { return CauchyNextR(src, δ, γ) }
+}
+
+// CauchyFill fills x with random numbers drawn from the Cauchy distribution.
+func CauchyFill(δ, γ float64, x []float64) {
+	CauchyFillR(GlobalRand, δ, γ, x)
+}
+
+// CauchyFillR fills x with random numbers drawn from the Cauchy distribution, using the random source src.
+func CauchyFillR(src *rand.Rand, δ, γ float64, x []float64) {
+	for i := range x {
+		x[i] = CauchyNextR(src, δ, γ)
+	}
 }
 
 // CauchyMean is not defined. 
@@ -176,6 +267,24 @@ func CauchyMedian(δ, γ float64) float64 {
 	return δ
 }
 
+// CauchyEntropy returns the differential entropy of the Cauchy distribution.
+func CauchyEntropy(δ, γ float64) float64 {
+	return log(4 * π * γ)
+}
+
+// CauchyCF returns the characteristic function of the Cauchy distribution.
+func CauchyCF(δ, γ, t float64) complex128 {
+	return cexp(complex(-γ*abs(t), δ*t))
+}
+
+// CauchyCGF returns the cumulant generating function of the Cauchy distribution, +Inf for t ≠ 0.
+func CauchyCGF(δ, γ, t float64) float64 {
+	if t == 0 {
+		return 0
+	}
+	return posInf
+}
+
 // CauchyVar is not defined. 
 
 // CauchyStd is not defined. 
@@ -185,3 +294,80 @@ func CauchyMedian(δ, γ float64) float64 {
 // CauchyExKurt is not defined. 
 
 // CauchyMGF does not exist.
+
+// CauchyMatchQtls returns the Cauchy distribution whose quantiles for the probabilities p best match x.
+func CauchyMatchQtls(p, x []float64) CauchyDist {
+	// x = δ + γ tan(π(p-1/2))
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return tan(π * (p - 0.5)) }), x)
+	e := matchQtls(func(θ []float64) Continuous { return CauchyDist{θ[0], θ[1]} }, p, x, []float64{a, b}, []mleBound{mleReal, mlePos})
+	return CauchyDist{e[0], e[1]}
+}
+
+// CauchyFit returns the maximum-likelihood estimates of δ, γ of the Cauchy distribution from the sample x, starting from its median and half its interquartile range.
+func CauchyFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return CauchyLnPDF(θ[0], θ[1]) })
+	s := empiricalSorted(x)
+	q1, q2, q3 := empiricalQtl(s, 7, 0.25), empiricalQtl(s, 7, 0.5), empiricalQtl(s, 7, 0.75)
+	return mleFit(lnL, []float64{q2, (q3 - q1) / 2}, []mleBound{mleReal, mlePos})
+}
+
+// CauchyDist is the Cauchy distribution with location δ = Delta and scale γ = Gamma. It implements Continuous.
+type CauchyDist struct {
+	Delta, Gamma float64
+}
+
+// PDF returns the value of PDF of the Cauchy distribution at x.
+func (d CauchyDist) PDF(x float64) float64 { return CauchyPDFAt(d.Delta, d.Gamma, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Cauchy distribution at x.
+func (d CauchyDist) LnPDF(x float64) float64 { return CauchyLnPDF(d.Delta, d.Gamma)(x) }
+
+// CDF returns the value of CDF of the Cauchy distribution at x.
+func (d CauchyDist) CDF(x float64) float64 { return CauchyCDFAt(d.Delta, d.Gamma, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Cauchy distribution at x.
+func (d CauchyDist) Surv(x float64) float64 { return CauchyCDFTail(d.Delta, d.Gamma, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Cauchy distribution at x.
+func (d CauchyDist) LnCDF(x float64) float64 { return CauchyCDFTail(d.Delta, d.Gamma, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Cauchy distribution at x.
+func (d CauchyDist) LnSurv(x float64) float64 { return CauchyCDFTail(d.Delta, d.Gamma, false, true)(x) }
+
+// Qtl returns the quantile of the Cauchy distribution for probability p.
+func (d CauchyDist) Qtl(p float64) float64 { return CauchyQtlFor(d.Delta, d.Gamma, p) }
+
+// QtlTail returns the quantile of the Cauchy distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d CauchyDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return CauchyQtlTail(d.Delta, d.Gamma, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Cauchy distribution.
+func (d CauchyDist) Rand() float64 { return CauchyNext(d.Delta, d.Gamma) }
+
+// RandR returns random number drawn from the Cauchy distribution, using the random source src.
+func (d CauchyDist) RandR(src *rand.Rand) float64 { return CauchyNextR(src, d.Delta, d.Gamma) }
+
+// Mean returns the mean of the Cauchy distribution.
+func (d CauchyDist) Mean() float64 { return NaN } // undefined
+
+// Var returns the variance of the Cauchy distribution.
+func (d CauchyDist) Var() float64 { return NaN } // undefined
+
+// Skew returns the skewness of the Cauchy distribution.
+func (d CauchyDist) Skew() float64 { return NaN } // undefined
+
+// ExKurt returns the excess kurtosis of the Cauchy distribution.
+func (d CauchyDist) ExKurt() float64 { return NaN } // undefined
+
+// Entropy returns the differential entropy of the Cauchy distribution.
+func (d CauchyDist) Entropy() float64 { return CauchyEntropy(d.Delta, d.Gamma) }
+
+// CF returns the characteristic function of the Cauchy distribution at t.
+func (d CauchyDist) CF(t float64) complex128 { return CauchyCF(d.Delta, d.Gamma, t) }
+
+// CGF returns the cumulant generating function of the Cauchy distribution at t.
+func (d CauchyDist) CGF(t float64) float64 { return CauchyCGF(d.Delta, d.Gamma, t) }
+
+// Support returns the support of the Cauchy distribution.
+func (d CauchyDist) Support() (a, b float64) { return negInf, posInf }
@@ -8,12 +8,19 @@ package dst
 // Support: 
 // x ∈ [0, +∞]
 
+import (
+	"math/rand"
+)
+
 // ChiSquarePDF returns the PDF of the ChiSquare distribution. 
 func ChiSquarePDF(n int64) func(x float64) float64 {
 	k := float64(n) / 2
 	normalization := pow(0.5, k) / Γ(k)
 	return func(x float64) float64 {
-		return normalization * pow(x, k-1) * ExponentialNext(-x/2)
+		if x < 0 {
+			return 0
+		}
+		return normalization * pow(x, k-1) * exp(-x/2)
 	}
 }
 
@@ -22,6 +29,12 @@ func ChiSquareLnPDF(n int64) func(x float64) float64 {
 	k := float64(n) / 2
 	normalization := log(0.5)*k - LnΓ(k)
 	return func(x float64) float64 {
+		switch {
+		case x < 0:
+			return negInf
+		case k == 1:
+			return normalization - x/2
+		}
 		return normalization + log(x)*(k-1) - x/2
 	}
 }
@@ -46,6 +59,11 @@ func ChiSquareCDFAt(n int64, x float64) float64 {
 	return cdf(x)
 }
 
+// ChiSquareCDFTail returns the CDF of the Chi-Squared distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ChiSquareCDFTail(n int64, lowerTail, logP bool) func(x float64) float64 {
+	return GammaCDFTail(float64(n)/2, 2, lowerTail, logP)
+}
+
 // ChiSquareQtl returns the inverse of the CDF (quantile) of the ChiSquare distribution. 
 func ChiSquareQtl(n int64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -53,21 +71,40 @@ func ChiSquareQtl(n int64) func(p float64) float64 {
 	}
 }
 
+// ChiSquareQtlTail returns the inverse of ChiSquareCDFTail (quantile) of the Chi-Squared distribution.
+func ChiSquareQtlTail(n int64, lowerTail, logP bool) func(p float64) float64 {
+	return GammaQtlTail(float64(n)/2, 2, lowerTail, logP)
+}
+
 // ChiSquareNext returns random number drawn from the ChiSquare distribution. 
 func ChiSquareNext(n int64) (x float64) {
-	//ChiSquare(n) => sum of n N(0,1)^2
-	for i := iZero; i < n; i++ {
-		n := NormalNext(0, 1)
-		x += n * n
-	}
-	return
+	return ChiSquareNextR(GlobalRand, n)
+}
+
+// ChiSquareNextR returns random number drawn from the ChiSquare distribution, using the random source src.
+func ChiSquareNextR(src *rand.Rand, n int64) (x float64) {
+	// ChiSquare(n) = Gamma(n/2, 2), in constant time rather than as the sum of n N(0,1)^2
+	return GammaNextR(src, float64(n)/2, 2)
 }
 
 // ChiSquare returns the random number generator with  ChiSquare distribution. 
 func ChiSquare(n int64) func() float64 {
-	return func() float64 {
-		return ChiSquareNext(n)
-	}
+	return ChiSquareR(GlobalRand, n)
+}
+
+// ChiSquareR returns the random number generator with  ChiSquare distribution, using the random source src.
+func ChiSquareR(src *rand.Rand, n int64) func() float64 {
+	return GammaR(src, float64(n)/2, 2)
+}
+
+// ChiSquareFill fills x with random numbers drawn from the ChiSquare distribution.
+func ChiSquareFill(n int64, x []float64) {
+	ChiSquareFillR(GlobalRand, n, x)
+}
+
+// ChiSquareFillR fills x with random numbers drawn from the ChiSquare distribution, using the random source src.
+func ChiSquareFillR(src *rand.Rand, n int64, x []float64) {
+	GammaFillR(src, float64(n)/2, 2, x)
 }
 
 // ChiSquareMean returns the mean of the ChiSquare distribution. 
@@ -77,9 +114,9 @@ func ChiSquareMean(n int64) float64 {
 
 // ChiSquareMedian returns the approximate median of the ChiSquare distribution. 
 func ChiSquareMedian(n int64) float64 {
-	c := 1 - (2.0 / (9.0 * n))
+	c := 1 - (2.0 / (9.0 * float64(n)))
 	c = c * c * c
-	return float64(n * c)
+	return float64(n) * c
 }
 
 // ChiSquareMode returns the mode of the ChiSquare distribution. 
@@ -99,10 +136,94 @@ func ChiSquareStd(n int64) float64 {
 
 // ChiSquareSkew returns the skewness of the ChiSquare distribution. 
 func ChiSquareSkew(n int64) float64 {
-	return sqrt(float64(8 / n))
+	return sqrt(8 / float64(n))
 }
 
 // ChiSquareExKurt returns the excess kurtosis of the ChiSquare distribution. 
 func ChiSquareExKurt(n int64) float64 {
-	return float64(12 / n)
+	return 12 / float64(n)
+}
+
+// ChiSquareEntropy returns the differential entropy of the Chi-Squared distribution.
+func ChiSquareEntropy(n int64) float64 {
+	return GammaEntropy(float64(n)/2, 2)
+}
+
+// ChiSquareCF returns the characteristic function of the Chi-Squared distribution.
+func ChiSquareCF(n int64, t float64) complex128 {
+	return GammaCF(float64(n)/2, 2, t)
+}
+
+// ChiSquareCGF returns the cumulant generating function of the Chi-Squared distribution; +Inf for t ≥ ½.
+func ChiSquareCGF(n int64, t float64) float64 {
+	return GammaCGF(float64(n)/2, 2, t)
+}
+
+// ChiSquareFit returns the maximum-likelihood estimate of the degrees of freedom n of the Chi-Squared distribution from the sample x,
+// the integer found by climbing from its mean; its standard error is NaN.
+func ChiSquareFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return ChiSquareLnPDF(int64(θ[0])) })
+	μ, _ := mleMeanVar(x)
+	return mleAtFixed(lnL, mleIntClimb(lnL, []float64{μ}, 1), mleAllFixed(1))
+}
+
+// ChiSquareDist is the Chi-Squared distribution with N degrees of freedom. It implements Continuous.
+type ChiSquareDist struct {
+	N int64
+}
+
+// PDF returns the value of PDF of the Chi-Squared distribution at x.
+func (d ChiSquareDist) PDF(x float64) float64 { return ChiSquarePDFAt(d.N, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Chi-Squared distribution at x.
+func (d ChiSquareDist) LnPDF(x float64) float64 { return ChiSquareLnPDF(d.N)(x) }
+
+// CDF returns the value of CDF of the Chi-Squared distribution at x.
+func (d ChiSquareDist) CDF(x float64) float64 { return ChiSquareCDFAt(d.N, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Chi-Squared distribution at x.
+func (d ChiSquareDist) Surv(x float64) float64 { return ChiSquareCDFTail(d.N, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Chi-Squared distribution at x.
+func (d ChiSquareDist) LnCDF(x float64) float64 { return ChiSquareCDFTail(d.N, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Chi-Squared distribution at x.
+func (d ChiSquareDist) LnSurv(x float64) float64 { return ChiSquareCDFTail(d.N, false, true)(x) }
+
+// Qtl returns the quantile of the Chi-Squared distribution for probability p.
+func (d ChiSquareDist) Qtl(p float64) float64 { return ChiSquareQtl(d.N)(p) }
+
+// QtlTail returns the quantile of the Chi-Squared distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ChiSquareDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return ChiSquareQtlTail(d.N, lowerTail, logP)(p)
 }
+
+// Rand returns random number drawn from the Chi-Squared distribution.
+func (d ChiSquareDist) Rand() float64 { return ChiSquareNext(d.N) }
+
+// RandR returns random number drawn from the Chi-Squared distribution, using the random source src.
+func (d ChiSquareDist) RandR(src *rand.Rand) float64 { return ChiSquareNextR(src, d.N) }
+
+// Mean returns the mean of the Chi-Squared distribution.
+func (d ChiSquareDist) Mean() float64 { return ChiSquareMean(d.N) }
+
+// Var returns the variance of the Chi-Squared distribution.
+func (d ChiSquareDist) Var() float64 { return ChiSquareVar(d.N) }
+
+// Skew returns the skewness of the Chi-Squared distribution.
+func (d ChiSquareDist) Skew() float64 { return ChiSquareSkew(d.N) }
+
+// ExKurt returns the excess kurtosis of the Chi-Squared distribution.
+func (d ChiSquareDist) ExKurt() float64 { return ChiSquareExKurt(d.N) }
+
+// Entropy returns the differential entropy of the Chi-Squared distribution.
+func (d ChiSquareDist) Entropy() float64 { return ChiSquareEntropy(d.N) }
+
+// CF returns the characteristic function of the Chi-Squared distribution at t.
+func (d ChiSquareDist) CF(t float64) complex128 { return ChiSquareCF(d.N, t) }
+
+// CGF returns the cumulant generating function of the Chi-Squared distribution at t.
+func (d ChiSquareDist) CGF(t float64) float64 { return ChiSquareCGF(d.N, t) }
+
+// Support returns the support of the Chi-Squared distribution.
+func (d ChiSquareDist) Support() (a, b float64) { return 0, posInf }
@@ -0,0 +1,353 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Noncentral Chi-Squared distribution.
+// The distribution of Σ (Z_i + μ_i)², the sum of squares of ν independent Normal variables with unit variance and means μ_i, λ = Σ μ_i²:
+// the distribution of the statistic of a Chi-Squared test under the alternative, for calculations of power.
+// It is the Chi-Squared distribution if λ = 0.
+//
+// Parameters:
+// ν > 0		degrees of freedom (real)
+// λ ≥ 0		noncentrality
+//
+// Support:
+// x ∈ [0; ∞)
+
+import (
+	"math/rand"
+)
+
+// NoncentralChiSquarePDF returns the PDF of the noncentral Chi-Squared distribution.
+func NoncentralChiSquarePDF(ν, λ float64) func(x float64) float64 {
+	lnPDF := NoncentralChiSquareLnPDF(ν, λ)
+	return func(x float64) float64 {
+		return exp(lnPDF(x))
+	}
+}
+
+// NoncentralChiSquareLnPDF returns the natural logarithm of the PDF of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareLnPDF(ν, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case ν <= 0 || λ < 0:
+			return NaN
+		case x < 0 || isInf(x, 1):
+			return negInf
+		case x == 0:
+			// only the central term is left
+			if ν < 2 {
+				return posInf
+			}
+			if ν == 2 {
+				return -λ/2 - Ln2
+			}
+			return negInf
+		}
+		// Poisson mixture of the Chi-Squared densities with ν + 2i degrees of freedom
+		return lnPoissonMix(λ/2, func(i float64) float64 {
+			k := ν/2 + i
+			return (k-1)*log(x/2) - x/2 - Ln2 - LnΓ(k)
+		})
+	}
+}
+
+// NoncentralChiSquarePDFAt returns the value of PDF of noncentral Chi-Squared distribution at x.
+func NoncentralChiSquarePDFAt(ν, λ, x float64) float64 {
+	pdf := NoncentralChiSquarePDF(ν, λ)
+	return pdf(x)
+}
+
+// NoncentralChiSquareCDF returns the CDF of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareCDF(ν, λ float64) func(x float64) float64 {
+	return NoncentralChiSquareCDFTail(ν, λ, true, false)
+}
+
+// NoncentralChiSquareCDFAt returns the value of CDF of the noncentral Chi-Squared distribution, at x.
+func NoncentralChiSquareCDFAt(ν, λ, x float64) float64 {
+	cdf := NoncentralChiSquareCDF(ν, λ)
+	return cdf(x)
+}
+
+// NoncentralChiSquareCDFTail returns the CDF of the noncentral Chi-Squared distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func NoncentralChiSquareCDFTail(ν, λ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ν <= 0 || λ < 0 {
+			return NaN
+		}
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		if isInf(x, 1) {
+			return pTailBounds(true, lowerTail, logP)
+		}
+		p := min(0, lnPoissonMix(λ/2, func(i float64) float64 {
+			return pgamma_raw(x/2, ν/2+i, lowerTail, true)
+		}))
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// NoncentralChiSquareQtl returns the inverse of the CDF (quantile) of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareQtl(ν, λ float64) func(p float64) float64 {
+	return NoncentralChiSquareQtlTail(ν, λ, true, false)
+}
+
+// NoncentralChiSquareQtlFor returns the inverse of the CDF (quantile) of the noncentral Chi-Squared distribution, for given probability.
+func NoncentralChiSquareQtlFor(ν, λ, p float64) float64 {
+	qtl := NoncentralChiSquareQtl(ν, λ)
+	return qtl(p)
+}
+
+// NoncentralChiSquareQtlTail returns the inverse of NoncentralChiSquareCDFTail (quantile) of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareQtlTail(ν, λ float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := NoncentralChiSquareCDFTail(ν, λ, lowerTail, logP)
+	return func(p float64) float64 {
+		if ν <= 0 || λ < 0 {
+			return NaN
+		}
+		return qtlTail(cdf, p, 0, posInf, lowerTail, logP)
+	}
+}
+
+// NoncentralChiSquareNext returns random number drawn from the noncentral Chi-Squared distribution.
+func NoncentralChiSquareNext(ν, λ float64) float64 {
+	return NoncentralChiSquareNextR(GlobalRand, ν, λ)
+}
+
+// NoncentralChiSquareNextR returns random number drawn from the noncentral Chi-Squared distribution, using the random source src.
+func NoncentralChiSquareNextR(src *rand.Rand, ν, λ float64) float64 {
+	cs := newNoncentralChiSquareSampler(ν, λ)
+	return cs.next(src)
+}
+
+// noncentralChiSquareSampler draws the central part, and a Chi-Squared variable with 2K degrees of freedom, K ~ Poisson(λ/2).
+type noncentralChiSquareSampler struct {
+	ν, λ float64
+	gs   gammaSampler   // the central part, if ν > 0
+	ps   poissonSampler // K, if λ > 0
+}
+
+func newNoncentralChiSquareSampler(ν, λ float64) noncentralChiSquareSampler {
+	cs := noncentralChiSquareSampler{ν: ν, λ: λ}
+	if ν > 0 {
+		cs.gs = newGammaSampler(ν/2, 2)
+	}
+	if λ > 0 {
+		cs.ps = newPoissonSampler(λ / 2)
+	}
+	return cs
+}
+
+// next returns random number drawn from the noncentral Chi-Squared distribution, using the random source src.
+func (cs *noncentralChiSquareSampler) next(src *rand.Rand) float64 {
+	var x float64
+	if cs.ν > 0 {
+		x = cs.gs.next(src)
+	}
+	if cs.λ > 0 {
+		if k := cs.ps.next(src); k > 0 {
+			x += GammaNextR(src, float64(k), 2)
+		}
+	}
+	return x
+}
+
+// NoncentralChiSquare returns the random number generator with  noncentral Chi-Squared distribution.
+func NoncentralChiSquare(ν, λ float64) func() float64 {
+	return NoncentralChiSquareR(GlobalRand, ν, λ)
+}
+
+// NoncentralChiSquareR returns the random number generator with  noncentral Chi-Squared distribution, using the random source src.
+func NoncentralChiSquareR(src *rand.Rand, ν, λ float64) func() float64 {
+	cs := newNoncentralChiSquareSampler(ν, λ)
+	return func() float64 { return cs.next(src) }
+}
+
+// NoncentralChiSquareFill fills x with random numbers drawn from the noncentral Chi-Squared distribution.
+func NoncentralChiSquareFill(ν, λ float64, x []float64) {
+	NoncentralChiSquareFillR(GlobalRand, ν, λ, x)
+}
+
+// NoncentralChiSquareFillR fills x with random numbers drawn from the noncentral Chi-Squared distribution, using the random source src;
+// the samplers of the central part and of the Poisson count are set up once for all the draws, not the Gamma variate of the count.
+func NoncentralChiSquareFillR(src *rand.Rand, ν, λ float64, x []float64) {
+	next := NoncentralChiSquareR(src, ν, λ)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// noncentralChiSquareRaw returns the raw moments E[X^k], k = 1, ..., 4, of the noncentral Chi-Squared distribution,
+// from its cumulants 2^(k-1) (k-1)! (ν + kλ).
+func noncentralChiSquareRaw(ν, λ float64) (m [5]float64) {
+	k1, k2, k3, k4 := ν+λ, 2*(ν+2*λ), 8*(ν+3*λ), 48*(ν+4*λ)
+	m[0] = 1
+	m[1] = k1
+	m[2] = k2 + k1*k1
+	m[3] = k3 + 3*k2*k1 + k1*k1*k1
+	m[4] = k4 + 4*k3*k1 + 3*k2*k2 + 6*k2*k1*k1 + k1*k1*k1*k1
+	return
+}
+
+// NoncentralChiSquareMean returns the mean of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareMean(ν, λ float64) float64 {
+	return ν + λ
+}
+
+// NoncentralChiSquareVar returns the variance of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareVar(ν, λ float64) float64 {
+	return 2 * (ν + 2*λ)
+}
+
+// NoncentralChiSquareStd returns the standard deviation of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareStd(ν, λ float64) float64 {
+	return sqrt(2 * (ν + 2*λ))
+}
+
+// NoncentralChiSquareSkew returns the skewness of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareSkew(ν, λ float64) float64 {
+	return pow(2, 1.5) * (ν + 3*λ) / pow(ν+2*λ, 1.5)
+}
+
+// NoncentralChiSquareExKurt returns the excess kurtosis of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareExKurt(ν, λ float64) float64 {
+	return 12 * (ν + 4*λ) / ((ν + 2*λ) * (ν + 2*λ))
+}
+
+// NoncentralChiSquareEntropy returns the differential entropy of the noncentral Chi-Squared distribution, by numerical integration.
+func NoncentralChiSquareEntropy(ν, λ float64) float64 {
+	return entropyLnPDF(NoncentralChiSquareLnPDF(ν, λ), NoncentralChiSquareQtl(ν, λ), 0, posInf)
+}
+
+// NoncentralChiSquareCF returns the characteristic function of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareCF(ν, λ, t float64) complex128 {
+	z := complex(1, -2*t)
+	return cexp(complex(0, λ*t)/z) * cpow(z, complex(-ν/2, 0))
+}
+
+// NoncentralChiSquareCGF returns the cumulant generating function of the noncentral Chi-Squared distribution; +Inf for t ≥ ½.
+func NoncentralChiSquareCGF(ν, λ, t float64) float64 {
+	if t >= 0.5 {
+		return posInf
+	}
+	return λ*t/(1-2*t) - ν/2*log1p(-2*t)
+}
+
+// NoncentralChiSquareMGF returns the moment-generating function of the noncentral Chi-Squared distribution.
+func NoncentralChiSquareMGF(ν, λ, t float64) float64 {
+	if t >= 0.5 {
+		return posInf
+	}
+	return exp(λ*t/(1-2*t)) * pow(1-2*t, -ν/2)
+}
+
+// NoncentralChiSquareFromMeanStd returns the noncentral Chi-Squared distribution with mean μ and standard deviation σ,
+// ν = 2μ - σ²/2 and λ = σ²/2 - μ.
+func NoncentralChiSquareFromMeanStd(μ, σ float64) NoncentralChiSquareDist {
+	λ := σ*σ/2 - μ
+	if λ < 0 || μ <= λ {
+		return NoncentralChiSquareDist{NaN, NaN}
+	}
+	return NoncentralChiSquareDist{μ - λ, λ}
+}
+
+// NoncentralChiSquareMatchQtls returns the noncentral Chi-Squared distribution whose quantiles for the probabilities p best match x.
+func NoncentralChiSquareMatchQtls(p, x []float64) NoncentralChiSquareDist {
+	d := NoncentralChiSquareFromMeanStd(qtlMeanStd(p, x))
+	e := matchQtls(func(θ []float64) Continuous { return NoncentralChiSquareDist{θ[0], θ[1]} }, p, x, []float64{d.Nu, d.Lambda}, []mleBound{mlePos, mlePos})
+	return NoncentralChiSquareDist{e[0], e[1]}
+}
+
+// NoncentralChiSquareFit returns the maximum-likelihood estimates of ν, λ of the Noncentral Chi-Squared distribution from the sample x.
+func NoncentralChiSquareFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return NoncentralChiSquareLnPDF(θ[0], θ[1]) })
+	// the mean is ν + λ, the variance 2(ν + 2λ)
+	μ, σ2 := mleMeanVar(x)
+	λ := min(max(σ2/2-μ, μ/10), μ*0.9)
+	return mleFit(lnL, []float64{μ - λ, λ}, []mleBound{mlePos, mlePos})
+}
+
+// NoncentralChiSquareDist is the noncentral Chi-Squared distribution with ν = Nu degrees of freedom and noncentrality λ = Lambda. It implements Continuous.
+type NoncentralChiSquareDist struct {
+	Nu, Lambda float64
+}
+
+// PDF returns the value of PDF of the noncentral Chi-Squared distribution at x.
+func (d NoncentralChiSquareDist) PDF(x float64) float64 {
+	return NoncentralChiSquarePDFAt(d.Nu, d.Lambda, x)
+}
+
+// LnPDF returns the natural logarithm of the PDF of the noncentral Chi-Squared distribution at x.
+func (d NoncentralChiSquareDist) LnPDF(x float64) float64 {
+	return NoncentralChiSquareLnPDF(d.Nu, d.Lambda)(x)
+}
+
+// CDF returns the value of CDF of the noncentral Chi-Squared distribution at x.
+func (d NoncentralChiSquareDist) CDF(x float64) float64 {
+	return NoncentralChiSquareCDFAt(d.Nu, d.Lambda, x)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the noncentral Chi-Squared distribution at x.
+func (d NoncentralChiSquareDist) Surv(x float64) float64 {
+	return NoncentralChiSquareCDFTail(d.Nu, d.Lambda, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the noncentral Chi-Squared distribution at x.
+func (d NoncentralChiSquareDist) LnCDF(x float64) float64 {
+	return NoncentralChiSquareCDFTail(d.Nu, d.Lambda, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the noncentral Chi-Squared distribution at x.
+func (d NoncentralChiSquareDist) LnSurv(x float64) float64 {
+	return NoncentralChiSquareCDFTail(d.Nu, d.Lambda, false, true)(x)
+}
+
+// Qtl returns the quantile of the noncentral Chi-Squared distribution for probability p.
+func (d NoncentralChiSquareDist) Qtl(p float64) float64 {
+	return NoncentralChiSquareQtlFor(d.Nu, d.Lambda, p)
+}
+
+// QtlTail returns the quantile of the noncentral Chi-Squared distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d NoncentralChiSquareDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return NoncentralChiSquareQtlTail(d.Nu, d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the noncentral Chi-Squared distribution.
+func (d NoncentralChiSquareDist) Rand() float64 { return NoncentralChiSquareNext(d.Nu, d.Lambda) }
+
+// RandR returns random number drawn from the noncentral Chi-Squared distribution, using the random source src.
+func (d NoncentralChiSquareDist) RandR(src *rand.Rand) float64 {
+	return NoncentralChiSquareNextR(src, d.Nu, d.Lambda)
+}
+
+// Mean returns the mean of the noncentral Chi-Squared distribution.
+func (d NoncentralChiSquareDist) Mean() float64 { return NoncentralChiSquareMean(d.Nu, d.Lambda) }
+
+// Var returns the variance of the noncentral Chi-Squared distribution.
+func (d NoncentralChiSquareDist) Var() float64 { return NoncentralChiSquareVar(d.Nu, d.Lambda) }
+
+// Skew returns the skewness of the noncentral Chi-Squared distribution.
+func (d NoncentralChiSquareDist) Skew() float64 { return NoncentralChiSquareSkew(d.Nu, d.Lambda) }
+
+// ExKurt returns the excess kurtosis of the noncentral Chi-Squared distribution.
+func (d NoncentralChiSquareDist) ExKurt() float64 { return NoncentralChiSquareExKurt(d.Nu, d.Lambda) }
+
+// Entropy returns the differential entropy of the noncentral Chi-Squared distribution.
+func (d NoncentralChiSquareDist) Entropy() float64 { return NoncentralChiSquareEntropy(d.Nu, d.Lambda) }
+
+// CF returns the characteristic function of the noncentral Chi-Squared distribution at t.
+func (d NoncentralChiSquareDist) CF(t float64) complex128 {
+	return NoncentralChiSquareCF(d.Nu, d.Lambda, t)
+}
+
+// CGF returns the cumulant generating function of the noncentral Chi-Squared distribution at t.
+func (d NoncentralChiSquareDist) CGF(t float64) float64 {
+	return NoncentralChiSquareCGF(d.Nu, d.Lambda, t)
+}
+
+// Support returns the support of the noncentral Chi-Squared distribution.
+func (d NoncentralChiSquareDist) Support() (a, b float64) { return 0, posInf }
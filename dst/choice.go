@@ -1,6 +1,8 @@
 package dst
 
-import ()
+import (
+	"math/rand"
+)
 
 func ChoicePMF(θ []float64) func(i int64) float64 {
 	return func(i int64) float64 {
@@ -15,7 +17,12 @@ func ChoiceLnPMF(θ []float64) func(i int64) float64 {
 }
 
 func ChoiceNext(θ []float64) int64 {
-	u := UniformNext(0, 1)
+	return ChoiceNextR(GlobalRand, θ)
+}
+
+// ChoiceNextR returns random number drawn from the categorical distribution, using the random source src.
+func ChoiceNextR(src *rand.Rand, θ []float64) int64 {
+	u := UniformNextR(src, 0, 1)
 	i := 0
 	sum := θ[0]
 	for ; sum < u && i < len(θ)-1; i++ {
@@ -28,16 +35,62 @@ func ChoiceNext(θ []float64) int64 {
 }
 
 func Choice(θ []float64) func() int64 {
+	return ChoiceR(GlobalRand, θ)
+}
+
+// ChoiceR returns the random number generator with  categorical distribution, using the random source src;
+// it draws in constant time from the alias table of θ.
+func ChoiceR(src *rand.Rand, θ []float64) func() int64 {
+	a := choiceAlias(θ)
 	return func() int64 {
-		return ChoiceNext(θ)
+		return a.NextR(src)
+	}
+}
+
+// ChoiceFill fills x with random numbers drawn from the categorical distribution.
+func ChoiceFill(θ []float64, x []int64) {
+	ChoiceFillR(GlobalRand, θ, x)
+}
+
+// ChoiceFillR fills x with random numbers drawn from the categorical distribution, using the random source src;
+// it draws in constant time from the alias table of θ.
+func ChoiceFillR(src *rand.Rand, θ []float64, x []int64) {
+	choiceAlias(θ).FillR(src, x)
+}
+
+// choiceAlias returns the alias table of the categorical distribution; like ChoiceNext, it draws len(θ) with the probability 1 - Σθ missing from θ,
+// unless it is only the rounding of the sum.
+func choiceAlias(θ []float64) *Alias {
+	sum := fZero
+	for _, v := range θ {
+		sum += v
+	}
+	if sum < 1-float64(len(θ))*eps64 {
+		return NewAlias(append(append([]float64(nil), θ...), 1-sum))
 	}
+	return NewAlias(θ)
 }
 
 func LogChoiceNext(lws []float64) int64 {
-	return LogChoice(lws)()
+	return LogChoiceNextR(GlobalRand, lws)
+}
+
+// LogChoiceNextR returns random number drawn from the categorical distribution with log-weights lws, using the random source src.
+func LogChoiceNextR(src *rand.Rand, lws []float64) int64 {
+	return ChoiceNextR(src, logChoiceWeights(lws))
 }
 
 func LogChoice(lws []float64) func() int64 {
+	return LogChoiceR(GlobalRand, lws)
+}
+
+// LogChoiceR returns the random number generator with  categorical distribution with log-weights lws, using the random source src.
+func LogChoiceR(src *rand.Rand, lws []float64) func() int64 {
+	return ChoiceR(src, logChoiceWeights(lws))
+}
+
+// logChoiceWeights returns the probabilities of the categorical distribution with log-weights lws.
+func logChoiceWeights(lws []float64) []float64 {
 	max := lws[0]
 	for _, lw := range lws[1:len(lws)] {
 		if lw > max {
@@ -54,5 +107,169 @@ func LogChoice(lws []float64) func() int64 {
 	for i := range ws {
 		ws[i] *= norm
 	}
-	return Choice(ws)
+	return ws
+}
+
+// LogChoiceFill fills x with random numbers drawn from the categorical distribution with log-weights lws.
+func LogChoiceFill(lws []float64, x []int64) {
+	LogChoiceFillR(GlobalRand, lws, x)
+}
+
+// LogChoiceFillR fills x with random numbers drawn from the categorical distribution with log-weights lws, using the random source src;
+// the weights are normalized, and their alias table built, once for all the draws.
+func LogChoiceFillR(src *rand.Rand, lws []float64, x []int64) {
+	next := LogChoiceR(src, lws)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// ChoiceFit returns the maximum-likelihood estimates of the probabilities θ of the Choice distribution on {0, ..., max(k)} from the sample k:
+// the relative frequencies, with their multinomial covariance matrix.
+func ChoiceFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return ChoiceDist{θ}.LnPMF })
+	_, b := mleMinMaxInt(k)
+	θ := make([]float64, b+1)
+	for _, v := range k {
+		θ[v]++
+	}
+	n := float64(len(k))
+	for i := range θ {
+		θ[i] /= n
+	}
+	r := MLE{Est: θ, StdErr: make([]float64, len(θ)), Cov: make([][]float64, len(θ)), LnL: lnL(θ)}
+	for i := range θ {
+		r.Cov[i] = make([]float64, len(θ))
+		for j := range θ {
+			r.Cov[i][j] = -θ[i] * θ[j] / n
+		}
+		r.Cov[i][i] += θ[i] / n
+		r.StdErr[i] = sqrt(r.Cov[i][i])
+	}
+	return r
+}
+
+// ChoiceEntropy returns the Shannon entropy of the categorical distribution.
+func ChoiceEntropy(θ []float64) float64 {
+	h := fZero
+	for _, p := range θ {
+		if p > 0 {
+			h -= p * log(p)
+		}
+	}
+	return h
+}
+
+// ChoiceCF returns the characteristic function of the categorical distribution.
+func ChoiceCF(θ []float64, t float64) complex128 {
+	var φ complex128
+	for k, p := range θ {
+		φ += complex(p, 0) * cexp(complex(0, t*float64(k)))
+	}
+	return φ
 }
+
+// ChoiceCGF returns the cumulant generating function of the categorical distribution.
+func ChoiceCGF(θ []float64, t float64) float64 {
+	x := make([]float64, len(θ))
+	for k, p := range θ {
+		x[k] = log(p) + t*float64(k)
+	}
+	return logSumExp(x)
+}
+
+// ChoiceDist is the categorical distribution on {0, ..., len(Theta)-1} with probabilities Theta. It implements Discrete.
+type ChoiceDist struct {
+	Theta []float64
+}
+
+// PMF returns the value of PMF of the categorical distribution at k.
+func (d ChoiceDist) PMF(k int64) float64 {
+	if k < 0 || k >= int64(len(d.Theta)) {
+		return 0
+	}
+	return ChoicePMF(d.Theta)(k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the categorical distribution at k.
+func (d ChoiceDist) LnPMF(k int64) float64 {
+	if k < 0 || k >= int64(len(d.Theta)) {
+		return negInf
+	}
+	return ChoiceLnPMF(d.Theta)(k)
+}
+
+// CDF returns the value of CDF of the categorical distribution at k.
+func (d ChoiceDist) CDF(k int64) float64 {
+	p := 0.0
+	for i := 0; i < len(d.Theta) && int64(i) <= k; i++ {
+		p += d.Theta[i]
+	}
+	return p
+}
+
+// Surv returns the value of the survival function 1 - CDF of the categorical distribution at k.
+func (d ChoiceDist) Surv(k int64) float64 {
+	p := 0.0
+	for i := len(d.Theta) - 1; i >= 0 && int64(i) > k; i-- {
+		p += d.Theta[i]
+	}
+	return p
+}
+
+// LnCDF returns the natural logarithm of the CDF of the categorical distribution at k.
+func (d ChoiceDist) LnCDF(k int64) float64 { return log(d.CDF(k)) }
+
+// LnSurv returns the natural logarithm of the survival function of the categorical distribution at k.
+func (d ChoiceDist) LnSurv(k int64) float64 { return log(d.Surv(k)) }
+
+// Qtl returns the quantile of the categorical distribution for probability p.
+func (d ChoiceDist) Qtl(p float64) int64 { return qtlSearch(d.CDF, p, 0, int64(len(d.Theta))-1) }
+
+// QtlTail returns the quantile of the categorical distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ChoiceDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	cdf := func(k int64) float64 { return pTail2(d.CDF(k), d.Surv(k), lowerTail, logP) }
+	return qtlSearchTail(cdf, p, 0, int64(len(d.Theta))-1, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the categorical distribution.
+func (d ChoiceDist) Rand() int64 { return ChoiceNext(d.Theta) }
+
+// RandR returns random number drawn from the categorical distribution, using the random source src.
+func (d ChoiceDist) RandR(src *rand.Rand) int64 { return ChoiceNextR(src, d.Theta) }
+
+// Mean returns the mean of the categorical distribution.
+func (d ChoiceDist) Mean() float64 {
+	μ, _, _, _ := discreteMoments(d.PMF, 0, int64(len(d.Theta))-1)
+	return μ
+}
+
+// Var returns the variance of the categorical distribution.
+func (d ChoiceDist) Var() float64 {
+	_, σ2, _, _ := discreteMoments(d.PMF, 0, int64(len(d.Theta))-1)
+	return σ2
+}
+
+// Skew returns the skewness of the categorical distribution.
+func (d ChoiceDist) Skew() float64 {
+	_, _, s, _ := discreteMoments(d.PMF, 0, int64(len(d.Theta))-1)
+	return s
+}
+
+// ExKurt returns the excess kurtosis of the categorical distribution.
+func (d ChoiceDist) ExKurt() float64 {
+	_, _, _, k := discreteMoments(d.PMF, 0, int64(len(d.Theta))-1)
+	return k
+}
+
+// Entropy returns the Shannon entropy of the categorical distribution.
+func (d ChoiceDist) Entropy() float64 { return ChoiceEntropy(d.Theta) }
+
+// CF returns the characteristic function of the categorical distribution at t.
+func (d ChoiceDist) CF(t float64) complex128 { return ChoiceCF(d.Theta, t) }
+
+// CGF returns the cumulant generating function of the categorical distribution at t.
+func (d ChoiceDist) CGF(t float64) float64 { return ChoiceCGF(d.Theta, t) }
+
+// Support returns the support of the categorical distribution.
+func (d ChoiceDist) Support() (a, b int64) { return 0, int64(len(d.Theta)) - 1 }
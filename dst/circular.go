@@ -0,0 +1,82 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Circular distributions, helper functions.
+// Angles are measured in radians, on the interval [μ - π; μ + π] centred at the mean direction μ.
+// A circular distribution symmetric about μ is given by its trigonometric moments a_j = E[cos(j(θ - μ))], j = 1, 2, ...,
+// as the Fourier series f(θ) = (1 + 2 Σ a_j cos(j(θ - μ))) / 2π.
+// The linear moments (Var, ExKurt) refer to θ on that interval; the circular ones (CircMean, CircVar) do not depend on it.
+
+// wrapAngle returns the angle θ reduced to [-π; π).
+func wrapAngle(θ float64) float64 {
+	return θ - 2*π*floor((θ+π)/(2*π))
+}
+
+// circPDF returns the density at θ ∈ [-π; π] of the circular distribution centred at 0 with trigonometric moments a.
+func circPDF(a []float64, θ float64) float64 {
+	s := 1.0
+	for j, aj := range a {
+		s += 2 * aj * cos(float64(j+1)*θ)
+	}
+	return s / (2 * π)
+}
+
+// circCDF returns the CDF at θ ∈ [-π; π] of the circular distribution centred at 0 with trigonometric moments a.
+func circCDF(a []float64, θ float64) float64 {
+	if θ <= -π {
+		return 0
+	}
+	if θ >= π {
+		return 1
+	}
+	s := (θ + π) / 2
+	for j, aj := range a {
+		k := float64(j + 1)
+		s += aj * sin(k*θ) / k
+	}
+	// the truncated series may stray out of [0, 1] far in the tails
+	switch p := s / π; {
+	case p < 0:
+		return 0
+	case p > 1:
+		return 1
+	default:
+		return p
+	}
+}
+
+// circVarKurt returns the variance and the excess kurtosis of θ ∈ [-π; π] of the circular distribution centred at 0 with trigonometric moments a.
+func circVarKurt(a []float64) (v, exKurt float64) {
+	// ∫ θ² cos(kθ) dθ = 4π(-1)^k / k², ∫ θ⁴ cos(kθ) dθ = (-1)^k (8π³ / k² - 48π / k⁴) over [-π; π]
+	m2, m4 := π*π/3, π*π*π*π/5
+	for j, aj := range a {
+		k := float64(j + 1)
+		s := aj / (k * k)
+		if j%2 == 0 {
+			s = -s
+		}
+		m2 += 4 * s
+		m4 += s * (8*π*π - 48/(k*k))
+	}
+	return m2, m4/(m2*m2) - 3
+}
+
+// circSampleMean returns the mean direction μ and the mean resultant length R of the sample of angles x.
+func circSampleMean(x []float64) (μ, R float64) {
+	var c, s float64
+	for _, v := range x {
+		c += cos(v)
+		s += sin(v)
+	}
+	n := float64(len(x))
+	return atan2(s, c), sqrt(c*c+s*s) / n
+}
+
+// circLnPDF returns the logarithm of the PDF lnPDF of a circular distribution with mean direction μ,
+// at any angle, reduced to the interval [μ - π; μ + π].
+func circLnPDF(μ float64, lnPDF func(x float64) float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		return lnPDF(μ + wrapAngle(x-μ))
+	}
+}
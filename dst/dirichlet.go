@@ -8,6 +8,10 @@ package dst
 // Support: 
 // θi ∈ [0, 1] and Σθi = 1
 
+import (
+	"math/rand"
+)
+
 // DirichletPDF returns the PDF of the Dirichlet distribution. 
 func DirichletPDF(α []float64) func(θ []float64) float64 {
 	return func(θ []float64) float64 {
@@ -60,22 +64,67 @@ func DirichletPDFAt(α, θ []float64) float64 {
 
 // DirichletNext returns random number drawn from the Dirichlet distribution. 
 func DirichletNext(α []float64) []float64 {
-	k := len(α)
-	x := make([]float64, k)
+	return DirichletNextR(GlobalRand, α)
+}
+
+// DirichletNextR returns random number drawn from the Dirichlet distribution, using the random source src.
+func DirichletNextR(src *rand.Rand, α []float64) []float64 {
+	x := make([]float64, len(α))
+	ds := newDirichletSampler(α)
+	ds.next(src, x)
+	return x
+}
+
+// dirichletSampler draws from the Dirichlet distribution, as Gamma variates of the shapes α normalized to sum 1.
+type dirichletSampler []gammaSampler
+
+func newDirichletSampler(α []float64) dirichletSampler {
+	ds := make(dirichletSampler, len(α))
+	for i, a := range α {
+		ds[i] = newGammaSampler(a, 1.0)
+	}
+	return ds
+}
+
+// next stores in x random vector drawn from the Dirichlet distribution.
+func (ds dirichletSampler) next(src *rand.Rand, x []float64) {
 	sum := fZero
-	for i := 0; i < len(α); i++ {
-		x[i] = GammaNext(α[i], 1.0)
+	for i := range ds {
+		x[i] = ds[i].next(src)
 		sum += x[i]
 	}
-	for i := 0; i < len(α); i++ {
+	for i := range ds {
 		x[i] /= sum
 	}
-	return x
 }
 
 // Dirichlet returns the random number generator with  Dirichlet distribution. 
 func Dirichlet(α []float64) func() []float64 {
-	return func() []float64 { return DirichletNext(α) }
+	return DirichletR(GlobalRand, α)
+}
+
+// DirichletR returns the random number generator with  Dirichlet distribution, using the random source src.
+func DirichletR(src *rand.Rand, α []float64) func() []float64 {
+	ds := newDirichletSampler(α)
+	return func() []float64 {
+		x := make([]float64, len(α))
+		ds.next(src, x)
+		return x
+	}
+}
+
+// DirichletFill fills each row of x with random vector drawn from the Dirichlet distribution.
+func DirichletFill(α []float64, x [][]float64) {
+	DirichletFillR(GlobalRand, α, x)
+}
+
+// DirichletFillR fills each row of x with random vector drawn from the Dirichlet distribution, using the random source src;
+// the Gamma samplers of the components are set up once for all the rows.
+func DirichletFillR(src *rand.Rand, α []float64, x [][]float64) {
+	ds := newDirichletSampler(α)
+	for _, xi := range x {
+		ds.next(src, xi)
+	}
 }
 
 // DirichletMean returns the mean of the Dirichlet distribution. 
@@ -126,3 +175,32 @@ func DirichletVar(α []float64) []float64 {
 	}
 	return x
 }
+
+// DirichletEntropy returns the differential entropy of the Dirichlet distribution.
+func DirichletEntropy(α []float64) float64 {
+	α0 := fZero
+	h := fZero
+	for _, a := range α {
+		α0 += a
+		h += LnΓ(a) - (a-1)*digamma(a)
+	}
+	return h - LnΓ(α0) + (α0-float64(len(α)))*digamma(α0)
+}
+
+// DirichletKL returns the Kullback–Leibler divergence of the Dirichlet distribution with α2 from the one with α1.
+func DirichletKL(α1, α2 []float64) float64 {
+	if len(α1) != len(α2) {
+		panic("bad parameters")
+	}
+	s1, s2 := fZero, fZero
+	for i := range α1 {
+		s1 += α1[i]
+		s2 += α2[i]
+	}
+	kl := LnΓ(s1) - LnΓ(s2)
+	ψ0 := digamma(s1)
+	for i := range α1 {
+		kl += LnΓ(α2[i]) - LnΓ(α1[i]) + (α1[i]-α2[i])*(digamma(α1[i])-ψ0)
+	}
+	return kl
+}
@@ -0,0 +1,225 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Dirichlet-multinomial distribution.
+// The counts in n trials with the event probabilities drawn from the Dirichlet distribution with concentrations α:
+// the Multinomial distribution overdispersed by the variation of the probabilities between groups of trials.
+// It is the multivariate generalization of the Beta-binomial distribution, and approaches the Multinomial distribution with θi = αi / Σα as Σα → ∞.
+// The marginal distribution of xi is Beta-binomial with shapes αi and Σα - αi; being multivariate, the distribution has no CDF nor quantiles.
+// The reparametrization by the mean probabilities μ = α / Σα and the sample size ν = Σα is provided by the DirichletMultinomialμν functions.
+//
+// Parameters:
+// αi > 0		concentration parameters
+// n ∈ N0		number of trials
+//
+// Support:
+// xi ∈ {0, ... , n}
+// Σxi = n
+
+import (
+	"math/rand"
+)
+
+// DirichletMultinomialPMF returns the PMF of the Dirichlet-multinomial distribution.
+func DirichletMultinomialPMF(α []float64, n int64) func(x []int64) float64 {
+	lnPMF := DirichletMultinomialLnPMF(α, n)
+	return func(x []int64) float64 {
+		return exp(lnPMF(x))
+	}
+}
+
+// DirichletMultinomialLnPMF returns the natural logarithm of the PMF of the Dirichlet-multinomial distribution.
+func DirichletMultinomialLnPMF(α []float64, n int64) func(x []int64) float64 {
+	return func(x []int64) float64 {
+		if len(x) != len(α) {
+			return negInf
+		}
+		l := fZero
+		totalx := iZero
+		totalα := fZero
+		for i := 0; i < len(x); i++ {
+			if x[i] < 0 {
+				return negInf
+			}
+			l += LnΓ(float64(x[i])+α[i]) - LnΓ(α[i]) - LnΓ(float64(x[i]+1))
+			totalx += x[i]
+			totalα += α[i]
+		}
+		if totalx != n {
+			return negInf
+		}
+		l += LnΓ(float64(n+1)) + LnΓ(totalα) - LnΓ(float64(n)+totalα)
+		return l
+	}
+}
+
+// DirichletMultinomialPMFAt returns the value of PMF of Dirichlet-multinomial distribution at x.
+func DirichletMultinomialPMFAt(α []float64, n int64, x []int64) float64 {
+	pmf := DirichletMultinomialPMF(α, n)
+	return pmf(x)
+}
+
+// DirichletMultinomialNext returns random vector drawn from the Dirichlet-multinomial distribution.
+func DirichletMultinomialNext(α []float64, n int64) []int64 {
+	return DirichletMultinomialNextR(GlobalRand, α, n)
+}
+
+// DirichletMultinomialNextR returns random vector drawn from the Dirichlet-multinomial distribution, using the random source src.
+func DirichletMultinomialNextR(src *rand.Rand, α []float64, n int64) []int64 {
+	return MultinomialNextR(src, DirichletNextR(src, α), n)
+}
+
+// DirichletMultinomial returns the random vector generator with  Dirichlet-multinomial distribution.
+func DirichletMultinomial(α []float64, n int64) func() []int64 {
+	return DirichletMultinomialR(GlobalRand, α, n)
+}
+
+// DirichletMultinomialR returns the random vector generator with  Dirichlet-multinomial distribution, using the random source src.
+func DirichletMultinomialR(src *rand.Rand, α []float64, n int64) func() []int64 {
+	return func() []int64 {
+		return DirichletMultinomialNextR(src, α, n)
+	}
+}
+
+// DirichletMultinomialFill fills each row of x with random vector drawn from the Dirichlet-multinomial distribution.
+func DirichletMultinomialFill(α []float64, n int64, x [][]int64) {
+	DirichletMultinomialFillR(GlobalRand, α, n, x)
+}
+
+// DirichletMultinomialFillR fills each row of x with random vector drawn from the Dirichlet-multinomial distribution, using the random source src;
+// the Dirichlet sampler of the probabilities is set up once for all the rows.
+func DirichletMultinomialFillR(src *rand.Rand, α []float64, n int64, x [][]int64) {
+	θ := make([]float64, len(α))
+	ds := newDirichletSampler(α)
+	for _, xi := range x {
+		ds.next(src, θ)
+		multinomialInto(src, θ, n, xi)
+	}
+}
+
+// DirichletMultinomialMean returns the mean of the Dirichlet-multinomial distribution.
+func DirichletMultinomialMean(α []float64, n int64) []float64 {
+	return MultinomialMean(DirichletMean(α), n)
+}
+
+// DirichletMultinomialVar returns the variance of the Dirichlet-multinomial distribution.
+func DirichletMultinomialVar(α []float64, n int64) []float64 {
+	totalα := fZero
+	for i := 0; i < len(α); i++ {
+		totalα += α[i]
+	}
+	// the Multinomial variance, inflated by the overdispersion
+	x := MultinomialVar(DirichletMean(α), n)
+	for i := range x {
+		x[i] *= (float64(n) + totalα) / (1 + totalα)
+	}
+	return x
+}
+
+// DirichletMultinomialStd returns the standard deviation of the Dirichlet-multinomial distribution.
+func DirichletMultinomialStd(α []float64, n int64) []float64 {
+	x := DirichletMultinomialVar(α, n)
+	for i := range x {
+		x[i] = sqrt(x[i])
+	}
+	return x
+}
+
+// DirichletMultinomialCov returns the covariance matrix of the Dirichlet-multinomial distribution.
+func DirichletMultinomialCov(α []float64, n int64) [][]float64 {
+	k := len(α)
+	totalα := fZero
+	for i := 0; i < k; i++ {
+		totalα += α[i]
+	}
+	θ := DirichletMean(α)
+	c := float64(n) * (float64(n) + totalα) / (1 + totalα)
+	x := make([][]float64, k)
+	for i := 0; i < k; i++ {
+		x[i] = make([]float64, k)
+		for j := 0; j < k; j++ {
+			if i == j {
+				x[i][j] = c * θ[i] * (1 - θ[i])
+			} else {
+				x[i][j] = -c * θ[i] * θ[j]
+			}
+		}
+	}
+	return x
+}
+
+// dirichletMultinomialα returns the concentration parameters α = μν.
+func dirichletMultinomialα(μ []float64, ν float64) []float64 {
+	α := make([]float64, len(μ))
+	for i := range μ {
+		α[i] = μ[i] * ν
+	}
+	return α
+}
+
+// DirichletMultinomialμνPMF returns the PMF of the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνPMF(μ []float64, ν float64, n int64) func(x []int64) float64 {
+	return DirichletMultinomialPMF(dirichletMultinomialα(μ, ν), n)
+}
+
+// DirichletMultinomialμνLnPMF returns the natural logarithm of the PMF of the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνLnPMF(μ []float64, ν float64, n int64) func(x []int64) float64 {
+	return DirichletMultinomialLnPMF(dirichletMultinomialα(μ, ν), n)
+}
+
+// DirichletMultinomialμνPMFAt returns the value of PMF of the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size, at x.
+func DirichletMultinomialμνPMFAt(μ []float64, ν float64, n int64, x []int64) float64 {
+	pmf := DirichletMultinomialμνPMF(μ, ν, n)
+	return pmf(x)
+}
+
+// DirichletMultinomialμνNext returns random vector drawn from the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνNext(μ []float64, ν float64, n int64) []int64 {
+	return DirichletMultinomialμνNextR(GlobalRand, μ, ν, n)
+}
+
+// DirichletMultinomialμνNextR returns random vector drawn from the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size, using the random source src.
+func DirichletMultinomialμνNextR(src *rand.Rand, μ []float64, ν float64, n int64) []int64 {
+	return DirichletMultinomialNextR(src, dirichletMultinomialα(μ, ν), n)
+}
+
+// DirichletMultinomialμν returns the random vector generator with  Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμν(μ []float64, ν float64, n int64) func() []int64 {
+	return DirichletMultinomialμνR(GlobalRand, μ, ν, n)
+}
+
+// DirichletMultinomialμνR returns the random vector generator with  Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size, using the random source src.
+func DirichletMultinomialμνR(src *rand.Rand, μ []float64, ν float64, n int64) func() []int64 {
+	return DirichletMultinomialR(src, dirichletMultinomialα(μ, ν), n)
+}
+
+// DirichletMultinomialμνFill fills each row of x with random vector drawn from the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνFill(μ []float64, ν float64, n int64, x [][]int64) {
+	DirichletMultinomialμνFillR(GlobalRand, μ, ν, n, x)
+}
+
+// DirichletMultinomialμνFillR fills each row of x with random vector drawn from the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size, using the random source src.
+func DirichletMultinomialμνFillR(src *rand.Rand, μ []float64, ν float64, n int64, x [][]int64) {
+	DirichletMultinomialFillR(src, dirichletMultinomialα(μ, ν), n, x)
+}
+
+// DirichletMultinomialμνMean returns the mean of the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνMean(μ []float64, ν float64, n int64) []float64 {
+	return MultinomialMean(μ, n)
+}
+
+// DirichletMultinomialμνVar returns the variance of the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνVar(μ []float64, ν float64, n int64) []float64 {
+	return DirichletMultinomialVar(dirichletMultinomialα(μ, ν), n)
+}
+
+// DirichletMultinomialμνStd returns the standard deviation of the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνStd(μ []float64, ν float64, n int64) []float64 {
+	return DirichletMultinomialStd(dirichletMultinomialα(μ, ν), n)
+}
+
+// DirichletMultinomialμνCov returns the covariance matrix of the Dirichlet-multinomial distribution reparametrized using mean probabilities and sample size.
+func DirichletMultinomialμνCov(μ []float64, ν float64, n int64) [][]float64 {
+	return DirichletMultinomialCov(dirichletMultinomialα(μ, ν), n)
+}
@@ -0,0 +1,154 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Common interfaces of univariate distributions.
+// Every family provides, besides its closure-based functions (NormalPDF, NormalCDF, ...),
+// a concrete type (NormalDist, GammaDist, PoissonDist, ...) holding the parameters,
+// whose methods delegate to these functions. Generic code (fitting, plotting, priors)
+// can then accept any Continuous or Discrete distribution.
+
+import (
+	"math/rand"
+)
+
+// Continuous is a univariate continuous probability distribution.
+type Continuous interface {
+	PDF(x float64) float64                           // probability density function
+	LnPDF(x float64) float64                         // natural logarithm of the PDF
+	CDF(x float64) float64                           // cumulative distribution function
+	Surv(x float64) float64                          // survival function, 1 - CDF
+	LnCDF(x float64) float64                         // natural logarithm of the CDF
+	LnSurv(x float64) float64                        // natural logarithm of the survival function
+	Qtl(p float64) float64                           // quantile function, inverse of the CDF
+	QtlTail(p float64, lowerTail, logP bool) float64 // quantile for p of the lower or upper tail, p given as logarithm if logP
+	Rand() float64                                   // random number drawn from the distribution
+	RandR(src *rand.Rand) float64                    // random number drawn from the distribution, using the random source src
+	Mean() float64                                   // mean
+	Var() float64                                    // variance
+	Skew() float64                                   // skewness
+	ExKurt() float64                                 // excess kurtosis
+	Entropy() float64                                // differential entropy, in nats
+	CF(t float64) complex128                         // characteristic function E[e^{itX}]
+	CGF(t float64) float64                           // cumulant generating function ln E[e^{tX}], +Inf where the moment-generating function diverges
+	Support() (a, b float64)                         // support [a, b], possibly infinite
+}
+
+// Discrete is a univariate discrete probability distribution on integers.
+type Discrete interface {
+	PMF(k int64) float64                           // probability mass function
+	LnPMF(k int64) float64                         // natural logarithm of the PMF
+	CDF(k int64) float64                           // cumulative distribution function
+	Surv(k int64) float64                          // survival function, 1 - CDF
+	LnCDF(k int64) float64                         // natural logarithm of the CDF
+	LnSurv(k int64) float64                        // natural logarithm of the survival function
+	Qtl(p float64) int64                           // quantile function, the smallest k with CDF(k) >= p; it panics if p or the parameters are invalid
+	QtlTail(p float64, lowerTail, logP bool) int64 // quantile for p of the lower or upper tail, p given as logarithm if logP
+	Rand() int64                                   // random number drawn from the distribution
+	RandR(src *rand.Rand) int64                    // random number drawn from the distribution, using the random source src
+	Mean() float64                                 // mean
+	Var() float64                                  // variance
+	Skew() float64                                 // skewness
+	ExKurt() float64                               // excess kurtosis
+	Entropy() float64                              // Shannon entropy, in nats
+	CF(t float64) complex128                       // characteristic function E[e^{itX}]
+	CGF(t float64) float64                         // cumulant generating function ln E[e^{tX}], +Inf where the moment-generating function diverges
+	Support() (a, b int64)                         // support {a, ..., b}; b is math.MaxInt64 if unbounded
+}
+
+// bisectFn solves f(x) = y for a nondecreasing f by bisection on [a, b]; infinite bounds are bracketed first.
+func bisectFn(f func(x float64) float64, y, a, b float64) float64 {
+	const tol = 1e-12
+	lo, hi := a, b
+	if isInf(lo, -1) {
+		lo = -1
+		if !isInf(hi, 1) && hi-1 < lo {
+			lo = hi - 1
+		}
+		for step := 1.0; f(lo) > y; step *= 2 {
+			lo -= step
+		}
+	}
+	if isInf(hi, 1) {
+		hi = lo + 1
+		for step := 1.0; f(hi) < y; step *= 2 {
+			hi += step
+		}
+	}
+	for i := 0; i < 1100 && hi-lo > tol*(abs(lo)+abs(hi)); i++ {
+		x := lo + (hi-lo)/2
+		if x == lo || x == hi {
+			break
+		}
+		if f(x) < y {
+			lo = x
+		} else {
+			hi = x
+		}
+	}
+	return lo + (hi-lo)/2
+}
+
+// qtlSearch inverts a discrete CDF on the support {a, ..., b} by searching upwards from a;
+// it panics with ErrQtlProb for p not in [0, 1], and with ErrQtlParam if the CDF is NaN, as it is for invalid parameters.
+func qtlSearch(cdf func(k int64) float64, p float64, a, b int64) int64 {
+	if isNaN(p) || p < 0 || p > 1 {
+		panic(ErrQtlProb)
+	}
+	if isNaN(cdf(a)) {
+		panic(ErrQtlParam)
+	}
+	if p == 1 {
+		return b
+	}
+	p *= 1 - 64*eps64
+	return searchUp(func(k int64) bool { return cdf(k) >= p }, a)
+}
+
+// searchUp returns the smallest k ≥ a for which ok(k) holds, ok being monotone.
+func searchUp(ok func(k int64) bool, a int64) int64 {
+	// double the step until the quantile is bracketed, then bisect
+	lo, hi := a, a
+	for step := int64(1); !ok(hi); step *= 2 {
+		lo = hi + 1
+		hi += step
+	}
+	for lo < hi {
+		k := lo + (hi-lo)/2
+		if !ok(k) {
+			lo = k + 1
+		} else {
+			hi = k
+		}
+	}
+	return lo
+}
+
+// discreteMoments returns the mean, variance, skewness and excess kurtosis of a distribution with finite support {a, ..., b}.
+func discreteMoments(pmf func(k int64) float64, a, b int64) (μ, σ2, skew, kurt float64) {
+	for k := a; k <= b; k++ {
+		μ += float64(k) * pmf(k)
+	}
+	var m3, m4 float64
+	for k := a; k <= b; k++ {
+		d := float64(k) - μ
+		p := pmf(k)
+		σ2 += d * d * p
+		m3 += d * d * d * p
+		m4 += d * d * d * d * p
+	}
+	skew = m3 / pow(σ2, 1.5)
+	kurt = m4/(σ2*σ2) - 3
+	return
+}
+
+// rawMoments converts the first four raw moments to mean, variance, skewness and excess kurtosis.
+func rawMoments(m1, m2, m3, m4 float64) (μ, σ2, skew, kurt float64) {
+	μ = m1
+	σ2 = m2 - m1*m1
+	c3 := m3 - 3*m1*m2 + 2*m1*m1*m1
+	c4 := m4 - 4*m1*m3 + 6*m1*m1*m2 - 3*m1*m1*m1*m1
+	skew = c3 / pow(σ2, 1.5)
+	kurt = c4/(σ2*σ2) - 3
+	return
+}
@@ -0,0 +1,305 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Empirical distribution.
+// The distribution putting mass 1/n on each of the n values of the sample x: samples from a posterior (Gibbs sampler, simulation) as a distribution.
+// Its CDF is the step function #{xi ≤ y} / n, and its moments are those of the sample with divisor n.
+// Quantiles are interpolated between the order statistics by any of the nine types of
+// Hyndman, R. J., Fan, Y. (1996). Sample quantiles in statistical packages. American Statistician 50, 361-365,
+// numbered as in R's quantile(): types 1-3 are discontinuous (type 1 inverts the CDF), types 4-9 interpolate linearly; type 7 is the default.
+// Random numbers are drawn by resampling x with replacement (bootstrap).
+// The distribution has no density, so EmpiricalDist does not implement Continuous.
+//
+// Parameters:
+// x ∈ ℝn		sample, n > 0
+// t ∈ {1, ..., 9}	Hyndman-Fan type of the quantiles
+//
+// Support:
+// y ∈ {x1, ..., xn}
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// empiricalSorted returns a sorted copy of x.
+func empiricalSorted(x []float64) []float64 {
+	s := append([]float64(nil), x...)
+	sort.Float64s(s)
+	return s
+}
+
+// empiricalCount returns the number of the values of the sorted s not greater than y.
+func empiricalCount(s []float64, y float64) int {
+	return sort.Search(len(s), func(i int) bool { return s[i] > y })
+}
+
+// empiricalQtl returns the quantile of type t for probability p of the sorted sample s.
+func empiricalQtl(s []float64, t int, p float64) float64 {
+	n := len(s)
+	if n == 0 || isNaN(p) || p < 0 || p > 1 || t < 1 || t > 9 {
+		return NaN
+	}
+	// Q(p) = (1-h) s[j] + h s[j+1], with 1-based j and the order statistics held constant beyond 1 and n
+	const fuzz = 4 * eps64
+	nf := float64(n)
+	var j, h float64
+	if t <= 3 {
+		np := nf * p
+		if t == 3 {
+			np -= 0.5
+		}
+		j = floor(np + fuzz)
+		switch {
+		case t == 1 && np > j:
+			h = 1
+		case t == 2 && np > j:
+			h = 1
+		case t == 2:
+			h = 0.5
+		case t == 3 && (np != j || int64(j)%2 == 1):
+			h = 1
+		}
+	} else {
+		// plotting positions pk = (k - a) / (n + 1 - a - b)
+		a := []float64{0, 0.5, 0, 1, 1.0 / 3, 3.0 / 8}[t-4]
+		b := []float64{1, 0.5, 0, 1, 1.0 / 3, 3.0 / 8}[t-4]
+		np := a + p*(nf+1-a-b)
+		j = floor(np + fuzz)
+		h = np - j
+		if abs(h) < fuzz {
+			h = 0
+		}
+	}
+	at := func(k float64) float64 {
+		return s[imax(0, imin(int64(n-1), int64(k)-1))]
+	}
+	if h == 0 {
+		return at(j)
+	}
+	return (1-h)*at(j) + h*at(j+1)
+}
+
+// EmpiricalCDF returns the CDF of the Empirical distribution of the sample x.
+func EmpiricalCDF(x []float64) func(y float64) float64 {
+	return EmpiricalCDFTail(x, true, false)
+}
+
+// EmpiricalCDFAt returns the value of CDF of the Empirical distribution of the sample x, at y.
+func EmpiricalCDFAt(x []float64, y float64) float64 {
+	cdf := EmpiricalCDF(x)
+	return cdf(y)
+}
+
+// EmpiricalCDFTail returns the CDF of the Empirical distribution of the sample x if lowerTail, the survival function otherwise; their logarithm if logP.
+func EmpiricalCDFTail(x []float64, lowerTail, logP bool) func(y float64) float64 {
+	d := NewEmpiricalDist(x)
+	return func(y float64) float64 {
+		return d.tail(y, lowerTail, logP)
+	}
+}
+
+// EmpiricalQtl returns the inverse of the CDF (quantile) of the Empirical distribution of the sample x, of Hyndman-Fan type t.
+func EmpiricalQtl(x []float64, t int) func(p float64) float64 {
+	return EmpiricalQtlTail(x, t, true, false)
+}
+
+// EmpiricalQtlFor returns the inverse of the CDF (quantile) of the Empirical distribution of the sample x, of Hyndman-Fan type t, for given probability.
+func EmpiricalQtlFor(x []float64, t int, p float64) float64 {
+	qtl := EmpiricalQtl(x, t)
+	return qtl(p)
+}
+
+// EmpiricalQtlTail returns the inverse of EmpiricalCDFTail (quantile) of the Empirical distribution of the sample x, of Hyndman-Fan type t.
+func EmpiricalQtlTail(x []float64, t int, lowerTail, logP bool) func(p float64) float64 {
+	d := EmpiricalDist{empiricalSorted(x), t}
+	return func(p float64) float64 {
+		return d.QtlTail(p, lowerTail, logP)
+	}
+}
+
+// EmpiricalNext returns random number drawn from the Empirical distribution of the sample x.
+func EmpiricalNext(x []float64) float64 {
+	return EmpiricalNextR(GlobalRand, x)
+}
+
+// EmpiricalNextR returns random number drawn from the Empirical distribution of the sample x, using the random source src.
+func EmpiricalNextR(src *rand.Rand, x []float64) float64 {
+	return x[src.Intn(len(x))]
+}
+
+// Empirical returns the random number generator with  Empirical distribution of the sample x (bootstrap resampling).
+func Empirical(x []float64) func() float64 {
+	return EmpiricalR(GlobalRand, x)
+}
+
+// EmpiricalR returns the random number generator with  Empirical distribution of the sample x (bootstrap resampling), using the random source src.
+func EmpiricalR(src *rand.Rand, x []float64) func() float64 {
+	return func() float64 { return EmpiricalNextR(src, x) }
+}
+
+// EmpiricalFill fills y with random numbers drawn from the Empirical distribution of the sample x.
+func EmpiricalFill(x []float64, y []float64) {
+	EmpiricalFillR(GlobalRand, x, y)
+}
+
+// EmpiricalFillR fills y with random numbers drawn from the Empirical distribution of the sample x, using the random source src.
+func EmpiricalFillR(src *rand.Rand, x []float64, y []float64) {
+	for i := range y {
+		y[i] = EmpiricalNextR(src, x)
+	}
+}
+
+// empiricalMoments returns the mean, and the central moments of order 2, 3 and 4 of the sample x, with divisor n.
+func empiricalMoments(x []float64) (μ, m2, m3, m4 float64) {
+	n := float64(len(x))
+	for _, v := range x {
+		μ += v
+	}
+	μ /= n
+	for _, v := range x {
+		d := v - μ
+		m2 += d * d
+		m3 += d * d * d
+		m4 += d * d * d * d
+	}
+	return μ, m2 / n, m3 / n, m4 / n
+}
+
+// EmpiricalMean returns the mean of the Empirical distribution of the sample x.
+func EmpiricalMean(x []float64) float64 {
+	μ, _, _, _ := empiricalMoments(x)
+	return μ
+}
+
+// EmpiricalVar returns the variance of the Empirical distribution of the sample x, with divisor n.
+func EmpiricalVar(x []float64) float64 {
+	_, m2, _, _ := empiricalMoments(x)
+	return m2
+}
+
+// EmpiricalStd returns the standard deviation of the Empirical distribution of the sample x, with divisor n.
+func EmpiricalStd(x []float64) float64 {
+	return sqrt(EmpiricalVar(x))
+}
+
+// EmpiricalSkew returns the skewness of the Empirical distribution of the sample x.
+func EmpiricalSkew(x []float64) float64 {
+	_, m2, m3, _ := empiricalMoments(x)
+	return m3 / pow(m2, 1.5)
+}
+
+// EmpiricalExKurt returns the excess kurtosis of the Empirical distribution of the sample x.
+func EmpiricalExKurt(x []float64) float64 {
+	_, m2, _, m4 := empiricalMoments(x)
+	return m4/(m2*m2) - 3
+}
+
+// EmpiricalEntropy returns the Shannon entropy of the Empirical distribution, of the frequencies of the distinct values of x.
+func EmpiricalEntropy(x []float64) float64 {
+	s := empiricalSorted(x)
+	n := float64(len(s))
+	h := fZero
+	for i, j := 0, 0; i < len(s); i = j {
+		for j = i + 1; j < len(s) && s[j] == s[i]; j++ {
+		}
+		p := float64(j-i) / n
+		h -= p * log(p)
+	}
+	return h
+}
+
+// EmpiricalCF returns the characteristic function of the Empirical distribution.
+func EmpiricalCF(x []float64, t float64) complex128 {
+	var φ complex128
+	for _, v := range x {
+		φ += cexp(complex(0, t*v))
+	}
+	return φ / complex(float64(len(x)), 0)
+}
+
+// EmpiricalCGF returns the cumulant generating function of the Empirical distribution.
+func EmpiricalCGF(x []float64, t float64) float64 {
+	tx := make([]float64, len(x))
+	for i, v := range x {
+		tx[i] = t * v
+	}
+	return logSumExp(tx) - log(float64(len(x)))
+}
+
+// EmpiricalDist is the Empirical distribution of the sample X, sorted, with quantiles of Hyndman-Fan type T.
+// NewEmpiricalDist builds it from a sample in any order.
+type EmpiricalDist struct {
+	X []float64
+	T int
+}
+
+// NewEmpiricalDist returns the Empirical distribution of a sorted copy of the sample x, with quantiles of type 7.
+func NewEmpiricalDist(x []float64) EmpiricalDist {
+	return EmpiricalDist{empiricalSorted(x), 7}
+}
+
+// CDF returns the value of CDF of the Empirical distribution at y.
+func (d EmpiricalDist) CDF(y float64) float64 { return d.tail(y, true, false) }
+
+// Surv returns the value of the survival function 1 - CDF of the Empirical distribution at y.
+func (d EmpiricalDist) Surv(y float64) float64 { return d.tail(y, false, false) }
+
+// LnCDF returns the natural logarithm of the CDF of the Empirical distribution at y.
+func (d EmpiricalDist) LnCDF(y float64) float64 { return d.tail(y, true, true) }
+
+// LnSurv returns the natural logarithm of the survival function of the Empirical distribution at y.
+func (d EmpiricalDist) LnSurv(y float64) float64 { return d.tail(y, false, true) }
+
+// tail returns the CDF of the Empirical distribution at y, on the tail and scale selected by lowerTail and logP; X is already sorted.
+func (d EmpiricalDist) tail(y float64, lowerTail, logP bool) float64 {
+	n := len(d.X)
+	if n == 0 || isNaN(y) {
+		return NaN
+	}
+	// both tails are exact fractions
+	k := empiricalCount(d.X, y)
+	return pTail2(float64(k)/float64(n), float64(n-k)/float64(n), lowerTail, logP)
+}
+
+// Qtl returns the quantile of the Empirical distribution for probability p.
+func (d EmpiricalDist) Qtl(p float64) float64 { return empiricalQtl(d.X, d.T, p) }
+
+// QtlTail returns the quantile of the Empirical distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d EmpiricalDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	if isNaN(p) || !pValid(p, logP) {
+		return NaN
+	}
+	return empiricalQtl(d.X, d.T, pLower(p, lowerTail, logP))
+}
+
+// Rand returns random number drawn from the Empirical distribution.
+func (d EmpiricalDist) Rand() float64 { return EmpiricalNext(d.X) }
+
+// RandR returns random number drawn from the Empirical distribution, using the random source src.
+func (d EmpiricalDist) RandR(src *rand.Rand) float64 { return EmpiricalNextR(src, d.X) }
+
+// Mean returns the mean of the Empirical distribution.
+func (d EmpiricalDist) Mean() float64 { return EmpiricalMean(d.X) }
+
+// Var returns the variance of the Empirical distribution.
+func (d EmpiricalDist) Var() float64 { return EmpiricalVar(d.X) }
+
+// Skew returns the skewness of the Empirical distribution.
+func (d EmpiricalDist) Skew() float64 { return EmpiricalSkew(d.X) }
+
+// ExKurt returns the excess kurtosis of the Empirical distribution.
+func (d EmpiricalDist) ExKurt() float64 { return EmpiricalExKurt(d.X) }
+
+// Entropy returns the Shannon entropy of the Empirical distribution.
+func (d EmpiricalDist) Entropy() float64 { return EmpiricalEntropy(d.X) }
+
+// CF returns the characteristic function of the Empirical distribution at t.
+func (d EmpiricalDist) CF(t float64) complex128 { return EmpiricalCF(d.X, t) }
+
+// CGF returns the cumulant generating function of the Empirical distribution at t.
+func (d EmpiricalDist) CGF(t float64) float64 { return EmpiricalCGF(d.X, t) }
+
+// Support returns the smallest and the largest values of the Empirical distribution.
+func (d EmpiricalDist) Support() (a, b float64) { return d.X[0], d.X[len(d.X)-1] }
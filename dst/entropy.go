@@ -0,0 +1,281 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Information measures of distributions.
+// The Entropy methods return the differential entropy -∫ f ln f of the continuous distributions, and the Shannon entropy -Σ p ln p
+// of the discrete ones, in nats; the families without a closed form compute it by the numerical integrals and sums below.
+// The Kullback–Leibler divergence, Hellinger distance and total-variation distance of arbitrary distributions are integrated
+// piecewise between quantiles of both distributions, their infinite tails mapped onto [0, 1) by x = x0 ± s t / (1 - t);
+// the sums over discrete supports stop where the mass left of both distributions is negligible, or after infoMaxTerms terms.
+// Ref.: Cover, T. M., Thomas, J. A. (2006). Elements of Information Theory, 2nd ed. Wiley, New York: ch. 2, 8.
+
+import (
+	"sort"
+)
+
+// infoProbs are the probabilities of the quantiles splitting the numerical integrals.
+var infoProbs = []float64{1e-12, 1e-6, 1e-3, 0.02, 0.1, 0.25, 0.5, 0.75, 0.9, 0.98, 1 - 1e-3, 1 - 1e-6, 1 - 1e-12}
+
+// infoTiny is the mass of the tails left out of the sums over discrete supports.
+const infoTiny = 1e-17
+
+// infoMaxTerms bounds the terms of the sums over discrete supports, for heavy tails.
+const infoMaxTerms = 1000000
+
+// entropyContinuous returns the differential entropy of d, by numerical integration.
+func entropyContinuous(d Continuous) float64 {
+	a, b := d.Support()
+	return entropyLnPDF(d.LnPDF, d.Qtl, a, b)
+}
+
+// entropyLnPDF returns the differential entropy of the distribution with the logarithm of the PDF lnPDF and the quantile function qtl on [a, b],
+// by numerical integration; the closures of a family set up once for all the evaluations.
+func entropyLnPDF(lnPDF, qtl func(x float64) float64, a, b float64) float64 {
+	f := func(x float64) float64 {
+		lf := lnPDF(x)
+		if isNaN(lf) || isInf(lf, 0) {
+			return 0
+		}
+		return -exp(lf) * lf
+	}
+	return integrateLine(f, a, b, infoBreaks(a, b, qtl))
+}
+
+// entropyDiscrete returns the Shannon entropy of d, by summation.
+func entropyDiscrete(d Discrete) float64 {
+	f := func(k int64) float64 {
+		lp := d.LnPMF(k)
+		if isNaN(lp) || isInf(lp, 0) {
+			return 0
+		}
+		return -exp(lp) * lp
+	}
+	return infoSum(f, d)
+}
+
+// KLContinuous returns the Kullback–Leibler divergence ∫ p ln(p/q) of the distribution q from p, +Inf if p is not absolutely continuous with respect to q.
+func KLContinuous(p, q Continuous) float64 {
+	a, b := p.Support()
+	if qa, qb := q.Support(); qa > a || qb < b {
+		return posInf
+	}
+	inf := false
+	f := func(x float64) float64 {
+		lp := p.LnPDF(x)
+		if isNaN(lp) || isInf(lp, 0) {
+			return 0
+		}
+		lq := q.LnPDF(x)
+		switch {
+		case isInf(lq, -1):
+			inf = true
+			return 0
+		case isNaN(lq) || isInf(lq, 1):
+			return 0
+		}
+		return exp(lp) * (lp - lq)
+	}
+	kl := integrateLine(f, a, b, infoBreaks(a, b, p.Qtl, q.Qtl))
+	if inf {
+		return posInf
+	}
+	return max(kl, 0)
+}
+
+// HellingerContinuous returns the Hellinger distance of the distributions p and q, the square root of ½∫ (√p - √q)², in [0, 1].
+func HellingerContinuous(p, q Continuous) float64 {
+	a, b := infoUnion(p, q)
+	f := func(x float64) float64 {
+		d := sqrt(infoPDF(p, x)) - sqrt(infoPDF(q, x))
+		return d * d / 2
+	}
+	h2 := integrateLine(f, a, b, infoBreaks(a, b, p.Qtl, q.Qtl))
+	return sqrt(min(max(h2, 0), 1))
+}
+
+// TotalVariationContinuous returns the total-variation distance ½∫ |p - q| of the distributions p and q, in [0, 1].
+func TotalVariationContinuous(p, q Continuous) float64 {
+	a, b := infoUnion(p, q)
+	f := func(x float64) float64 {
+		return abs(infoPDF(p, x)-infoPDF(q, x)) / 2
+	}
+	tv := integrateLine(f, a, b, infoBreaks(a, b, p.Qtl, q.Qtl))
+	return min(max(tv, 0), 1)
+}
+
+// KLDiscrete returns the Kullback–Leibler divergence Σ p ln(p/q) of the distribution q from p, +Inf if p puts mass where q does not.
+func KLDiscrete(p, q Discrete) float64 {
+	a, b := p.Support()
+	if qa, qb := q.Support(); qa > a || qb < b {
+		return posInf
+	}
+	inf := false
+	f := func(k int64) float64 {
+		lp := p.LnPMF(k)
+		if isNaN(lp) || isInf(lp, 0) {
+			return 0
+		}
+		lq := q.LnPMF(k)
+		switch {
+		case isInf(lq, -1):
+			inf = true
+			return 0
+		case isNaN(lq) || isInf(lq, 1):
+			return 0
+		}
+		return exp(lp) * (lp - lq)
+	}
+	kl := infoSum(f, p)
+	if inf {
+		return posInf
+	}
+	return max(kl, 0)
+}
+
+// HellingerDiscrete returns the Hellinger distance of the distributions p and q, the square root of ½Σ (√p - √q)², in [0, 1].
+func HellingerDiscrete(p, q Discrete) float64 {
+	f := func(k int64) float64 {
+		d := sqrt(infoPMF(p, k)) - sqrt(infoPMF(q, k))
+		return d * d / 2
+	}
+	return sqrt(min(max(infoSum(f, p, q), 0), 1))
+}
+
+// TotalVariationDiscrete returns the total-variation distance ½Σ |p - q| of the distributions p and q, in [0, 1].
+func TotalVariationDiscrete(p, q Discrete) float64 {
+	f := func(k int64) float64 {
+		return abs(infoPMF(p, k)-infoPMF(q, k)) / 2
+	}
+	return min(max(infoSum(f, p, q), 0), 1)
+}
+
+// infoPDF returns the PDF of d at x, 0 outside its support and at its singularities.
+func infoPDF(d Continuous, x float64) float64 {
+	if a, b := d.Support(); x < a || x > b {
+		return 0
+	}
+	f := d.PDF(x)
+	if isNaN(f) || isInf(f, 0) {
+		return 0
+	}
+	return f
+}
+
+// infoPMF returns the PMF of d at k, 0 outside its support.
+func infoPMF(d Discrete, k int64) float64 {
+	if a, b := d.Support(); k < a || k > b {
+		return 0
+	}
+	p := d.PMF(k)
+	if isNaN(p) {
+		return 0
+	}
+	return p
+}
+
+// infoUnion returns the smallest interval holding the supports of p and q.
+func infoUnion(p, q Continuous) (a, b float64) {
+	a, b = p.Support()
+	qa, qb := q.Support()
+	return min(a, qa), max(b, qb)
+}
+
+// infoBreaks returns the sorted distinct finite ends a, b and quantiles of the quantile functions qtls within [a, b].
+func infoBreaks(a, b float64, qtls ...func(p float64) float64) []float64 {
+	br := make([]float64, 0, len(qtls)*len(infoProbs)+2)
+	if !isInf(a, 0) {
+		br = append(br, a)
+	}
+	if !isInf(b, 0) {
+		br = append(br, b)
+	}
+	for _, qtl := range qtls {
+		for _, p := range infoProbs {
+			if x := qtl(p); x > a && x < b && !isInf(x, 0) {
+				br = append(br, x)
+			}
+		}
+	}
+	if len(br) == 0 {
+		br = append(br, 0)
+	}
+	sort.Float64s(br)
+	n := 1
+	for _, x := range br[1:] {
+		if x > br[n-1] {
+			br[n] = x
+			n++
+		}
+	}
+	return br[:n]
+}
+
+// integrateLine returns the integral of f over [a, b], possibly infinite, split at the sorted points br within it;
+// the infinite tails beyond the outer points are mapped onto [0, 1), scaled by the spacing of the two outer points.
+// f must be finite everywhere, as the adaptive Simpson's rule does not converge otherwise.
+func integrateLine(f func(x float64) float64, a, b float64, br []float64) float64 {
+	n := len(br)
+	s := fZero
+	for i := 1; i < n; i++ {
+		s += integrate(f, br[i-1], br[i])
+	}
+	tail := func(x0, h float64) func(t float64) float64 {
+		return func(t float64) float64 {
+			if t >= 1 {
+				return 0
+			}
+			u := 1 - t
+			return f(x0+h*t/u) * abs(h) / (u * u)
+		}
+	}
+	if isInf(a, -1) {
+		h := fOne
+		if n > 1 {
+			h = br[1] - br[0]
+		}
+		s += integrate(tail(br[0], -h), 0, 1)
+	}
+	if isInf(b, 1) {
+		h := fOne
+		if n > 1 {
+			h = br[n-1] - br[n-2]
+		}
+		s += integrate(tail(br[n-1], h), 0, 1)
+	}
+	return s
+}
+
+// infoSum returns the sum of f(k) over the supports of the distributions ds, from the first quantile of infoTiny,
+// until the mass left of all of them is below infoTiny, or after infoMaxTerms terms.
+func infoSum(f func(k int64) float64, ds ...Discrete) float64 {
+	lo := posInfInt64
+	for _, d := range ds {
+		a, _ := d.Support()
+		k := d.Qtl(infoTiny)
+		if k < a {
+			k = a
+		}
+		if k < lo {
+			lo = k
+		}
+	}
+	mass := make([]float64, len(ds))
+	s := fZero
+	for k, n := lo, 0; n < infoMaxTerms; k, n = k+1, n+1 {
+		s += f(k)
+		done := true
+		for i, d := range ds {
+			p := infoPMF(d, k)
+			mass[i] += p
+			// the survival function only once the tail is small, as it may be costly, and rounded above infoTiny
+			if _, b := d.Support(); k < b && (mass[i] < 1-1e-6 || (p > 0 && d.Surv(k) > infoTiny)) {
+				done = false
+			}
+		}
+		if done {
+			break
+		}
+	}
+	return s
+}
@@ -53,6 +53,16 @@ func ExponentialCDFAt(λ, x float64) float64 {
 	return cdf(x)
 }
 
+// ExponentialCDFTail returns the CDF of the Exponential distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ExponentialCDFTail(λ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnUpper(-λ*x, lowerTail, logP)
+	}
+}
+
 // ExponentialQtl returns the inverse of the CDF (quantile) of the Exponential distribution. 
 func ExponentialQtl(λ float64) func(p float64) float64 {
 	// p: probability for which the quantile is evaluated
@@ -67,11 +77,41 @@ func ExponentialQtlFor(λ, p float64) float64 {
 	return cdf(p)
 }
 
+// ExponentialQtlTail returns the inverse of ExponentialCDFTail (quantile) of the Exponential distribution.
+func ExponentialQtlTail(λ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return -pLnUpper(p, lowerTail, logP) / λ
+	}
+}
+
 // ExponentialNext returns random number drawn from the Exponential distribution. 
-func ExponentialNext(λ float64) float64 { return rand.ExpFloat64() / λ }
+func ExponentialNext(λ float64) float64 { return ExponentialNextR(GlobalRand, λ) }
+
+// ExponentialNextR returns random number drawn from the Exponential distribution, using the random source src.
+func ExponentialNextR(src *rand.Rand, λ float64) float64 { return expZig(src) / λ }
 
 // Exponential returns the random number generator with  Exponential distribution. 
-func Exponential(λ float64) func() float64 { return func() float64 { return ExponentialNext(λ) } }
+func Exponential(λ float64) func() float64 { return ExponentialR(GlobalRand, λ) }
+
+// ExponentialR returns the random number generator with  Exponential distribution, using the random source src.
+func ExponentialR(src *rand.Rand, λ float64) func() float64 {
+	return func() float64 { return ExponentialNextR(src, λ) }
+}
+
+// ExponentialFill fills x with random numbers drawn from the Exponential distribution.
+func ExponentialFill(λ float64, x []float64) {
+	ExponentialFillR(GlobalRand, λ, x)
+}
+
+// ExponentialFillR fills x with random numbers drawn from the Exponential distribution, using the random source src.
+func ExponentialFillR(src *rand.Rand, λ float64, x []float64) {
+	for i := range x {
+		x[i] = ExponentialNextR(src, λ)
+	}
+}
 
 // ExponentialMean returns the mean of the Exponential distribution. 
 func ExponentialMean(λ float64) float64 {
@@ -108,7 +148,111 @@ func ExponentialExKurt(λ float64) float64 {
 	return 6
 }
 
+// ExponentialEntropy returns the differential entropy of the Exponential distribution.
+func ExponentialEntropy(λ float64) float64 {
+	return 1 - log(λ)
+}
+
+// ExponentialCF returns the characteristic function of the Exponential distribution.
+func ExponentialCF(λ, t float64) complex128 {
+	return complex(λ, 0) / complex(λ, -t)
+}
+
+// ExponentialCGF returns the cumulant generating function of the Exponential distribution; +Inf for t ≥ λ.
+func ExponentialCGF(λ, t float64) float64 {
+	if t >= λ {
+		return posInf
+	}
+	return -log1p(-t / λ)
+}
+
 // ExponentialMGF returns the moment-generating function of the Exponential distribution. 
 func ExponentialMGF(λ, p, t float64) float64 {
 	return 1 / (1 - t/λ)
 }
+
+// ExponentialMatchQtls returns the Exponential distribution whose quantiles for the probabilities p best match x.
+func ExponentialMatchQtls(p, x []float64) ExponentialDist {
+	// x = -log(1-p) / λ, fitted through the origin
+	var sww, swx float64
+	for i, v := range p {
+		w := -log1p(-v)
+		sww += w * w
+		swx += w * x[i]
+	}
+	λ := sww / swx
+	e := matchQtls(func(θ []float64) Continuous { return ExponentialDist{θ[0]} }, p, x, []float64{λ}, []mleBound{mlePos})
+	return ExponentialDist{e[0]}
+}
+
+// ExponentialFit returns the maximum-likelihood estimate of λ of the Exponential distribution from the sample x: the inverse of its mean.
+func ExponentialFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return ExponentialLnPDF(θ[0]) })
+	μ, _ := mleMeanVar(x)
+	return mleAt(lnL, []float64{1 / μ})
+}
+
+// ExponentialDist is the Exponential distribution with rate λ = Lambda. It implements Continuous.
+type ExponentialDist struct {
+	Lambda float64
+}
+
+// PDF returns the value of PDF of the Exponential distribution at x.
+func (d ExponentialDist) PDF(x float64) float64 { return ExponentialPDFAt(d.Lambda, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Exponential distribution at x.
+func (d ExponentialDist) LnPDF(x float64) float64 { return ExponentialLnPDF(d.Lambda)(x) }
+
+// CDF returns the value of CDF of the Exponential distribution at x.
+func (d ExponentialDist) CDF(x float64) float64 { return ExponentialCDFTail(d.Lambda, true, false)(x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Exponential distribution at x.
+func (d ExponentialDist) Surv(x float64) float64 {
+	return ExponentialCDFTail(d.Lambda, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Exponential distribution at x.
+func (d ExponentialDist) LnCDF(x float64) float64 { return ExponentialCDFTail(d.Lambda, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Exponential distribution at x.
+func (d ExponentialDist) LnSurv(x float64) float64 {
+	return ExponentialCDFTail(d.Lambda, false, true)(x)
+}
+
+// Qtl returns the quantile of the Exponential distribution for probability p.
+func (d ExponentialDist) Qtl(p float64) float64 { return ExponentialQtlFor(d.Lambda, p) }
+
+// QtlTail returns the quantile of the Exponential distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ExponentialDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return ExponentialQtlTail(d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Exponential distribution.
+func (d ExponentialDist) Rand() float64 { return ExponentialNext(d.Lambda) }
+
+// RandR returns random number drawn from the Exponential distribution, using the random source src.
+func (d ExponentialDist) RandR(src *rand.Rand) float64 { return ExponentialNextR(src, d.Lambda) }
+
+// Mean returns the mean of the Exponential distribution.
+func (d ExponentialDist) Mean() float64 { return ExponentialMean(d.Lambda) }
+
+// Var returns the variance of the Exponential distribution.
+func (d ExponentialDist) Var() float64 { return ExponentialVar(d.Lambda) }
+
+// Skew returns the skewness of the Exponential distribution.
+func (d ExponentialDist) Skew() float64 { return ExponentialSkew(d.Lambda) }
+
+// ExKurt returns the excess kurtosis of the Exponential distribution.
+func (d ExponentialDist) ExKurt() float64 { return ExponentialExKurt(d.Lambda) }
+
+// Entropy returns the differential entropy of the Exponential distribution.
+func (d ExponentialDist) Entropy() float64 { return ExponentialEntropy(d.Lambda) }
+
+// CF returns the characteristic function of the Exponential distribution at t.
+func (d ExponentialDist) CF(t float64) complex128 { return ExponentialCF(d.Lambda, t) }
+
+// CGF returns the cumulant generating function of the Exponential distribution at t.
+func (d ExponentialDist) CGF(t float64) float64 { return ExponentialCGF(d.Lambda, t) }
+
+// Support returns the support of the Exponential distribution.
+func (d ExponentialDist) Support() (a, b float64) { return 0, posInf }
@@ -4,12 +4,24 @@ package dst
 
 // F-distribution, alias Fisher-Snedecor distribution
 
+import (
+	"math/rand"
+)
+
 // FPDF returns the PDF of the F distribution. 
 func FPDF(d1, d2 int64) func(x float64) float64 {
 	df1 := float64(d1)
 	df2 := float64(d2)
 	normalization := 1 / B(df1/2, df2/2)
 	return func(x float64) float64 {
+		switch {
+		case x < 0 || x == 0 && d1 > 2:
+			return 0
+		case x == 0 && d1 == 2:
+			return 1
+		case x == 0:
+			return posInf
+		}
 		return normalization * sqrt(pow(df1*x, df1)*pow(df2, df2)/pow(df1*x+df2, df1+df2)) / x
 	}
 }
@@ -20,6 +32,14 @@ func FLnPDF(d1, d2 int64) func(x float64) float64 {
 	df2 := float64(d2)
 	normalization := -logB(df1/2, df2/2)
 	return func(x float64) float64 {
+		switch {
+		case x < 0 || x == 0 && d1 > 2:
+			return negInf
+		case x == 0 && d1 == 2:
+			return 0
+		case x == 0:
+			return posInf
+		}
 		return normalization + log(df1*x)*df1/2 + log(df2)*df2/2 - log(df1*x+df2)*(df1+df2)/2 - log(x)
 	}
 }
@@ -46,6 +66,30 @@ func FCDFAt(d1, d2 int64, x float64) float64 {
 	return cdf(x)
 }
 
+// FCDFTail returns the CDF of the F distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func FCDFTail(d1, d2 int64, lowerTail, logP bool) func(x float64) float64 {
+	df1 := float64(d1)
+	df2 := float64(d2)
+	lower := BetaCDFTail(df1/2, df2/2, lowerTail, logP)
+	upper := BetaCDFTail(df2/2, df1/2, !lowerTail, logP)
+	return func(x float64) float64 {
+		if df1 <= 0 || df2 <= 0 {
+			return NaN
+		}
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		if isInf(x, 1) {
+			return pTailBounds(true, lowerTail, logP)
+		}
+		// the Beta argument is kept away from 1
+		if df1*x > df2 {
+			return upper(df2 / (df2 + df1*x))
+		}
+		return lower(df1 * x / (df2 + df1*x))
+	}
+}
+
 // FQtl returns the inverse of the CDF (quantile) of the F distribution. 
 func FQtl(d1, d2 int64) func(p float64) float64 {
 	df1 := float64(d1)
@@ -73,17 +117,61 @@ func FQtlFor(d1, d2 int64, p float64) float64 {
 	return cdf(p)
 }
 
+// FQtlTail returns the inverse of FCDFTail (quantile) of the F distribution.
+func FQtlTail(d1, d2 int64, lowerTail, logP bool) func(p float64) float64 {
+	df1 := float64(d1)
+	df2 := float64(d2)
+	lower := BetaQtlTail(df1/2, df2/2, true, logP)
+	upper := BetaQtlTail(df2/2, df1/2, true, logP)
+	return func(p float64) float64 {
+		if df1 < 1 || df2 < 1 {
+			return NaN
+		}
+		if lowerTail {
+			u := lower(p)
+			return u / (1 - u) * df2 / df1
+		}
+		return (1/upper(p) - 1) * df2 / df1
+	}
+}
+
 // FNext returns random number drawn from the F distribution. 
 func FNext(d1, d2 int64) float64 {
+	return FNextR(GlobalRand, d1, d2)
+}
+
+// FNextR returns random number drawn from the F distribution, using the random source src.
+func FNextR(src *rand.Rand, d1, d2 int64) float64 {
 	df1 := float64(d1)
 	df2 := float64(d2)
-	return ChiSquareNext(d1) * df2 / (ChiSquareNext(d2) * df1)
+	return ChiSquareNextR(src, d1) * df2 / (ChiSquareNextR(src, d2) * df1)
 }
 
 // F returns the random number generator with  F distribution. 
 func F(d1, d2 int64) func() float64 {
-	return func() float64 {
-		return FNext(d1, d2)
+	return FR(GlobalRand, d1, d2)
+}
+
+// FR returns the random number generator with  F distribution, using the random source src.
+func FR(src *rand.Rand, d1, d2 int64) func() float64 {
+	df1 := float64(d1)
+	df2 := float64(d2)
+	gs1 := newGammaSampler(df1/2, 2)
+	gs2 := newGammaSampler(df2/2, 2)
+	return func() float64 { return gs1.next(src) * df2 / (gs2.next(src) * df1) }
+}
+
+// FFill fills x with random numbers drawn from the F distribution.
+func FFill(d1, d2 int64, x []float64) {
+	FFillR(GlobalRand, d1, d2, x)
+}
+
+// FFillR fills x with random numbers drawn from the F distribution, using the random source src;
+// the samplers of both Chi-Squared variates are set up once for all the draws.
+func FFillR(src *rand.Rand, d1, d2 int64, x []float64) {
+	next := FR(src, d1, d2)
+	for i := range x {
+		x[i] = next()
 	}
 }
 
@@ -134,7 +222,7 @@ func FSkew(d1, d2 int64) float64 {
 	}
 	df1 := float64(d1)
 	df2 := float64(d2)
-	return (2*df1 + df2 - 2) * sqrt(8*(df2-4)) / (df2 - 6) * sqrt(df1*(df1+df2-2))
+	return (2*df1 + df2 - 2) * sqrt(8*(df2-4)) / ((df2 - 6) * sqrt(df1*(df1+df2-2)))
 }
 
 // FExKurt returns the excess kurtosis of the F distribution. 
@@ -146,3 +234,95 @@ func FExKurt(d1, d2 int64) float64 {
 	df2 := float64(d2)
 	return 12 * (df1*(5*df2-22)*(df1+df2-2) + (df2-4)*(df2-2)*(df2-2)) / (df1 * (df2 - 6) * (df2 - 8) * (df1 + df2 - 2))
 }
+
+// FEntropy returns the differential entropy of the F distribution.
+func FEntropy(d1, d2 int64) float64 {
+	a, b := float64(d1)/2, float64(d2)/2
+	return log(b/a) + logB(a, b) + (1-a)*digamma(a) - (1+b)*digamma(b) + (a+b)*digamma(a+b)
+}
+
+// FCF returns the characteristic function of the F distribution, by numerical integration.
+func FCF(d1, d2 int64, t float64) complex128 {
+	return cfLnPDF(FLnPDF(d1, d2), FQtl(d1, d2), 0, posInf, t)
+}
+
+// FCGF returns the cumulant generating function of the F distribution, by numerical integration; +Inf for t > 0.
+func FCGF(d1, d2 int64, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfLnPDF(FLnPDF(d1, d2), FQtl(d1, d2), 0, posInf, t)
+}
+
+// FFit returns the maximum-likelihood estimates of the degrees of freedom d1, d2 of the F-distribution from the sample x,
+// the integers found by climbing from d1 = 5 and the d2 of its mean d2 / (d2 - 2); their standard errors are NaN.
+func FFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return FLnPDF(int64(θ[0]), int64(θ[1])) })
+	μ, _ := mleMeanVar(x)
+	d2 := 10.0
+	if μ > 1 && μ < 3 {
+		d2 = 2 * μ / (μ - 1)
+	}
+	return mleAtFixed(lnL, mleIntClimb(lnL, []float64{5, d2}, 1), mleAllFixed(2))
+}
+
+// FDist is the F-distribution with D1 and D2 degrees of freedom. It implements Continuous.
+type FDist struct {
+	D1, D2 int64
+}
+
+// PDF returns the value of PDF of the F distribution at x.
+func (d FDist) PDF(x float64) float64 { return FPDFAt(d.D1, d.D2, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the F distribution at x.
+func (d FDist) LnPDF(x float64) float64 { return FLnPDF(d.D1, d.D2)(x) }
+
+// CDF returns the value of CDF of the F distribution at x.
+func (d FDist) CDF(x float64) float64 { return FCDFAt(d.D1, d.D2, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the F distribution at x.
+func (d FDist) Surv(x float64) float64 { return FCDFTail(d.D1, d.D2, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the F distribution at x.
+func (d FDist) LnCDF(x float64) float64 { return FCDFTail(d.D1, d.D2, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the F distribution at x.
+func (d FDist) LnSurv(x float64) float64 { return FCDFTail(d.D1, d.D2, false, true)(x) }
+
+// Qtl returns the quantile of the F distribution for probability p.
+func (d FDist) Qtl(p float64) float64 { return FQtlFor(d.D1, d.D2, p) }
+
+// QtlTail returns the quantile of the F distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d FDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return FQtlTail(d.D1, d.D2, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the F distribution.
+func (d FDist) Rand() float64 { return FNext(d.D1, d.D2) }
+
+// RandR returns random number drawn from the F distribution, using the random source src.
+func (d FDist) RandR(src *rand.Rand) float64 { return FNextR(src, d.D1, d.D2) }
+
+// Mean returns the mean of the F distribution.
+func (d FDist) Mean() float64 { return FMean(d.D1, d.D2) }
+
+// Var returns the variance of the F distribution.
+func (d FDist) Var() float64 { return FVar(d.D1, d.D2) }
+
+// Skew returns the skewness of the F distribution.
+func (d FDist) Skew() float64 { return FSkew(d.D1, d.D2) }
+
+// ExKurt returns the excess kurtosis of the F distribution.
+func (d FDist) ExKurt() float64 { return FExKurt(d.D1, d.D2) }
+
+// Entropy returns the differential entropy of the F distribution.
+func (d FDist) Entropy() float64 { return FEntropy(d.D1, d.D2) }
+
+// CF returns the characteristic function of the F distribution at t.
+func (d FDist) CF(t float64) complex128 { return FCF(d.D1, d.D2, t) }
+
+// CGF returns the cumulant generating function of the F distribution at t.
+func (d FDist) CGF(t float64) float64 { return FCGF(d.D1, d.D2, t) }
+
+// Support returns the support of the F distribution.
+func (d FDist) Support() (a, b float64) { return 0, posInf }
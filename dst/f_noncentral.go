@@ -0,0 +1,303 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Noncentral F-distribution.
+// The distribution of (U/ν1) / (V/ν2), with U noncentral Chi-Squared with ν1 degrees of freedom and noncentrality λ, and V Chi-Squared with ν2 degrees of freedom:
+// the distribution of the F statistic under the alternative, for calculations of power of the analysis of variance.
+// It is the F-distribution if λ = 0; ν1 X / (ν1 X + ν2) has the noncentral Beta distribution with shapes ν1/2, ν2/2 and noncentrality λ.
+//
+// Parameters:
+// ν1 > 0		degrees of freedom of the numerator (real)
+// ν2 > 0		degrees of freedom of the denominator (real)
+// λ ≥ 0		noncentrality
+//
+// Support:
+// x ∈ [0; ∞)
+
+import (
+	"math/rand"
+)
+
+// NoncentralFPDF returns the PDF of the noncentral F distribution.
+func NoncentralFPDF(ν1, ν2, λ float64) func(x float64) float64 {
+	lnPDF := NoncentralFLnPDF(ν1, ν2, λ)
+	return func(x float64) float64 {
+		return exp(lnPDF(x))
+	}
+}
+
+// NoncentralFLnPDF returns the natural logarithm of the PDF of the noncentral F distribution.
+func NoncentralFLnPDF(ν1, ν2, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case ν1 <= 0 || ν2 <= 0 || λ < 0:
+			return NaN
+		case x < 0 || isInf(x, 1):
+			return negInf
+		case x == 0:
+			// only the central term is left
+			if ν1 < 2 {
+				return posInf
+			}
+			if ν1 == 2 {
+				return -λ / 2
+			}
+			return negInf
+		}
+		// the noncentral Beta density, times the derivative ν1 ν2 / (ν1 x + ν2)² of the transformation
+		s := ν1*x + ν2
+		return ncBetaLnPDF(ν1/2, ν2/2, λ, ν1*x/s, ν2/s) + log(ν1*ν2) - 2*log(s)
+	}
+}
+
+// NoncentralFPDFAt returns the value of PDF of noncentral F distribution at x.
+func NoncentralFPDFAt(ν1, ν2, λ, x float64) float64 {
+	pdf := NoncentralFPDF(ν1, ν2, λ)
+	return pdf(x)
+}
+
+// NoncentralFCDF returns the CDF of the noncentral F distribution.
+func NoncentralFCDF(ν1, ν2, λ float64) func(x float64) float64 {
+	return NoncentralFCDFTail(ν1, ν2, λ, true, false)
+}
+
+// NoncentralFCDFAt returns the value of CDF of the noncentral F distribution, at x.
+func NoncentralFCDFAt(ν1, ν2, λ, x float64) float64 {
+	cdf := NoncentralFCDF(ν1, ν2, λ)
+	return cdf(x)
+}
+
+// NoncentralFCDFTail returns the CDF of the noncentral F distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func NoncentralFCDFTail(ν1, ν2, λ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ν1 <= 0 || ν2 <= 0 || λ < 0 {
+			return NaN
+		}
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		if isInf(x, 1) {
+			return pTailBounds(true, lowerTail, logP)
+		}
+		s := ν1*x + ν2
+		p := min(0, ncBetaLnCDF(ν1/2, ν2/2, λ, ν1*x/s, ν2/s, lowerTail))
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// NoncentralFQtl returns the inverse of the CDF (quantile) of the noncentral F distribution.
+func NoncentralFQtl(ν1, ν2, λ float64) func(p float64) float64 {
+	return NoncentralFQtlTail(ν1, ν2, λ, true, false)
+}
+
+// NoncentralFQtlFor returns the inverse of the CDF (quantile) of the noncentral F distribution, for given probability.
+func NoncentralFQtlFor(ν1, ν2, λ, p float64) float64 {
+	qtl := NoncentralFQtl(ν1, ν2, λ)
+	return qtl(p)
+}
+
+// NoncentralFQtlTail returns the inverse of NoncentralFCDFTail (quantile) of the noncentral F distribution.
+func NoncentralFQtlTail(ν1, ν2, λ float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := NoncentralFCDFTail(ν1, ν2, λ, lowerTail, logP)
+	return func(p float64) float64 {
+		if ν1 <= 0 || ν2 <= 0 || λ < 0 {
+			return NaN
+		}
+		return qtlTail(cdf, p, 0, posInf, lowerTail, logP)
+	}
+}
+
+// NoncentralFNext returns random number drawn from the noncentral F distribution.
+func NoncentralFNext(ν1, ν2, λ float64) float64 { return NoncentralFNextR(GlobalRand, ν1, ν2, λ) }
+
+// NoncentralFNextR returns random number drawn from the noncentral F distribution, using the random source src.
+func NoncentralFNextR(src *rand.Rand, ν1, ν2, λ float64) float64 {
+	return NoncentralChiSquareNextR(src, ν1, λ) / ν1 / (GammaNextR(src, ν2/2, 2) / ν2)
+}
+
+// NoncentralF returns the random number generator with  noncentral F distribution.
+func NoncentralF(ν1, ν2, λ float64) func() float64 { return NoncentralFR(GlobalRand, ν1, ν2, λ) }
+
+// NoncentralFR returns the random number generator with  noncentral F distribution, using the random source src.
+func NoncentralFR(src *rand.Rand, ν1, ν2, λ float64) func() float64 {
+	cs := newNoncentralChiSquareSampler(ν1, λ)
+	gs := newGammaSampler(ν2/2, 2)
+	return func() float64 { return cs.next(src) / ν1 / (gs.next(src) / ν2) }
+}
+
+// NoncentralFFill fills x with random numbers drawn from the noncentral F distribution.
+func NoncentralFFill(ν1, ν2, λ float64, x []float64) {
+	NoncentralFFillR(GlobalRand, ν1, ν2, λ, x)
+}
+
+// NoncentralFFillR fills x with random numbers drawn from the noncentral F distribution, using the random source src;
+// the samplers are set up once for all the draws, but for the Gamma variate of the Poisson count, whose shape varies.
+func NoncentralFFillR(src *rand.Rand, ν1, ν2, λ float64, x []float64) {
+	next := NoncentralFR(src, ν1, ν2, λ)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// noncentralFRaw returns the raw moments E[X^k], k = 1, ..., 4, of the noncentral F-distribution,
+// (ν2/ν1)^k E[U^k] E[V^-k], with E[V^-k] = 1 / Π (ν2 - 2j), j = 1, ..., k; they are finite for ν2 > 2k.
+func noncentralFRaw(ν1, ν2, λ float64) (m [5]float64) {
+	u := noncentralChiSquareRaw(ν1, λ)
+	m[0] = 1
+	c := 1.0
+	for k := 1; k <= 4; k++ {
+		c *= ν2 / ν1 / (ν2 - 2*float64(k))
+		m[k] = c * u[k]
+	}
+	return
+}
+
+// NoncentralFMean returns the mean of the noncentral F distribution.
+func NoncentralFMean(ν1, ν2, λ float64) float64 {
+	if ν2 <= 2 {
+		return NaN
+	}
+	return noncentralFRaw(ν1, ν2, λ)[1]
+}
+
+// NoncentralFVar returns the variance of the noncentral F distribution.
+func NoncentralFVar(ν1, ν2, λ float64) float64 {
+	if ν2 <= 4 {
+		return NaN
+	}
+	m := noncentralFRaw(ν1, ν2, λ)
+	return m[2] - m[1]*m[1]
+}
+
+// NoncentralFStd returns the standard deviation of the noncentral F distribution.
+func NoncentralFStd(ν1, ν2, λ float64) float64 {
+	return sqrt(NoncentralFVar(ν1, ν2, λ))
+}
+
+// NoncentralFSkew returns the skewness of the noncentral F distribution.
+func NoncentralFSkew(ν1, ν2, λ float64) float64 {
+	if ν2 <= 6 {
+		return NaN
+	}
+	m := noncentralFRaw(ν1, ν2, λ)
+	_, _, skew, _ := rawMoments(m[1], m[2], m[3], 0)
+	return skew
+}
+
+// NoncentralFExKurt returns the excess kurtosis of the noncentral F distribution.
+func NoncentralFExKurt(ν1, ν2, λ float64) float64 {
+	if ν2 <= 8 {
+		return NaN
+	}
+	m := noncentralFRaw(ν1, ν2, λ)
+	_, _, _, kurt := rawMoments(m[1], m[2], m[3], m[4])
+	return kurt
+}
+
+// NoncentralFEntropy returns the differential entropy of the noncentral F distribution, by numerical integration.
+func NoncentralFEntropy(ν1, ν2, λ float64) float64 {
+	return entropyLnPDF(NoncentralFLnPDF(ν1, ν2, λ), NoncentralFQtl(ν1, ν2, λ), 0, posInf)
+}
+
+// NoncentralFCF returns the characteristic function of the noncentral F distribution, by numerical integration.
+func NoncentralFCF(ν1, ν2, λ, t float64) complex128 {
+	return cfLnPDF(NoncentralFLnPDF(ν1, ν2, λ), NoncentralFQtl(ν1, ν2, λ), 0, posInf, t)
+}
+
+// NoncentralFCGF returns the cumulant generating function of the noncentral F distribution, by numerical integration; +Inf for t > 0.
+func NoncentralFCGF(ν1, ν2, λ, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfLnPDF(NoncentralFLnPDF(ν1, ν2, λ), NoncentralFQtl(ν1, ν2, λ), 0, posInf, t)
+}
+
+// NoncentralFMGF does not exist: the noncentral F-distribution has only moments of order less than ν2/2.
+
+// NoncentralFMatchQtls returns the noncentral F-distribution whose quantiles for the probabilities p best match x.
+func NoncentralFMatchQtls(p, x []float64) NoncentralFDist {
+	e := matchQtls(func(θ []float64) Continuous { return NoncentralFDist{θ[0], θ[1], θ[2]} }, p, x, []float64{5, 10, 1}, []mleBound{mlePos, mlePos, mlePos})
+	return NoncentralFDist{e[0], e[1], e[2]}
+}
+
+// NoncentralFFit returns the maximum-likelihood estimates of ν1, ν2, λ of the Noncentral F-distribution from the sample x.
+func NoncentralFFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return NoncentralFLnPDF(θ[0], θ[1], θ[2]) })
+	// the mean is ν2 (ν1 + λ) / (ν1 (ν2 - 2)); ν1 = 5, ν2 = 10 to start
+	μ, _ := mleMeanVar(x)
+	λ := max(5*(μ*0.8-1), 0.5)
+	return mleFit(lnL, []float64{5, 10, λ}, []mleBound{mlePos, mlePos, mlePos})
+}
+
+// NoncentralFDist is the noncentral F-distribution with ν1 = Nu1 and ν2 = Nu2 degrees of freedom and noncentrality λ = Lambda. It implements Continuous.
+type NoncentralFDist struct {
+	Nu1, Nu2, Lambda float64
+}
+
+// PDF returns the value of PDF of the noncentral F distribution at x.
+func (d NoncentralFDist) PDF(x float64) float64 { return NoncentralFPDFAt(d.Nu1, d.Nu2, d.Lambda, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the noncentral F distribution at x.
+func (d NoncentralFDist) LnPDF(x float64) float64 { return NoncentralFLnPDF(d.Nu1, d.Nu2, d.Lambda)(x) }
+
+// CDF returns the value of CDF of the noncentral F distribution at x.
+func (d NoncentralFDist) CDF(x float64) float64 { return NoncentralFCDFAt(d.Nu1, d.Nu2, d.Lambda, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the noncentral F distribution at x.
+func (d NoncentralFDist) Surv(x float64) float64 {
+	return NoncentralFCDFTail(d.Nu1, d.Nu2, d.Lambda, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the noncentral F distribution at x.
+func (d NoncentralFDist) LnCDF(x float64) float64 {
+	return NoncentralFCDFTail(d.Nu1, d.Nu2, d.Lambda, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the noncentral F distribution at x.
+func (d NoncentralFDist) LnSurv(x float64) float64 {
+	return NoncentralFCDFTail(d.Nu1, d.Nu2, d.Lambda, false, true)(x)
+}
+
+// Qtl returns the quantile of the noncentral F distribution for probability p.
+func (d NoncentralFDist) Qtl(p float64) float64 { return NoncentralFQtlFor(d.Nu1, d.Nu2, d.Lambda, p) }
+
+// QtlTail returns the quantile of the noncentral F distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d NoncentralFDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return NoncentralFQtlTail(d.Nu1, d.Nu2, d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the noncentral F distribution.
+func (d NoncentralFDist) Rand() float64 { return NoncentralFNext(d.Nu1, d.Nu2, d.Lambda) }
+
+// RandR returns random number drawn from the noncentral F distribution, using the random source src.
+func (d NoncentralFDist) RandR(src *rand.Rand) float64 {
+	return NoncentralFNextR(src, d.Nu1, d.Nu2, d.Lambda)
+}
+
+// Mean returns the mean of the noncentral F distribution.
+func (d NoncentralFDist) Mean() float64 { return NoncentralFMean(d.Nu1, d.Nu2, d.Lambda) }
+
+// Var returns the variance of the noncentral F distribution.
+func (d NoncentralFDist) Var() float64 { return NoncentralFVar(d.Nu1, d.Nu2, d.Lambda) }
+
+// Skew returns the skewness of the noncentral F distribution.
+func (d NoncentralFDist) Skew() float64 { return NoncentralFSkew(d.Nu1, d.Nu2, d.Lambda) }
+
+// ExKurt returns the excess kurtosis of the noncentral F distribution.
+func (d NoncentralFDist) ExKurt() float64 { return NoncentralFExKurt(d.Nu1, d.Nu2, d.Lambda) }
+
+// Entropy returns the differential entropy of the noncentral F distribution.
+func (d NoncentralFDist) Entropy() float64 { return NoncentralFEntropy(d.Nu1, d.Nu2, d.Lambda) }
+
+// CF returns the characteristic function of the noncentral F distribution at t.
+func (d NoncentralFDist) CF(t float64) complex128 { return NoncentralFCF(d.Nu1, d.Nu2, d.Lambda, t) }
+
+// CGF returns the cumulant generating function of the noncentral F distribution at t.
+func (d NoncentralFDist) CGF(t float64) float64 { return NoncentralFCGF(d.Nu1, d.Nu2, d.Lambda, t) }
+
+// Support returns the support of the noncentral F distribution.
+func (d NoncentralFDist) Support() (a, b float64) { return 0, posInf }
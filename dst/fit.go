@@ -0,0 +1,408 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Maximum-likelihood fitting.
+// Every univariate family provides XxxFit(x), which returns the maximum-likelihood estimates of its parameters from the sample x,
+// in the order of the parameters of the family, with their standard errors from the observed information and the maximized log-likelihood.
+// The estimates are in closed form where it exists, found by Newton's method where the likelihood reduces to one equation,
+// and by the Nelder-Mead simplex method on the log-likelihood otherwise, starting from the method of moments or the quantiles.
+// Integer parameters, such as the number of trials of the Binomial distribution, are given, not estimated, and not part of Est, unless noted;
+// where they are estimated, and where an estimate lies on a bound set by the sample (the end points of the Uniform distribution),
+// the information is not defined and the standard errors are NaN.
+// The wrappers TruncDist and MixtureDist, the Empirical distribution and the multivariate distributions have no XxxFit.
+
+import (
+	"sort"
+)
+
+// MLE is the result of a maximum-likelihood fit.
+type MLE struct {
+	Est    []float64   // estimates of the parameters
+	StdErr []float64   // standard errors, from the observed information
+	Cov    [][]float64 // asymptotic covariance matrix of the estimates, the inverse of the observed information
+	LnL    float64     // log-likelihood at the estimates
+}
+
+// mleBound is the open interval (Lo, Hi) of the values of a parameter.
+type mleBound struct {
+	Lo, Hi float64
+}
+
+var (
+	mleReal = mleBound{negInf, posInf}
+	mlePos  = mleBound{0, posInf}
+	mleUnit = mleBound{0, 1}
+)
+
+// to maps the unconstrained u to the interval.
+func (b mleBound) to(u float64) float64 {
+	switch {
+	case isInf(b.Lo, -1) && isInf(b.Hi, 1):
+		return u
+	case isInf(b.Hi, 1):
+		return b.Lo + exp(u)
+	case isInf(b.Lo, -1):
+		return b.Hi - exp(-u)
+	}
+	return b.Lo + (b.Hi-b.Lo)/(1+exp(-u))
+}
+
+// from maps θ within the interval to the unconstrained scale.
+func (b mleBound) from(θ float64) float64 {
+	switch {
+	case isInf(b.Lo, -1) && isInf(b.Hi, 1):
+		return θ
+	case isInf(b.Hi, 1):
+		return log(θ - b.Lo)
+	case isInf(b.Lo, -1):
+		return -log(b.Hi - θ)
+	}
+	return log((θ - b.Lo) / (b.Hi - θ))
+}
+
+// mleLnL returns the log-likelihood of the parameters θ for the sample x, given the logarithm of the PDF for θ.
+func mleLnL(x []float64, lnPDF func(θ []float64) func(x float64) float64) func(θ []float64) float64 {
+	return func(θ []float64) float64 {
+		f := lnPDF(θ)
+		l := fZero
+		for _, v := range x {
+			l += f(v)
+		}
+		return l
+	}
+}
+
+// mleLnLInt returns the log-likelihood of the parameters θ for the sample k, given the logarithm of the PMF for θ.
+func mleLnLInt(k []int64, lnPMF func(θ []float64) func(k int64) float64) func(θ []float64) float64 {
+	return func(θ []float64) float64 {
+		f := lnPMF(θ)
+		l := fZero
+		for _, v := range k {
+			l += f(v)
+		}
+		return l
+	}
+}
+
+// mleFit maximizes the log-likelihood lnL by the Nelder-Mead method from θ0, each parameter kept within its bound.
+func mleFit(lnL func(θ []float64) float64, θ0 []float64, bounds []mleBound) MLE {
+	return mleFitFixed(lnL, θ0, bounds, nil)
+}
+
+// mleFitFixed maximizes the log-likelihood lnL by the Nelder-Mead method from θ0, each parameter kept within its bound,
+// over the parameters not fixed; the fixed ones stay at their values in θ0.
+func mleFitFixed(lnL func(θ []float64) float64, θ0 []float64, bounds []mleBound, fixed []bool) MLE {
+	free := mleFree(len(θ0), fixed)
+	θ := append([]float64(nil), θ0...)
+	toθ := func(u []float64) []float64 {
+		for i, j := range free {
+			θ[j] = bounds[j].to(u[i])
+		}
+		return θ
+	}
+	u0 := make([]float64, len(free))
+	for i, j := range free {
+		u0[i] = bounds[j].from(θ0[j])
+	}
+	u := nelderMead(func(u []float64) float64 { return -lnL(toθ(u)) }, u0)
+	return mleAtFixed(lnL, append([]float64(nil), toθ(u)...), fixed)
+}
+
+// mleFree returns the indices of the parameters not fixed.
+func mleFree(n int, fixed []bool) []int {
+	var free []int
+	for i := 0; i < n; i++ {
+		if fixed == nil || !fixed[i] {
+			free = append(free, i)
+		}
+	}
+	return free
+}
+
+// mleAt returns the fit at the estimates θ, with the standard errors from the observed information,
+// the Hessian of the log-likelihood lnL by central differences.
+func mleAt(lnL func(θ []float64) float64, θ []float64) MLE {
+	return mleAtFixed(lnL, θ, nil)
+}
+
+// mleAtFixed returns the fit at the estimates θ, with the standard errors of the parameters not fixed from their observed information.
+// The standard errors of the fixed parameters, on a bound set by the sample or integer, are NaN, as are their rows and columns of Cov.
+// If the information is singular or not finite, all standard errors are NaN and Cov is nil.
+func mleAtFixed(lnL func(θ []float64) float64, θ []float64, fixed []bool) MLE {
+	n := len(θ)
+	r := MLE{Est: θ, StdErr: make([]float64, n), LnL: lnL(θ)}
+	for i := range r.StdErr {
+		r.StdErr[i] = NaN
+	}
+	free := mleFree(n, fixed)
+	m := len(free)
+	h := make([]float64, n)
+	for i := range θ {
+		h[i] = 1e-4 * max(abs(θ[i]), 1e-2)
+	}
+	t := make([]float64, n)
+	at := func(i int, di float64, j int, dj float64) float64 {
+		copy(t, θ)
+		t[i] += di
+		t[j] += dj
+		return lnL(t)
+	}
+	// the observed information of the free parameters
+	H := make([][]float64, m)
+	for a := range H {
+		H[a] = make([]float64, m)
+	}
+	for a, i := range free {
+		for b, j := range free[:a+1] {
+			var v float64
+			if i == j {
+				v = (at(i, h[i], i, 0) - 2*r.LnL + at(i, -h[i], i, 0)) / (h[i] * h[i])
+			} else {
+				v = (at(i, h[i], j, h[j]) - at(i, h[i], j, -h[j]) - at(i, -h[i], j, h[j]) + at(i, -h[i], j, -h[j])) / (4 * h[i] * h[j])
+			}
+			if isNaN(v) || isInf(v, 0) {
+				return r
+			}
+			H[a][b], H[b][a] = -v, -v
+		}
+	}
+	c := invert(H)
+	if c == nil {
+		return r
+	}
+	r.Cov = make([][]float64, n)
+	for i := range r.Cov {
+		r.Cov[i] = make([]float64, n)
+		for j := range r.Cov[i] {
+			r.Cov[i][j] = NaN
+		}
+	}
+	for a, i := range free {
+		for b, j := range free {
+			r.Cov[i][j] = c[a][b]
+		}
+		if c[a][a] > 0 {
+			r.StdErr[i] = sqrt(c[a][a])
+		}
+	}
+	return r
+}
+
+// mleAllFixed returns n true values: no parameter has a standard error.
+func mleAllFixed(n int) []bool {
+	fixed := make([]bool, n)
+	for i := range fixed {
+		fixed[i] = true
+	}
+	return fixed
+}
+
+// invert returns the inverse of the square matrix a by Gauss-Jordan elimination with partial pivoting, nil if a is singular.
+func invert(a [][]float64) [][]float64 {
+	n := len(a)
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, 2*n)
+		copy(m[i], a[i])
+		m[i][n+i] = 1
+	}
+	for c := 0; c < n; c++ {
+		p := c
+		for i := c + 1; i < n; i++ {
+			if abs(m[i][c]) > abs(m[p][c]) {
+				p = i
+			}
+		}
+		if m[p][c] == 0 || isNaN(m[p][c]) {
+			return nil
+		}
+		m[c], m[p] = m[p], m[c]
+		d := m[c][c]
+		for j := range m[c] {
+			m[c][j] /= d
+		}
+		for i := 0; i < n; i++ {
+			if i != c && m[i][c] != 0 {
+				f := m[i][c]
+				for j := range m[i] {
+					m[i][j] -= f * m[c][j]
+				}
+			}
+		}
+	}
+	for i := range m {
+		m[i] = m[i][n:]
+	}
+	return m
+}
+
+// nelderMead returns the minimum of f near x0, by the simplex method of Nelder and Mead with the standard coefficients,
+// restarted from the best point until it stops improving. Values of f that are not finite count as +∞.
+func nelderMead(f func(x []float64) float64, x0 []float64) []float64 {
+	n := len(x0)
+	g := func(x []float64) float64 {
+		v := f(x)
+		if isNaN(v) || isInf(v, 0) {
+			return posInf
+		}
+		return v
+	}
+	best := append([]float64(nil), x0...)
+	fbest := g(best)
+	for restart := 0; restart < 20; restart++ {
+		// the simplex around the best point
+		s := make([][]float64, n+1)
+		fs := make([]float64, n+1)
+		for i := range s {
+			s[i] = append([]float64(nil), best...)
+			if i > 0 {
+				s[i][i-1] += 0.1 * max(abs(best[i-1]), 1)
+			}
+			fs[i] = g(s[i])
+		}
+		c := make([]float64, n)
+		xr := make([]float64, n)
+		xe := make([]float64, n)
+		point := func(dst []float64, t float64, worst []float64) {
+			for j := range dst {
+				dst[j] = c[j] + t*(worst[j]-c[j])
+			}
+		}
+		for it := 0; it < 1000*n; it++ {
+			sort.Sort(simplex{s, fs})
+			size := fZero
+			for i := 1; i <= n; i++ {
+				for j := 0; j < n; j++ {
+					size = max(size, abs(s[i][j]-s[0][j])/(1+abs(s[0][j])))
+				}
+			}
+			if size < 1e-10 && abs(fs[n]-fs[0]) <= 1e-12*(1+abs(fs[0])) {
+				break
+			}
+			for j := range c {
+				c[j] = 0
+				for i := 0; i < n; i++ {
+					c[j] += s[i][j] / float64(n)
+				}
+			}
+			point(xr, -1, s[n])
+			fr := g(xr)
+			switch {
+			case fr < fs[0]:
+				point(xe, -2, s[n])
+				if fe := g(xe); fe < fr {
+					copy(s[n], xe)
+					fs[n] = fe
+				} else {
+					copy(s[n], xr)
+					fs[n] = fr
+				}
+			case fr < fs[n-1]:
+				copy(s[n], xr)
+				fs[n] = fr
+			default:
+				// contraction, outside or inside
+				t := -0.5
+				if fr >= fs[n] {
+					t = 0.5
+				}
+				point(xe, t, s[n])
+				if fc := g(xe); fc < min(fr, fs[n]) {
+					copy(s[n], xe)
+					fs[n] = fc
+				} else {
+					// shrink towards the best point
+					for i := 1; i <= n; i++ {
+						for j := range s[i] {
+							s[i][j] = s[0][j] + 0.5*(s[i][j]-s[0][j])
+						}
+						fs[i] = g(s[i])
+					}
+				}
+			}
+		}
+		sort.Sort(simplex{s, fs})
+		improved := fs[0] < fbest-1e-12*(1+abs(fbest))
+		if fs[0] <= fbest {
+			copy(best, s[0])
+			fbest = fs[0]
+		}
+		if !improved && restart > 0 {
+			break
+		}
+	}
+	return best
+}
+
+// simplex sorts the vertices of the Nelder-Mead simplex by their values.
+type simplex struct {
+	x [][]float64
+	f []float64
+}
+
+func (s simplex) Len() int           { return len(s.f) }
+func (s simplex) Less(i, j int) bool { return s.f[i] < s.f[j] }
+func (s simplex) Swap(i, j int) {
+	s.x[i], s.x[j] = s.x[j], s.x[i]
+	s.f[i], s.f[j] = s.f[j], s.f[i]
+}
+
+// mleIntClimb returns the integer parameters θ ≥ lo maximizing lnL, by coordinate ascent from θ0, for likelihoods unimodal in each parameter.
+func mleIntClimb(lnL func(θ []float64) float64, θ0 []float64, lo float64) []float64 {
+	θ := make([]float64, len(θ0))
+	for i := range θ {
+		θ[i] = max(floor(θ0[i]+0.5), lo)
+	}
+	l := lnL(θ)
+	for moved := true; moved; {
+		moved = false
+		for i := range θ {
+			for _, step := range []float64{1, -1} {
+				for θ[i]+step >= lo {
+					θ[i] += step
+					if v := lnL(θ); v > l {
+						l, moved = v, true
+						continue
+					}
+					θ[i] -= step
+					break
+				}
+			}
+		}
+	}
+	return θ
+}
+
+// mleMeanVar returns the mean and the variance, with divisor n, of the sample x.
+func mleMeanVar(x []float64) (μ, σ2 float64) {
+	μ, σ2, _, _ = empiricalMoments(x)
+	return
+}
+
+// mleMeanVarInt returns the mean and the variance, with divisor n, of the sample k.
+func mleMeanVarInt(k []int64) (μ, σ2 float64) {
+	x := make([]float64, len(k))
+	for i, v := range k {
+		x[i] = float64(v)
+	}
+	return mleMeanVar(x)
+}
+
+// mleMinMax returns the smallest and the largest values of the sample x.
+func mleMinMax(x []float64) (a, b float64) {
+	a, b = posInf, negInf
+	for _, v := range x {
+		a, b = min(a, v), max(b, v)
+	}
+	return
+}
+
+// mleMinMaxInt returns the smallest and the largest values of the sample k.
+func mleMinMaxInt(k []int64) (a, b int64) {
+	a, b = posInfInt64, -posInfInt64
+	for _, v := range k {
+		a, b = imin(a, v), imax(b, v)
+	}
+	return
+}
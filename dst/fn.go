@@ -3,6 +3,7 @@ package dst
 import (
 	fn "code.google.com/p/go-fn/fn"
 	"math"
+	"math/rand"
 )
 
 const π = float64(math.Pi)
@@ -23,6 +24,7 @@ var iOne int64 = int64(1)
 
 var negInf float64 = math.Inf(-1)
 var posInf float64 = math.Inf(+1)
+var posInfInt64 int64 = math.MaxInt64 // upper end of unbounded discrete support
 
 // Functions imported from "math".
 var abs func(float64) float64 = math.Abs
@@ -34,10 +36,16 @@ var exp func(float64) float64 = math.Exp
 var sqrt func(float64) float64 = math.Sqrt
 var pow func(float64, float64) float64 = math.Pow
 var atan func(float64) float64 = math.Atan
+var atan2 func(float64, float64) float64 = math.Atan2
+var acos func(float64) float64 = math.Acos
+var sin func(float64) float64 = math.Sin
+var cos func(float64) float64 = math.Cos
 var tan func(float64) float64 = math.Tan
 var trunc func(float64) float64 = math.Trunc
 var erf func(float64) float64 = math.Erf
 var erfc func(float64) float64 = math.Erfc
+var erfinv func(float64) float64 = math.Erfinv
+var lgamma func(float64) (float64, int) = math.Lgamma
 var isNaN func(float64) bool = math.IsNaN
 var isInf func(float64, int) bool = math.IsInf
 
@@ -162,17 +170,27 @@ func expm1(x float64) float64 {
 }
 
 func RejectionSample(targetDensity func(float64) float64, sourceDensity func(float64) float64, source func() float64, K float64) float64 {
+	return RejectionSampleR(GlobalRand, targetDensity, sourceDensity, source, K)
+}
+
+// RejectionSampleR draws from targetDensity by rejection from source, bounded by K*sourceDensity, using the random source src for acceptance.
+func RejectionSampleR(src *rand.Rand, targetDensity func(float64) float64, sourceDensity func(float64) float64, source func() float64, K float64) float64 {
 	x := source()
-	for ; UniformNext(0, 1) >= targetDensity(x)/(K*sourceDensity(x)); x = source() {
+	for ; UniformNextR(src, 0, 1) >= targetDensity(x)/(K*sourceDensity(x)); x = source() {
 
 	}
 	return x
 }
 
 func ShuffleInt64(x []int64) {
+	ShuffleInt64R(GlobalRand, x)
+}
+
+// ShuffleInt64R shuffles x in place, using the random source src.
+func ShuffleInt64R(src *rand.Rand, x []int64) {
 	n := int64(len(x))
 	for i := iZero; i < n; i++ {
-		j := i + RangeNext(n-i)
+		j := i + RangeNextR(src, n-i)
 		t := x[i]
 		x[i] = x[j]
 		x[j] = t
@@ -180,9 +198,14 @@ func ShuffleInt64(x []int64) {
 }
 
 func ShuffleFloat64(x []float64) {
+	ShuffleFloat64R(GlobalRand, x)
+}
+
+// ShuffleFloat64R shuffles x in place, using the random source src.
+func ShuffleFloat64R(src *rand.Rand, x []float64) {
 	n := int64(len(x))
 	for i := iZero; i < n; i++ {
-		j := i + RangeNext(n-i)
+		j := i + RangeNextR(src, n-i)
 		t := x[i]
 		x[i] = x[j]
 		x[j] = t
@@ -190,9 +213,14 @@ func ShuffleFloat64(x []float64) {
 }
 
 func Shuffle(x []interface{}) {
+	ShuffleR(GlobalRand, x)
+}
+
+// ShuffleR shuffles x in place, using the random source src.
+func ShuffleR(src *rand.Rand, x []interface{}) {
 	n := int64(len(x))
 	for i := iZero; i < n; i++ {
-		j := i + RangeNext(n-i)
+		j := i + RangeNextR(src, n-i)
 		t := x[i]
 		x[i] = x[j]
 		x[j] = t
@@ -230,3 +258,173 @@ func fmin2(x, y float64) float64 {
 	}
 	return y
 }
+
+// upperΓ returns the upper incomplete gamma function Γ(a, x) for x > 0 and any real a,
+// using Γ(a, x) = (Γ(a+1, x) - x^a e^-x)/a to step up to a positive argument.
+func upperΓ(a, x float64) float64 {
+	if a > 0 {
+		return iΓ(a, x)
+	}
+	if a == trunc(a) {
+		// start from Γ(0, x) = E1(x)
+		g := expInt1(x)
+		for s := 0.0; s > a; s-- {
+			g = (g - pow(x, s-1)*exp(-x)) / (s - 1)
+		}
+		return g
+	}
+	return (upperΓ(a+1, x) - pow(x, a)*exp(-x)) / a
+}
+
+// expInt1 returns the exponential integral E1(x) for x > 0.
+func expInt1(x float64) float64 {
+	const euler = 0.57721566490153286061
+	if x < 1 {
+		// power series
+		sum, term := 0.0, 1.0
+		for k := 1.0; k < 100; k++ {
+			term *= -x / k
+			sum += term / k
+			if abs(term/k) < eps64*abs(sum) {
+				break
+			}
+		}
+		return -euler - log(x) - sum
+	}
+	// continued fraction, modified Lentz
+	b := x + 1
+	c := 1e300
+	d := 1 / b
+	h := d
+	for i := 1.0; i < 1000; i++ {
+		an := -i * i
+		b += 2
+		d = 1 / (an*d + b)
+		c = b + an/c
+		del := c * d
+		h *= del
+		if abs(del-1) < eps64 {
+			break
+		}
+	}
+	return h * exp(-x)
+}
+
+// maxEval caps the evaluations of the integrand by integrate and integrateRel.
+const maxEval = 500000
+
+// integrate returns the integral of f over the finite interval [a, b], by adaptive Simpson's rule, or NaN if it has not converged within maxEval evaluations.
+func integrate(f func(x float64) float64, a, b float64) float64 {
+	const tol = 1e-10
+	n := maxEval - 3
+	fa, fm, fb := f(a), f((a+b)/2), f(b)
+	s := simpson(f, a, b, fa, fm, fb, (b-a)/6*(fa+4*fm+fb), tol, 50, &n)
+	if n < 0 {
+		return NaN
+	}
+	return s
+}
+
+// integrateRel returns the integral of the nonnegative f over the finite interval [a, b], by adaptive Simpson's rule,
+// to a precision relative to the integral itself, for integrals too small for integrate; NaN if it has not converged within maxEval evaluations.
+func integrateRel(f func(x float64) float64, a, b float64) float64 {
+	n := maxEval - 3
+	fa, fm, fb := f(a), f((a+b)/2), f(b)
+	whole := (b - a) / 6 * (fa + 4*fm + fb)
+	tol := 1e-10
+	s := simpson(f, a, b, fa, fm, fb, whole, tol, 50, &n)
+	// tighten the tolerance to the size of the estimate, until it is no longer the limit
+	for i := 0; i < 4 && n >= 0 && s > 0 && 1e-10*s < tol; i++ {
+		tol = 1e-10 * s
+		s = simpson(f, a, b, fa, fm, fb, whole, tol, 50, &n)
+	}
+	if n < 0 {
+		return NaN
+	}
+	return s
+}
+
+// integrateLnUnimodal returns the logarithm of the integral over the line of exp(h), for h unimodal with the derivative dh, its maximum within [lo, hi].
+// The integrand is taken relative to the maximum, which keeps it from underflowing, out to where h falls 40 below it on either side.
+// The trapezoidal rule converges geometrically for such a smooth integrand that vanishes at both ends: the step is halved until the sum settles.
+func integrateLnUnimodal(h, dh func(y float64) float64, lo, hi float64) float64 {
+	y0 := bisectFn(func(y float64) float64 { return -dh(y) }, 0, lo, hi)
+	c := h(y0)
+	f := func(y float64) float64 { return exp(h(y) - c) }
+	var ends [2]float64
+	for j, dir := range []float64{-1, 1} {
+		step := 1e-3
+		for i := 0; i < 40 && h(y0+dir*step) > c-40; i++ {
+			step *= 2
+		}
+		ends[j] = y0 + dir*step
+	}
+	a, n := ends[0], 16
+	d := (ends[1] - a) / float64(n)
+	s := 0.0
+	for i := 1; i < n; i++ {
+		s += f(a + float64(i)*d)
+	}
+	s *= d
+	for ; n < maxEval; n, d = 2*n, d/2 {
+		m := 0.0
+		for i := 0; i < n; i++ {
+			m += f(a + (float64(i)+0.5)*d)
+		}
+		prev := s
+		s = (s + m*d) / 2
+		if abs(s-prev) <= 1e-13*s {
+			break
+		}
+	}
+	return c + log(s)
+}
+
+// simpson refines the Simpson estimate whole of the integral of f over [a, b] until it is within tol, or within 1e-10 of itself,
+// below which the rounding of f may dominate; it spends at most *n evaluations of f, and *n goes negative if they run out first.
+func simpson(f func(x float64) float64, a, b, fa, fm, fb, whole, tol float64, depth int, n *int) float64 {
+	m := (a + b) / 2
+	flm, frm := f((a+m)/2), f((m+b)/2)
+	*n -= 2
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+	if d := abs(left + right - whole); depth <= 0 || *n < 0 || d <= 15*tol || d <= 1e-10*abs(left+right) {
+		return left + right + (left+right-whole)/15
+	}
+	return simpson(f, a, m, fa, flm, fm, left, tol/2, depth-1, n) + simpson(f, m, b, fm, frm, fb, right, tol/2, depth-1, n)
+}
+
+// digamma returns the digamma function ψ(x) = d/dx log Γ(x), by the recurrence ψ(x) = ψ(x+1) - 1/x up to x ≥ 10 and the asymptotic series.
+func digamma(x float64) float64 {
+	switch {
+	case isNaN(x) || isInf(x, -1) || (x <= 0 && x == floor(x)):
+		return NaN
+	case x < 0:
+		// reflection
+		return digamma(1-x) - π/tan(π*x)
+	}
+	s := fZero
+	for ; x < 10; x++ {
+		s -= 1 / x
+	}
+	z := 1 / (x * x)
+	return s + log(x) - 0.5/x - z*(1.0/12-z*(1.0/120-z*(1.0/252-z*(1.0/240-z*(1.0/132)))))
+}
+
+// trigamma returns the trigamma function ψ'(x), by the recurrence ψ'(x) = ψ'(x+1) + 1/x² up to x ≥ 10 and the asymptotic series.
+func trigamma(x float64) float64 {
+	switch {
+	case isNaN(x) || isInf(x, -1) || (x <= 0 && x == floor(x)):
+		return NaN
+	case x < 0:
+		// reflection
+		s := sin(π * x)
+		return -trigamma(1-x) + π*π/(s*s)
+	}
+	s := fZero
+	for ; x < 10; x++ {
+		s += 1 / (x * x)
+	}
+	z := 1 / (x * x)
+	return s + 1/x + z/2 + z/x*(1.0/6-z*(1.0/30-z*(1.0/42-z*(1.0/30-z*(5.0/66)))))
+}
@@ -0,0 +1,287 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Fréchet distribution (type II extreme value distribution).
+// The limiting distribution of the maximum of many samples from a heavy-tailed distribution, such as the Pareto or Student's t distributions.
+//
+// Parameters:
+// α > 0		shape
+// σ > 0		scale
+// μ ∈ R		location (minimum)
+//
+// Support:
+// x ∈ (μ; ∞)
+
+import (
+	"math/rand"
+)
+
+// FrechetPDF returns the PDF of the Fréchet distribution.
+func FrechetPDF(α, σ, μ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if α <= 0 || σ <= 0 {
+			return NaN
+		}
+		if x <= μ {
+			return 0
+		}
+		z := (x - μ) / σ
+		return α / σ * pow(z, -1-α) * exp(-pow(z, -α))
+	}
+}
+
+// FrechetLnPDF returns the natural logarithm of the PDF of the Fréchet distribution.
+func FrechetLnPDF(α, σ, μ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if α <= 0 || σ <= 0 {
+			return NaN
+		}
+		if x <= μ {
+			return negInf
+		}
+		z := (x - μ) / σ
+		return log(α/σ) - (1+α)*log(z) - pow(z, -α)
+	}
+}
+
+// FrechetPDFAt returns the value of PDF of Fréchet distribution at x.
+func FrechetPDFAt(α, σ, μ, x float64) float64 {
+	pdf := FrechetPDF(α, σ, μ)
+	return pdf(x)
+}
+
+// FrechetCDF returns the CDF of the Fréchet distribution.
+func FrechetCDF(α, σ, μ float64) func(x float64) float64 {
+	return FrechetCDFTail(α, σ, μ, true, false)
+}
+
+// FrechetCDFAt returns the value of CDF of the Fréchet distribution, at x.
+func FrechetCDFAt(α, σ, μ, x float64) float64 {
+	cdf := FrechetCDF(α, σ, μ)
+	return cdf(x)
+}
+
+// FrechetCDFTail returns the CDF of the Fréchet distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func FrechetCDFTail(α, σ, μ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if α <= 0 || σ <= 0 {
+			return NaN
+		}
+		if x <= μ {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnLower(-pow((x-μ)/σ, -α), lowerTail, logP)
+	}
+}
+
+// FrechetQtl returns the inverse of the CDF (quantile) of the Fréchet distribution.
+func FrechetQtl(α, σ, μ float64) func(p float64) float64 {
+	return FrechetQtlTail(α, σ, μ, true, false)
+}
+
+// FrechetQtlFor returns the inverse of the CDF (quantile) of the Fréchet distribution, for given probability.
+func FrechetQtlFor(α, σ, μ, p float64) float64 {
+	qtl := FrechetQtl(α, σ, μ)
+	return qtl(p)
+}
+
+// FrechetQtlTail returns the inverse of FrechetCDFTail (quantile) of the Fréchet distribution.
+func FrechetQtlTail(α, σ, μ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if α <= 0 || σ <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return μ + σ*pow(-pLnLower(p, lowerTail, logP), -1/α)
+	}
+}
+
+// FrechetNext returns random number drawn from the Fréchet distribution.
+func FrechetNext(α, σ, μ float64) float64 { return FrechetNextR(GlobalRand, α, σ, μ) }
+
+// FrechetNextR returns random number drawn from the Fréchet distribution, using the random source src.
+func FrechetNextR(src *rand.Rand, α, σ, μ float64) float64 {
+	return μ + σ*pow(expZig(src), -1/α)
+}
+
+// Frechet returns the random number generator with  Fréchet distribution.
+func Frechet(α, σ, μ float64) func() float64 { return FrechetR(GlobalRand, α, σ, μ) }
+
+// FrechetR returns the random number generator with  Fréchet distribution, using the random source src.
+func FrechetR(src *rand.Rand, α, σ, μ float64) func() float64 {
+	return func() float64 { return FrechetNextR(src, α, σ, μ) }
+}
+
+// FrechetFill fills x with random numbers drawn from the Fréchet distribution.
+func FrechetFill(α, σ, μ float64, x []float64) {
+	FrechetFillR(GlobalRand, α, σ, μ, x)
+}
+
+// FrechetFillR fills x with random numbers drawn from the Fréchet distribution, using the random source src.
+func FrechetFillR(src *rand.Rand, α, σ, μ float64, x []float64) {
+	for i := range x {
+		x[i] = FrechetNextR(src, α, σ, μ)
+	}
+}
+
+// FrechetMean returns the mean of the Fréchet distribution.
+func FrechetMean(α, σ, μ float64) float64 {
+	if α <= 1 {
+		return posInf
+	}
+	return μ + σ*Γ(1-1/α)
+}
+
+// FrechetMedian returns the median of the Fréchet distribution.
+func FrechetMedian(α, σ, μ float64) float64 {
+	return μ + σ*pow(Ln2, -1/α)
+}
+
+// FrechetMode returns the mode of the Fréchet distribution.
+func FrechetMode(α, σ, μ float64) float64 {
+	return μ + σ*pow(α/(1+α), 1/α)
+}
+
+// FrechetVar returns the variance of the Fréchet distribution.
+func FrechetVar(α, σ, μ float64) float64 {
+	if α <= 2 {
+		return posInf
+	}
+	g1, g2 := Γ(1-1/α), Γ(1-2/α)
+	return σ * σ * (g2 - g1*g1)
+}
+
+// FrechetStd returns the standard deviation of the Fréchet distribution.
+func FrechetStd(α, σ, μ float64) float64 {
+	return sqrt(FrechetVar(α, σ, μ))
+}
+
+// FrechetSkew returns the skewness of the Fréchet distribution.
+func FrechetSkew(α, σ, μ float64) float64 {
+	if α <= 3 {
+		return posInf
+	}
+	g1, g2, g3 := Γ(1-1/α), Γ(1-2/α), Γ(1-3/α)
+	return (g3 - 3*g2*g1 + 2*g1*g1*g1) / pow(g2-g1*g1, 1.5)
+}
+
+// FrechetExKurt returns the excess kurtosis of the Fréchet distribution.
+func FrechetExKurt(α, σ, μ float64) float64 {
+	if α <= 4 {
+		return posInf
+	}
+	g1, g2, g3, g4 := Γ(1-1/α), Γ(1-2/α), Γ(1-3/α), Γ(1-4/α)
+	v := g2 - g1*g1
+	return (g4-4*g3*g1+6*g2*g1*g1-3*g1*g1*g1*g1)/(v*v) - 3
+}
+
+// FrechetEntropy returns the differential entropy of the Fréchet distribution.
+func FrechetEntropy(α, σ, μ float64) float64 {
+	return 1 + eulerγ/α + eulerγ + log(σ/α)
+}
+
+// FrechetCF returns the characteristic function of the Fréchet distribution, by numerical integration.
+func FrechetCF(α, σ, μ, t float64) complex128 {
+	return cfLnPDF(FrechetLnPDF(α, σ, μ), FrechetQtl(α, σ, μ), μ, posInf, t)
+}
+
+// FrechetCGF returns the cumulant generating function of the Fréchet distribution, by numerical integration; +Inf for t > 0.
+func FrechetCGF(α, σ, μ, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfLnPDF(FrechetLnPDF(α, σ, μ), FrechetQtl(α, σ, μ), μ, posInf, t)
+}
+
+// FrechetMGF does not exist.
+
+// FrechetMatchQtls returns the Fréchet distribution whose quantiles for the probabilities p best match x.
+func FrechetMatchQtls(p, x []float64) FrechetDist {
+	lo, hi := mleMinMax(x)
+	μ := lo - (hi-lo)/10
+	// log(x-μ) = log σ - log(-log p) / α
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return log(-log(p)) }), matchMap(x, func(x float64) float64 { return log(x - μ) }))
+	e := matchQtls(func(θ []float64) Continuous { return FrechetDist{θ[0], θ[1], θ[2]} }, p, x, []float64{-1 / b, exp(a), μ}, []mleBound{mlePos, mlePos, mleReal})
+	return FrechetDist{e[0], e[1], e[2]}
+}
+
+// FrechetFit returns the maximum-likelihood estimates of α, σ, μ of the Fréchet distribution from the sample x, with μ below its minimum.
+// It starts from the Weibull distribution of 1 / (x - μ), with shape α and scale 1/σ.
+func FrechetFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return FrechetLnPDF(θ[0], θ[1], θ[2]) })
+	a, _ := mleMinMax(x)
+	μ := a - (empiricalQtl(empiricalSorted(x), 7, 0.5)-a)/2
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = 1 / (v - μ)
+	}
+	α, λ := weibullFit(y)
+	return mleFit(lnL, []float64{α, 1 / λ, μ}, []mleBound{mlePos, mlePos, {negInf, a}})
+}
+
+// FrechetDist is the Fréchet distribution with shape α = Alpha, scale σ = Sigma and location μ = Mu. It implements Continuous.
+type FrechetDist struct {
+	Alpha, Sigma, Mu float64
+}
+
+// PDF returns the value of PDF of the Fréchet distribution at x.
+func (d FrechetDist) PDF(x float64) float64 { return FrechetPDFAt(d.Alpha, d.Sigma, d.Mu, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Fréchet distribution at x.
+func (d FrechetDist) LnPDF(x float64) float64 { return FrechetLnPDF(d.Alpha, d.Sigma, d.Mu)(x) }
+
+// CDF returns the value of CDF of the Fréchet distribution at x.
+func (d FrechetDist) CDF(x float64) float64 { return FrechetCDFAt(d.Alpha, d.Sigma, d.Mu, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Fréchet distribution at x.
+func (d FrechetDist) Surv(x float64) float64 {
+	return FrechetCDFTail(d.Alpha, d.Sigma, d.Mu, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Fréchet distribution at x.
+func (d FrechetDist) LnCDF(x float64) float64 {
+	return FrechetCDFTail(d.Alpha, d.Sigma, d.Mu, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Fréchet distribution at x.
+func (d FrechetDist) LnSurv(x float64) float64 {
+	return FrechetCDFTail(d.Alpha, d.Sigma, d.Mu, false, true)(x)
+}
+
+// Qtl returns the quantile of the Fréchet distribution for probability p.
+func (d FrechetDist) Qtl(p float64) float64 { return FrechetQtlFor(d.Alpha, d.Sigma, d.Mu, p) }
+
+// QtlTail returns the quantile of the Fréchet distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d FrechetDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return FrechetQtlTail(d.Alpha, d.Sigma, d.Mu, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Fréchet distribution.
+func (d FrechetDist) Rand() float64 { return FrechetNext(d.Alpha, d.Sigma, d.Mu) }
+
+// RandR returns random number drawn from the Fréchet distribution, using the random source src.
+func (d FrechetDist) RandR(src *rand.Rand) float64 { return FrechetNextR(src, d.Alpha, d.Sigma, d.Mu) }
+
+// Mean returns the mean of the Fréchet distribution.
+func (d FrechetDist) Mean() float64 { return FrechetMean(d.Alpha, d.Sigma, d.Mu) }
+
+// Var returns the variance of the Fréchet distribution.
+func (d FrechetDist) Var() float64 { return FrechetVar(d.Alpha, d.Sigma, d.Mu) }
+
+// Skew returns the skewness of the Fréchet distribution.
+func (d FrechetDist) Skew() float64 { return FrechetSkew(d.Alpha, d.Sigma, d.Mu) }
+
+// ExKurt returns the excess kurtosis of the Fréchet distribution.
+func (d FrechetDist) ExKurt() float64 { return FrechetExKurt(d.Alpha, d.Sigma, d.Mu) }
+
+// Entropy returns the differential entropy of the Fréchet distribution.
+func (d FrechetDist) Entropy() float64 { return FrechetEntropy(d.Alpha, d.Sigma, d.Mu) }
+
+// CF returns the characteristic function of the Fréchet distribution at t.
+func (d FrechetDist) CF(t float64) complex128 { return FrechetCF(d.Alpha, d.Sigma, d.Mu, t) }
+
+// CGF returns the cumulant generating function of the Fréchet distribution at t.
+func (d FrechetDist) CGF(t float64) float64 { return FrechetCGF(d.Alpha, d.Sigma, d.Mu, t) }
+
+// Support returns the support of the Fréchet distribution.
+func (d FrechetDist) Support() (a, b float64) { return d.Mu, posInf }
@@ -11,6 +11,10 @@ package dst
 // Support: 
 // x ∈ (0, ∞)
 
+import (
+	"math/rand"
+)
+
 // GammaPDF returns the value of CDF of the Gamma distribution, at x. 
 func GammaPDF(α float64, θ float64) func(x float64) float64 {
 	//  Computes the density of the gamma distribution,
@@ -30,7 +34,7 @@ func GammaPDF(α float64, θ float64) func(x float64) float64 {
 			return NaN
 		}
 		if x < 0 {
-			return negInf
+			return 0
 		}
 		if α == 0 {
 			//	return (x == 0)? ML_POSINF : R_D__0;
@@ -160,7 +164,7 @@ func GammaCDF(α float64, θ float64) func(x float64) float64 {
 				return 1
 			}
 		}
-		return pgamma_raw(x, α)
+		return pgamma_raw(x, α, true, false)
 	}
 }
 
@@ -193,7 +197,7 @@ func GammaLnCDF(α float64, θ float64) func(x float64) float64 {
 				return 0
 			}
 		}
-		return pgamma_raw_ln(x, α)
+		return pgamma_raw(x, α, true, true)
 	}
 }
 
@@ -203,52 +207,116 @@ func GammaLnCDFAt(α, θ, x float64) float64 {
 	return cdf(x)
 }
 
+// GammaCDFTail returns the CDF of the Gamma distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func GammaCDFTail(α, θ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if isNaN(x) || isNaN(α) || isNaN(θ) {
+			return x + α + θ
+		}
+		if α < 0 || θ <= 0 {
+			return NaN
+		}
+		x /= θ
+		if isNaN(x) { // eg. original x = θ = +Inf
+			return x
+		}
+		if α == 0 { // limit case, all mass at 0
+			return pTailBounds(x > 0, lowerTail, logP)
+		}
+		return pgamma_raw(x, α, lowerTail, logP)
+	}
+}
+
 // GammaNext returns random number drawn from the Gamma distribution. 
 func GammaNext(α float64, θ float64) float64 {
-	//if α is a small integer, this way is faster on my laptop
-	if α == float64(int64(α)) && α <= 15 {
-		x := ExponentialNext(θ)
-		for i := 1; i < int(α); i++ {
-			x += ExponentialNext(θ)
-		}
-		return x
+	return GammaNextR(GlobalRand, α, θ)
+}
+
+// GammaNextR returns random number drawn from the Gamma distribution, using the random source src.
+func GammaNextR(src *rand.Rand, α float64, θ float64) float64 {
+	gs := newGammaSampler(α, θ)
+	return gs.next(src)
+}
+
+// Gamma returns the random number generator with  Gamma distribution. 
+func Gamma(α, θ float64) func() float64 {
+	return GammaR(GlobalRand, α, θ)
+}
+
+// GammaR returns the random number generator with  Gamma distribution, using the random source src.
+func GammaR(src *rand.Rand, α, θ float64) func() float64 {
+	gs := newGammaSampler(α, θ)
+	return func() float64 { return gs.next(src) }
+}
+
+// GammaFill fills x with random numbers drawn from the Gamma distribution.
+func GammaFill(α float64, θ float64, x []float64) {
+	GammaFillR(GlobalRand, α, θ, x)
+}
+
+// GammaFillR fills x with random numbers drawn from the Gamma distribution, using the random source src.
+func GammaFillR(src *rand.Rand, α float64, θ float64, x []float64) {
+	gs := newGammaSampler(α, θ)
+	for i := range x {
+		x[i] = gs.next(src)
 	}
+}
+
+// gammaSampler draws from the Gamma distribution by the method of Marsaglia and Tsang: d v for v = (1 + c z)^3 of a standard Normal z,
+// accepted by a squeeze in 98% of the cases; the shapes α < 1 are drawn with α + 1, times U^(1/α).
+// The shapes 1, 2 and 3 are drawn faster as sums of Exponential variates.
+// Ref.: Marsaglia, G., Tsang, W. W. (2000). A simple method for generating gamma variables. ACM Transactions on Mathematical Software 26(3), 363–372.
+type gammaSampler struct {
+	θ, d, c float64
+	invα    float64 // 1/α if α < 1, 0 otherwise
+	k       int     // α if it is 1, 2 or 3, 0 otherwise
+}
 
-	if α < 0.75 {
-		return RejectionSample(GammaPDF(α, θ), ExponentialPDF(θ), Exponential(θ), 1)
+// newGammaSampler returns the sampler of the Gamma distribution with shape α and scale θ.
+func newGammaSampler(α, θ float64) gammaSampler {
+	if !(α >= 0) || isInf(α, 1) {
+		panic("bad parameters")
 	}
+	gs := gammaSampler{θ: θ}
+	if α == 1 || α == 2 || α == 3 {
+		gs.k = int(α)
+		return gs
+	}
+	if α < 1 {
+		gs.invα = 1 / α
+		α++
+	}
+	gs.d = α - 1.0/3
+	gs.c = 1 / sqrt(9*gs.d)
+	return gs
+}
 
-	//Tadikamalla ACM '73
-	a := α - 1
-	b := 0.5 + 0.5*sqrt(4*α-3)
-	c := a * (1 + b) / b
-	d := (b - 1) / (a * b)
-	s := a / b
-	p := 1.0 / (2 - exp(-s))
-	var x, y float64
-	for i := 1; ; i++ {
-		u := UniformNext(0, 1)
-		if u > p {
-			var e float64
-			for e = -log((1 - u) / (1 - p)); e > s; e = e - a/b {
-			}
-			x = a - b*e
-			y = a - x
-		} else {
-			x = a - b*log(u/p)
-			y = x - a
+// next returns random number drawn from the Gamma distribution, using the random source src.
+func (gs *gammaSampler) next(src *rand.Rand) float64 {
+	if gs.k > 0 {
+		x := expZig(src)
+		for i := 1; i < gs.k; i++ {
+			x += expZig(src)
 		}
-		u2 := UniformNext(0, 1)
-		if log(u2) <= a*log(d*x)-x+y/b+c {
-			break
+		return x * gs.θ
+	}
+	for {
+		var z, v float64
+		for v <= 0 {
+			z = normalZig(src)
+			v = 1 + gs.c*z
+		}
+		v = v * v * v
+		u := src.Float64()
+		z2 := z * z
+		if u < 1-0.0331*z2*z2 || log(u) < 0.5*z2+gs.d*(1-v+log(v)) {
+			x := gs.d * v
+			if gs.invα != 0 {
+				x *= exp(log(src.Float64()) * gs.invα)
+			}
+			return x * gs.θ
 		}
 	}
-	return x / θ
-}
-
-// Gamma returns the random number generator with  Gamma distribution. 
-func Gamma(α, θ float64) func() float64 {
-	return func() float64 { return GammaNext(α, θ) }
 }
 
 // GammaMean returns the mean of the Gamma distribution. 
@@ -279,6 +347,34 @@ func GammaSkew(α, θ float64) float64 {
 	return 2 / sqrt(α)
 }
 
+// GammaExKurt returns the excess kurtosis of the Gamma distribution.
+func GammaExKurt(α, θ float64) float64 {
+	return 6 / α
+}
+
+// GammaEntropy returns the differential entropy of the Gamma distribution.
+func GammaEntropy(α, θ float64) float64 {
+	return α + log(θ) + LnΓ(α) + (1-α)*digamma(α)
+}
+
+// GammaCF returns the characteristic function of the Gamma distribution.
+func GammaCF(α, θ, t float64) complex128 {
+	return cpow(complex(1, -θ*t), complex(-α, 0))
+}
+
+// GammaCGF returns the cumulant generating function of the Gamma distribution; +Inf for θt ≥ 1.
+func GammaCGF(α, θ, t float64) float64 {
+	if θ*t >= 1 {
+		return posInf
+	}
+	return -α * log1p(-θ*t)
+}
+
+// GammaKL returns the Kullback–Leibler divergence of the Gamma distribution with α2, θ2 from the one with α1, θ1.
+func GammaKL(α1, θ1, α2, θ2 float64) float64 {
+	return (α1-α2)*digamma(α1) - LnΓ(α1) + LnΓ(α2) + α2*log(θ2/θ1) + α1*(θ1-θ2)/θ2
+}
+
 // GammaRateToScale returns the parameter θ (scale) of the Gamma distribution calculated from β = rate.
 // α = shape, β = rate
 // To be used to reparametrize the Gamma distribution. 
@@ -402,3 +498,123 @@ func GammaQtl(α, θ float64) func(p float64) float64 {
 }
 
 */
+
+// GammaFromMeanStd returns the Gamma distribution with mean μ and standard deviation σ.
+func GammaFromMeanStd(μ, σ float64) GammaDist {
+	if μ <= 0 || σ <= 0 {
+		return GammaDist{NaN, NaN}
+	}
+	α, θ := GammaReparamMeanStd(μ, σ)
+	return GammaDist{α, θ}
+}
+
+// GammaFromModeStd returns the Gamma distribution with mode m and standard deviation σ.
+func GammaFromModeStd(m, σ float64) GammaDist {
+	if m < 0 || σ <= 0 {
+		return GammaDist{NaN, NaN}
+	}
+	α, θ := GammaReparamModeStd(m, σ)
+	return GammaDist{α, θ}
+}
+
+// GammaMatchQtls returns the Gamma distribution whose quantiles for the probabilities p best match x.
+func GammaMatchQtls(p, x []float64) GammaDist {
+	α, θ := GammaReparamMeanStd(qtlMeanStd(p, x))
+	e := matchQtls(func(θ []float64) Continuous { return GammaDist{θ[0], θ[1]} }, p, x, []float64{α, θ}, []mleBound{mlePos, mlePos})
+	return GammaDist{e[0], e[1]}
+}
+
+// GammaFit returns the maximum-likelihood estimates of α, θ of the Gamma distribution from the sample x.
+func GammaFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return GammaLnPDF(θ[0], θ[1]) })
+	α, θ := gammaFit(x)
+	return mleAt(lnL, []float64{α, θ})
+}
+
+// gammaFit returns the maximum-likelihood estimates of α, θ of the Gamma distribution from the sample x,
+// solving log α - ψ(α) = log mean(x) - mean(log x) by Newton's method; then θ = mean(x) / α.
+func gammaFit(x []float64) (α, θ float64) {
+	var m, l float64
+	for _, v := range x {
+		m += v
+		l += log(v)
+	}
+	n := float64(len(x))
+	m /= n
+	s := log(m) - l/n
+	// Minka's approximation, within 1.5% of the root
+	α = (3 - s + sqrt((s-3)*(s-3)+24*s)) / (12 * s)
+	for i := 0; i < 100; i++ {
+		d := (log(α) - digamma(α) - s) / (1/α - trigamma(α))
+		if α-d <= 0 {
+			α /= 2
+		} else {
+			α -= d
+		}
+		if abs(d) < 1e-14*α {
+			break
+		}
+	}
+	return α, m / α
+}
+
+// GammaDist is the Gamma distribution with shape α = Alpha and scale θ = Theta. It implements Continuous.
+type GammaDist struct {
+	Alpha, Theta float64
+}
+
+// PDF returns the value of PDF of the Gamma distribution at x.
+func (d GammaDist) PDF(x float64) float64 { return GammaPDFAt(d.Alpha, d.Theta, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Gamma distribution at x.
+func (d GammaDist) LnPDF(x float64) float64 { return GammaLnPDFAt(d.Alpha, d.Theta, x) }
+
+// CDF returns the value of CDF of the Gamma distribution at x.
+func (d GammaDist) CDF(x float64) float64 { return GammaCDFAt(d.Alpha, d.Theta, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Gamma distribution at x.
+func (d GammaDist) Surv(x float64) float64 { return GammaCDFTail(d.Alpha, d.Theta, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Gamma distribution at x.
+func (d GammaDist) LnCDF(x float64) float64 { return GammaCDFTail(d.Alpha, d.Theta, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Gamma distribution at x.
+func (d GammaDist) LnSurv(x float64) float64 { return GammaCDFTail(d.Alpha, d.Theta, false, true)(x) }
+
+// Qtl returns the quantile of the Gamma distribution for probability p.
+func (d GammaDist) Qtl(p float64) float64 { return GammaQtlFor(d.Alpha, d.Theta, p) }
+
+// QtlTail returns the quantile of the Gamma distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d GammaDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return GammaQtlTail(d.Alpha, d.Theta, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Gamma distribution.
+func (d GammaDist) Rand() float64 { return GammaNext(d.Alpha, d.Theta) }
+
+// RandR returns random number drawn from the Gamma distribution, using the random source src.
+func (d GammaDist) RandR(src *rand.Rand) float64 { return GammaNextR(src, d.Alpha, d.Theta) }
+
+// Mean returns the mean of the Gamma distribution.
+func (d GammaDist) Mean() float64 { return GammaMean(d.Alpha, d.Theta) }
+
+// Var returns the variance of the Gamma distribution.
+func (d GammaDist) Var() float64 { return GammaVar(d.Alpha, d.Theta) }
+
+// Skew returns the skewness of the Gamma distribution.
+func (d GammaDist) Skew() float64 { return GammaSkew(d.Alpha, d.Theta) }
+
+// ExKurt returns the excess kurtosis of the Gamma distribution.
+func (d GammaDist) ExKurt() float64 { return GammaExKurt(d.Alpha, d.Theta) }
+
+// Entropy returns the differential entropy of the Gamma distribution.
+func (d GammaDist) Entropy() float64 { return GammaEntropy(d.Alpha, d.Theta) }
+
+// CF returns the characteristic function of the Gamma distribution at t.
+func (d GammaDist) CF(t float64) complex128 { return GammaCF(d.Alpha, d.Theta, t) }
+
+// CGF returns the cumulant generating function of the Gamma distribution at t.
+func (d GammaDist) CGF(t float64) float64 { return GammaCGF(d.Alpha, d.Theta, t) }
+
+// Support returns the support of the Gamma distribution.
+func (d GammaDist) Support() (a, b float64) { return 0, posInf }
@@ -80,7 +80,8 @@ func log1pmx(x float64) float64 {
 
 // Ln(Abs(Gamma()))
 func lgammafn(a float64) float64 {
-	return log(abs(Γ(a)))
+	lg, _ := lgamma(a) // log(abs(Γ(a))) overflows for a > 171
+	return lg
 }
 
 // Compute  log(gamma(a+1))  accurately also for small a (0 < a < 0.5).
@@ -178,8 +179,8 @@ func logspace_sub(logx, logy float64) float64 {
 }
 
 // Abramowitz and Stegun 6.5.29 [right]
-func pgamma_smallx(x, shape float64) float64 {
-	var term, f2 float64
+func pgamma_smallx(x, shape float64, lower_tail, log_p bool) float64 {
+	var term float64
 	sum := 0.0
 	c := shape
 	n := 0.0
@@ -194,15 +195,37 @@ func pgamma_smallx(x, shape float64) float64 {
 		sum += term
 	}
 
-	f1 := 1 + sum
-
-	if shape > 1 {
-		f2 = dpois_raw(shape, x)
-		f2 = f2 * exp(x)
-	} else {
-		f2 = pow(x, shape) / exp(lgamma1p(shape))
+	if lower_tail {
+		var f1, f2 float64
+		if log_p {
+			f1 = log1p(sum)
+		} else {
+			f1 = 1 + sum
+		}
+		if shape > 1 {
+			if log_p {
+				f2 = dpois_raw_ln(shape, x) + x
+			} else {
+				f2 = dpois_raw(shape, x) * exp(x)
+			}
+		} else {
+			f2 = shape*log(x) - lgamma1p(shape)
+			if !log_p {
+				f2 = exp(f2)
+			}
+		}
+		if log_p {
+			return f1 + f2
+		}
+		return f1 * f2
 	}
-	return f1 * f2
+	lf2 := shape*log(x) - lgamma1p(shape)
+	if log_p {
+		return log1Exp(log1p(sum) + lf2)
+	}
+	f1m1 := sum
+	f2m1 := expm1(lf2)
+	return -(f1m1 + f2m1 + f1m1*f2m1)
 }
 
 func pd_upper_series(x, y float64) float64 {
@@ -337,7 +360,7 @@ func pd_lower_series(lambda, y float64) float64 {
 //	   pnorm (x, 0, 1, lower_tail, FALSE)
 //
 // Abramowitz & Stegun 26.2.12
-func dpnorm(x, lp float64) float64 {
+func dpnorm(x float64, lower_tail bool, lp float64) float64 {
 	// So as not to repeat a pnorm call, we expect
 	//
 	//	 lp == pnorm (x, 0, 1, lower_tail, TRUE)
@@ -345,38 +368,35 @@ func dpnorm(x, lp float64) float64 {
 	// but use it only in the non-critical case where either x is small
 	// or p==exp(lp) is close to 1.
 
-	lower_tail := true
 	if x < 0 {
 		x = -x
 		lower_tail = !lower_tail
 	}
 
-	if x > 10 && (!lower_tail) {
+	if x > 10 && !lower_tail {
 		term := 1 / x
 		sum := term
 		x2 := x * x
-		i := 1
-		term = 1e32 // just to enter the while loop
-		for abs(term) > eps64*sum {
-			term *= float64(-i) / x2
+		i := 1.0
+		for {
+			term *= -i / x2
 			sum += term
 			i += 2
+			if abs(term) <= eps64*sum {
+				break
+			}
 		}
-
 		return 1 / sum
-	} else {
-		//	 d := dnorm (x, 0, 1, FALSE)
-		d := ZPDFAt(x)
-		return d / exp(lp)
 	}
-	return NaN // should not happen
+	d := ZPDFAt(x)
+	return d / exp(lp)
 }
 
 // Asymptotic expansion to calculate the probability that Poisson variate
 // has value <= x.
 // Various assertions about this are made (without proof) at
 // http://members.aol.com/iandjmsmith/PoissonApprox.htm
-func ppois_asymp(lambda, x float64, log_p bool) float64 {
+func ppois_asymp(x, lambda float64, lower_tail, log_p bool) float64 {
 	var coefs_a = [8]float64{
 		-1e9, // placeholder used for 1-indexing
 		2 / 3.0,
@@ -405,7 +425,6 @@ func ppois_asymp(lambda, x float64, log_p bool) float64 {
 		dfm, pt_, s2pt, f, np                         float64
 	)
 
-	lower_tail := true
 	dfm = lambda - x
 
 	// If lambda is large, the distribution is highly concentrated
@@ -439,23 +458,19 @@ func ppois_asymp(lambda, x float64, log_p bool) float64 {
 		elfb_term /= x
 	}
 
-	if !lower_tail { // should never happen
+	if !lower_tail {
 		elfb = -elfb
 	}
 
 	f = res12 / elfb
-	np = ZCDFAt(s2pt)
+	np = pnorm(s2pt, !lower_tail, log_p)
 
 	if log_p {
-		//	n_d_over_p := dpnorm(s2pt, !lower_tail, np)
-		n_d_over_p := dpnorm(s2pt, np)
+		n_d_over_p := dpnorm(s2pt, !lower_tail, np)
 		return np + log1p(f*n_d_over_p)
-	} else {
-		nd := ZPDFAt(s2pt)
-
-		return np + f*nd
 	}
-	return NaN // should not happen
+	nd := ZPDFAt(s2pt)
+	return np + f*nd
 }
 
 func dpois_wrap(x_plus_1, lambda float64) float64 {
@@ -464,14 +479,13 @@ func dpois_wrap(x_plus_1, lambda float64) float64 {
 	}
 
 	if x_plus_1 > 1 {
-		//		return dpois_raw(x_plus_1-1, lambda, give_log)
-		return PoissonPMFAt(lambda, int64(x_plus_1-1))
+		return dpois_raw(x_plus_1-1, lambda)
 	}
 
 	if lambda > abs(x_plus_1-1)*M_cutoff {
 		return exp(-lambda - lgammafn(x_plus_1))
 	}
-	d := PoissonPMFAt(lambda, int64(x_plus_1))
+	d := dpois_raw(x_plus_1, lambda)
 	return d * (x_plus_1 / lambda)
 }
 
@@ -506,51 +520,88 @@ func dpois_raw(x, lambda float64) float64 {
 	return exp(-stirlerr(x)-bd0(x, lambda)) / sqrt((π+π)*x)
 }
 
-func pgamma_raw(x, shape float64) float64 {
+func pgamma_raw(x, shape float64, lower_tail, log_p bool) float64 {
 	// Here, assume that  (x,shape) are not NA  &  shape > 0 . 
 
-	var res, sum float64
+	var res, sum, d float64
 
 	if x <= 0 {
-		return 0
+		return pTailBounds(false, lower_tail, log_p)
 	}
 	if x >= posInf {
-		return 1
+		return pTailBounds(true, lower_tail, log_p)
 	}
 
 	if x < 1 {
-		res = pgamma_smallx(x, shape)
+		res = pgamma_smallx(x, shape, lower_tail, log_p)
 	} else if x <= shape-1 && x < 0.8*(shape+50) {
 		// incl. large shape compared to x
-		sum = pd_upper_series(x, shape) /* = x/shape + o(x/shape) */
-		d := dpois_wrap(shape, x)
-		res = sum * d
+		sum = pd_upper_series(x, shape) // = x/shape + o(x/shape)
+		if log_p {
+			sum = log(sum)
+			d = dpois_wrap_ln(shape, x)
+		} else {
+			d = dpois_wrap(shape, x)
+		}
+		switch {
+		case !lower_tail && log_p:
+			res = log1Exp(d + sum)
+		case !lower_tail:
+			res = 1 - d*sum
+		case log_p:
+			res = sum + d
+		default:
+			res = sum * d
+		}
 	} else if shape-1 < x && shape < 0.8*(x+50) {
 		// incl. large x compared to shape
-		d := dpois_wrap(shape, x)
+		if log_p {
+			d = dpois_wrap_ln(shape, x)
+		} else {
+			d = dpois_wrap(shape, x)
+		}
 		if shape < 1 {
 			if x*eps64 > 1-shape {
 				//				sum = R_D__1
 				sum = 1
+				if log_p {
+					sum = 0
+				}
 			} else {
 				f := pd_lower_cf(shape, x-(shape-1)) * x / shape
 				// = [shape/(x - shape+1) + o(shape/(x-shape+1))] * x/shape = 1 + o(1)
 				sum = f
+				if log_p {
+					sum = log(f)
+				}
 			}
 		} else {
 			sum = pd_lower_series(x, shape-1) // = (shape-1)/x + o((shape-1)/x)
-			sum = 1 + sum
+			if log_p {
+				sum = log1p(sum)
+			} else {
+				sum = 1 + sum
+			}
+		}
+		switch {
+		case !lower_tail && log_p:
+			res = sum + d
+		case !lower_tail:
+			res = sum * d
+		case log_p:
+			res = log1Exp(d + sum)
+		default:
+			res = 1 - d*sum
 		}
-		res = 1 - d*sum
 	} else { // x >= 1 and x fairly near shape.
-		res = ppois_asymp(shape-1, x, false)
+		res = ppois_asymp(shape-1, x, !lower_tail, log_p)
 	}
 
 	// We lose a fair amount of accuracy to underflow in the cases
 	// where the final result is very close to min64.	
 	//  In those cases, simply redo via logarithm.
-	if res < min64/eps64 {
-		return exp(pgamma_raw_ln(shape, x))
+	if !log_p && res < min64/eps64 {
+		return exp(pgamma_raw(x, shape, lower_tail, true))
 	}
 	return res
 }
@@ -584,7 +635,7 @@ func qchisq_appr(p, nu, g float64, lower_tail, log_p bool, tol float64) float64
 
 	alpha = 0.5 * nu // = [pq]gamma() shape
 	c = alpha - 1
-	p1 = log(p)
+	p1 = pLnLower(p, lower_tail, log_p)
 
 	if nu < (-1.24)*(p1) { // for small chi-squared
 
@@ -603,20 +654,20 @@ func qchisq_appr(p, nu, g float64, lower_tail, log_p bool, tol float64) float64
 
 		//  using Wilson and Hilferty estimate
 		//	x = qnorm(p, 0, 1, lower_tail, log_p)
-		x = ZQtlFor(p)
+		x = qnorm(p, lower_tail, log_p)
 		p1 = 2. / (9 * nu)
 		ch = nu * pow(x*sqrt(p1)+1-p1, 3)
 
 		// approximation for p tending to 1
 		if ch > 2.2*nu+6 {
 			//	    ch = -2*(R_DT_Clog(p) - c*log(0.5*ch) + g)
-			ch = -2 * (log1p(-p) - c*log(0.5*ch) + g)
+			ch = -2 * (pLnUpper(p, lower_tail, log_p) - c*log(0.5*ch) + g)
 		}
 	} else { // "small nu" : 1.24*(-log(p)) <= nu <= 0.32
 
 		ch = 0.4
 		//	a = R_DT_Clog(p) + g + c*M_LN2
-		a = log1p(-p) + g + c*Ln2
+		a = pLnUpper(p, lower_tail, log_p) + g + c*Ln2
 
 		q = 1 // to enter the while loop
 		for abs(q-ch) > tol*abs(ch) {
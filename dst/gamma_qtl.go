@@ -13,6 +13,11 @@ package dst
 
 // GammaQtl returns the inverse of the CDF (quantile) of the Gamma distribution. 
 func GammaQtl(alpha, scale float64) func(p float64) float64 {
+	return GammaQtlTail(alpha, scale, true, false)
+}
+
+// GammaQtlTail returns the inverse of GammaCDFTail (quantile) of the Gamma distribution.
+func GammaQtlTail(alpha, scale float64, lowerTail, logP bool) func(p float64) float64 {
 	/*	This function is based on the Applied Statistics
 	 *	Algorithm AS 91 ("ppchi2") and via pgamma(.) AS 239.
 	 *
@@ -30,8 +35,8 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 
 	return func(p float64) float64 {
 
-		lower_tail := true // to be removed
-		log_p := false
+		lower_tail := lowerTail
+		log_p := logP
 
 		const (
 			EPS1   = 1e-2
@@ -59,14 +64,13 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 			return p + alpha + scale
 		}
 		//    R_Q_P01_boundaries(p, 0., ML_POSINF)
-		if p < 0 || p > 1 {
+		if !pValid(p, log_p) {
 			return NaN
 		}
-		if p == 0 {
-
+		if pEdge(p, false, lower_tail, log_p) {
 			return 0
 		}
-		if p == 1 {
+		if pEdge(p, true, lower_tail, log_p) {
 			return posInf
 		}
 
@@ -83,8 +87,7 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 			max_it_Newton = 7 // may still be increased below
 		}
 
-		//    p_ = R_DT_qIv(p)// lower_tail prob (in any case)
-		p_ = p
+		p_ = pLower(p, lower_tail, log_p) // lower_tail prob (in any case)
 
 		g = lgammafn(alpha) // log Gamma(v/2) 
 
@@ -119,7 +122,7 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 			q = ch
 			p1 = 0.5 * ch
 			//	p2 = p_ - pgamma_raw(p1, alpha, /*lower_tail*/TRUE, /*log_p*/FALSE)
-			p2 = p_ - pgamma_raw(p1, alpha)
+			p2 = p_ - pgamma_raw(p1, alpha, true, false)
 
 			if isInf(p2, 0) || ch <= 0 {
 				ch = ch0
@@ -163,24 +166,21 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 
 		if max_it_Newton != 0 {
 			/* always use log scale */
-			//	if (!log_p) {
-			p = log(p)
-			log_p = true
-			//	}
+			if !log_p {
+				p = log(p)
+				log_p = true
+			}
+			cdf := GammaCDFTail(alpha, scale, lower_tail, log_p)
 			if x == 0 {
 				_1_p := 1. + 1e-7
-				//				_1_m := 1. - 1e-7
+				_1_m := 1. - 1e-7
 				x = min64
-				//	    p_ = pgamma(x, alpha, scale, lower_tail, log_p)
-				p_ = GammaLnCDFAt(alpha, scale, x)
-
-				//	    if(( lower_tail && p_ > p * _1_p) || (!lower_tail && p_ < p * _1_m))
-				if lower_tail && p_ > p*_1_p {
+				p_ = cdf(x)
+				if (lower_tail && p_ > p*_1_p) || (!lower_tail && p_ < p*_1_m) {
 					return 0
 				}
 			} else { // continue, using x = min64 instead of  0
-				//	    p_ = pgamma(x, alpha, scale, lower_tail, log_p)
-				p_ = GammaLnCDFAt(alpha, scale, x)
+				p_ = cdf(x)
 			}
 			if p_ == negInf {
 				return 0 /* PR#14710 */
@@ -204,30 +204,18 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 				 */
 				//	    t = log_p ? p1*exp(p_ - g) : p1/g ;
 
-				if log_p { // ALWAYS, 
-					t = p1 * exp(p_-g) // = "delta x"
-				} else { // TO BE REMOVED
-					t = p1 / g
+				t = p1 * exp(p_-g) // = "delta x", log_p always
+				if lower_tail {
+					t = x - t
+				} else {
+					t = x + t
 				}
 
-				//	    t = lower_tail ? x - t : x + t
-				t = x - t
-
-				//	    p_ = pgamma (t, alpha, scale, lower_tail, log_p)
-				p_ = GammaLnCDFAt(alpha, scale, x)
+				p_ = cdf(t)
 				if abs(p_-p) > abs(p1) || (i > 1 && abs(p_-p) == abs(p1)) { // <- against flip-flop
 					// no improvement
 					break
 				} // else : 
-				//ifdef Harmful_notably_if_max_it_Newton_is_1
-				// control step length: this could have started at the initial approximation 
-
-				if t > 1.1*x {
-					t = 1.1 * x
-				} else if t < 0.9*x {
-					t = 0.9 * x
-				}
-				//endif
 				x = t
 			}
 		}
@@ -0,0 +1,317 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Generalized Pareto distribution (GPD) of peaks over a threshold.
+// The limiting distribution of the excesses over a high threshold μ; it includes the Exponential (ξ = 0), Pareto (ξ > 0) and Uniform (ξ = -1) distributions.
+// Not to be confused with ParetoG, the generalized Pareto distribution of the Beta-prime type.
+//
+// Parameters:
+// μ ∈ R		location (threshold)
+// σ > 0		scale
+// ξ ∈ R		shape
+//
+// Support:
+// x ∈ [μ; ∞) if ξ >= 0
+// x ∈ [μ; μ - σ/ξ] if ξ < 0
+
+import (
+	"math/rand"
+)
+
+// genParetoLnS returns the logarithm of the survival function of the GPD at x; ok is false outside of the support.
+func genParetoLnS(μ, σ, ξ, x float64) (lnS float64, ok bool) {
+	z := (x - μ) / σ
+	switch {
+	case z < 0:
+		return 0, false
+	case ξ == 0:
+		return -z, true
+	case 1+ξ*z <= 0:
+		return negInf, false
+	}
+	return -log1p(ξ*z) / ξ, true
+}
+
+// genParetoX returns x with the given logarithm of the survival function.
+func genParetoX(μ, σ, ξ, lnS float64) float64 {
+	if ξ == 0 {
+		return μ - σ*lnS
+	}
+	return μ + σ*expm1(-ξ*lnS)/ξ
+}
+
+// GenParetoPDF returns the PDF of the Generalized Pareto distribution.
+func GenParetoPDF(μ, σ, ξ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		lnS, ok := genParetoLnS(μ, σ, ξ, x)
+		if !ok {
+			return 0
+		}
+		return exp((1+ξ)*lnS) / σ
+	}
+}
+
+// GenParetoLnPDF returns the natural logarithm of the PDF of the Generalized Pareto distribution.
+func GenParetoLnPDF(μ, σ, ξ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		lnS, ok := genParetoLnS(μ, σ, ξ, x)
+		if !ok {
+			return negInf
+		}
+		return -log(σ) + (1+ξ)*lnS
+	}
+}
+
+// GenParetoPDFAt returns the value of PDF of Generalized Pareto distribution at x.
+func GenParetoPDFAt(μ, σ, ξ, x float64) float64 {
+	pdf := GenParetoPDF(μ, σ, ξ)
+	return pdf(x)
+}
+
+// GenParetoCDF returns the CDF of the Generalized Pareto distribution.
+func GenParetoCDF(μ, σ, ξ float64) func(x float64) float64 {
+	return GenParetoCDFTail(μ, σ, ξ, true, false)
+}
+
+// GenParetoCDFAt returns the value of CDF of the Generalized Pareto distribution, at x.
+func GenParetoCDFAt(μ, σ, ξ, x float64) float64 {
+	cdf := GenParetoCDF(μ, σ, ξ)
+	return cdf(x)
+}
+
+// GenParetoCDFTail returns the CDF of the Generalized Pareto distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func GenParetoCDFTail(μ, σ, ξ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		lnS, ok := genParetoLnS(μ, σ, ξ, x)
+		if !ok {
+			return pTailBounds(x > μ, lowerTail, logP)
+		}
+		return pTailLnUpper(lnS, lowerTail, logP)
+	}
+}
+
+// GenParetoQtl returns the inverse of the CDF (quantile) of the Generalized Pareto distribution.
+func GenParetoQtl(μ, σ, ξ float64) func(p float64) float64 {
+	return GenParetoQtlTail(μ, σ, ξ, true, false)
+}
+
+// GenParetoQtlFor returns the inverse of the CDF (quantile) of the Generalized Pareto distribution, for given probability.
+func GenParetoQtlFor(μ, σ, ξ, p float64) float64 {
+	qtl := GenParetoQtl(μ, σ, ξ)
+	return qtl(p)
+}
+
+// GenParetoQtlTail returns the inverse of GenParetoCDFTail (quantile) of the Generalized Pareto distribution.
+func GenParetoQtlTail(μ, σ, ξ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if σ <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return genParetoX(μ, σ, ξ, pLnUpper(p, lowerTail, logP))
+	}
+}
+
+// GenParetoNext returns random number drawn from the Generalized Pareto distribution.
+func GenParetoNext(μ, σ, ξ float64) float64 { return GenParetoNextR(GlobalRand, μ, σ, ξ) }
+
+// GenParetoNextR returns random number drawn from the Generalized Pareto distribution, using the random source src.
+func GenParetoNextR(src *rand.Rand, μ, σ, ξ float64) float64 {
+	return genParetoX(μ, σ, ξ, -expZig(src))
+}
+
+// GenPareto returns the random number generator with  Generalized Pareto distribution.
+func GenPareto(μ, σ, ξ float64) func() float64 { return GenParetoR(GlobalRand, μ, σ, ξ) }
+
+// GenParetoR returns the random number generator with  Generalized Pareto distribution, using the random source src.
+func GenParetoR(src *rand.Rand, μ, σ, ξ float64) func() float64 {
+	return func() float64 { return GenParetoNextR(src, μ, σ, ξ) }
+}
+
+// GenParetoFill fills x with random numbers drawn from the Generalized Pareto distribution.
+func GenParetoFill(μ, σ, ξ float64, x []float64) {
+	GenParetoFillR(GlobalRand, μ, σ, ξ, x)
+}
+
+// GenParetoFillR fills x with random numbers drawn from the Generalized Pareto distribution, using the random source src.
+func GenParetoFillR(src *rand.Rand, μ, σ, ξ float64, x []float64) {
+	for i := range x {
+		x[i] = GenParetoNextR(src, μ, σ, ξ)
+	}
+}
+
+// GenParetoMean returns the mean of the Generalized Pareto distribution.
+func GenParetoMean(μ, σ, ξ float64) float64 {
+	if ξ >= 1 {
+		return posInf
+	}
+	return μ + σ/(1-ξ)
+}
+
+// GenParetoMedian returns the median of the Generalized Pareto distribution.
+func GenParetoMedian(μ, σ, ξ float64) float64 {
+	return genParetoX(μ, σ, ξ, -Ln2)
+}
+
+// GenParetoMode returns the mode of the Generalized Pareto distribution.
+func GenParetoMode(μ, σ, ξ float64) float64 {
+	if ξ < -1 {
+		return μ - σ/ξ
+	}
+	return μ
+}
+
+// GenParetoVar returns the variance of the Generalized Pareto distribution.
+func GenParetoVar(μ, σ, ξ float64) float64 {
+	if ξ >= 0.5 {
+		return posInf
+	}
+	return σ * σ / ((1 - ξ) * (1 - ξ) * (1 - 2*ξ))
+}
+
+// GenParetoStd returns the standard deviation of the Generalized Pareto distribution.
+func GenParetoStd(μ, σ, ξ float64) float64 {
+	return sqrt(GenParetoVar(μ, σ, ξ))
+}
+
+// GenParetoSkew returns the skewness of the Generalized Pareto distribution.
+func GenParetoSkew(μ, σ, ξ float64) float64 {
+	if ξ >= 1.0/3 {
+		return posInf
+	}
+	return 2 * (1 + ξ) * sqrt(1-2*ξ) / (1 - 3*ξ)
+}
+
+// GenParetoExKurt returns the excess kurtosis of the Generalized Pareto distribution.
+func GenParetoExKurt(μ, σ, ξ float64) float64 {
+	if ξ >= 0.25 {
+		return posInf
+	}
+	return 3*(1-2*ξ)*(2*ξ*ξ+ξ+3)/((1-3*ξ)*(1-4*ξ)) - 3
+}
+
+// GenParetoEntropy returns the differential entropy of the Generalized Pareto distribution.
+func GenParetoEntropy(μ, σ, ξ float64) float64 {
+	return log(σ) + ξ + 1
+}
+
+// GenParetoCF returns the characteristic function of the Generalized Pareto distribution, by numerical integration for ξ ≠ 0.
+func GenParetoCF(μ, σ, ξ, t float64) complex128 {
+	if ξ == 0 {
+		return cexp(complex(0, μ*t)) * ExponentialCF(1/σ, t)
+	}
+	a, b := GenParetoDist{μ, σ, ξ}.Support()
+	return cfLnPDF(GenParetoLnPDF(μ, σ, ξ), GenParetoQtl(μ, σ, ξ), a, b, t)
+}
+
+// GenParetoCGF returns the cumulant generating function of the Generalized Pareto distribution, by numerical integration for ξ ≠ 0; +Inf for t > 0 if ξ > 0.
+func GenParetoCGF(μ, σ, ξ, t float64) float64 {
+	switch {
+	case ξ == 0:
+		return μ*t + ExponentialCGF(1/σ, t)
+	case t > 0 && ξ > 0:
+		return posInf
+	}
+	a, b := GenParetoDist{μ, σ, ξ}.Support()
+	return cgfLnPDF(GenParetoLnPDF(μ, σ, ξ), GenParetoQtl(μ, σ, ξ), a, b, t)
+}
+
+// GenParetoMGF has no closed form.
+
+// GenParetoMatchQtls returns the generalized Pareto distribution whose quantiles for the probabilities p best match x.
+func GenParetoMatchQtls(p, x []float64) GenParetoDist {
+	// ξ = 0 is the Exponential distribution, x = μ - σ log(1-p)
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return -log1p(-p) }), x)
+	e := matchQtls(func(θ []float64) Continuous { return GenParetoDist{θ[0], θ[1], θ[2]} }, p, x, []float64{a, b, 0}, []mleBound{mleReal, mlePos, mleReal})
+	return GenParetoDist{e[0], e[1], e[2]}
+}
+
+// GenParetoFit returns the maximum-likelihood estimates of μ, σ, ξ of the Generalized Pareto distribution from the sample x, starting from the Exponential distribution:
+// μ is its minimum, on the bound of the likelihood, so that its standard error is NaN.
+func GenParetoFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return GenParetoLnPDF(θ[0], θ[1], θ[2]) })
+	μ, _ := mleMinMax(x)
+	m, _ := mleMeanVar(x)
+	return mleFitFixed(lnL, []float64{μ, m - μ, 0}, []mleBound{mleReal, mlePos, mleReal}, []bool{true, false, false})
+}
+
+// GenParetoDist is the generalized Pareto distribution of peaks over the threshold μ = Mu, with scale σ = Sigma and shape ξ = Xi. It implements Continuous.
+type GenParetoDist struct {
+	Mu, Sigma, Xi float64
+}
+
+// PDF returns the value of PDF of the Generalized Pareto distribution at x.
+func (d GenParetoDist) PDF(x float64) float64 { return GenParetoPDFAt(d.Mu, d.Sigma, d.Xi, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Generalized Pareto distribution at x.
+func (d GenParetoDist) LnPDF(x float64) float64 { return GenParetoLnPDF(d.Mu, d.Sigma, d.Xi)(x) }
+
+// CDF returns the value of CDF of the Generalized Pareto distribution at x.
+func (d GenParetoDist) CDF(x float64) float64 { return GenParetoCDFAt(d.Mu, d.Sigma, d.Xi, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Generalized Pareto distribution at x.
+func (d GenParetoDist) Surv(x float64) float64 {
+	return GenParetoCDFTail(d.Mu, d.Sigma, d.Xi, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Generalized Pareto distribution at x.
+func (d GenParetoDist) LnCDF(x float64) float64 {
+	return GenParetoCDFTail(d.Mu, d.Sigma, d.Xi, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Generalized Pareto distribution at x.
+func (d GenParetoDist) LnSurv(x float64) float64 {
+	return GenParetoCDFTail(d.Mu, d.Sigma, d.Xi, false, true)(x)
+}
+
+// Qtl returns the quantile of the Generalized Pareto distribution for probability p.
+func (d GenParetoDist) Qtl(p float64) float64 { return GenParetoQtlFor(d.Mu, d.Sigma, d.Xi, p) }
+
+// QtlTail returns the quantile of the Generalized Pareto distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d GenParetoDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return GenParetoQtlTail(d.Mu, d.Sigma, d.Xi, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Generalized Pareto distribution.
+func (d GenParetoDist) Rand() float64 { return GenParetoNext(d.Mu, d.Sigma, d.Xi) }
+
+// RandR returns random number drawn from the Generalized Pareto distribution, using the random source src.
+func (d GenParetoDist) RandR(src *rand.Rand) float64 { return GenParetoNextR(src, d.Mu, d.Sigma, d.Xi) }
+
+// Mean returns the mean of the Generalized Pareto distribution.
+func (d GenParetoDist) Mean() float64 { return GenParetoMean(d.Mu, d.Sigma, d.Xi) }
+
+// Var returns the variance of the Generalized Pareto distribution.
+func (d GenParetoDist) Var() float64 { return GenParetoVar(d.Mu, d.Sigma, d.Xi) }
+
+// Skew returns the skewness of the Generalized Pareto distribution.
+func (d GenParetoDist) Skew() float64 { return GenParetoSkew(d.Mu, d.Sigma, d.Xi) }
+
+// ExKurt returns the excess kurtosis of the Generalized Pareto distribution.
+func (d GenParetoDist) ExKurt() float64 { return GenParetoExKurt(d.Mu, d.Sigma, d.Xi) }
+
+// Entropy returns the differential entropy of the Generalized Pareto distribution.
+func (d GenParetoDist) Entropy() float64 { return GenParetoEntropy(d.Mu, d.Sigma, d.Xi) }
+
+// CF returns the characteristic function of the Generalized Pareto distribution at t.
+func (d GenParetoDist) CF(t float64) complex128 { return GenParetoCF(d.Mu, d.Sigma, d.Xi, t) }
+
+// CGF returns the cumulant generating function of the Generalized Pareto distribution at t.
+func (d GenParetoDist) CGF(t float64) float64 { return GenParetoCGF(d.Mu, d.Sigma, d.Xi, t) }
+
+// Support returns the support of the Generalized Pareto distribution.
+func (d GenParetoDist) Support() (a, b float64) {
+	if d.Xi < 0 {
+		return d.Mu, d.Mu - d.Sigma/d.Xi
+	}
+	return d.Mu, posInf
+}
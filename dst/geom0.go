@@ -9,6 +9,10 @@ package dst
 // Support: 
 // k ∈ {0, ... , n}
 
+import (
+	"math/rand"
+)
+
 // GeometricPMF returns the PMF of the Geometric distribution. 
 func GeometricPMF(ρ float64) func(k int64) float64 {
 	return func(k int64) float64 { return ρ * pow(1-ρ, float64(k)) }
@@ -16,7 +20,7 @@ func GeometricPMF(ρ float64) func(k int64) float64 {
 
 // GeometricLnPMF returns the natural logarithm of the PMF of the Geometric distribution. 
 func GeometricLnPMF(ρ float64) func(k int64) float64 {
-	return func(k int64) float64 { return log(1-ρ) + float64(k)*log(ρ) }
+	return func(k int64) float64 { return log(ρ) + float64(k)*log(1-ρ) }
 }
 
 // GeometricPMFAt returns the value of PMF of Geometric distribution at k. 
@@ -41,19 +45,112 @@ func GeometricCDFAt(ρ float64, k int64) float64 {
 	return cdf(k)
 }
 
-/* Not tested, looking strange, commented out, waiting for revision
+// GeometricCDFTail returns the CDF of the Geometric distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func GeometricCDFTail(ρ float64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnUpper(float64(k+1)*log1p(-ρ), lowerTail, logP)
+	}
+}
+
+// GeometricQtl returns the inverse of the CDF (quantile) of the Geometric distribution. 
+// It panics with the error that GeometricQtlErr returns, if any.
+func GeometricQtl(ρ float64) func(p float64) int64 {
+	qtl := GeometricQtlErr(ρ)
+	return func(p float64) int64 {
+		k, err := qtl(p)
+		if err != nil {
+			panic(err)
+		}
+		return k
+	}
+}
+
+// GeometricQtlErr returns the inverse of the CDF (quantile) of the Geometric distribution, with the error of the inversion, if any.
+func GeometricQtlErr(ρ float64) func(p float64) (int64, error) {
+	cdf := GeometricCDFTail(ρ, true, false)
+	return func(p float64) (int64, error) {
+		switch {
+		case !(ρ > 0 && ρ <= 1):
+			return 0, ErrQtlParam
+		case !(p >= 0 && p <= 1):
+			return 0, ErrQtlProb
+		case p == 1:
+			return posInfInt64, nil
+		case p == 0 || ρ == 1:
+			return 0, nil
+		}
+		// the closed form, off by one where rounding crosses an integer, corrected on the CDF
+		x := ceil(log1p(-p)/log1p(-ρ)) - 1
+		k0 := int64(0)
+		switch {
+		case x >= float64(posInfInt64):
+			k0 = posInfInt64
+		case x > 0:
+			k0 = int64(x)
+		}
+		return invertCDFDiscrete(cdf, p, 0, posInfInt64, k0)
+	}
+}
+
+// GeometricQtlFor returns the inverse of the CDF (quantile) of the Geometric distribution, for given probability.
+func GeometricQtlFor(ρ, p float64) int64 {
+	qtl := GeometricQtl(ρ)
+	return qtl(p)
+}
+
+// GeometricQtlTail returns the inverse of GeometricCDFTail (quantile) of the Geometric distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func GeometricQtlTail(ρ float64, lowerTail, logP bool) func(p float64) int64 {
+	return func(p float64) int64 {
+		switch {
+		case !(ρ > 0 && ρ <= 1):
+			panic(ErrQtlParam)
+		case isNaN(p) || !pValid(p, logP):
+			panic(ErrQtlProb)
+		case pEdge(p, true, lowerTail, logP):
+			return posInfInt64
+		case pEdge(p, false, lowerTail, logP) || ρ == 1:
+			return 0
+		}
+		return imax(0, int64(ceil(pLnUpper(p, lowerTail, logP)/log1p(-ρ)-1-1e-12)))
+	}
+}
+
 // GeometricNext returns random number drawn from the Geometric distribution. 
-//GeometricNext(ρ) => # of GeometricNext(ρ) failures before one success
+// Devroye 1986: 499.
 func GeometricNext(ρ float64) int64 {
-	if GeometricNext(ρ) == 1 {
-		return 1 + GeometricNext(ρ)
+	return GeometricNextR(GlobalRand, ρ)
+}
+
+// GeometricNextR returns random number drawn from the Geometric distribution, using the random source src.
+func GeometricNextR(src *rand.Rand, ρ float64) int64 {
+	if ρ == 1 {
+		return 0
 	}
-	return 0
+	return int64(floor(log(1-src.Float64()) / log1p(-ρ)))
 }
 
 // Geometric returns the random number generator with  Geometric distribution. 
-func Geometric(ρ float64) func() int64 { return func() int64 { return GeometricNext(ρ) } }
-*/
+func Geometric(ρ float64) func() int64 { return GeometricR(GlobalRand, ρ) }
+
+// GeometricR returns the random number generator with  Geometric distribution, using the random source src.
+func GeometricR(src *rand.Rand, ρ float64) func() int64 {
+	return func() int64 { return GeometricNextR(src, ρ) }
+}
+
+// GeometricFill fills x with random numbers drawn from the Geometric distribution.
+func GeometricFill(ρ float64, x []int64) {
+	GeometricFillR(GlobalRand, ρ, x)
+}
+
+// GeometricFillR fills x with random numbers drawn from the Geometric distribution, using the random source src.
+func GeometricFillR(src *rand.Rand, ρ float64, x []int64) {
+	for i := range x {
+		x[i] = GeometricNextR(src, ρ)
+	}
+}
 
 // GeometricMean returns the mean of the Geometric distribution. 
 func GeometricMean(ρ float64) float64 {
@@ -92,7 +189,111 @@ func GeometricExKurt(ρ float64) float64 {
 	return 6 + (ρ*ρ)/(1-ρ)
 }
 
+// GeometricEntropy returns the Shannon entropy of the Geometric distribution.
+func GeometricEntropy(ρ float64) float64 {
+	if ρ == 1 {
+		return 0
+	}
+	return -(ρ*log(ρ) + (1-ρ)*log1p(-ρ)) / ρ
+}
+
+// GeometricCF returns the characteristic function of the Geometric distribution.
+func GeometricCF(ρ, t float64) complex128 {
+	return complex(ρ, 0) / (1 - complex(1-ρ, 0)*cexp(complex(0, t)))
+}
+
+// GeometricCGF returns the cumulant generating function of the Geometric distribution; +Inf for t ≥ -ln(1-ρ).
+func GeometricCGF(ρ, t float64) float64 {
+	if t >= -log1p(-ρ) {
+		return posInf
+	}
+	return log(ρ) - log1p(-(1-ρ)*exp(t))
+}
+
 // GeometricMGF returns the moment-generating function of the Geometric distribution. 
 func GeometricMGF(ρ, t float64) float64 {
 	return ρ / (1 - (1-ρ)*exp(t))
 }
+
+// GeometricFit returns the maximum-likelihood estimate of ρ of the Geometric distribution (type 0) from the sample k: 1 / (1 + its mean).
+func GeometricFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return GeometricLnPMF(θ[0]) })
+	μ, _ := mleMeanVarInt(k)
+	return mleAt(lnL, []float64{1 / (1 + μ)})
+}
+
+// GeometricDist is the Geometric distribution (number of failures before the first success) with probability of success ρ = Rho. It implements Discrete.
+type GeometricDist struct {
+	Rho float64
+}
+
+// PMF returns the value of PMF of the Geometric distribution at k.
+func (d GeometricDist) PMF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return GeometricPMFAt(d.Rho, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Geometric distribution at k.
+func (d GeometricDist) LnPMF(k int64) float64 {
+	if k < 0 {
+		return negInf
+	}
+	return GeometricLnPMF(d.Rho)(k)
+}
+
+// CDF returns the value of CDF of the Geometric distribution at k.
+func (d GeometricDist) CDF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return GeometricCDFAt(d.Rho, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Geometric distribution at k.
+func (d GeometricDist) Surv(k int64) float64 { return GeometricCDFTail(d.Rho, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Geometric distribution at k.
+func (d GeometricDist) LnCDF(k int64) float64 { return GeometricCDFTail(d.Rho, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Geometric distribution at k.
+func (d GeometricDist) LnSurv(k int64) float64 { return GeometricCDFTail(d.Rho, false, true)(k) }
+
+// Qtl returns the quantile of the Geometric distribution for probability p.
+func (d GeometricDist) Qtl(p float64) int64 { return GeometricQtlFor(d.Rho, p) }
+
+// QtlTail returns the quantile of the Geometric distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d GeometricDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return GeometricQtlTail(d.Rho, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Geometric distribution.
+func (d GeometricDist) Rand() int64 { return GeometricNext(d.Rho) }
+
+// RandR returns random number drawn from the Geometric distribution, using the random source src.
+func (d GeometricDist) RandR(src *rand.Rand) int64 { return GeometricNextR(src, d.Rho) }
+
+// Mean returns the mean of the Geometric distribution.
+func (d GeometricDist) Mean() float64 { return GeometricMean(d.Rho) }
+
+// Var returns the variance of the Geometric distribution.
+func (d GeometricDist) Var() float64 { return GeometricVar(d.Rho) }
+
+// Skew returns the skewness of the Geometric distribution.
+func (d GeometricDist) Skew() float64 { return GeometricSkew(d.Rho) }
+
+// ExKurt returns the excess kurtosis of the Geometric distribution.
+func (d GeometricDist) ExKurt() float64 { return GeometricExKurt(d.Rho) }
+
+// Entropy returns the Shannon entropy of the Geometric distribution.
+func (d GeometricDist) Entropy() float64 { return GeometricEntropy(d.Rho) }
+
+// CF returns the characteristic function of the Geometric distribution at t.
+func (d GeometricDist) CF(t float64) complex128 { return GeometricCF(d.Rho, t) }
+
+// CGF returns the cumulant generating function of the Geometric distribution at t.
+func (d GeometricDist) CGF(t float64) float64 { return GeometricCGF(d.Rho, t) }
+
+// Support returns the support of the Geometric distribution.
+func (d GeometricDist) Support() (a, b int64) { return 0, posInfInt64 }
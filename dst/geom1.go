@@ -9,6 +9,10 @@ package dst
 // Support: 
 // k ∈ {1, ... , n}
 
+import (
+	"math/rand"
+)
+
 // Geometric1PMF returns the PMF of the Geometric1 distribution. 
 func Geometric1PMF(ρ float64) func(k int64) float64 {
 	return func(k int64) float64 { return ρ * pow(1-ρ, float64(k-1)) }
@@ -16,7 +20,7 @@ func Geometric1PMF(ρ float64) func(k int64) float64 {
 
 // Geometric1LnPMF returns the natural logarithm of the PMF of the Geometric distribution (type 1). 
 func Geometric1LnPMF(ρ float64) func(k int64) float64 {
-	return func(k int64) float64 { return log(1-ρ) + float64(k-1)*log(ρ) }
+	return func(k int64) float64 { return log(ρ) + float64(k-1)*log(1-ρ) }
 }
 
 // Geometric1PMFAt returns the value of PMF of Geometric distribution (type 1) at k. 
@@ -41,19 +45,73 @@ func Geometric1CDFAt(ρ float64, k int64) float64 {
 	return cdf(k)
 }
 
-/* Not tested, looking strange, commented out, waiting for revision
+// Geometric1CDFTail returns the CDF of the Geometric distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func Geometric1CDFTail(ρ float64, lowerTail, logP bool) func(k int64) float64 {
+	cdf := GeometricCDFTail(ρ, lowerTail, logP)
+	return func(k int64) float64 {
+		return cdf(k - 1)
+	}
+}
+
+// Geometric1Qtl returns the inverse of the CDF (quantile) of the Geometric distribution (type 1).
+func Geometric1Qtl(ρ float64) func(p float64) int64 {
+	qtl := GeometricQtl(ρ)
+	return func(p float64) int64 {
+		k := qtl(p)
+		if k == posInfInt64 {
+			return k
+		}
+		return k + 1
+	}
+}
+
+// Geometric1QtlFor returns the inverse of the CDF (quantile) of the Geometric distribution (type 1), for given probability.
+func Geometric1QtlFor(ρ, p float64) int64 {
+	qtl := Geometric1Qtl(ρ)
+	return qtl(p)
+}
+
+// Geometric1QtlTail returns the inverse of Geometric1CDFTail (quantile) of the Geometric distribution.
+func Geometric1QtlTail(ρ float64, lowerTail, logP bool) func(p float64) int64 {
+	qtl := GeometricQtlTail(ρ, lowerTail, logP)
+	return func(p float64) int64 {
+		k := qtl(p)
+		if k == posInfInt64 {
+			return k
+		}
+		return k + 1
+	}
+}
+
 // Geometric1Next returns random number drawn from the Geometric distribution (type 1). 
-//Geometric1Next(ρ) => # of Geometric1Next(ρ) failures before one success
 func Geometric1Next(ρ float64) int64 {
-	if Geometric1Next(ρ) == 1 {
-		return 1 + Geometric1Next(ρ)
-	}
-	return 0
+	return Geometric1NextR(GlobalRand, ρ)
+}
+
+// Geometric1NextR returns random number drawn from the Geometric distribution (type 1), using the random source src.
+func Geometric1NextR(src *rand.Rand, ρ float64) int64 {
+	return GeometricNextR(src, ρ) + 1
 }
 
 // Geometric1 returns the random number generator with  Geometric distribution (type 1). 
-func Geometric1(ρ float64) func() int64 { return func() int64 { return Geometric1Next(ρ) } }
-*/
+func Geometric1(ρ float64) func() int64 { return Geometric1R(GlobalRand, ρ) }
+
+// Geometric1R returns the random number generator with  Geometric distribution (type 1), using the random source src.
+func Geometric1R(src *rand.Rand, ρ float64) func() int64 {
+	return func() int64 { return Geometric1NextR(src, ρ) }
+}
+
+// Geometric1Fill fills x with random numbers drawn from the Geometric distribution (type 1).
+func Geometric1Fill(ρ float64, x []int64) {
+	Geometric1FillR(GlobalRand, ρ, x)
+}
+
+// Geometric1FillR fills x with random numbers drawn from the Geometric distribution (type 1), using the random source src.
+func Geometric1FillR(src *rand.Rand, ρ float64, x []int64) {
+	for i := range x {
+		x[i] = Geometric1NextR(src, ρ)
+	}
+}
 
 // Geometric1Mean returns the mean of the Geometric distribution (type 1). 
 func Geometric1Mean(ρ float64) float64 {
@@ -92,6 +150,21 @@ func Geometric1ExKurt(ρ float64) float64 {
 	return 6 + (ρ*ρ)/(1-ρ)
 }
 
+// Geometric1Entropy returns the Shannon entropy of the Geometric distribution.
+func Geometric1Entropy(ρ float64) float64 {
+	return GeometricEntropy(ρ)
+}
+
+// Geometric1CF returns the characteristic function of the Geometric distribution.
+func Geometric1CF(ρ, t float64) complex128 {
+	return cexp(complex(0, t)) * GeometricCF(ρ, t)
+}
+
+// Geometric1CGF returns the cumulant generating function of the Geometric distribution; +Inf for t ≥ -ln(1-ρ).
+func Geometric1CGF(ρ, t float64) float64 {
+	return t + GeometricCGF(ρ, t)
+}
+
 // Geometric1MGF returns the moment-generating function of the Geometric distribution (type 1). 
 func Geometric1MGF(ρ, t float64) float64 {
 	if t >= -log(1-ρ) {
@@ -99,3 +172,86 @@ func Geometric1MGF(ρ, t float64) float64 {
 	}
 	return ρ * exp(t) / (1 - (1-ρ)*exp(t))
 }
+
+// Geometric1Fit returns the maximum-likelihood estimate of ρ of the Geometric distribution (type 1) from the sample k: the inverse of its mean.
+func Geometric1Fit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return Geometric1LnPMF(θ[0]) })
+	μ, _ := mleMeanVarInt(k)
+	return mleAt(lnL, []float64{1 / μ})
+}
+
+// Geometric1Dist is the Geometric distribution (number of trials up to and including the first success) with probability of success ρ = Rho. It implements Discrete.
+type Geometric1Dist struct {
+	Rho float64
+}
+
+// PMF returns the value of PMF of the Geometric distribution at k.
+func (d Geometric1Dist) PMF(k int64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return Geometric1PMFAt(d.Rho, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Geometric distribution at k.
+func (d Geometric1Dist) LnPMF(k int64) float64 {
+	if k < 1 {
+		return negInf
+	}
+	return Geometric1LnPMF(d.Rho)(k)
+}
+
+// CDF returns the value of CDF of the Geometric distribution at k.
+func (d Geometric1Dist) CDF(k int64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return Geometric1CDFAt(d.Rho, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Geometric distribution at k.
+func (d Geometric1Dist) Surv(k int64) float64 { return Geometric1CDFTail(d.Rho, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Geometric distribution at k.
+func (d Geometric1Dist) LnCDF(k int64) float64 { return Geometric1CDFTail(d.Rho, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Geometric distribution at k.
+func (d Geometric1Dist) LnSurv(k int64) float64 { return Geometric1CDFTail(d.Rho, false, true)(k) }
+
+// Qtl returns the quantile of the Geometric distribution for probability p.
+func (d Geometric1Dist) Qtl(p float64) int64 { return Geometric1QtlFor(d.Rho, p) }
+
+// QtlTail returns the quantile of the Geometric distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d Geometric1Dist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return Geometric1QtlTail(d.Rho, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Geometric distribution.
+func (d Geometric1Dist) Rand() int64 { return Geometric1Next(d.Rho) }
+
+// RandR returns random number drawn from the Geometric distribution, using the random source src.
+func (d Geometric1Dist) RandR(src *rand.Rand) int64 { return Geometric1NextR(src, d.Rho) }
+
+// Mean returns the mean of the Geometric distribution.
+func (d Geometric1Dist) Mean() float64 { return Geometric1Mean(d.Rho) }
+
+// Var returns the variance of the Geometric distribution.
+func (d Geometric1Dist) Var() float64 { return Geometric1Var(d.Rho) }
+
+// Skew returns the skewness of the Geometric distribution.
+func (d Geometric1Dist) Skew() float64 { return Geometric1Skew(d.Rho) }
+
+// ExKurt returns the excess kurtosis of the Geometric distribution.
+func (d Geometric1Dist) ExKurt() float64 { return Geometric1ExKurt(d.Rho) }
+
+// Entropy returns the Shannon entropy of the Geometric distribution.
+func (d Geometric1Dist) Entropy() float64 { return Geometric1Entropy(d.Rho) }
+
+// CF returns the characteristic function of the Geometric distribution at t.
+func (d Geometric1Dist) CF(t float64) complex128 { return Geometric1CF(d.Rho, t) }
+
+// CGF returns the cumulant generating function of the Geometric distribution at t.
+func (d Geometric1Dist) CGF(t float64) float64 { return Geometric1CGF(d.Rho, t) }
+
+// Support returns the support of the Geometric distribution.
+func (d Geometric1Dist) Support() (a, b int64) { return 1, posInfInt64 }
@@ -0,0 +1,329 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Generalized extreme value (GEV) distribution.
+// The limiting distribution of normalized maxima of a sequence of i.i.d. random variables. It unites the Gumbel (ξ = 0), Fréchet (ξ > 0) and reversed Weibull (ξ < 0) distributions.
+//
+// Parameters:
+// μ ∈ R		location
+// σ > 0		scale
+// ξ ∈ R		shape
+//
+// Support:
+// x ∈ [μ - σ/ξ; ∞) if ξ > 0
+// x ∈ R if ξ = 0
+// x ∈ (-∞; μ - σ/ξ] if ξ < 0
+
+import (
+	"math/rand"
+)
+
+// gevLnT returns the logarithm of t(x), where exp(-t(x)) is the CDF of the GEV distribution; ok is false outside of the support.
+func gevLnT(μ, σ, ξ, x float64) (lnt float64, ok bool) {
+	z := (x - μ) / σ
+	if ξ == 0 {
+		return -z, true
+	}
+	if 1+ξ*z <= 0 {
+		return 0, false
+	}
+	return -log1p(ξ*z) / ξ, true
+}
+
+// gevX returns x such that t(x) = y, the inverse of t.
+func gevX(μ, σ, ξ, y float64) float64 {
+	if ξ == 0 {
+		return μ - σ*log(y)
+	}
+	return μ + σ*expm1(-ξ*log(y))/ξ
+}
+
+// GEVPDF returns the PDF of the Generalized extreme value distribution.
+func GEVPDF(μ, σ, ξ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		lnt, ok := gevLnT(μ, σ, ξ, x)
+		if !ok {
+			return 0
+		}
+		return exp((ξ+1)*lnt-exp(lnt)) / σ
+	}
+}
+
+// GEVLnPDF returns the natural logarithm of the PDF of the Generalized extreme value distribution.
+func GEVLnPDF(μ, σ, ξ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		lnt, ok := gevLnT(μ, σ, ξ, x)
+		if !ok {
+			return negInf
+		}
+		return -log(σ) + (ξ+1)*lnt - exp(lnt)
+	}
+}
+
+// GEVPDFAt returns the value of PDF of Generalized extreme value distribution at x.
+func GEVPDFAt(μ, σ, ξ, x float64) float64 {
+	pdf := GEVPDF(μ, σ, ξ)
+	return pdf(x)
+}
+
+// GEVCDF returns the CDF of the Generalized extreme value distribution.
+func GEVCDF(μ, σ, ξ float64) func(x float64) float64 {
+	return GEVCDFTail(μ, σ, ξ, true, false)
+}
+
+// GEVCDFAt returns the value of CDF of the Generalized extreme value distribution, at x.
+func GEVCDFAt(μ, σ, ξ, x float64) float64 {
+	cdf := GEVCDF(μ, σ, ξ)
+	return cdf(x)
+}
+
+// GEVCDFTail returns the CDF of the Generalized extreme value distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func GEVCDFTail(μ, σ, ξ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		lnt, ok := gevLnT(μ, σ, ξ, x)
+		if !ok {
+			// below the lower end if ξ > 0, above the upper end if ξ < 0
+			return pTailBounds(ξ < 0, lowerTail, logP)
+		}
+		return pTailLnLower(-exp(lnt), lowerTail, logP)
+	}
+}
+
+// GEVQtl returns the inverse of the CDF (quantile) of the Generalized extreme value distribution.
+func GEVQtl(μ, σ, ξ float64) func(p float64) float64 {
+	return GEVQtlTail(μ, σ, ξ, true, false)
+}
+
+// GEVQtlFor returns the inverse of the CDF (quantile) of the Generalized extreme value distribution, for given probability.
+func GEVQtlFor(μ, σ, ξ, p float64) float64 {
+	qtl := GEVQtl(μ, σ, ξ)
+	return qtl(p)
+}
+
+// GEVQtlTail returns the inverse of GEVCDFTail (quantile) of the Generalized extreme value distribution.
+func GEVQtlTail(μ, σ, ξ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if σ <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return gevX(μ, σ, ξ, -pLnLower(p, lowerTail, logP))
+	}
+}
+
+// GEVNext returns random number drawn from the Generalized extreme value distribution.
+func GEVNext(μ, σ, ξ float64) float64 { return GEVNextR(GlobalRand, μ, σ, ξ) }
+
+// GEVNextR returns random number drawn from the Generalized extreme value distribution, using the random source src.
+func GEVNextR(src *rand.Rand, μ, σ, ξ float64) float64 {
+	return gevX(μ, σ, ξ, expZig(src))
+}
+
+// GEV returns the random number generator with  Generalized extreme value distribution.
+func GEV(μ, σ, ξ float64) func() float64 { return GEVR(GlobalRand, μ, σ, ξ) }
+
+// GEVR returns the random number generator with  Generalized extreme value distribution, using the random source src.
+func GEVR(src *rand.Rand, μ, σ, ξ float64) func() float64 {
+	return func() float64 { return GEVNextR(src, μ, σ, ξ) }
+}
+
+// GEVFill fills x with random numbers drawn from the Generalized extreme value distribution.
+func GEVFill(μ, σ, ξ float64, x []float64) {
+	GEVFillR(GlobalRand, μ, σ, ξ, x)
+}
+
+// GEVFillR fills x with random numbers drawn from the Generalized extreme value distribution, using the random source src.
+func GEVFillR(src *rand.Rand, μ, σ, ξ float64, x []float64) {
+	for i := range x {
+		x[i] = GEVNextR(src, μ, σ, ξ)
+	}
+}
+
+// GEVMean returns the mean of the Generalized extreme value distribution.
+func GEVMean(μ, σ, ξ float64) float64 {
+	switch {
+	case ξ >= 1:
+		return posInf
+	case ξ == 0:
+		return μ + σ*eulerγ
+	}
+	return μ + σ*(Γ(1-ξ)-1)/ξ
+}
+
+// GEVMedian returns the median of the Generalized extreme value distribution.
+func GEVMedian(μ, σ, ξ float64) float64 {
+	return gevX(μ, σ, ξ, Ln2)
+}
+
+// GEVMode returns the mode of the Generalized extreme value distribution.
+func GEVMode(μ, σ, ξ float64) float64 {
+	return gevX(μ, σ, ξ, 1+ξ)
+}
+
+// GEVVar returns the variance of the Generalized extreme value distribution.
+func GEVVar(μ, σ, ξ float64) float64 {
+	switch {
+	case ξ >= 0.5:
+		return posInf
+	case ξ == 0:
+		return π * π * σ * σ / 6
+	}
+	g1, g2 := Γ(1-ξ), Γ(1-2*ξ)
+	return σ * σ * (g2 - g1*g1) / (ξ * ξ)
+}
+
+// GEVStd returns the standard deviation of the Generalized extreme value distribution.
+func GEVStd(μ, σ, ξ float64) float64 {
+	return sqrt(GEVVar(μ, σ, ξ))
+}
+
+// GEVSkew returns the skewness of the Generalized extreme value distribution.
+func GEVSkew(μ, σ, ξ float64) float64 {
+	switch {
+	case ξ >= 1.0/3:
+		return posInf
+	case ξ == 0:
+		return gumbelSkew
+	}
+	g1, g2, g3 := Γ(1-ξ), Γ(1-2*ξ), Γ(1-3*ξ)
+	s := (g3 - 3*g2*g1 + 2*g1*g1*g1) / pow(g2-g1*g1, 1.5)
+	if ξ < 0 {
+		return -s
+	}
+	return s
+}
+
+// GEVExKurt returns the excess kurtosis of the Generalized extreme value distribution.
+func GEVExKurt(μ, σ, ξ float64) float64 {
+	switch {
+	case ξ >= 0.25:
+		return posInf
+	case ξ == 0:
+		return 12.0 / 5
+	}
+	g1, g2, g3, g4 := Γ(1-ξ), Γ(1-2*ξ), Γ(1-3*ξ), Γ(1-4*ξ)
+	v := g2 - g1*g1
+	return (g4-4*g3*g1+6*g2*g1*g1-3*g1*g1*g1*g1)/(v*v) - 3
+}
+
+// GEVEntropy returns the differential entropy of the Generalized extreme value distribution.
+func GEVEntropy(μ, σ, ξ float64) float64 {
+	return log(σ) + eulerγ*ξ + eulerγ + 1
+}
+
+// GEVCF returns the characteristic function of the Generalized extreme value distribution, by numerical integration for ξ ≠ 0.
+func GEVCF(μ, σ, ξ, t float64) complex128 {
+	if ξ == 0 {
+		return GumbelCF(μ, σ, t)
+	}
+	a, b := GEVDist{μ, σ, ξ}.Support()
+	return cfLnPDF(GEVLnPDF(μ, σ, ξ), GEVQtl(μ, σ, ξ), a, b, t)
+}
+
+// GEVCGF returns the cumulant generating function of the Generalized extreme value distribution, by numerical integration for ξ ≠ 0.
+func GEVCGF(μ, σ, ξ, t float64) float64 {
+	// the right tail is heavy for ξ > 0, the left one lighter than exponential for ξ > -1, and exponential of rate 1/σ for ξ = -1
+	switch {
+	case ξ == 0:
+		return GumbelCGF(μ, σ, t)
+	case t > 0 && ξ > 0, t < 0 && ξ < -1, t < 0 && ξ == -1 && σ*t <= -1:
+		return posInf
+	}
+	a, b := GEVDist{μ, σ, ξ}.Support()
+	return cgfLnPDF(GEVLnPDF(μ, σ, ξ), GEVQtl(μ, σ, ξ), a, b, t)
+}
+
+// GEVMGF has no closed form.
+
+// GEVMatchQtls returns the generalized extreme value distribution whose quantiles for the probabilities p best match x.
+func GEVMatchQtls(p, x []float64) GEVDist {
+	// ξ = 0 is the Gumbel distribution, x = μ - σ log(-log p)
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return -log(-log(p)) }), x)
+	e := matchQtls(func(θ []float64) Continuous { return GEVDist{θ[0], θ[1], θ[2]} }, p, x, []float64{a, b, 0}, []mleBound{mleReal, mlePos, mleReal})
+	return GEVDist{e[0], e[1], e[2]}
+}
+
+// GEVFit returns the maximum-likelihood estimates of μ, σ, ξ of the GEV distribution from the sample x, starting from the Gumbel distribution.
+func GEVFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return GEVLnPDF(θ[0], θ[1], θ[2]) })
+	μ, σ := gumbelStart(x)
+	return mleFit(lnL, []float64{μ, σ, 0}, []mleBound{mleReal, mlePos, mleReal})
+}
+
+// GEVDist is the generalized extreme value distribution with location μ = Mu, scale σ = Sigma and shape ξ = Xi. It implements Continuous.
+type GEVDist struct {
+	Mu, Sigma, Xi float64
+}
+
+// PDF returns the value of PDF of the Generalized extreme value distribution at x.
+func (d GEVDist) PDF(x float64) float64 { return GEVPDFAt(d.Mu, d.Sigma, d.Xi, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Generalized extreme value distribution at x.
+func (d GEVDist) LnPDF(x float64) float64 { return GEVLnPDF(d.Mu, d.Sigma, d.Xi)(x) }
+
+// CDF returns the value of CDF of the Generalized extreme value distribution at x.
+func (d GEVDist) CDF(x float64) float64 { return GEVCDFAt(d.Mu, d.Sigma, d.Xi, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Generalized extreme value distribution at x.
+func (d GEVDist) Surv(x float64) float64 { return GEVCDFTail(d.Mu, d.Sigma, d.Xi, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Generalized extreme value distribution at x.
+func (d GEVDist) LnCDF(x float64) float64 { return GEVCDFTail(d.Mu, d.Sigma, d.Xi, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Generalized extreme value distribution at x.
+func (d GEVDist) LnSurv(x float64) float64 { return GEVCDFTail(d.Mu, d.Sigma, d.Xi, false, true)(x) }
+
+// Qtl returns the quantile of the Generalized extreme value distribution for probability p.
+func (d GEVDist) Qtl(p float64) float64 { return GEVQtlFor(d.Mu, d.Sigma, d.Xi, p) }
+
+// QtlTail returns the quantile of the Generalized extreme value distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d GEVDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return GEVQtlTail(d.Mu, d.Sigma, d.Xi, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Generalized extreme value distribution.
+func (d GEVDist) Rand() float64 { return GEVNext(d.Mu, d.Sigma, d.Xi) }
+
+// RandR returns random number drawn from the Generalized extreme value distribution, using the random source src.
+func (d GEVDist) RandR(src *rand.Rand) float64 { return GEVNextR(src, d.Mu, d.Sigma, d.Xi) }
+
+// Mean returns the mean of the Generalized extreme value distribution.
+func (d GEVDist) Mean() float64 { return GEVMean(d.Mu, d.Sigma, d.Xi) }
+
+// Var returns the variance of the Generalized extreme value distribution.
+func (d GEVDist) Var() float64 { return GEVVar(d.Mu, d.Sigma, d.Xi) }
+
+// Skew returns the skewness of the Generalized extreme value distribution.
+func (d GEVDist) Skew() float64 { return GEVSkew(d.Mu, d.Sigma, d.Xi) }
+
+// ExKurt returns the excess kurtosis of the Generalized extreme value distribution.
+func (d GEVDist) ExKurt() float64 { return GEVExKurt(d.Mu, d.Sigma, d.Xi) }
+
+// Entropy returns the differential entropy of the Generalized extreme value distribution.
+func (d GEVDist) Entropy() float64 { return GEVEntropy(d.Mu, d.Sigma, d.Xi) }
+
+// CF returns the characteristic function of the Generalized extreme value distribution at t.
+func (d GEVDist) CF(t float64) complex128 { return GEVCF(d.Mu, d.Sigma, d.Xi, t) }
+
+// CGF returns the cumulant generating function of the Generalized extreme value distribution at t.
+func (d GEVDist) CGF(t float64) float64 { return GEVCGF(d.Mu, d.Sigma, d.Xi, t) }
+
+// Support returns the support of the Generalized extreme value distribution.
+func (d GEVDist) Support() (a, b float64) {
+	switch {
+	case d.Xi > 0:
+		return d.Mu - d.Sigma/d.Xi, posInf
+	case d.Xi < 0:
+		return negInf, d.Mu - d.Sigma/d.Xi
+	}
+	return negInf, posInf
+}
@@ -0,0 +1,281 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Gumbel distribution (type I extreme value distribution, of the maximum).
+// The limiting distribution of the maximum of many samples from a distribution with an exponential tail, such as the Normal or Gamma distributions.
+//
+// Parameters:
+// μ ∈ R		location
+// β > 0		scale
+//
+// Support:
+// x ∈ R
+
+import (
+	"math/rand"
+)
+
+const (
+	eulerγ     = 0.57721566490153286061 // Euler–Mascheroni constant
+	gumbelSkew = 1.13954709940464866    // 12 √6 ζ(3) / π³, skewness of the Gumbel distribution
+)
+
+// GumbelPDF returns the PDF of the Gumbel distribution.
+func GumbelPDF(μ, β float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if β <= 0 {
+			return NaN
+		}
+		z := (x - μ) / β
+		return exp(-z-exp(-z)) / β
+	}
+}
+
+// GumbelLnPDF returns the natural logarithm of the PDF of the Gumbel distribution.
+func GumbelLnPDF(μ, β float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if β <= 0 {
+			return NaN
+		}
+		z := (x - μ) / β
+		return -log(β) - z - exp(-z)
+	}
+}
+
+// GumbelPDFAt returns the value of PDF of Gumbel distribution at x.
+func GumbelPDFAt(μ, β, x float64) float64 {
+	pdf := GumbelPDF(μ, β)
+	return pdf(x)
+}
+
+// GumbelCDF returns the CDF of the Gumbel distribution.
+func GumbelCDF(μ, β float64) func(x float64) float64 {
+	return GumbelCDFTail(μ, β, true, false)
+}
+
+// GumbelCDFAt returns the value of CDF of the Gumbel distribution, at x.
+func GumbelCDFAt(μ, β, x float64) float64 {
+	cdf := GumbelCDF(μ, β)
+	return cdf(x)
+}
+
+// GumbelCDFTail returns the CDF of the Gumbel distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func GumbelCDFTail(μ, β float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if β <= 0 {
+			return NaN
+		}
+		return pTailLnLower(-exp(-(x-μ)/β), lowerTail, logP)
+	}
+}
+
+// GumbelQtl returns the inverse of the CDF (quantile) of the Gumbel distribution.
+func GumbelQtl(μ, β float64) func(p float64) float64 {
+	return GumbelQtlTail(μ, β, true, false)
+}
+
+// GumbelQtlFor returns the inverse of the CDF (quantile) of the Gumbel distribution, for given probability.
+func GumbelQtlFor(μ, β, p float64) float64 {
+	qtl := GumbelQtl(μ, β)
+	return qtl(p)
+}
+
+// GumbelQtlTail returns the inverse of GumbelCDFTail (quantile) of the Gumbel distribution.
+func GumbelQtlTail(μ, β float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if β <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return μ - β*log(-pLnLower(p, lowerTail, logP))
+	}
+}
+
+// GumbelNext returns random number drawn from the Gumbel distribution.
+func GumbelNext(μ, β float64) float64 { return GumbelNextR(GlobalRand, μ, β) }
+
+// GumbelNextR returns random number drawn from the Gumbel distribution, using the random source src.
+func GumbelNextR(src *rand.Rand, μ, β float64) float64 {
+	return μ - β*log(expZig(src))
+}
+
+// Gumbel returns the random number generator with  Gumbel distribution.
+func Gumbel(μ, β float64) func() float64 { return GumbelR(GlobalRand, μ, β) }
+
+// GumbelR returns the random number generator with  Gumbel distribution, using the random source src.
+func GumbelR(src *rand.Rand, μ, β float64) func() float64 {
+	return func() float64 { return GumbelNextR(src, μ, β) }
+}
+
+// GumbelFill fills x with random numbers drawn from the Gumbel distribution.
+func GumbelFill(μ, β float64, x []float64) {
+	GumbelFillR(GlobalRand, μ, β, x)
+}
+
+// GumbelFillR fills x with random numbers drawn from the Gumbel distribution, using the random source src.
+func GumbelFillR(src *rand.Rand, μ, β float64, x []float64) {
+	for i := range x {
+		x[i] = GumbelNextR(src, μ, β)
+	}
+}
+
+// GumbelMean returns the mean of the Gumbel distribution.
+func GumbelMean(μ, β float64) float64 {
+	return μ + β*eulerγ
+}
+
+// GumbelMedian returns the median of the Gumbel distribution.
+func GumbelMedian(μ, β float64) float64 {
+	return μ - β*log(Ln2)
+}
+
+// GumbelMode returns the mode of the Gumbel distribution.
+func GumbelMode(μ, β float64) float64 {
+	return μ
+}
+
+// GumbelVar returns the variance of the Gumbel distribution.
+func GumbelVar(μ, β float64) float64 {
+	return π * π * β * β / 6
+}
+
+// GumbelStd returns the standard deviation of the Gumbel distribution.
+func GumbelStd(μ, β float64) float64 {
+	return π * β / sqrt(6)
+}
+
+// GumbelSkew returns the skewness of the Gumbel distribution.
+func GumbelSkew(μ, β float64) float64 {
+	return gumbelSkew
+}
+
+// GumbelExKurt returns the excess kurtosis of the Gumbel distribution.
+func GumbelExKurt(μ, β float64) float64 {
+	return 12.0 / 5
+}
+
+// GumbelEntropy returns the differential entropy of the Gumbel distribution.
+func GumbelEntropy(μ, β float64) float64 {
+	return log(β) + eulerγ + 1
+}
+
+// GumbelCF returns the characteristic function of the Gumbel distribution.
+func GumbelCF(μ, β, t float64) complex128 {
+	return cexp(lnΓc(complex(1, -β*t)) + complex(0, μ*t))
+}
+
+// GumbelCGF returns the cumulant generating function of the Gumbel distribution; +Inf for βt ≥ 1.
+func GumbelCGF(μ, β, t float64) float64 {
+	if β*t >= 1 {
+		return posInf
+	}
+	return μ*t + LnΓ(1-β*t)
+}
+
+// GumbelMGF returns the moment-generating function of the Gumbel distribution.
+func GumbelMGF(μ, β, t float64) float64 {
+	if β*t >= 1 {
+		return posInf
+	}
+	return Γ(1-β*t) * exp(μ*t)
+}
+
+// GumbelFromMeanStd returns the Gumbel distribution with mean μ and standard deviation σ.
+func GumbelFromMeanStd(μ, σ float64) GumbelDist {
+	if σ <= 0 {
+		return GumbelDist{NaN, NaN}
+	}
+	β := σ * sqrt(6) / π
+	return GumbelDist{μ - β*eulerγ, β}
+}
+
+// GumbelFromModeStd returns the Gumbel distribution with mode m and standard deviation σ.
+func GumbelFromModeStd(m, σ float64) GumbelDist {
+	if σ <= 0 {
+		return GumbelDist{NaN, NaN}
+	}
+	return GumbelDist{m, σ * sqrt(6) / π}
+}
+
+// GumbelMatchQtls returns the Gumbel distribution whose quantiles for the probabilities p best match x.
+func GumbelMatchQtls(p, x []float64) GumbelDist {
+	// x = μ - β log(-log p)
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return -log(-log(p)) }), x)
+	e := matchQtls(func(θ []float64) Continuous { return GumbelDist{θ[0], θ[1]} }, p, x, []float64{a, b}, []mleBound{mleReal, mlePos})
+	return GumbelDist{e[0], e[1]}
+}
+
+// GumbelFit returns the maximum-likelihood estimates of μ, β of the Gumbel distribution from the sample x.
+func GumbelFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return GumbelLnPDF(θ[0], θ[1]) })
+	μ, β := gumbelStart(x)
+	return mleFit(lnL, []float64{μ, β}, []mleBound{mleReal, mlePos})
+}
+
+// gumbelStart returns the estimates of μ, β of the Gumbel distribution by the method of moments.
+func gumbelStart(x []float64) (μ, β float64) {
+	m, σ2 := mleMeanVar(x)
+	β = sqrt(6*σ2) / π
+	return m - eulerγ*β, β
+}
+
+// GumbelDist is the Gumbel distribution of the maximum with location μ = Mu and scale β = Beta. It implements Continuous.
+type GumbelDist struct {
+	Mu, Beta float64
+}
+
+// PDF returns the value of PDF of the Gumbel distribution at x.
+func (d GumbelDist) PDF(x float64) float64 { return GumbelPDFAt(d.Mu, d.Beta, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Gumbel distribution at x.
+func (d GumbelDist) LnPDF(x float64) float64 { return GumbelLnPDF(d.Mu, d.Beta)(x) }
+
+// CDF returns the value of CDF of the Gumbel distribution at x.
+func (d GumbelDist) CDF(x float64) float64 { return GumbelCDFAt(d.Mu, d.Beta, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Gumbel distribution at x.
+func (d GumbelDist) Surv(x float64) float64 { return GumbelCDFTail(d.Mu, d.Beta, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Gumbel distribution at x.
+func (d GumbelDist) LnCDF(x float64) float64 { return GumbelCDFTail(d.Mu, d.Beta, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Gumbel distribution at x.
+func (d GumbelDist) LnSurv(x float64) float64 { return GumbelCDFTail(d.Mu, d.Beta, false, true)(x) }
+
+// Qtl returns the quantile of the Gumbel distribution for probability p.
+func (d GumbelDist) Qtl(p float64) float64 { return GumbelQtlFor(d.Mu, d.Beta, p) }
+
+// QtlTail returns the quantile of the Gumbel distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d GumbelDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return GumbelQtlTail(d.Mu, d.Beta, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Gumbel distribution.
+func (d GumbelDist) Rand() float64 { return GumbelNext(d.Mu, d.Beta) }
+
+// RandR returns random number drawn from the Gumbel distribution, using the random source src.
+func (d GumbelDist) RandR(src *rand.Rand) float64 { return GumbelNextR(src, d.Mu, d.Beta) }
+
+// Mean returns the mean of the Gumbel distribution.
+func (d GumbelDist) Mean() float64 { return GumbelMean(d.Mu, d.Beta) }
+
+// Var returns the variance of the Gumbel distribution.
+func (d GumbelDist) Var() float64 { return GumbelVar(d.Mu, d.Beta) }
+
+// Skew returns the skewness of the Gumbel distribution.
+func (d GumbelDist) Skew() float64 { return GumbelSkew(d.Mu, d.Beta) }
+
+// ExKurt returns the excess kurtosis of the Gumbel distribution.
+func (d GumbelDist) ExKurt() float64 { return GumbelExKurt(d.Mu, d.Beta) }
+
+// Entropy returns the differential entropy of the Gumbel distribution.
+func (d GumbelDist) Entropy() float64 { return GumbelEntropy(d.Mu, d.Beta) }
+
+// CF returns the characteristic function of the Gumbel distribution at t.
+func (d GumbelDist) CF(t float64) complex128 { return GumbelCF(d.Mu, d.Beta, t) }
+
+// CGF returns the cumulant generating function of the Gumbel distribution at t.
+func (d GumbelDist) CGF(t float64) float64 { return GumbelCGF(d.Mu, d.Beta, t) }
+
+// Support returns the support of the Gumbel distribution.
+func (d GumbelDist) Support() (a, b float64) { return negInf, posInf }
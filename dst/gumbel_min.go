@@ -0,0 +1,274 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Gumbel distribution of the minimum (type I extreme value distribution of the minimum, log-Weibull).
+// If X is Gumbel(μ, β), then -X is GumbelMin(-μ, β).
+//
+// Parameters:
+// μ ∈ R		location
+// β > 0		scale
+//
+// Support:
+// x ∈ R
+
+import (
+	"math/rand"
+)
+
+// GumbelMinPDF returns the PDF of the Gumbel (minimum) distribution.
+func GumbelMinPDF(μ, β float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if β <= 0 {
+			return NaN
+		}
+		z := (x - μ) / β
+		return exp(z-exp(z)) / β
+	}
+}
+
+// GumbelMinLnPDF returns the natural logarithm of the PDF of the Gumbel (minimum) distribution.
+func GumbelMinLnPDF(μ, β float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if β <= 0 {
+			return NaN
+		}
+		z := (x - μ) / β
+		return -log(β) + z - exp(z)
+	}
+}
+
+// GumbelMinPDFAt returns the value of PDF of Gumbel (minimum) distribution at x.
+func GumbelMinPDFAt(μ, β, x float64) float64 {
+	pdf := GumbelMinPDF(μ, β)
+	return pdf(x)
+}
+
+// GumbelMinCDF returns the CDF of the Gumbel (minimum) distribution.
+func GumbelMinCDF(μ, β float64) func(x float64) float64 {
+	return GumbelMinCDFTail(μ, β, true, false)
+}
+
+// GumbelMinCDFAt returns the value of CDF of the Gumbel (minimum) distribution, at x.
+func GumbelMinCDFAt(μ, β, x float64) float64 {
+	cdf := GumbelMinCDF(μ, β)
+	return cdf(x)
+}
+
+// GumbelMinCDFTail returns the CDF of the Gumbel (minimum) distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func GumbelMinCDFTail(μ, β float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if β <= 0 {
+			return NaN
+		}
+		return pTailLnUpper(-exp((x-μ)/β), lowerTail, logP)
+	}
+}
+
+// GumbelMinQtl returns the inverse of the CDF (quantile) of the Gumbel (minimum) distribution.
+func GumbelMinQtl(μ, β float64) func(p float64) float64 {
+	return GumbelMinQtlTail(μ, β, true, false)
+}
+
+// GumbelMinQtlFor returns the inverse of the CDF (quantile) of the Gumbel (minimum) distribution, for given probability.
+func GumbelMinQtlFor(μ, β, p float64) float64 {
+	qtl := GumbelMinQtl(μ, β)
+	return qtl(p)
+}
+
+// GumbelMinQtlTail returns the inverse of GumbelMinCDFTail (quantile) of the Gumbel (minimum) distribution.
+func GumbelMinQtlTail(μ, β float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if β <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return μ + β*log(-pLnUpper(p, lowerTail, logP))
+	}
+}
+
+// GumbelMinNext returns random number drawn from the Gumbel (minimum) distribution.
+func GumbelMinNext(μ, β float64) float64 { return GumbelMinNextR(GlobalRand, μ, β) }
+
+// GumbelMinNextR returns random number drawn from the Gumbel (minimum) distribution, using the random source src.
+func GumbelMinNextR(src *rand.Rand, μ, β float64) float64 {
+	return μ + β*log(expZig(src))
+}
+
+// GumbelMin returns the random number generator with  Gumbel (minimum) distribution.
+func GumbelMin(μ, β float64) func() float64 { return GumbelMinR(GlobalRand, μ, β) }
+
+// GumbelMinR returns the random number generator with  Gumbel (minimum) distribution, using the random source src.
+func GumbelMinR(src *rand.Rand, μ, β float64) func() float64 {
+	return func() float64 { return GumbelMinNextR(src, μ, β) }
+}
+
+// GumbelMinFill fills x with random numbers drawn from the Gumbel (minimum) distribution.
+func GumbelMinFill(μ, β float64, x []float64) {
+	GumbelMinFillR(GlobalRand, μ, β, x)
+}
+
+// GumbelMinFillR fills x with random numbers drawn from the Gumbel (minimum) distribution, using the random source src.
+func GumbelMinFillR(src *rand.Rand, μ, β float64, x []float64) {
+	for i := range x {
+		x[i] = GumbelMinNextR(src, μ, β)
+	}
+}
+
+// GumbelMinMean returns the mean of the Gumbel (minimum) distribution.
+func GumbelMinMean(μ, β float64) float64 {
+	return μ - β*eulerγ
+}
+
+// GumbelMinMedian returns the median of the Gumbel (minimum) distribution.
+func GumbelMinMedian(μ, β float64) float64 {
+	return μ + β*log(Ln2)
+}
+
+// GumbelMinMode returns the mode of the Gumbel (minimum) distribution.
+func GumbelMinMode(μ, β float64) float64 {
+	return μ
+}
+
+// GumbelMinVar returns the variance of the Gumbel (minimum) distribution.
+func GumbelMinVar(μ, β float64) float64 {
+	return π * π * β * β / 6
+}
+
+// GumbelMinStd returns the standard deviation of the Gumbel (minimum) distribution.
+func GumbelMinStd(μ, β float64) float64 {
+	return π * β / sqrt(6)
+}
+
+// GumbelMinSkew returns the skewness of the Gumbel (minimum) distribution.
+func GumbelMinSkew(μ, β float64) float64 {
+	return -gumbelSkew
+}
+
+// GumbelMinExKurt returns the excess kurtosis of the Gumbel (minimum) distribution.
+func GumbelMinExKurt(μ, β float64) float64 {
+	return 12.0 / 5
+}
+
+// GumbelMinEntropy returns the differential entropy of the Gumbel (minimum) distribution.
+func GumbelMinEntropy(μ, β float64) float64 {
+	return log(β) + eulerγ + 1
+}
+
+// GumbelMinCF returns the characteristic function of the Gumbel (minimum) distribution.
+func GumbelMinCF(μ, β, t float64) complex128 {
+	return cexp(lnΓc(complex(1, β*t)) + complex(0, μ*t))
+}
+
+// GumbelMinCGF returns the cumulant generating function of the Gumbel (minimum) distribution; +Inf for βt ≤ -1.
+func GumbelMinCGF(μ, β, t float64) float64 {
+	if β*t <= -1 {
+		return posInf
+	}
+	return μ*t + LnΓ(1+β*t)
+}
+
+// GumbelMinMGF returns the moment-generating function of the Gumbel (minimum) distribution.
+func GumbelMinMGF(μ, β, t float64) float64 {
+	if β*t <= -1 {
+		return posInf
+	}
+	return Γ(1+β*t) * exp(μ*t)
+}
+
+// GumbelMinFromMeanStd returns the Gumbel distribution of the minimum with mean μ and standard deviation σ.
+func GumbelMinFromMeanStd(μ, σ float64) GumbelMinDist {
+	if σ <= 0 {
+		return GumbelMinDist{NaN, NaN}
+	}
+	β := σ * sqrt(6) / π
+	return GumbelMinDist{μ + β*eulerγ, β}
+}
+
+// GumbelMinFromModeStd returns the Gumbel distribution of the minimum with mode m and standard deviation σ.
+func GumbelMinFromModeStd(m, σ float64) GumbelMinDist {
+	if σ <= 0 {
+		return GumbelMinDist{NaN, NaN}
+	}
+	return GumbelMinDist{m, σ * sqrt(6) / π}
+}
+
+// GumbelMinMatchQtls returns the Gumbel distribution of the minimum whose quantiles for the probabilities p best match x.
+func GumbelMinMatchQtls(p, x []float64) GumbelMinDist {
+	// x = μ + β log(-log(1-p))
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return log(-log1p(-p)) }), x)
+	e := matchQtls(func(θ []float64) Continuous { return GumbelMinDist{θ[0], θ[1]} }, p, x, []float64{a, b}, []mleBound{mleReal, mlePos})
+	return GumbelMinDist{e[0], e[1]}
+}
+
+// GumbelMinFit returns the maximum-likelihood estimates of μ, β of the Gumbel distribution of the minimum from the sample x.
+func GumbelMinFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return GumbelMinLnPDF(θ[0], θ[1]) })
+	m, σ2 := mleMeanVar(x)
+	β := sqrt(6*σ2) / π
+	return mleFit(lnL, []float64{m + eulerγ*β, β}, []mleBound{mleReal, mlePos})
+}
+
+// GumbelMinDist is the Gumbel distribution of the minimum with location μ = Mu and scale β = Beta. It implements Continuous.
+type GumbelMinDist struct {
+	Mu, Beta float64
+}
+
+// PDF returns the value of PDF of the Gumbel (minimum) distribution at x.
+func (d GumbelMinDist) PDF(x float64) float64 { return GumbelMinPDFAt(d.Mu, d.Beta, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Gumbel (minimum) distribution at x.
+func (d GumbelMinDist) LnPDF(x float64) float64 { return GumbelMinLnPDF(d.Mu, d.Beta)(x) }
+
+// CDF returns the value of CDF of the Gumbel (minimum) distribution at x.
+func (d GumbelMinDist) CDF(x float64) float64 { return GumbelMinCDFAt(d.Mu, d.Beta, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Gumbel (minimum) distribution at x.
+func (d GumbelMinDist) Surv(x float64) float64 {
+	return GumbelMinCDFTail(d.Mu, d.Beta, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Gumbel (minimum) distribution at x.
+func (d GumbelMinDist) LnCDF(x float64) float64 { return GumbelMinCDFTail(d.Mu, d.Beta, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Gumbel (minimum) distribution at x.
+func (d GumbelMinDist) LnSurv(x float64) float64 {
+	return GumbelMinCDFTail(d.Mu, d.Beta, false, true)(x)
+}
+
+// Qtl returns the quantile of the Gumbel (minimum) distribution for probability p.
+func (d GumbelMinDist) Qtl(p float64) float64 { return GumbelMinQtlFor(d.Mu, d.Beta, p) }
+
+// QtlTail returns the quantile of the Gumbel (minimum) distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d GumbelMinDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return GumbelMinQtlTail(d.Mu, d.Beta, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Gumbel (minimum) distribution.
+func (d GumbelMinDist) Rand() float64 { return GumbelMinNext(d.Mu, d.Beta) }
+
+// RandR returns random number drawn from the Gumbel (minimum) distribution, using the random source src.
+func (d GumbelMinDist) RandR(src *rand.Rand) float64 { return GumbelMinNextR(src, d.Mu, d.Beta) }
+
+// Mean returns the mean of the Gumbel (minimum) distribution.
+func (d GumbelMinDist) Mean() float64 { return GumbelMinMean(d.Mu, d.Beta) }
+
+// Var returns the variance of the Gumbel (minimum) distribution.
+func (d GumbelMinDist) Var() float64 { return GumbelMinVar(d.Mu, d.Beta) }
+
+// Skew returns the skewness of the Gumbel (minimum) distribution.
+func (d GumbelMinDist) Skew() float64 { return GumbelMinSkew(d.Mu, d.Beta) }
+
+// ExKurt returns the excess kurtosis of the Gumbel (minimum) distribution.
+func (d GumbelMinDist) ExKurt() float64 { return GumbelMinExKurt(d.Mu, d.Beta) }
+
+// Entropy returns the differential entropy of the Gumbel (minimum) distribution.
+func (d GumbelMinDist) Entropy() float64 { return GumbelMinEntropy(d.Mu, d.Beta) }
+
+// CF returns the characteristic function of the Gumbel (minimum) distribution at t.
+func (d GumbelMinDist) CF(t float64) complex128 { return GumbelMinCF(d.Mu, d.Beta, t) }
+
+// CGF returns the cumulant generating function of the Gumbel (minimum) distribution at t.
+func (d GumbelMinDist) CGF(t float64) float64 { return GumbelMinCGF(d.Mu, d.Beta, t) }
+
+// Support returns the support of the Gumbel (minimum) distribution.
+func (d GumbelMinDist) Support() (a, b float64) { return negInf, posInf }
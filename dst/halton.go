@@ -0,0 +1,115 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Halton sequence.
+// The coordinate j of the point n is the radical inverse of n in the base of the j-th prime: the digits of n, mirrored about the radix point.
+// Its uniformity degrades in high dimensions, where the bases are large, unless it is scrambled.
+// Ref.: Halton, J. H. (1960). On the efficiency of certain quasi-random sequences of points in evaluating multi-dimensional integrals.
+// Numerische Mathematik 2, 84-90.
+//
+// Parameters:
+// d ∈ {1, 2, ...}	dimension
+//
+// Support:
+// x ∈ [0, 1)^d
+
+import (
+	"math/rand"
+)
+
+// haltonBases returns the first d primes.
+func haltonBases(d int) []uint64 {
+	b := make([]uint64, 0, d)
+	for p := uint64(2); len(b) < d; p++ {
+		prime := true
+		for _, q := range b {
+			if q*q > p {
+				break
+			}
+			if p%q == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			b = append(b, p)
+		}
+	}
+	return b
+}
+
+// radicalInverse returns the radical inverse of n in the base b.
+func radicalInverse(n, b uint64) float64 {
+	x := fZero
+	f := 1 / float64(b)
+	for w := f; n > 0; n /= b {
+		x += float64(n%b) * w
+		w *= f
+	}
+	return x
+}
+
+// Halton returns the generator of the Halton sequence in d dimensions, from its second point: the first, the origin, has
+// infinite quantiles for distributions unbounded below.
+func Halton(d int) func() []float64 {
+	b := haltonBases(d)
+	n := uint64(0)
+	return func() []float64 {
+		n++
+		u := make([]float64, d)
+		for j := range u {
+			u[j] = radicalInverse(n, b[j])
+		}
+		return u
+	}
+}
+
+// HaltonOwen returns the generator of the Halton sequence in d dimensions, scrambled by Owen's nested uniform scrambling
+// drawn from the random source src, from its first point.
+func HaltonOwen(src *rand.Rand, d int) func() []float64 {
+	b := haltonBases(d)
+	seed := make([]uint64, d)
+	for j := range seed {
+		seed[j] = src.Uint64()
+	}
+	n := uint64(0)
+	return func() []float64 {
+		u := make([]float64, d)
+		for j := range u {
+			u[j] = owenScramble(n, b[j], seed[j])
+		}
+		n++
+		return u
+	}
+}
+
+// owenScramble returns the radical inverse of n in the base b, each digit permuted by a random permutation that depends on the seed
+// and on the digits above it; the digits beyond those of n, all zero, become random, up to the precision of a float64.
+func owenScramble(n, b uint64, seed uint64) float64 {
+	x := fZero
+	f := 1 / float64(b)
+	h := seed
+	perm := make([]uint64, b)
+	for w := f; w*float64(b) > eps64; w *= f {
+		digit := n % b
+		n /= b
+		// the digit of the random permutation of 0, ..., b-1 drawn by Fisher-Yates from the hash h
+		for i := range perm {
+			perm[i] = uint64(i)
+		}
+		r := h
+		for i := b - 1; i > 0 && i >= digit; i-- {
+			r = qmcMix(r)
+			k := r % (i + 1)
+			perm[i], perm[k] = perm[k], perm[i]
+		}
+		x += float64(perm[digit]) * w
+		h = qmcMix(h ^ (digit + 1))
+	}
+	if x >= 1 {
+		// rounded up from below 1
+		x = 1 - eps64/2
+	}
+	return x
+}
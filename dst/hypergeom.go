@@ -12,6 +12,10 @@ package dst
 // Support: 
 // k ∈ {max(0, n+m-nN), ... , min(m, n)}
 
+import (
+	"math/rand"
+)
+
 // HypergeometricPMF returns the PMF of the Hypergeometric distribution. 
 func HypergeometricPMF(nN, m, n int64) func(k int64) float64 {
 	return func(k int64) float64 {
@@ -72,6 +76,35 @@ func HypergeometricCDFAt(nN, m, n, k int64) float64 {
 	return cdf(k)
 }
 
+// HypergeometricCDFTail returns the CDF of the Hypergeometric distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func HypergeometricCDFTail(nN, m, n int64, lowerTail, logP bool) func(k int64) float64 {
+	pmf := HypergeometricPMF(nN, m, n)
+	a, b := imax(0, n+m-nN), imin(m, n)
+	return func(k int64) float64 {
+		switch {
+		case k < a:
+			return pTailBounds(false, lowerTail, logP)
+		case k >= b:
+			return pTailBounds(true, lowerTail, logP)
+		}
+		// sum the requested tail
+		p := 0.0
+		if lowerTail {
+			for i := a; i <= k; i++ {
+				p += pmf(i)
+			}
+		} else {
+			for i := k + 1; i <= b; i++ {
+				p += pmf(i)
+			}
+		}
+		if logP {
+			return log(p)
+		}
+		return p
+	}
+}
+
 //		=== Approximations using standard normal distribution function ===
 //		Only use iff n is large, nN and m are large compared to n 
 //		and p = m/nN is not close to 0 or 1
@@ -152,6 +185,21 @@ func HypergeometricExKurt(nN, m, n int64) float64 {
 	return float64(num) / float64(den)
 }
 
+// HypergeometricEntropy returns the Shannon entropy of the Hypergeometric distribution, by numerical summation.
+func HypergeometricEntropy(nN, m, n int64) float64 {
+	return entropyDiscrete(HypergeometricDist{nN, m, n})
+}
+
+// HypergeometricCF returns the characteristic function of the Hypergeometric distribution, by numerical summation.
+func HypergeometricCF(nN, m, n int64, t float64) complex128 {
+	return cfDiscrete(HypergeometricDist{nN, m, n}, t)
+}
+
+// HypergeometricCGF returns the cumulant generating function of the Hypergeometric distribution, by numerical summation.
+func HypergeometricCGF(nN, m, n int64, t float64) float64 {
+	return cgfDiscrete(HypergeometricDist{nN, m, n}, t)
+}
+
 /* To be implemented ...
 // HypergeometricMGF returns the moment-generating function of the Hypergeometric distribution. 
 func HypergeometricMGF(n int64, p, t float64) float64 {
@@ -159,66 +207,83 @@ func HypergeometricMGF(n int64, p, t float64) float64 {
 }
 */
 
+// HypergeometricQtl returns the inverse of the CDF (quantile) of the Hypergeometric distribution.
 func HypergeometricQtl(nN, m, n int64) func(p float64) float64 {
+	cdf := HypergeometricCDFTail(nN, m, n, true, false)
+	a, b := imax(0, n+m-nN), imin(m, n)
+	μ, σ, γ := HypergeometricMean(nN, m, n), HypergeometricStd(nN, m, n), HypergeometricSkew(nN, m, n)
 	return func(p float64) float64 {
-		var xstart, xend, xr, xb, sum, term float64
-		var smallN bool
-		nr := float64(m)
-		nb := float64(nN - m)
-		nn := float64(n)
-		tot := float64(nN)
-
-		if isNaN(p) || isNaN(nr) || isNaN(nb) || isNaN(nn) {
-			return p + nr + nb + nn
-		}
-		if isInf(p, 1) || isInf(nr, 1) || isInf(nb, 1) || isInf(nn, 1) {
+		if nN < 1 || m < 0 || m > nN || n < 0 || n > nN {
 			return NaN
 		}
-		if nr < 0 || nb < 0 || nn < 0 || nn > tot {
+		k, err := InvertCDFDiscrete(cdf, p, a, b, μ, σ, γ)
+		if err != nil {
 			return NaN
 		}
+		return float64(k)
+	}
+}
 
-		xstart = fmax2(0, nn-nb)
-		xend = fmin2(nn, nr)
+// hypergeometricInv returns the quantile of the Hypergeometric distribution for probability p by summing the PMF from the lower end of the support,
+// its terms by recursion: in one pass, faster for sampling than the search of HypergeometricQtl.
+func hypergeometricInv(nN, m, n int64, p float64) float64 {
+	var xstart, xend, xr, xb, sum, term float64
+	var smallN bool
+	nr := float64(m)
+	nb := float64(nN - m)
+	nn := float64(n)
+	tot := float64(nN)
 
-		xr = xstart
-		xb = nn - xr 
+	if isNaN(p) || isNaN(nr) || isNaN(nb) || isNaN(nn) {
+		return p + nr + nb + nn
+	}
+	if isInf(p, 1) || isInf(nr, 1) || isInf(nb, 1) || isInf(nn, 1) {
+		return NaN
+	}
+	if nr < 0 || nb < 0 || nn < 0 || nn > tot {
+		return NaN
+	}
 
-		smallN = (tot < 1000)
-		term = logBinomCoeff(nr, xr) + logBinomCoeff(nb, xb) - logBinomCoeff(tot, nn)
-		if smallN {
-			term = exp(term)
-		}
-		nr -= xr
-		nb -= xb
+	xstart = fmax2(0, nn-nb)
+	xend = fmin2(nn, nr)
+
+	xr = xstart
+	xb = nn - xr 
 
-		p *= 1 - 1000*eps64
+	smallN = (tot < 1000)
+	term = logBinomCoeff(nr, xr) + logBinomCoeff(nb, xb) - logBinomCoeff(tot, nn)
+	if smallN {
+		term = exp(term)
+	}
+	nr -= xr
+	nb -= xb
+
+	p *= 1 - 1000*eps64
+	if smallN {
+		sum = term
+	} else {
+		sum = exp(term)
+	}
+
+	for sum < p && xr < xend {
+		xr++
+		nb++
 		if smallN {
-			sum = term
+			term *= (nr / xr) * (xb / nb)
 		} else {
-			sum = exp(term)
+			term += log((nr / xr) * (xb / nb))
 		}
 
-		for sum < p && xr < xend {
-			xr++
-			nb++
-			if smallN {
-				term *= (nr / xr) * (xb / nb)
-			} else {
-				term += log((nr / xr) * (xb / nb))
-			}
-
-			if smallN {
-				sum += term
-			} else {
-				sum += exp(term)
+		if smallN {
+			sum += term
+		} else {
+			sum += exp(term)
 
-			}
-			xb--
-			nr--
 		}
-		return xr
+		xb--
+		nr--
 	}
+	return xr
 }
 
 // HypergeometricQtlFor returns the inverse of the CDF (quantile) of the Hypergeometric distribution, for given probability.
@@ -226,3 +291,166 @@ func HypergeometricQtlFor(nN, m, n int64, p float64) float64 {
 	cdf := HypergeometricQtl(nN, m, n)
 	return cdf(p)
 }
+
+// HypergeometricQtlTail returns the inverse of HypergeometricCDFTail (quantile) of the Hypergeometric distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func HypergeometricQtlTail(nN, m, n int64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := HypergeometricCDFTail(nN, m, n, lowerTail, logP)
+	return func(p float64) int64 {
+		if nN < 1 || m < 0 || m > nN || n < 0 || n > nN {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, imax(0, n+m-nN), imin(m, n), lowerTail, logP)
+	}
+}
+
+// HypergeometricNext returns random number drawn from the Hypergeometric distribution.
+func HypergeometricNext(nN, m, n int64) int64 {
+	return HypergeometricNextR(GlobalRand, nN, m, n)
+}
+
+// HypergeometricNextR returns random number drawn from the Hypergeometric distribution, using the random source src.
+func HypergeometricNextR(src *rand.Rand, nN, m, n int64) int64 {
+	return int64(hypergeometricInv(nN, m, n, UniformNextR(src, 0, 1)))
+}
+
+// Hypergeometric returns the random number generator with  Hypergeometric distribution.
+func Hypergeometric(nN, m, n int64) func() int64 {
+	return HypergeometricR(GlobalRand, nN, m, n)
+}
+
+// HypergeometricR returns the random number generator with  Hypergeometric distribution, using the random source src.
+func HypergeometricR(src *rand.Rand, nN, m, n int64) func() int64 {
+	next := hypergeometricDraw(nN, m, n)
+	return func() int64 { return next(src) }
+}
+
+// HypergeometricFill fills x with random numbers drawn from the Hypergeometric distribution.
+func HypergeometricFill(nN, m, n int64, x []int64) {
+	HypergeometricFillR(GlobalRand, nN, m, n, x)
+}
+
+// HypergeometricFillR fills x with random numbers drawn from the Hypergeometric distribution, using the random source src;
+// the table of the PMF is computed once for all the draws.
+func HypergeometricFillR(src *rand.Rand, nN, m, n int64, x []int64) {
+	next := hypergeometricDraw(nN, m, n)
+	for i := range x {
+		x[i] = next(src)
+	}
+}
+
+// hypergeometricDraw returns the sampler of the Hypergeometric distribution for many draws: by the guide table of the PMF
+// if the support has at most guideMax values, by the search of HypergeometricQtl otherwise.
+func hypergeometricDraw(nN, m, n int64) func(src *rand.Rand) int64 {
+	a, b := imax(0, n+m-nN), imin(m, n)
+	if b-a < guideMax {
+		return NewGuide(a, pmfTable(HypergeometricPMF(nN, m, n), a, b)).NextR
+	}
+	return func(src *rand.Rand) int64 { return HypergeometricNextR(src, nN, m, n) }
+}
+
+// HypergeometricFit returns the maximum-likelihood estimate of the number of successes m in the population of the Hypergeometric distribution with population nN and n draws, from the sample k,
+// the integer found by climbing from the mean nN k / n; its standard error is NaN.
+func HypergeometricFit(nN, n int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return HypergeometricDist{nN, int64(θ[0]), n}.LnPMF })
+	μ, _ := mleMeanVarInt(k)
+	// m within the range that makes the sample possible
+	a, b := mleMinMaxInt(k)
+	m := min(max(floor(μ*float64(nN)/float64(n)+0.5), float64(b)), float64(nN-n+a))
+	return mleAtFixed(lnL, mleIntClimb(lnL, []float64{m}, 0), mleAllFixed(1))
+}
+
+// HypergeometricDist is the Hypergeometric distribution: N draws without replacement from a population of size NN containing M successes. It implements Discrete.
+type HypergeometricDist struct {
+	NN, M, N int64
+}
+
+// PMF returns the value of PMF of the Hypergeometric distribution at k.
+func (d HypergeometricDist) PMF(k int64) float64 {
+	if a, b := d.Support(); k < a || k > b {
+		return 0
+	}
+	return HypergeometricPMFAt(d.NN, d.M, d.N, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Hypergeometric distribution at k.
+func (d HypergeometricDist) LnPMF(k int64) float64 {
+	if a, b := d.Support(); k < a || k > b {
+		return negInf
+	}
+	return HypergeometricLnPMF(d.NN, d.M, d.N)(k)
+}
+
+// CDF returns the value of CDF of the Hypergeometric distribution at k.
+func (d HypergeometricDist) CDF(k int64) float64 {
+	a, b := d.Support()
+	switch {
+	case k < a:
+		return 0
+	case k >= b:
+		return 1
+	}
+	return HypergeometricCDFAt(d.NN, d.M, d.N, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Hypergeometric distribution at k.
+func (d HypergeometricDist) Surv(k int64) float64 {
+	return HypergeometricCDFTail(d.NN, d.M, d.N, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Hypergeometric distribution at k.
+func (d HypergeometricDist) LnCDF(k int64) float64 {
+	return HypergeometricCDFTail(d.NN, d.M, d.N, true, true)(k)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Hypergeometric distribution at k.
+func (d HypergeometricDist) LnSurv(k int64) float64 {
+	return HypergeometricCDFTail(d.NN, d.M, d.N, false, true)(k)
+}
+
+// Qtl returns the quantile of the Hypergeometric distribution for probability p; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func (d HypergeometricDist) Qtl(p float64) int64 {
+	switch {
+	case d.NN < 1 || d.M < 0 || d.M > d.NN || d.N < 0 || d.N > d.NN:
+		panic(ErrQtlParam)
+	case !(p >= 0 && p <= 1):
+		panic(ErrQtlProb)
+	}
+	return int64(HypergeometricQtlFor(d.NN, d.M, d.N, p))
+}
+
+// QtlTail returns the quantile of the Hypergeometric distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d HypergeometricDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return HypergeometricQtlTail(d.NN, d.M, d.N, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Hypergeometric distribution.
+func (d HypergeometricDist) Rand() int64 { return HypergeometricNext(d.NN, d.M, d.N) }
+
+// RandR returns random number drawn from the Hypergeometric distribution, using the random source src.
+func (d HypergeometricDist) RandR(src *rand.Rand) int64 {
+	return HypergeometricNextR(src, d.NN, d.M, d.N)
+}
+
+// Mean returns the mean of the Hypergeometric distribution.
+func (d HypergeometricDist) Mean() float64 { return HypergeometricMean(d.NN, d.M, d.N) }
+
+// Var returns the variance of the Hypergeometric distribution.
+func (d HypergeometricDist) Var() float64 { return HypergeometricVar(d.NN, d.M, d.N) }
+
+// Skew returns the skewness of the Hypergeometric distribution.
+func (d HypergeometricDist) Skew() float64 { return HypergeometricSkew(d.NN, d.M, d.N) }
+
+// ExKurt returns the excess kurtosis of the Hypergeometric distribution.
+func (d HypergeometricDist) ExKurt() float64 { return HypergeometricExKurt(d.NN, d.M, d.N) }
+
+// Entropy returns the Shannon entropy of the Hypergeometric distribution.
+func (d HypergeometricDist) Entropy() float64 { return HypergeometricEntropy(d.NN, d.M, d.N) }
+
+// CF returns the characteristic function of the Hypergeometric distribution at t.
+func (d HypergeometricDist) CF(t float64) complex128 { return HypergeometricCF(d.NN, d.M, d.N, t) }
+
+// CGF returns the cumulant generating function of the Hypergeometric distribution at t.
+func (d HypergeometricDist) CGF(t float64) float64 { return HypergeometricCGF(d.NN, d.M, d.N, t) }
+
+// Support returns the support of the Hypergeometric distribution.
+func (d HypergeometricDist) Support() (a, b int64) { return imax(0, d.N+d.M-d.NN), imin(d.M, d.N) }
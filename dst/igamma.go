@@ -17,6 +17,10 @@ package dst
 // β > 0:		scale
 // Support:	x ∈ (0, ∞)
 
+import (
+	"math/rand"
+)
+
 // InvGammaPDF returns the PDF of the InvGamma distribution. 
 func InvGammaPDF(α, β float64) func(x float64) float64 {
 	return func(x float64) float64 {
@@ -80,8 +84,24 @@ func InvGammaCDFAt(α, β, x float64) float64 {
 	return cdf(x)
 }
 
+// InvGammaCDFTail returns the CDF of the InvGamma distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func InvGammaCDFTail(α, β float64, lowerTail, logP bool) func(x float64) float64 {
+	// X <= x if and only if 1/X >= 1/x, where 1/X is Gamma(α, 1/β)
+	cdf := GammaCDFTail(α, 1, !lowerTail, logP)
+	return func(x float64) float64 {
+		if isInf(α, 0) || isInf(β, 0) || α <= 0 || β <= 0 {
+			return NaN
+		}
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return cdf(β / x)
+	}
+}
+
 // InvGammaQtl returns the inverse of the CDF (quantile) of the InvGamma distribution. 
 func InvGammaQtl(α, β float64) func(p float64) float64 {
+	upper := GammaQtlTail(α, 1, false, false)
 	return func(p float64) float64 {
 		if isInf(α, 0) || isInf(β, 0) || α <= 0 || β <= 0 {
 			return NaN
@@ -95,9 +115,8 @@ func InvGammaQtl(α, β float64) func(p float64) float64 {
 		if p == 1 {
 			return posInf
 		}
-		//R_D_qIv
-		//    return β / qgamma(p, α, 1.0, !lower_tail, 0);
-		return β / GammaQtlFor(α, 1.0, 1-p)
+		// the upper tail of the Gamma distribution, without the loss of precision of 1 - p for small p
+		return β / upper(p)
 	}
 }
 
@@ -107,6 +126,52 @@ func InvGammaQtlFor(δ, γ, p float64) float64 {
 	return qtl(p)
 }
 
+// InvGammaQtlTail returns the inverse of InvGammaCDFTail (quantile) of the InvGamma distribution.
+func InvGammaQtlTail(α, β float64, lowerTail, logP bool) func(p float64) float64 {
+	qtl := GammaQtlTail(α, 1, !lowerTail, logP)
+	return func(p float64) float64 {
+		if isInf(α, 0) || isInf(β, 0) || α <= 0 || β <= 0 {
+			return NaN
+		}
+		return β / qtl(p)
+	}
+}
+
+// InvGammaNext returns random number drawn from the InvGamma distribution.
+func InvGammaNext(α, β float64) float64 {
+	return InvGammaNextR(GlobalRand, α, β)
+}
+
+// InvGammaNextR returns random number drawn from the InvGamma distribution, using the random source src.
+func InvGammaNextR(src *rand.Rand, α, β float64) float64 {
+	return β / GammaNextR(src, α, 1)
+}
+
+// InvGamma returns the random number generator with  InvGamma distribution.
+func InvGamma(α, β float64) func() float64 {
+	return InvGammaR(GlobalRand, α, β)
+}
+
+// InvGammaR returns the random number generator with  InvGamma distribution, using the random source src.
+func InvGammaR(src *rand.Rand, α, β float64) func() float64 {
+	gs := newGammaSampler(α, 1)
+	return func() float64 { return β / gs.next(src) }
+}
+
+// InvGammaFill fills x with random numbers drawn from the InvGamma distribution.
+func InvGammaFill(α, β float64, x []float64) {
+	InvGammaFillR(GlobalRand, α, β, x)
+}
+
+// InvGammaFillR fills x with random numbers drawn from the InvGamma distribution, using the random source src;
+// the sampler of the Gamma variate it inverts is set up once for all the draws.
+func InvGammaFillR(src *rand.Rand, α, β float64, x []float64) {
+	next := InvGammaR(src, α, β)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
 // InvGammaMean returns the mean of the InvGamma distribution. 
 func InvGammaMean(α, β float64) float64 {
 	if α <= 1 {
@@ -155,6 +220,28 @@ func InvGammaExKurt(α, β float64) float64 {
 	return (30*α - 66) / ((α - 3) * (α - 4))
 }
 
+// InvGammaEntropy returns the differential entropy of the Inverse Gamma distribution.
+func InvGammaEntropy(α, β float64) float64 {
+	return α + log(β) + LnΓ(α) - (1+α)*digamma(α)
+}
+
+// InvGammaCF returns the characteristic function of the Inverse Gamma distribution, by numerical integration.
+func InvGammaCF(α, β, t float64) complex128 {
+	return cfLnPDF(InvGammaLnPDF(α, β), InvGammaQtl(α, β), 0, posInf, t)
+}
+
+// InvGammaCGF returns the cumulant generating function of the Inverse Gamma distribution; +Inf for t > 0.
+func InvGammaCGF(α, β, t float64) float64 {
+	switch {
+	case t == 0:
+		return 0
+	case t > 0:
+		return posInf
+	}
+	// 2 (-βt)^(α/2) K_α(√(-4βt)) / Γ(α)
+	return Ln2 + α/2*log(-β*t) + besselKLn(α, 2*sqrt(-β*t)) - LnΓ(α)
+}
+
 // InvGammaMGF returns the moment-generating function of the InvGamma distribution. To be implemented ...
 
 /*  some old code...
@@ -192,3 +279,109 @@ func InvGammaCDF(α, β float64) func(x float64) float64 {
 	}
 }
 */
+
+// InvGammaFromMeanStd returns the Inverse-gamma distribution with mean μ and standard deviation σ.
+func InvGammaFromMeanStd(μ, σ float64) InvGammaDist {
+	if μ <= 0 || σ <= 0 {
+		return InvGammaDist{NaN, NaN}
+	}
+	α := 2 + μ*μ/(σ*σ)
+	return InvGammaDist{α, μ * (α - 1)}
+}
+
+// InvGammaFromModeStd returns the Inverse-gamma distribution with mode m and standard deviation σ.
+func InvGammaFromModeStd(m, σ float64) InvGammaDist {
+	if m <= 0 || σ <= 0 {
+		return InvGammaDist{NaN, NaN}
+	}
+	// with β = m (α + 1), the squared coefficient σ²/m² = (α + 1)² / ((α - 1)² (α - 2)) decreases in α > 2
+	α := bisectFn(func(α float64) float64 { return -(α + 1) * (α + 1) / ((α - 1) * (α - 1) * (α - 2)) }, -σ*σ/(m*m), 2, posInf)
+	return InvGammaDist{α, m * (α + 1)}
+}
+
+// InvGammaMatchQtls returns the Inverse-gamma distribution whose quantiles for the probabilities p best match x.
+func InvGammaMatchQtls(p, x []float64) InvGammaDist {
+	// 1/x are the quantiles of the Gamma distribution for 1 - p
+	α, θ := GammaReparamMeanStd(qtlMeanStd(matchMap(p, func(p float64) float64 { return 1 - p }), matchMap(x, func(x float64) float64 { return 1 / x })))
+	e := matchQtls(func(θ []float64) Continuous { return InvGammaDist{θ[0], θ[1]} }, p, x, []float64{α, 1 / θ}, []mleBound{mlePos, mlePos})
+	return InvGammaDist{e[0], e[1]}
+}
+
+// InvGammaFit returns the maximum-likelihood estimates of α, β of the Inverse-gamma distribution from the sample x,
+// those of the Gamma distribution of 1/x, whose scale is 1/β.
+func InvGammaFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return InvGammaLnPDF(θ[0], θ[1]) })
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = 1 / v
+	}
+	α, θ := gammaFit(y)
+	return mleAt(lnL, []float64{α, 1 / θ})
+}
+
+// InvGammaDist is the Inverse Gamma distribution with shape α = Alpha and scale β = Beta. It implements Continuous.
+type InvGammaDist struct {
+	Alpha, Beta float64
+}
+
+// PDF returns the value of PDF of the Inverse Gamma distribution at x.
+func (d InvGammaDist) PDF(x float64) float64 { return InvGammaPDFAt(d.Alpha, d.Beta, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Inverse Gamma distribution at x.
+func (d InvGammaDist) LnPDF(x float64) float64 { return InvGammaLnPDF(d.Alpha, d.Beta)(x) }
+
+// CDF returns the value of CDF of the Inverse Gamma distribution at x.
+func (d InvGammaDist) CDF(x float64) float64 { return InvGammaCDFTail(d.Alpha, d.Beta, true, false)(x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Inverse Gamma distribution at x.
+func (d InvGammaDist) Surv(x float64) float64 {
+	return InvGammaCDFTail(d.Alpha, d.Beta, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Inverse Gamma distribution at x.
+func (d InvGammaDist) LnCDF(x float64) float64 {
+	return InvGammaCDFTail(d.Alpha, d.Beta, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Inverse Gamma distribution at x.
+func (d InvGammaDist) LnSurv(x float64) float64 {
+	return InvGammaCDFTail(d.Alpha, d.Beta, false, true)(x)
+}
+
+// Qtl returns the quantile of the Inverse Gamma distribution for probability p.
+func (d InvGammaDist) Qtl(p float64) float64 { return InvGammaQtlFor(d.Alpha, d.Beta, p) }
+
+// QtlTail returns the quantile of the Inverse Gamma distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d InvGammaDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return InvGammaQtlTail(d.Alpha, d.Beta, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Inverse Gamma distribution.
+func (d InvGammaDist) Rand() float64 { return InvGammaNext(d.Alpha, d.Beta) }
+
+// RandR returns random number drawn from the Inverse Gamma distribution, using the random source src.
+func (d InvGammaDist) RandR(src *rand.Rand) float64 { return InvGammaNextR(src, d.Alpha, d.Beta) }
+
+// Mean returns the mean of the Inverse Gamma distribution.
+func (d InvGammaDist) Mean() float64 { return InvGammaMean(d.Alpha, d.Beta) }
+
+// Var returns the variance of the Inverse Gamma distribution.
+func (d InvGammaDist) Var() float64 { return InvGammaVar(d.Alpha, d.Beta) }
+
+// Skew returns the skewness of the Inverse Gamma distribution.
+func (d InvGammaDist) Skew() float64 { return InvGammaSkew(d.Alpha, d.Beta) }
+
+// ExKurt returns the excess kurtosis of the Inverse Gamma distribution.
+func (d InvGammaDist) ExKurt() float64 { return InvGammaExKurt(d.Alpha, d.Beta) }
+
+// Entropy returns the differential entropy of the Inverse Gamma distribution.
+func (d InvGammaDist) Entropy() float64 { return InvGammaEntropy(d.Alpha, d.Beta) }
+
+// CF returns the characteristic function of the Inverse Gamma distribution at t.
+func (d InvGammaDist) CF(t float64) complex128 { return InvGammaCF(d.Alpha, d.Beta, t) }
+
+// CGF returns the cumulant generating function of the Inverse Gamma distribution at t.
+func (d InvGammaDist) CGF(t float64) float64 { return InvGammaCGF(d.Alpha, d.Beta, t) }
+
+// Support returns the support of the Inverse Gamma distribution.
+func (d InvGammaDist) Support() (a, b float64) { return 0, posInf }
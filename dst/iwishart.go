@@ -14,6 +14,7 @@ package dst
 
 import (
 	m "github.com/skelterjohn/go.matrix"
+	"math/rand"
 )
 
 // InverseWishartPDF returns the PDF of the Inverse-Wishart distribution. 
@@ -52,14 +53,24 @@ func InverseWishartLnPDF(n int, Ψ *m.DenseMatrix) func(W *m.DenseMatrix) float6
 
 // InverseWishartNext returns random number drawn from the Inverse-Wishart distribution. 
 func InverseWishartNext(n int, V *m.DenseMatrix) *m.DenseMatrix {
-	return InverseWishart(n, V)()
+	return InverseWishartNextR(GlobalRand, n, V)
+}
+
+// InverseWishartNextR returns random number drawn from the Inverse-Wishart distribution, using the random source src.
+func InverseWishartNextR(src *rand.Rand, n int, V *m.DenseMatrix) *m.DenseMatrix {
+	return InverseWishartR(src, n, V)()
 }
 
 // InverseWishart returns the random number generator with  Inverse-Wishart distribution. 
 func InverseWishart(n int, V *m.DenseMatrix) func() *m.DenseMatrix {
+	return InverseWishartR(GlobalRand, n, V)
+}
+
+// InverseWishartR returns the random number generator with  Inverse-Wishart distribution, using the random source src.
+func InverseWishartR(src *rand.Rand, n int, V *m.DenseMatrix) func() *m.DenseMatrix {
 	p := V.Rows()
 	zeros := m.Zeros(p, 1)
-	rowGen := MVNormal(zeros, V)
+	rowGen := MVNormalR(src, zeros, V)
 	return func() *m.DenseMatrix {
 		x := make([][]float64, n)
 		for i := 0; i < n; i++ {
@@ -71,3 +82,17 @@ func InverseWishart(n int, V *m.DenseMatrix) func() *m.DenseMatrix {
 		return Sinv
 	}
 }
+
+// InverseWishartFill fills x with random matrices drawn from the Inverse-Wishart distribution.
+func InverseWishartFill(n int, V *m.DenseMatrix, x []*m.DenseMatrix) {
+	InverseWishartFillR(GlobalRand, n, V, x)
+}
+
+// InverseWishartFillR fills x with random matrices drawn from the Inverse-Wishart distribution, using the random source src;
+// the generator is set up once for all the draws.
+func InverseWishartFillR(src *rand.Rand, n int, V *m.DenseMatrix, x []*m.DenseMatrix) {
+	next := InverseWishartR(src, n, V)
+	for i := range x {
+		x[i] = next()
+	}
+}
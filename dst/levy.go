@@ -11,6 +11,10 @@ package dst
 // Support: 
 // x ∈ [δ, ∞)
 
+import (
+	"math/rand"
+)
+
 // LevyPDF returns the PDF of the Lévy distribution. 
 func LevyPDF(δ, γ float64) func(x float64) float64 {
 	return func(x float64) float64 {
@@ -63,6 +67,36 @@ func LevyCDFAt(δ, γ, x float64) float64 {
 	return cdf(x)
 }
 
+// LevyCDFTail returns the CDF of the Lévy distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func LevyCDFTail(δ, γ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if isNaN(x) || isNaN(δ) || isNaN(γ) {
+			return x + δ + γ
+		}
+		if γ <= 0 || δ <= 0 {
+			return NaN
+		}
+		if x <= δ {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		z := sqrt(γ / (x - δ))
+		if lowerTail {
+			// 2 * (1 - Φ(z))
+			p := pnorm(z, false, logP)
+			if logP {
+				return Ln2 + p
+			}
+			return 2 * p
+		}
+		// 2 * Φ(z) - 1
+		p := erf(z / sqrt2)
+		if logP {
+			return log(p)
+		}
+		return p
+	}
+}
+
 // LevyQtl returns the inverse of the CDF (quantile) of the Lévy distribution. 
 func LevyQtl(δ, γ float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -86,15 +120,60 @@ func LevyQtlFor(δ, γ, p float64) float64 {
 	return qtl(p)
 }
 
+// LevyQtlTail returns the inverse of LevyCDFTail (quantile) of the Lévy distribution.
+func LevyQtlTail(δ, γ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || isNaN(δ) || isNaN(γ) {
+			return p + δ + γ
+		}
+		if γ <= 0 || δ <= 0 || !pValid(p, logP) {
+			return NaN
+		}
+		var z float64
+		if lowerTail {
+			if logP {
+				z = qnorm(p-Ln2, false, true)
+			} else {
+				z = qnorm(p/2, false, false)
+			}
+		} else {
+			z = sqrt2 * erfinv(pLinear(p, logP))
+		}
+		return γ/(z*z) + δ
+	}
+}
+
 // LevyNext returns random number drawn from the Lévy distribution. 
 func LevyNext(δ, γ float64) float64 {
-	z := NormalNext(0, 1)
+	return LevyNextR(GlobalRand, δ, γ)
+}
+
+// LevyNextR returns random number drawn from the Lévy distribution, using the random source src.
+func LevyNextR(src *rand.Rand, δ, γ float64) float64 {
+	z := NormalNextR(src, 0, 1)
 	return γ/(z*z) + δ // Nolan 2009: 21, Eq. 1.12
 }
 
 // Levy returns the random number generator with  Lévy distribution. 
 func Levy(δ, γ float64) func() float64 {
-	return func() float64 { return LevyNext(δ, γ) }
+	return LevyR(GlobalRand, δ, γ)
+}
+
+// LevyR returns the random number generator with  Lévy distribution, using the random source src.
+func LevyR(src *rand.Rand, δ, γ float64) func() float64 {
+	return func() float64 { return LevyNextR(src, δ, γ) }
+}
+
+// LevyFill fills x with random numbers drawn from the Lévy distribution.
+func LevyFill(δ, γ float64, x []float64) {
+	LevyFillR(GlobalRand, δ, γ, x)
+}
+
+// LevyFillR fills x with random numbers drawn from the Lévy distribution, using the random source src.
+func LevyFillR(src *rand.Rand, δ, γ float64, x []float64) {
+	for i := range x {
+		x[i] = LevyNextR(src, δ, γ)
+	}
 }
 
 // LevyMean returns the mean of the Lévy distribution. 
@@ -126,8 +205,127 @@ func LevyStd(δ, γ float64) float64 {
 	return posInf
 }
 
+// LevyEntropy returns the differential entropy of the Lévy distribution.
+func LevyEntropy(δ, γ float64) float64 {
+	return (1 + 3*eulerγ + log(16*π*γ*γ)) / 2
+}
+
+// LevyCF returns the characteristic function of the Lévy distribution.
+func LevyCF(δ, γ, t float64) complex128 {
+	// e^{iδt - √(-2iγt)}, with √(-2iγt) = √(γ|t|) (1 - i sign t)
+	r := sqrt(γ * abs(t))
+	if t < 0 {
+		r = -r
+	}
+	return cexp(complex(-abs(r), δ*t+r))
+}
+
+// LevyCGF returns the cumulant generating function of the Lévy distribution; +Inf for t > 0.
+func LevyCGF(δ, γ, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return δ*t - sqrt(-2*γ*t)
+}
+
 // LevySkew is not defined. 
 
 // LevyExKurt is not defined. 
 
 // LevyMGF does not exist.
+
+// LevyMatchQtls returns the Lévy distribution whose quantiles for the probabilities p best match x.
+func LevyMatchQtls(p, x []float64) LevyDist {
+	// x = δ + γ / z², with z the quantile of the Normal distribution for 1 - p/2
+	a, b := matchLine(matchMap(p, func(p float64) float64 {
+		z := qnorm(p/2, false, false)
+		return 1 / (z * z)
+	}), x)
+	lo, _ := mleMinMax(x)
+	e := matchQtls(func(θ []float64) Continuous { return LevyDist{θ[0], θ[1]} }, p, x, []float64{a, b}, []mleBound{mleBound{0, lo}, mlePos})
+	return LevyDist{e[0], e[1]}
+}
+
+// LevyFit returns the maximum-likelihood estimates of δ, γ of the Lévy distribution from the sample x, with δ between 0 and its minimum.
+func LevyFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return LevyLnPDF(θ[0], θ[1]) })
+	a, _ := mleMinMax(x)
+	m := empiricalQtl(empiricalSorted(x), 7, 0.5)
+	δ := a - (m-a)/10
+	if δ <= 0 {
+		δ = a / 2
+	}
+	// the median is δ + γ / (2 erfc⁻¹(1/2)²)
+	return mleFit(lnL, []float64{δ, (m - δ) / 2.1981093001177866}, []mleBound{{0, a}, mlePos})
+}
+
+// LevyDist is the Lévy distribution with location δ = Delta and scale γ = Gamma. It implements Continuous.
+type LevyDist struct {
+	Delta, Gamma float64
+}
+
+// PDF returns the value of PDF of the Lévy distribution at x.
+func (d LevyDist) PDF(x float64) float64 {
+	if x <= d.Delta {
+		return 0
+	}
+	return LevyPDFAt(d.Delta, d.Gamma, x)
+}
+
+// LnPDF returns the natural logarithm of the PDF of the Lévy distribution at x.
+func (d LevyDist) LnPDF(x float64) float64 {
+	if x <= d.Delta {
+		return negInf
+	}
+	return LevyLnPDF(d.Delta, d.Gamma)(x)
+}
+
+// CDF returns the value of CDF of the Lévy distribution at x.
+func (d LevyDist) CDF(x float64) float64 { return LevyCDFTail(d.Delta, d.Gamma, true, false)(x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Lévy distribution at x.
+func (d LevyDist) Surv(x float64) float64 { return LevyCDFTail(d.Delta, d.Gamma, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Lévy distribution at x.
+func (d LevyDist) LnCDF(x float64) float64 { return LevyCDFTail(d.Delta, d.Gamma, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Lévy distribution at x.
+func (d LevyDist) LnSurv(x float64) float64 { return LevyCDFTail(d.Delta, d.Gamma, false, true)(x) }
+
+// Qtl returns the quantile of the Lévy distribution for probability p.
+func (d LevyDist) Qtl(p float64) float64 { return LevyQtlFor(d.Delta, d.Gamma, p) }
+
+// QtlTail returns the quantile of the Lévy distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d LevyDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return LevyQtlTail(d.Delta, d.Gamma, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Lévy distribution.
+func (d LevyDist) Rand() float64 { return LevyNext(d.Delta, d.Gamma) }
+
+// RandR returns random number drawn from the Lévy distribution, using the random source src.
+func (d LevyDist) RandR(src *rand.Rand) float64 { return LevyNextR(src, d.Delta, d.Gamma) }
+
+// Mean returns the mean of the Lévy distribution.
+func (d LevyDist) Mean() float64 { return LevyMean(d.Delta, d.Gamma) }
+
+// Var returns the variance of the Lévy distribution.
+func (d LevyDist) Var() float64 { return LevyVar(d.Delta, d.Gamma) }
+
+// Skew returns the skewness of the Lévy distribution.
+func (d LevyDist) Skew() float64 { return NaN } // undefined
+
+// ExKurt returns the excess kurtosis of the Lévy distribution.
+func (d LevyDist) ExKurt() float64 { return NaN } // undefined
+
+// Entropy returns the differential entropy of the Lévy distribution.
+func (d LevyDist) Entropy() float64 { return LevyEntropy(d.Delta, d.Gamma) }
+
+// CF returns the characteristic function of the Lévy distribution at t.
+func (d LevyDist) CF(t float64) complex128 { return LevyCF(d.Delta, d.Gamma, t) }
+
+// CGF returns the cumulant generating function of the Lévy distribution at t.
+func (d LevyDist) CGF(t float64) float64 { return LevyCGF(d.Delta, d.Gamma, t) }
+
+// Support returns the support of the Lévy distribution.
+func (d LevyDist) Support() (a, b float64) { return d.Delta, posInf }
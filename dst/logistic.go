@@ -12,6 +12,10 @@ package dst
 // Support: 
 // x ∈ R
 
+import (
+	"math/rand"
+)
+
 func log1pexp(x float64) float64 {
 	if x <= 18 {
 		return log1p(exp(x))
@@ -102,6 +106,23 @@ func LogisticCDFAt(μ, σ, x float64) float64 {
 	return cdf(x)
 }
 
+// LogisticCDFTail returns the CDF of the Logistic distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func LogisticCDFTail(μ, σ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if isNaN(x) || isNaN(μ) || isNaN(σ) {
+			return x + μ + σ
+		}
+		if σ <= 0 {
+			return NaN
+		}
+		x = (x - μ) / σ
+		if isNaN(x) {
+			return NaN
+		}
+		return pTailLn(-log1pexp(-x), -log1pexp(x), lowerTail, logP)
+	}
+}
+
 // LogisticQtl returns the inverse of the CDF (quantile) of the Logistic distribution. 
 func LogisticQtl(μ, σ float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -127,15 +148,51 @@ func LogisticQtlFor(μ, σ, p float64) float64 {
 	return qtl(p)
 }
 
+// LogisticQtlTail returns the inverse of LogisticCDFTail (quantile) of the Logistic distribution.
+func LogisticQtlTail(μ, σ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || isNaN(μ) || isNaN(σ) {
+			return p + μ + σ
+		}
+		if σ <= 0 || !pValid(p, logP) {
+			return NaN
+		}
+		// logit(p) = log(p / (1-p))
+		return μ + σ*(pLnLower(p, lowerTail, logP)-pLnUpper(p, lowerTail, logP))
+	}
+}
+
 // LogisticNext returns random number drawn from the Logistic distribution. 
 func LogisticNext(μ, σ float64) float64 {
-	p := UniformNext(0, 1)
+	return LogisticNextR(GlobalRand, μ, σ)
+}
+
+// LogisticNextR returns random number drawn from the Logistic distribution, using the random source src.
+func LogisticNextR(src *rand.Rand, μ, σ float64) float64 {
+	p := UniformNextR(src, 0, 1)
 	return LogisticQtlFor(μ, σ, p)
 }
 
 // Logistic returns the random number generator with  Logistic distribution. 
 func Logistic(μ, σ float64) func() float64 {
-	return func() float64 { return LogisticNext(μ, σ) }
+	return LogisticR(GlobalRand, μ, σ)
+}
+
+// LogisticR returns the random number generator with  Logistic distribution, using the random source src.
+func LogisticR(src *rand.Rand, μ, σ float64) func() float64 {
+	return func() float64 { return LogisticNextR(src, μ, σ) }
+}
+
+// LogisticFill fills x with random numbers drawn from the Logistic distribution.
+func LogisticFill(μ, σ float64, x []float64) {
+	LogisticFillR(GlobalRand, μ, σ, x)
+}
+
+// LogisticFillR fills x with random numbers drawn from the Logistic distribution, using the random source src.
+func LogisticFillR(src *rand.Rand, μ, σ float64, x []float64) {
+	for i := range x {
+		x[i] = LogisticNextR(src, μ, σ)
+	}
 }
 
 // LogisticMean returns the mean of the Logistic distribution. 
@@ -173,7 +230,129 @@ func LogisticExKurt(μ, σ float64) float64 {
 	return 6.0 / 5.0
 }
 
+// LogisticEntropy returns the differential entropy of the Logistic distribution.
+func LogisticEntropy(μ, σ float64) float64 {
+	return log(σ) + 2
+}
+
+// LogisticCF returns the characteristic function of the Logistic distribution.
+func LogisticCF(μ, σ, t float64) complex128 {
+	// e^{iμt} πσt / sinh(πσt)
+	h, s := π*σ*t, fOne
+	if h != 0 {
+		s = 2 * h / (expm1(h) - expm1(-h))
+	}
+	return cexp(complex(0, μ*t)) * complex(s, 0)
+}
+
+// LogisticCGF returns the cumulant generating function of the Logistic distribution; +Inf for |σt| ≥ 1.
+func LogisticCGF(μ, σ, t float64) float64 {
+	if abs(σ*t) >= 1 {
+		return posInf
+	}
+	// μt + ln B(1-σt, 1+σt) = μt + ln(πσt / sin(πσt))
+	h := π * σ * t
+	if h == 0 {
+		return 0
+	}
+	return μ*t + log(h/sin(h))
+}
+
 // LogisticMGF returns the moment-generating function of the Logistic distribution. 
 func LogisticMGF(μ, σ, t float64) float64 {
-	return exp(μ*t) * B(0, 2) // TO BE CHECKED
+	if abs(σ*t) >= 1 {
+		return posInf
+	}
+	return exp(μ*t) * B(1-σ*t, 1+σ*t)
 }
+
+// LogisticFromMeanStd returns the Logistic distribution with mean μ and standard deviation σ.
+func LogisticFromMeanStd(μ, σ float64) LogisticDist {
+	if σ <= 0 {
+		return LogisticDist{NaN, NaN}
+	}
+	return LogisticDist{μ, σ * sqrt(3) / π}
+}
+
+// LogisticFromModeStd returns the Logistic distribution with mode m and standard deviation σ.
+func LogisticFromModeStd(m, σ float64) LogisticDist {
+	return LogisticFromMeanStd(m, σ)
+}
+
+// LogisticMatchQtls returns the Logistic distribution whose quantiles for the probabilities p best match x.
+func LogisticMatchQtls(p, x []float64) LogisticDist {
+	// x = μ + σ log(p/(1-p))
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return log(p / (1 - p)) }), x)
+	e := matchQtls(func(θ []float64) Continuous { return LogisticDist{θ[0], θ[1]} }, p, x, []float64{a, b}, []mleBound{mleReal, mlePos})
+	return LogisticDist{e[0], e[1]}
+}
+
+// LogisticFit returns the maximum-likelihood estimates of μ, σ of the Logistic distribution from the sample x.
+func LogisticFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return LogisticLnPDF(θ[0], θ[1]) })
+	μ, σ2 := mleMeanVar(x)
+	return mleFit(lnL, []float64{μ, sqrt(3*σ2) / π}, []mleBound{mleReal, mlePos})
+}
+
+// LogisticDist is the Logistic distribution with location μ = Mu and scale σ = Sigma. It implements Continuous.
+type LogisticDist struct {
+	Mu, Sigma float64
+}
+
+// PDF returns the value of PDF of the Logistic distribution at x.
+func (d LogisticDist) PDF(x float64) float64 { return LogisticPDFAt(d.Mu, d.Sigma, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Logistic distribution at x.
+func (d LogisticDist) LnPDF(x float64) float64 { return LogisticLnPDF(d.Mu, d.Sigma)(x) }
+
+// CDF returns the value of CDF of the Logistic distribution at x.
+func (d LogisticDist) CDF(x float64) float64 { return LogisticCDFAt(d.Mu, d.Sigma, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Logistic distribution at x.
+func (d LogisticDist) Surv(x float64) float64 { return LogisticCDFTail(d.Mu, d.Sigma, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Logistic distribution at x.
+func (d LogisticDist) LnCDF(x float64) float64 { return LogisticCDFTail(d.Mu, d.Sigma, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Logistic distribution at x.
+func (d LogisticDist) LnSurv(x float64) float64 {
+	return LogisticCDFTail(d.Mu, d.Sigma, false, true)(x)
+}
+
+// Qtl returns the quantile of the Logistic distribution for probability p.
+func (d LogisticDist) Qtl(p float64) float64 { return LogisticQtlFor(d.Mu, d.Sigma, p) }
+
+// QtlTail returns the quantile of the Logistic distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d LogisticDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return LogisticQtlTail(d.Mu, d.Sigma, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Logistic distribution.
+func (d LogisticDist) Rand() float64 { return LogisticNext(d.Mu, d.Sigma) }
+
+// RandR returns random number drawn from the Logistic distribution, using the random source src.
+func (d LogisticDist) RandR(src *rand.Rand) float64 { return LogisticNextR(src, d.Mu, d.Sigma) }
+
+// Mean returns the mean of the Logistic distribution.
+func (d LogisticDist) Mean() float64 { return LogisticMean(d.Mu, d.Sigma) }
+
+// Var returns the variance of the Logistic distribution.
+func (d LogisticDist) Var() float64 { return LogisticVar(d.Mu, d.Sigma) }
+
+// Skew returns the skewness of the Logistic distribution.
+func (d LogisticDist) Skew() float64 { return LogisticSkew(d.Mu, d.Sigma) }
+
+// ExKurt returns the excess kurtosis of the Logistic distribution.
+func (d LogisticDist) ExKurt() float64 { return LogisticExKurt(d.Mu, d.Sigma) }
+
+// Entropy returns the differential entropy of the Logistic distribution.
+func (d LogisticDist) Entropy() float64 { return LogisticEntropy(d.Mu, d.Sigma) }
+
+// CF returns the characteristic function of the Logistic distribution at t.
+func (d LogisticDist) CF(t float64) complex128 { return LogisticCF(d.Mu, d.Sigma, t) }
+
+// CGF returns the cumulant generating function of the Logistic distribution at t.
+func (d LogisticDist) CGF(t float64) float64 { return LogisticCGF(d.Mu, d.Sigma, t) }
+
+// Support returns the support of the Logistic distribution.
+func (d LogisticDist) Support() (a, b float64) { return negInf, posInf }
@@ -12,14 +12,29 @@ package dst
 // x ∈ R
 
 import (
-
-//	"math/rand"
+	"math/rand"
 )
 
 // LogNormalPDF returns the PDF of the LogNormal distribution. 
 func LogNormalPDF(μ, σ float64) func(x float64) float64 {
 	normalogormalizer := 0.3989422804014327 / σ
-	return func(x float64) float64 { return normalogormalizer * exp(-1*(log(x)-μ)*(log(x)-μ)/(2*σ*σ)) / x }
+	return func(x float64) float64 {
+		if x <= 0 {
+			return 0
+		}
+		return normalogormalizer * exp(-1*(log(x)-μ)*(log(x)-μ)/(2*σ*σ)) / x
+	}
+}
+
+// LogNormalLnPDF returns the natural logarithm of the PDF of the LogNormal distribution.
+func LogNormalLnPDF(μ, σ float64) func(x float64) float64 {
+	lnnormalizer := -0.91893853320467267 - log(σ)
+	return func(x float64) float64 {
+		if x <= 0 {
+			return negInf
+		}
+		return lnnormalizer - (log(x)-μ)*(log(x)-μ)/(2*σ*σ) - log(x)
+	}
 }
 
 // LogNormalPDFAt returns the value of PDF of LogNormal distribution at x. 
@@ -30,7 +45,12 @@ func LogNormalPDFAt(μ, σ, x float64) float64 {
 
 // LogNormalCDF returns the CDF of the LogNormal distribution. 
 func LogNormalCDF(μ, σ float64) func(x float64) float64 {
-	return func(x float64) float64 { return ((1.0 / 2.0) * (1 + erf((log(x)-μ)/(σ*sqrt2)))) }
+	return func(x float64) float64 {
+		if x <= 0 {
+			return 0
+		}
+		return ((1.0 / 2.0) * (1 + erf((log(x)-μ)/(σ*sqrt2))))
+	}
 }
 
 // LogNormalCDFAt returns the value of CDF of the LogNormal distribution, at x. 
@@ -39,6 +59,16 @@ func LogNormalCDFAt(μ, σ, x float64) float64 {
 	return cdf(x)
 }
 
+// LogNormalCDFTail returns the CDF of the Log-normal distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func LogNormalCDFTail(μ, σ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pnorm((log(x)-μ)/σ, lowerTail, logP)
+	}
+}
+
 // LogNormalQtl returns the inverse of the CDF (quantile) of the LogNormal distribution. 
 func LogNormalQtl(μ, σ float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -52,12 +82,39 @@ func LogNormalQtlFor(μ, σ, p float64) float64 {
 	return qtl(p)
 }
 
+// LogNormalQtlTail returns the inverse of LogNormalCDFTail (quantile) of the Log-normal distribution.
+func LogNormalQtlTail(μ, σ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		return exp(μ + σ*qnorm(p, lowerTail, logP))
+	}
+}
+
 // LogNormalNext returns random number drawn from the LogNormal distribution. 
-func LogNormalNext(μ, σ float64) float64 { return exp(NormalNext(μ, σ)) }
+func LogNormalNext(μ, σ float64) float64 { return LogNormalNextR(GlobalRand, μ, σ) }
+
+// LogNormalNextR returns random number drawn from the LogNormal distribution, using the random source src.
+func LogNormalNextR(src *rand.Rand, μ, σ float64) float64 { return exp(NormalNextR(src, μ, σ)) }
 
 // LogNormal returns the random number generator with  LogNormal distribution. 
 func LogNormal(μ, σ float64) func() float64 {
-	return func() float64 { return LogNormalNext(μ, σ) }
+	return LogNormalR(GlobalRand, μ, σ)
+}
+
+// LogNormalR returns the random number generator with  LogNormal distribution, using the random source src.
+func LogNormalR(src *rand.Rand, μ, σ float64) func() float64 {
+	return func() float64 { return LogNormalNextR(src, μ, σ) }
+}
+
+// LogNormalFill fills x with random numbers drawn from the LogNormal distribution.
+func LogNormalFill(μ, σ float64, x []float64) {
+	LogNormalFillR(GlobalRand, μ, σ, x)
+}
+
+// LogNormalFillR fills x with random numbers drawn from the LogNormal distribution, using the random source src.
+func LogNormalFillR(src *rand.Rand, μ, σ float64, x []float64) {
+	for i := range x {
+		x[i] = LogNormalNextR(src, μ, σ)
+	}
 }
 
 // LogNormalMean returns the mean of the LogNormal distribution. 
@@ -77,7 +134,7 @@ func LogNormalMedian(μ, σ float64) float64 {
 
 // LogNormalVar returns the variance of the LogNormal distribution. 
 func LogNormalVar(μ, σ float64) float64 {
-	return exp(σ*σ) - 1*exp(2*μ+σ*σ)
+	return (exp(σ*σ) - 1) * exp(2*μ+σ*σ)
 }
 
 // LogNormalStd returns the standard deviation of the LogNormal distribution. 
@@ -87,10 +144,133 @@ func LogNormalStd(μ, σ float64) float64 {
 
 // LogNormalSkew returns the skewness of the LogNormal distribution. 
 func LogNormalSkew(μ, σ float64) float64 {
-	return exp(σ*σ) + 2*sqrt(exp(σ*σ)-1)
+	return (exp(σ*σ) + 2) * sqrt(exp(σ*σ)-1)
 }
 
 // LogNormalExKurt returns the excess kurtosis of the LogNormal distribution. 
 func LogNormalExKurt(μ, σ float64) float64 {
 	return exp(4*σ*σ) + 2*exp(3*σ*σ) + 3*exp(2*σ*σ) - 6
 }
+
+// LogNormalEntropy returns the differential entropy of the Log-normal distribution.
+func LogNormalEntropy(μ, σ float64) float64 {
+	return μ + log(σ) + 0.5 + M_LN_SQRT_2PI
+}
+
+// LogNormalCF returns the characteristic function of the Log-normal distribution, by numerical integration.
+func LogNormalCF(μ, σ, t float64) complex128 {
+	return cfLnPDF(LogNormalLnPDF(μ, σ), LogNormalQtl(μ, σ), 0, posInf, t)
+}
+
+// LogNormalCGF returns the cumulant generating function of the Log-normal distribution, by numerical integration; +Inf for t > 0.
+func LogNormalCGF(μ, σ, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfLnPDF(LogNormalLnPDF(μ, σ), LogNormalQtl(μ, σ), 0, posInf, t)
+}
+
+// LogNormalFromMeanStd returns the Log-normal distribution with mean m and standard deviation s.
+func LogNormalFromMeanStd(m, s float64) LogNormalDist {
+	if m <= 0 || s <= 0 {
+		return LogNormalDist{NaN, NaN}
+	}
+	σ2 := log1p(s * s / (m * m))
+	return LogNormalDist{log(m) - σ2/2, sqrt(σ2)}
+}
+
+// LogNormalFromModeStd returns the Log-normal distribution with mode m and standard deviation s.
+func LogNormalFromModeStd(m, s float64) LogNormalDist {
+	if m <= 0 || s <= 0 {
+		return LogNormalDist{NaN, NaN}
+	}
+	// with μ = log m + σ², the variance m² exp(3σ²) (exp(σ²) - 1) increases in σ²
+	σ2 := bisectFn(func(t float64) float64 { return exp(3*t) * expm1(t) }, s*s/(m*m), 0, posInf)
+	return LogNormalDist{log(m) + σ2, sqrt(σ2)}
+}
+
+// LogNormalMatchQtls returns the Log-normal distribution whose quantiles for the probabilities p best match x.
+func LogNormalMatchQtls(p, x []float64) LogNormalDist {
+	// log x are the quantiles of the Normal distribution
+	μ, σ := qtlMeanStd(p, matchMap(x, log))
+	e := matchQtls(func(θ []float64) Continuous { return LogNormalDist{θ[0], θ[1]} }, p, x, []float64{μ, σ}, []mleBound{mleReal, mlePos})
+	return LogNormalDist{e[0], e[1]}
+}
+
+// LogNormalFit returns the maximum-likelihood estimates of μ, σ of the Log-normal distribution from the sample x: the mean and the standard deviation with divisor n of log x.
+func LogNormalFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return LogNormalLnPDF(θ[0], θ[1]) })
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = log(v)
+	}
+	μ, σ2 := mleMeanVar(y)
+	return mleAt(lnL, []float64{μ, sqrt(σ2)})
+}
+
+// LogNormalDist is the Log-normal distribution with parameters μ = Mu and σ = Sigma of the underlying Normal distribution. It implements Continuous.
+type LogNormalDist struct {
+	Mu, Sigma float64
+}
+
+// PDF returns the value of PDF of the Log-normal distribution at x.
+func (d LogNormalDist) PDF(x float64) float64 { return LogNormalPDFAt(d.Mu, d.Sigma, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Log-normal distribution at x.
+func (d LogNormalDist) LnPDF(x float64) float64 { return LogNormalLnPDF(d.Mu, d.Sigma)(x) }
+
+// CDF returns the value of CDF of the Log-normal distribution at x.
+func (d LogNormalDist) CDF(x float64) float64 { return LogNormalCDFTail(d.Mu, d.Sigma, true, false)(x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Log-normal distribution at x.
+func (d LogNormalDist) Surv(x float64) float64 {
+	return LogNormalCDFTail(d.Mu, d.Sigma, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Log-normal distribution at x.
+func (d LogNormalDist) LnCDF(x float64) float64 {
+	return LogNormalCDFTail(d.Mu, d.Sigma, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Log-normal distribution at x.
+func (d LogNormalDist) LnSurv(x float64) float64 {
+	return LogNormalCDFTail(d.Mu, d.Sigma, false, true)(x)
+}
+
+// Qtl returns the quantile of the Log-normal distribution for probability p.
+func (d LogNormalDist) Qtl(p float64) float64 { return LogNormalQtlFor(d.Mu, d.Sigma, p) }
+
+// QtlTail returns the quantile of the Log-normal distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d LogNormalDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return LogNormalQtlTail(d.Mu, d.Sigma, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Log-normal distribution.
+func (d LogNormalDist) Rand() float64 { return LogNormalNext(d.Mu, d.Sigma) }
+
+// RandR returns random number drawn from the Log-normal distribution, using the random source src.
+func (d LogNormalDist) RandR(src *rand.Rand) float64 { return LogNormalNextR(src, d.Mu, d.Sigma) }
+
+// Mean returns the mean of the Log-normal distribution.
+func (d LogNormalDist) Mean() float64 { return LogNormalMean(d.Mu, d.Sigma) }
+
+// Var returns the variance of the Log-normal distribution.
+func (d LogNormalDist) Var() float64 { return LogNormalVar(d.Mu, d.Sigma) }
+
+// Skew returns the skewness of the Log-normal distribution.
+func (d LogNormalDist) Skew() float64 { return LogNormalSkew(d.Mu, d.Sigma) }
+
+// ExKurt returns the excess kurtosis of the Log-normal distribution.
+func (d LogNormalDist) ExKurt() float64 { return LogNormalExKurt(d.Mu, d.Sigma) }
+
+// Entropy returns the differential entropy of the Log-normal distribution.
+func (d LogNormalDist) Entropy() float64 { return LogNormalEntropy(d.Mu, d.Sigma) }
+
+// CF returns the characteristic function of the Log-normal distribution at t.
+func (d LogNormalDist) CF(t float64) complex128 { return LogNormalCF(d.Mu, d.Sigma, t) }
+
+// CGF returns the cumulant generating function of the Log-normal distribution at t.
+func (d LogNormalDist) CGF(t float64) float64 { return LogNormalCGF(d.Mu, d.Sigma, t) }
+
+// Support returns the support of the Log-normal distribution.
+func (d LogNormalDist) Support() (a, b float64) { return 0, posInf }
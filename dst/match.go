@@ -0,0 +1,111 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Parameters from the mean, the mode or the quantiles.
+// The families whose two parameters are determined by the mean and the standard deviation provide XxxFromMeanStd(μ, σ),
+// and those of them with a mode XxxFromModeStd(mode, σ); both return the distribution XxxDist with these values,
+// in closed form where it exists, and by solving one equation in the shape numerically otherwise.
+// The continuous families without integer parameters provide XxxMatchQtls(p, x), which returns the distribution whose quantiles
+// for the probabilities p best match x, in the least-squares sense on the normal scale of the probabilities: it minimizes
+// Σ (Φ⁻¹(F(xi)) - Φ⁻¹(pi))² by the Nelder-Mead simplex method, which is zero when a member of the family has these quantiles,
+// as with two quantiles of a two-parameter family. XxxMatchQtls needs at least as many quantiles as the family has parameters;
+// BetaMatchQtls extends bayes.BetaFromQtls, of two quantiles, to any number of them.
+// The parameters are NaN where no member of the family matches.
+// The circular families, and the families with integer parameters, have no XxxMatchQtls.
+
+// matchQtls returns the parameters θ, within the bounds, of the distribution d(θ) whose quantiles for the probabilities p best match x,
+// searched from θ0; NaN if there are fewer quantiles than parameters, or the probabilities are not within (0, 1).
+func matchQtls(d func(θ []float64) Continuous, p, x, θ0 []float64, bounds []mleBound) []float64 {
+	n := len(θ0)
+	θ := make([]float64, n)
+	z := make([]float64, len(p))
+	valid := len(p) == len(x) && len(p) >= n
+	for i := 0; valid && i < len(p); i++ {
+		valid = p[i] > 0 && p[i] < 1 && !isNaN(x[i])
+		z[i] = qnorm(p[i], true, false)
+	}
+	if !valid {
+		for i := range θ {
+			θ[i] = NaN
+		}
+		return θ
+	}
+	u := make([]float64, n)
+	for i, b := range bounds {
+		u[i] = b.from(matchStart(θ0[i], b))
+	}
+	f := func(u []float64) float64 {
+		for i, b := range bounds {
+			θ[i] = b.to(u[i])
+		}
+		dist := d(θ)
+		s := fZero
+		for i, v := range x {
+			// the upper tail keeps its precision above the median
+			var e float64
+			if z[i] > 0 {
+				e = qnorm(dist.Surv(v), false, false) - z[i]
+			} else {
+				e = qnorm(dist.CDF(v), true, false) - z[i]
+			}
+			s += e * e
+		}
+		return s
+	}
+	u = nelderMead(f, u)
+	for i, b := range bounds {
+		θ[i] = b.to(u[i])
+	}
+	return θ
+}
+
+// matchStart returns θ if it lies within the bound, and a value within it otherwise.
+func matchStart(θ float64, b mleBound) float64 {
+	if θ > b.Lo && θ < b.Hi {
+		return θ
+	}
+	switch {
+	case isInf(b.Lo, -1) && isInf(b.Hi, 1):
+		return 0
+	case isInf(b.Hi, 1):
+		return b.Lo + 1
+	case isInf(b.Lo, -1):
+		return b.Hi - 1
+	}
+	return (b.Lo + b.Hi) / 2
+}
+
+// matchLine returns the intercept a and the slope b of the least-squares line x = a + b w, the start of the quantile matching
+// of the families whose quantile function, transformed, is linear in a function w of the probability.
+func matchLine(w, x []float64) (a, b float64) {
+	n := float64(len(w))
+	var mw, mx, sww, swx float64
+	for i := range w {
+		mw += w[i] / n
+		mx += x[i] / n
+	}
+	for i := range w {
+		sww += (w[i] - mw) * (w[i] - mw)
+		swx += (w[i] - mw) * (x[i] - mx)
+	}
+	b = swx / sww
+	a = mx - b*mw
+	return
+}
+
+// matchMap returns the values f(v) for v in x.
+func matchMap(x []float64, f func(v float64) float64) []float64 {
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = f(v)
+	}
+	return y
+}
+
+// qtlMeanStd returns the mean and the standard deviation of the Normal distribution whose quantiles for the probabilities p best match x,
+// the start of the quantile matching of the families without a linearizing transform.
+func qtlMeanStd(p, x []float64) (μ, σ float64) {
+	z := matchMap(p, func(p float64) float64 { return qnorm(p, true, false) })
+	return matchLine(z, x)
+}
@@ -3,6 +3,7 @@ package dst
 import (
 	"fmt"
 	mx "github.com/skelterjohn/go.matrix"
+	"math/rand"
 )
 
 func checkMatrixNormal(M, Omega, Sigma *mx.DenseMatrix) {
@@ -123,17 +124,41 @@ func MatrixNormalLnPDF(M, Omega, Sigma *mx.DenseMatrix) func(A *mx.DenseMatrix)
 	}
 }
 func MatrixNormal(M, Omega, Sigma *mx.DenseMatrix) func() (X *mx.DenseMatrix) {
+	return MatrixNormalR(GlobalRand, M, Omega, Sigma)
+}
+
+// MatrixNormalR returns the random number generator with  Matrix normal distribution, using the random source src.
+func MatrixNormalR(src *rand.Rand, M, Omega, Sigma *mx.DenseMatrix) func() (X *mx.DenseMatrix) {
 	checkMatrixNormal(M, Omega, Sigma)
 
 	Mv := mx.Vectorize(M)
 	Cov := mx.Kronecker(Omega, Sigma)
-	normal := MVNormal(Mv, Cov)
+	normal := MVNormalR(src, Mv, Cov)
 	return func() (X *mx.DenseMatrix) {
 		Xv := normal()
 		X = mx.Unvectorize(Xv, M.Rows(), M.Cols())
 		return
 	}
 }
+
+// MatrixNormalFill fills x with random matrices drawn from the Matrix normal distribution.
+func MatrixNormalFill(M, Omega, Sigma *mx.DenseMatrix, x []*mx.DenseMatrix) {
+	MatrixNormalFillR(GlobalRand, M, Omega, Sigma, x)
+}
+
+// MatrixNormalFillR fills x with random matrices drawn from the Matrix normal distribution, using the random source src;
+// the generator is set up once for all the draws.
+func MatrixNormalFillR(src *rand.Rand, M, Omega, Sigma *mx.DenseMatrix, x []*mx.DenseMatrix) {
+	next := MatrixNormalR(src, M, Omega, Sigma)
+	for i := range x {
+		x[i] = next()
+	}
+}
 func MatrixNormalNext(M, Omega, Sigma *mx.DenseMatrix) (X *mx.DenseMatrix) {
-	return MatrixNormal(M, Omega, Sigma)()
+	return MatrixNormalNextR(GlobalRand, M, Omega, Sigma)
+}
+
+// MatrixNormalNextR returns random number drawn from the Matrix normal distribution, using the random source src.
+func MatrixNormalNextR(src *rand.Rand, M, Omega, Sigma *mx.DenseMatrix) (X *mx.DenseMatrix) {
+	return MatrixNormalR(src, M, Omega, Sigma)()
 }
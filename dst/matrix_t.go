@@ -3,6 +3,7 @@ package dst
 import (
 	"fmt"
 	mx "github.com/skelterjohn/go.matrix"
+	"math/rand"
 )
 
 func checkMatrixT(M, Omega, Sigma *mx.DenseMatrix, n int) {
@@ -112,11 +113,12 @@ func MatrixTLnPDF(M, Omega, Sigma *mx.DenseMatrix, n int) func(T *mx.DenseMatrix
 }
 
 func MatrixT(M, Omega, Sigma *mx.DenseMatrix, n int) func() (T *mx.DenseMatrix) {
-	checkMatrixT(M, Omega, Sigma, n)
+	return MatrixTR(GlobalRand, M, Omega, Sigma, n)
+}
 
-	fmt.Println("M:", M)
-	fmt.Println("Sigma:", Sigma)
-	fmt.Println("Omega:", Omega)
+// MatrixTR returns the random number generator with  Matrix T distribution, using the random source src.
+func MatrixTR(src *rand.Rand, M, Omega, Sigma *mx.DenseMatrix, n int) func() (T *mx.DenseMatrix) {
+	checkMatrixT(M, Omega, Sigma, n)
 
 	p := M.Rows()
 	m := M.Cols()
@@ -126,9 +128,9 @@ func MatrixT(M, Omega, Sigma *mx.DenseMatrix, n int) func() (T *mx.DenseMatrix)
 		panic(err)
 	}
 
-	Sdist := Wishart(n+p-1, OmegaInv)
+	Sdist := WishartR(src, n+p-1, OmegaInv)
 
-	Xdist := MatrixNormal(mx.Zeros(p, m), mx.Eye(p), Sigma)
+	Xdist := MatrixNormalR(src, mx.Zeros(p, m), mx.Eye(p), Sigma)
 
 	return func() (T *mx.DenseMatrix) {
 		S := Sdist()
@@ -141,8 +143,6 @@ func MatrixT(M, Omega, Sigma *mx.DenseMatrix, n int) func() (T *mx.DenseMatrix)
 			panic(err)
 		}
 		X := Xdist()
-		fmt.Println("Sinvc:", Sinvc)
-		fmt.Println("X:", X)
 		T, err = Sinvc.Transpose().TimesDense(X)
 		if err != nil {
 			panic(err)
@@ -155,6 +155,25 @@ func MatrixT(M, Omega, Sigma *mx.DenseMatrix, n int) func() (T *mx.DenseMatrix)
 	}
 }
 
+// MatrixTFill fills x with random matrices drawn from the Matrix T distribution.
+func MatrixTFill(M, Omega, Sigma *mx.DenseMatrix, n int, x []*mx.DenseMatrix) {
+	MatrixTFillR(GlobalRand, M, Omega, Sigma, n, x)
+}
+
+// MatrixTFillR fills x with random matrices drawn from the Matrix T distribution, using the random source src;
+// the generator is set up once for all the draws.
+func MatrixTFillR(src *rand.Rand, M, Omega, Sigma *mx.DenseMatrix, n int, x []*mx.DenseMatrix) {
+	next := MatrixTR(src, M, Omega, Sigma, n)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
 func MatrixTNext(M, Omega, Sigma *mx.DenseMatrix, n int) (T *mx.DenseMatrix) {
-	return MatrixT(M, Omega, Sigma, n)()
+	return MatrixTNextR(GlobalRand, M, Omega, Sigma, n)
+}
+
+// MatrixTNextR returns random number drawn from the Matrix T distribution, using the random source src.
+func MatrixTNextR(src *rand.Rand, M, Omega, Sigma *mx.DenseMatrix, n int) (T *mx.DenseMatrix) {
+	return MatrixTR(src, M, Omega, Sigma, n)()
 }
@@ -0,0 +1,363 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Finite mixture distributions.
+// X is drawn from the component distribution D[i] chosen with probability W[i]: multimodal priors and likelihoods,
+// or populations made of several subpopulations.
+// MixtureDist{[]float64{0.3, 0.7}, []Continuous{NormalDist{-2, 1}, NormalDist{3, 0.5}}} is a bimodal Normal mixture.
+// The weights W[i] ≥ 0 need not sum to one; they are normalized.
+// Logarithms of the density and of the tails are summed on the log scale, so that they keep their precision far in the tails;
+// quantiles are found numerically between the quantiles of the components.
+//
+// Support:
+// the union of the supports of the components
+
+import (
+	"math/rand"
+)
+
+// lnWeights returns the logarithms of the weights w, normalized to sum to one.
+func lnWeights(w []float64) []float64 {
+	var s float64
+	for _, v := range w {
+		s += v
+	}
+	lnw := make([]float64, len(w))
+	for i, v := range w {
+		lnw[i] = log(v / s)
+	}
+	return lnw
+}
+
+// weights returns the weights w, normalized to sum to one.
+func weights(w []float64) []float64 {
+	var s float64
+	for _, v := range w {
+		s += v
+	}
+	θ := make([]float64, len(w))
+	for i, v := range w {
+		θ[i] = v / s
+	}
+	return θ
+}
+
+// logSumExp returns log(Σ exp(x[i])), without overflow or underflow.
+func logSumExp(x []float64) float64 {
+	m := negInf
+	for _, v := range x {
+		if isNaN(v) {
+			return NaN
+		}
+		m = max(m, v)
+	}
+	if isInf(m, 0) {
+		return m
+	}
+	var s float64
+	for _, v := range x {
+		s += exp(v - m)
+	}
+	return m + log(s)
+}
+
+// moments4 is a distribution with known moments.
+type moments4 interface {
+	Mean() float64
+	Var() float64
+	Skew() float64
+	ExKurt() float64
+}
+
+// mixRawMoments returns the raw moments E[X^k], k = 1, ..., n ≤ 4, of the mixture of the distributions d(i) with normalized weights θ.
+func mixRawMoments(θ []float64, d func(i int) moments4, n int) (m [5]float64) {
+	for i, w := range θ {
+		if w == 0 {
+			continue
+		}
+		c := d(i)
+		μ := c.Mean()
+		m[1] += w * μ
+		if n < 2 {
+			continue
+		}
+		σ2 := c.Var()
+		m[2] += w * (σ2 + μ*μ)
+		if n < 3 {
+			continue
+		}
+		σ := sqrt(σ2)
+		γ := c.Skew() * σ2 * σ
+		m[3] += w * (μ*μ*μ + 3*μ*σ2 + γ)
+		if n < 4 {
+			continue
+		}
+		m[4] += w * (μ*μ*μ*μ + 6*μ*μ*σ2 + 4*μ*γ + (c.ExKurt()+3)*σ2*σ2)
+	}
+	return
+}
+
+// MixtureDist is the mixture of the continuous distributions D with weights W. It implements Continuous.
+type MixtureDist struct {
+	W []float64
+	D []Continuous
+}
+
+// lnSum returns the logarithm of Σ W[i] f(D[i]), given the logarithms f of the component values.
+func (m MixtureDist) lnSum(f func(d Continuous) float64) float64 {
+	lnw := lnWeights(m.W)
+	for i := range lnw {
+		if lnw[i] != negInf {
+			lnw[i] += f(m.D[i])
+		}
+	}
+	return logSumExp(lnw)
+}
+
+// PDF returns the value of PDF of the mixture distribution at x.
+func (m MixtureDist) PDF(x float64) float64 { return exp(m.LnPDF(x)) }
+
+// LnPDF returns the natural logarithm of the PDF of the mixture distribution at x.
+func (m MixtureDist) LnPDF(x float64) float64 {
+	return m.lnSum(func(d Continuous) float64 { return d.LnPDF(x) })
+}
+
+// CDF returns the value of CDF of the mixture distribution at x.
+func (m MixtureDist) CDF(x float64) float64 { return exp(m.LnCDF(x)) }
+
+// Surv returns the value of the survival function 1 - CDF of the mixture distribution at x.
+func (m MixtureDist) Surv(x float64) float64 { return exp(m.LnSurv(x)) }
+
+// LnCDF returns the natural logarithm of the CDF of the mixture distribution at x.
+func (m MixtureDist) LnCDF(x float64) float64 {
+	return min(0, m.lnSum(func(d Continuous) float64 { return d.LnCDF(x) }))
+}
+
+// LnSurv returns the natural logarithm of the survival function of the mixture distribution at x.
+func (m MixtureDist) LnSurv(x float64) float64 {
+	return min(0, m.lnSum(func(d Continuous) float64 { return d.LnSurv(x) }))
+}
+
+// Qtl returns the quantile of the mixture distribution for probability p.
+func (m MixtureDist) Qtl(p float64) float64 { return m.QtlTail(p, true, false) }
+
+// QtlTail returns the quantile of the mixture distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (m MixtureDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	if isNaN(p) || !pValid(p, logP) {
+		return NaN
+	}
+	// the CDF of the mixture is an average, so its quantile lies between the quantiles of the components
+	a, b := posInf, negInf
+	for i, w := range m.W {
+		if w > 0 {
+			x := m.D[i].QtlTail(p, lowerTail, logP)
+			a, b = min(a, x), max(b, x)
+		}
+	}
+	if a >= b {
+		return a
+	}
+	cdf := func(x float64) float64 {
+		return pTailLn(m.LnCDF(x), m.LnSurv(x), lowerTail, logP)
+	}
+	return qtlTail(cdf, p, a, b, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the mixture distribution.
+func (m MixtureDist) Rand() float64 { return m.RandR(GlobalRand) }
+
+// RandR returns random number drawn from the mixture distribution, using the random source src.
+func (m MixtureDist) RandR(src *rand.Rand) float64 {
+	return m.D[ChoiceNextR(src, weights(m.W))].RandR(src)
+}
+
+// raw returns the raw moments E[X^k], k = 1, ..., n, of the mixture distribution.
+func (m MixtureDist) raw(n int) [5]float64 {
+	return mixRawMoments(weights(m.W), func(i int) moments4 { return m.D[i] }, n)
+}
+
+// Mean returns the mean of the mixture distribution.
+func (m MixtureDist) Mean() float64 { return m.raw(1)[1] }
+
+// Var returns the variance of the mixture distribution.
+func (m MixtureDist) Var() float64 {
+	r := m.raw(2)
+	return r[2] - r[1]*r[1]
+}
+
+// Skew returns the skewness of the mixture distribution.
+func (m MixtureDist) Skew() float64 {
+	r := m.raw(3)
+	σ2 := r[2] - r[1]*r[1]
+	return (r[3] - 3*r[1]*r[2] + 2*r[1]*r[1]*r[1]) / pow(σ2, 1.5)
+}
+
+// ExKurt returns the excess kurtosis of the mixture distribution.
+func (m MixtureDist) ExKurt() float64 {
+	r := m.raw(4)
+	_, _, _, kurt := rawMoments(r[1], r[2], r[3], r[4])
+	return kurt
+}
+
+// Entropy returns the differential entropy of the mixture distribution.
+func (m MixtureDist) Entropy() float64 { return entropyContinuous(m) }
+
+// CF returns the characteristic function of the mixture distribution at t.
+func (m MixtureDist) CF(t float64) complex128 {
+	var φ complex128
+	for i, w := range weights(m.W) {
+		if w > 0 {
+			φ += complex(w, 0) * m.D[i].CF(t)
+		}
+	}
+	return φ
+}
+
+// CGF returns the cumulant generating function of the mixture distribution at t.
+func (m MixtureDist) CGF(t float64) float64 {
+	return m.lnSum(func(d Continuous) float64 { return d.CGF(t) })
+}
+
+// Support returns the support of the mixture distribution.
+func (m MixtureDist) Support() (a, b float64) {
+	a, b = posInf, negInf
+	for i, w := range m.W {
+		if w > 0 {
+			da, db := m.D[i].Support()
+			a, b = min(a, da), max(b, db)
+		}
+	}
+	return
+}
+
+// MixtureDiscreteDist is the mixture of the discrete distributions D with weights W. It implements Discrete.
+type MixtureDiscreteDist struct {
+	W []float64
+	D []Discrete
+}
+
+// lnSum returns the logarithm of Σ W[i] f(D[i]), given the logarithms f of the component values.
+func (m MixtureDiscreteDist) lnSum(f func(d Discrete) float64) float64 {
+	lnw := lnWeights(m.W)
+	for i := range lnw {
+		if lnw[i] != negInf {
+			lnw[i] += f(m.D[i])
+		}
+	}
+	return logSumExp(lnw)
+}
+
+// PMF returns the value of PMF of the mixture distribution at k.
+func (m MixtureDiscreteDist) PMF(k int64) float64 { return exp(m.LnPMF(k)) }
+
+// LnPMF returns the natural logarithm of the PMF of the mixture distribution at k.
+func (m MixtureDiscreteDist) LnPMF(k int64) float64 {
+	return m.lnSum(func(d Discrete) float64 { return d.LnPMF(k) })
+}
+
+// CDF returns the value of CDF of the mixture distribution at k.
+func (m MixtureDiscreteDist) CDF(k int64) float64 { return exp(m.LnCDF(k)) }
+
+// Surv returns the value of the survival function 1 - CDF of the mixture distribution at k.
+func (m MixtureDiscreteDist) Surv(k int64) float64 { return exp(m.LnSurv(k)) }
+
+// LnCDF returns the natural logarithm of the CDF of the mixture distribution at k.
+func (m MixtureDiscreteDist) LnCDF(k int64) float64 {
+	return min(0, m.lnSum(func(d Discrete) float64 { return d.LnCDF(k) }))
+}
+
+// LnSurv returns the natural logarithm of the survival function of the mixture distribution at k.
+func (m MixtureDiscreteDist) LnSurv(k int64) float64 {
+	return min(0, m.lnSum(func(d Discrete) float64 { return d.LnSurv(k) }))
+}
+
+// Qtl returns the quantile of the mixture distribution for probability p.
+func (m MixtureDiscreteDist) Qtl(p float64) int64 { return m.QtlTail(p, true, false) }
+
+// QtlTail returns the quantile of the mixture distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP;
+// it panics with ErrQtlProb for an invalid p.
+func (m MixtureDiscreteDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	_, b := m.Support()
+	if isNaN(p) || !pValid(p, logP) {
+		panic(ErrQtlProb)
+	}
+	// the quantile is at least the smallest of the quantiles of the components
+	a := b
+	for i, w := range m.W {
+		if w > 0 {
+			a = imin(a, m.D[i].QtlTail(p, lowerTail, logP))
+		}
+	}
+	cdf := func(k int64) float64 {
+		return pTailLn(m.LnCDF(k), m.LnSurv(k), lowerTail, logP)
+	}
+	return qtlSearchTail(cdf, p, a, b, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the mixture distribution.
+func (m MixtureDiscreteDist) Rand() int64 { return m.RandR(GlobalRand) }
+
+// RandR returns random number drawn from the mixture distribution, using the random source src.
+func (m MixtureDiscreteDist) RandR(src *rand.Rand) int64 {
+	return m.D[ChoiceNextR(src, weights(m.W))].RandR(src)
+}
+
+// raw returns the raw moments E[X^k], k = 1, ..., n, of the mixture distribution.
+func (m MixtureDiscreteDist) raw(n int) [5]float64 {
+	return mixRawMoments(weights(m.W), func(i int) moments4 { return m.D[i] }, n)
+}
+
+// Mean returns the mean of the mixture distribution.
+func (m MixtureDiscreteDist) Mean() float64 { return m.raw(1)[1] }
+
+// Var returns the variance of the mixture distribution.
+func (m MixtureDiscreteDist) Var() float64 {
+	r := m.raw(2)
+	return r[2] - r[1]*r[1]
+}
+
+// Skew returns the skewness of the mixture distribution.
+func (m MixtureDiscreteDist) Skew() float64 {
+	r := m.raw(3)
+	σ2 := r[2] - r[1]*r[1]
+	return (r[3] - 3*r[1]*r[2] + 2*r[1]*r[1]*r[1]) / pow(σ2, 1.5)
+}
+
+// ExKurt returns the excess kurtosis of the mixture distribution.
+func (m MixtureDiscreteDist) ExKurt() float64 {
+	r := m.raw(4)
+	_, _, _, kurt := rawMoments(r[1], r[2], r[3], r[4])
+	return kurt
+}
+
+// Entropy returns the Shannon entropy of the mixture distribution.
+func (m MixtureDiscreteDist) Entropy() float64 { return entropyDiscrete(m) }
+
+// CF returns the characteristic function of the mixture distribution at t.
+func (m MixtureDiscreteDist) CF(t float64) complex128 {
+	var φ complex128
+	for i, w := range weights(m.W) {
+		if w > 0 {
+			φ += complex(w, 0) * m.D[i].CF(t)
+		}
+	}
+	return φ
+}
+
+// CGF returns the cumulant generating function of the mixture distribution at t.
+func (m MixtureDiscreteDist) CGF(t float64) float64 {
+	return m.lnSum(func(d Discrete) float64 { return d.CGF(t) })
+}
+
+// Support returns the support of the mixture distribution.
+func (m MixtureDiscreteDist) Support() (a, b int64) {
+	a, b = posInfInt64, -posInfInt64
+	for i, w := range m.W {
+		if w > 0 {
+			da, db := m.D[i].Support()
+			a, b = imin(a, da), imax(b, db)
+		}
+	}
+	return
+}
@@ -17,6 +17,10 @@ package dst
 // xi ∈ {0, ... , n}
 // Σxi = n
 
+import (
+	"math/rand"
+)
+
 // MultinomialPMF returns the PMF of the Multinomial distribution. 
 func MultinomialPMF(θ []float64, n int64) func(x []int64) float64 {
 	return func(x []int64) float64 {
@@ -67,18 +71,55 @@ func MultinomialPMFAt(θ []float64, n int64, x []int64) float64 {
 
 // MultinomialNext returns random number drawn from the Multinomial distribution. 
 func MultinomialNext(θ []float64, n int64) []int64 {
+	return MultinomialNextR(GlobalRand, θ, n)
+}
+
+// MultinomialNextR returns random number drawn from the Multinomial distribution, using the random source src.
+func MultinomialNextR(src *rand.Rand, θ []float64, n int64) []int64 {
 	x := make([]int64, len(θ))
-	chooser := Choice(θ)
-	for i := iZero; i < n; i++ {
-		x[chooser()]++
-	}
+	multinomialInto(src, θ, n, x)
 	return x
 }
 
+// multinomialInto stores in x random vector drawn from the Multinomial distribution: the Binomial counts of the categories
+// one after the other, each with its probability conditional on the categories before.
+func multinomialInto(src *rand.Rand, θ []float64, n int64, x []int64) {
+	for i := range x {
+		x[i] = 0
+	}
+	rest := fOne
+	for i := 0; i < len(θ) && n > 0; i++ {
+		if i == len(θ)-1 || θ[i] >= rest {
+			x[i] = n
+			break
+		}
+		x[i] = BinomialNextR(src, n, θ[i]/rest)
+		n -= x[i]
+		rest -= θ[i]
+	}
+}
+
 // Multinomial returns the random number generator with  Multinomial distribution. 
 func Multinomial(θ []float64, n int64) func() []int64 {
+	return MultinomialR(GlobalRand, θ, n)
+}
+
+// MultinomialR returns the random number generator with  Multinomial distribution, using the random source src.
+func MultinomialR(src *rand.Rand, θ []float64, n int64) func() []int64 {
 	return func() []int64 {
-		return MultinomialNext(θ, n)
+		return MultinomialNextR(src, θ, n)
+	}
+}
+
+// MultinomialFill fills each row of x with random vector drawn from the Multinomial distribution.
+func MultinomialFill(θ []float64, n int64, x [][]int64) {
+	MultinomialFillR(GlobalRand, θ, n, x)
+}
+
+// MultinomialFillR fills each row of x with random vector drawn from the Multinomial distribution, using the random source src.
+func MultinomialFillR(src *rand.Rand, θ []float64, n int64, x [][]int64) {
+	for _, xi := range x {
+		multinomialInto(src, θ, n, xi)
 	}
 }
 
@@ -14,36 +14,72 @@ package dst
 
 import (
 	. "github.com/skelterjohn/go.matrix"
+	"math/rand"
 )
 
-// MVNormalPDF returns the PDF of the Multivariate normal distribution. 
-func MVNormalPDF(μ *DenseMatrix, Σ *DenseMatrix) func(x *DenseMatrix) float64 {
-	p := μ.Rows()
-	backμ := μ.DenseMatrix()
-	backμ.Scale(-1)
+// mvChol returns the lower triangular Cholesky factor L of the covariance Σ = L Lᵀ, and the logarithm Σ log(Lii) of the square root of its determinant.
+func mvChol(Σ *DenseMatrix) (L *DenseMatrix, lnDetRt float64) {
+	L, err := Σ.Cholesky()
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < L.Rows(); i++ {
+		lnDetRt += log(L.Get(i, i))
+	}
+	return
+}
 
-	Σdet := Σ.Det()
-	ΣdetRt := sqrt(Σdet)
-	Σinv, _ := Σ.Inverse()
+// mvMahalanobis2 returns the squared Mahalanobis distance (x-μ)ᵀ Σ⁻¹ (x-μ) = |z|², solving L z = x-μ by forward substitution, given the Cholesky factor L of Σ.
+func mvMahalanobis2(L, μ, x *DenseMatrix) float64 {
+	n := L.Rows()
+	z := make([]float64, n)
+	d := fZero
+	for i := 0; i < n; i++ {
+		s := x.Get(i, 0) - μ.Get(i, 0)
+		for j := 0; j < i; j++ {
+			s -= L.Get(i, j) * z[j]
+		}
+		z[i] = s / L.Get(i, i)
+		d += z[i] * z[i]
+	}
+	return d
+}
 
-	normalization := pow(2*π, -float64(p)/2) / ΣdetRt
+// MVNormalPDF returns the PDF of the Multivariate normal distribution. 
+func MVNormalPDF(μ *DenseMatrix, Σ *DenseMatrix) func(x *DenseMatrix) float64 {
+	lnPDF := MVNormalLnPDF(μ, Σ)
+	return func(x *DenseMatrix) float64 {
+		return exp(lnPDF(x))
+	}
+}
 
+// MVNormalLnPDF returns the natural logarithm of the PDF of the Multivariate normal distribution. 
+// The Cholesky factor of Σ is computed once, and reused at every x.
+func MVNormalLnPDF(μ *DenseMatrix, Σ *DenseMatrix) func(x *DenseMatrix) float64 {
+	L, lnDetRt := mvChol(Σ)
+	normalization := -float64(μ.Rows())*M_LN_SQRT_2PI - lnDetRt
 	return func(x *DenseMatrix) float64 {
-		δ, _ := x.PlusDense(backμ)
-		tmp := δ.Transpose()
-		tmp, _ = tmp.TimesDense(Σinv)
-		tmp, _ = tmp.TimesDense(δ)
-		f := tmp.Get(0, 0)
-		return normalization * exp(-f/2)
+		return normalization - mvMahalanobis2(L, μ, x)/2
 	}
 }
 
+// MVNormalPDFAt returns the value of PDF of Multivariate normal distribution at x. 
+func MVNormalPDFAt(μ, Σ, x *DenseMatrix) float64 {
+	pdf := MVNormalPDF(μ, Σ)
+	return pdf(x)
+}
+
 // MVNormalNext returns random number drawn from the Multivariate normal distribution. 
 func MVNormalNext(μ *DenseMatrix, Σ *DenseMatrix) *DenseMatrix {
+	return MVNormalNextR(GlobalRand, μ, Σ)
+}
+
+// MVNormalNextR returns random number drawn from the Multivariate normal distribution, using the random source src.
+func MVNormalNextR(src *rand.Rand, μ *DenseMatrix, Σ *DenseMatrix) *DenseMatrix {
 	n := μ.Rows()
 	x := Zeros(n, 1)
 	for i := 0; i < n; i++ {
-		x.Set(i, 0, NormalNext(0, 1))
+		x.Set(i, 0, NormalNextR(src, 0, 1))
 	}
 	C, err := Σ.Cholesky()
 	Cx, err := C.TimesDense(x)
@@ -56,12 +92,17 @@ func MVNormalNext(μ *DenseMatrix, Σ *DenseMatrix) *DenseMatrix {
 
 // MVNormal returns the random number generator with  Multivariate normal distribution. 
 func MVNormal(μ *DenseMatrix, Σ *DenseMatrix) func() *DenseMatrix {
+	return MVNormalR(GlobalRand, μ, Σ)
+}
+
+// MVNormalR returns the random number generator with  Multivariate normal distribution, using the random source src.
+func MVNormalR(src *rand.Rand, μ *DenseMatrix, Σ *DenseMatrix) func() *DenseMatrix {
 	C, _ := Σ.Cholesky()
 	n := μ.Rows()
 	return func() *DenseMatrix {
 		x := Zeros(n, 1)
 		for i := 0; i < n; i++ {
-			x.Set(i, 0, NormalNext(0, 1))
+			x.Set(i, 0, NormalNextR(src, 0, 1))
 		}
 		Cx, _ := C.TimesDense(x)
 		MCx, _ := μ.PlusDense(Cx)
@@ -69,6 +110,31 @@ func MVNormal(μ *DenseMatrix, Σ *DenseMatrix) func() *DenseMatrix {
 	}
 }
 
+// MVNormalFill fills each row of x, of as many columns as μ has rows, with random vector drawn from the Multivariate normal distribution.
+func MVNormalFill(μ, Σ, x *DenseMatrix) {
+	MVNormalFillR(GlobalRand, μ, Σ, x)
+}
+
+// MVNormalFillR fills each row of x, of as many columns as μ has rows, with random vector drawn from the Multivariate normal distribution,
+// using the random source src; Σ is factored once for all the draws.
+func MVNormalFillR(src *rand.Rand, μ, Σ, x *DenseMatrix) {
+	L, _ := mvChol(Σ)
+	n := μ.Rows()
+	z := make([]float64, n)
+	for r := 0; r < x.Rows(); r++ {
+		for i := range z {
+			z[i] = NormalNextR(src, 0, 1)
+		}
+		for i := 0; i < n; i++ {
+			v := μ.Get(i, 0)
+			for j := 0; j <= i; j++ {
+				v += L.Get(i, j) * z[j]
+			}
+			x.Set(r, i, v)
+		}
+	}
+}
+
 // MVNormalMean returns the mean of the Multivariate normal distribution. 
 func MVNormalMean(μ *DenseMatrix, Σ *DenseMatrix) *DenseMatrix {
 	return μ
@@ -83,3 +149,27 @@ func MVNormalMode(μ *DenseMatrix, Σ *DenseMatrix) *DenseMatrix {
 func MVNormalVar(μ *DenseMatrix, Σ *DenseMatrix) *DenseMatrix {
 	return Σ
 }
+
+// MVNormalEntropy returns the differential entropy of the Multivariate normal distribution.
+func MVNormalEntropy(μ *DenseMatrix, Σ *DenseMatrix) float64 {
+	_, lnDetRt := mvChol(Σ)
+	return float64(Σ.Rows())*(0.5+M_LN_SQRT_2PI) + lnDetRt
+}
+
+// MVNormalKL returns the Kullback–Leibler divergence of the Multivariate normal distribution with μ2, Σ2 from the one with μ1, Σ1,
+// ½ (tr(Σ2⁻¹ Σ1) + (μ2-μ1)ᵀ Σ2⁻¹ (μ2-μ1) - k + ln |Σ2| / |Σ1|).
+func MVNormalKL(μ1, Σ1, μ2, Σ2 *DenseMatrix) float64 {
+	L1, lnDetRt1 := mvChol(Σ1)
+	L2, lnDetRt2 := mvChol(Σ2)
+	k := L1.Rows()
+	// tr(Σ2⁻¹ Σ1) = Σ |L2⁻¹ c|² over the columns c of L1
+	zero, c := Zeros(k, 1), Zeros(k, 1)
+	tr := fZero
+	for j := 0; j < k; j++ {
+		for i := 0; i < k; i++ {
+			c.Set(i, 0, L1.Get(i, j))
+		}
+		tr += mvMahalanobis2(L2, zero, c)
+	}
+	return (tr+mvMahalanobis2(L2, μ2, μ1)-float64(k))/2 + lnDetRt2 - lnDetRt1
+}
@@ -0,0 +1,228 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Multivariate normal distribution: probabilities of rectangles.
+// Genz, A. (1992). Numerical computation of multivariate normal probabilities. Journal of Computational and Graphical Statistics 1, 141-150.
+// Genz, A., Bretz, F. (2002). Comparison of methods for the computation of multivariate t probabilities. Journal of Computational and Graphical Statistics 11, 950-971.
+// The Cholesky factor turns P[a < X ≤ b] into an integral over the unit cube of dimension k-1, estimated by randomized quasi-Monte Carlo (Richtmyer lattice rules);
+// the variables are reordered so that the outermost intervals are the narrowest, which reduces the variance of the estimate.
+// The random shifts of the lattice come from a fixed seed, so that the results are reproducible.
+
+import (
+	. "github.com/skelterjohn/go.matrix"
+	"math/rand"
+)
+
+const (
+	mvnAbsErr  = 1e-5    // target absolute error, three standard errors of the estimate
+	mvnShifts  = 12      // number of randomly shifted lattice rules
+	mvnMaxPts  = 1 << 15 // maximum number of lattice points per shift
+	mvnMinPts  = 1 << 7  // initial number of lattice points per shift
+	mvnRndSeed = 1992    // seed of the random shifts
+)
+
+// mvnReorder returns the Cholesky factor C of Σ and the bounds a, b, with the variables reordered as in Genz and Bretz (2002):
+// at each step, the variable with the smallest probability of its interval, given the expected values of the previous ones, comes next.
+// It returns nil if Σ is not positive definite.
+func mvnReorder(Σ [][]float64, a, b []float64) (C [][]float64, ra, rb []float64) {
+	n := len(a)
+	S := make([][]float64, n)
+	C = make([][]float64, n)
+	for i := range S {
+		S[i] = append([]float64(nil), Σ[i]...)
+		C[i] = make([]float64, n)
+	}
+	ra = append([]float64(nil), a...)
+	rb = append([]float64(nil), b...)
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		best, k := posInf, i
+		for j := i; j < n; j++ {
+			v, u := S[j][j], fZero
+			for m := 0; m < i; m++ {
+				v -= C[j][m] * C[j][m]
+				u += C[j][m] * y[m]
+			}
+			if v <= 0 {
+				continue
+			}
+			d := sqrt(v)
+			if p := pnorm((rb[j]-u)/d, true, false) - pnorm((ra[j]-u)/d, true, false); p < best {
+				best, k = p, j
+			}
+		}
+		// swap the variables i and k
+		ra[i], ra[k] = ra[k], ra[i]
+		rb[i], rb[k] = rb[k], rb[i]
+		S[i], S[k] = S[k], S[i]
+		for m := range S {
+			S[m][i], S[m][k] = S[m][k], S[m][i]
+		}
+		C[i], C[k] = C[k], C[i]
+
+		v, u := S[i][i], fZero
+		for m := 0; m < i; m++ {
+			v -= C[i][m] * C[i][m]
+			u += C[i][m] * y[m]
+		}
+		if v <= 0 {
+			return nil, nil, nil
+		}
+		C[i][i] = sqrt(v)
+		for r := i + 1; r < n; r++ {
+			s := S[r][i]
+			for m := 0; m < i; m++ {
+				s -= C[i][m] * C[r][m]
+			}
+			C[r][i] = s / C[i][i]
+		}
+		// the expected value of the standardized variable i within its interval
+		lo, hi := (ra[i]-u)/C[i][i], (rb[i]-u)/C[i][i]
+		p := pnorm(hi, true, false) - pnorm(lo, true, false)
+		switch {
+		case p > 0:
+			y[i] = (mvnDens(lo) - mvnDens(hi)) / p
+		case isInf(lo, 0):
+			y[i] = hi
+		case isInf(hi, 0):
+			y[i] = lo
+		default:
+			y[i] = (lo + hi) / 2
+		}
+	}
+	return C, ra, rb
+}
+
+// mvnDens returns the PDF of the Standard Normal distribution, zero at ±∞.
+func mvnDens(x float64) float64 {
+	if isInf(x, 0) {
+		return 0
+	}
+	return M_1_SQRT_2PI * exp(-x*x/2)
+}
+
+// mvnIntegrand returns the integrand of Genz (1992) at w in the unit cube, given the reordered Cholesky factor C and bounds a, b; y is scratch space.
+func mvnIntegrand(C [][]float64, a, b, w, y []float64) float64 {
+	d, e := pnorm(a[0]/C[0][0], true, false), pnorm(b[0]/C[0][0], true, false)
+	f := e - d
+	for i := 1; i < len(a) && f > 0; i++ {
+		u := max(min64, min(d+w[i-1]*(e-d), 1-eps64))
+		y[i-1] = qnorm(u, true, false)
+		s := fZero
+		for j := 0; j < i; j++ {
+			s += C[i][j] * y[j]
+		}
+		d, e = pnorm((a[i]-s)/C[i][i], true, false), pnorm((b[i]-s)/C[i][i], true, false)
+		f *= e - d
+	}
+	return f
+}
+
+// mvnRect returns P[a < Z ≤ b] for Z with the multivariate normal distribution with zero mean and covariance Σ, and three standard errors of the estimate.
+func mvnRect(Σ [][]float64, a, b []float64) (p, err float64) {
+	n := len(a)
+	for i := 0; i < n; i++ {
+		if a[i] >= b[i] {
+			return 0, 0
+		}
+	}
+	C, a, b := mvnReorder(Σ, a, b)
+	if C == nil {
+		return NaN, NaN
+	}
+	if n == 1 {
+		return pnorm(b[0]/C[0][0], true, false) - pnorm(a[0]/C[0][0], true, false), 0
+	}
+
+	// Richtmyer generators, the square roots of the first primes
+	q := make([]float64, 0, n-1)
+	for k := 2; len(q) < n-1; k++ {
+		prime := true
+		for j := 2; j*j <= k; j++ {
+			if k%j == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			q = append(q, sqrt(float64(k)))
+		}
+	}
+
+	src := rand.New(rand.NewSource(mvnRndSeed))
+	w := make([]float64, n-1)
+	y := make([]float64, n)
+	Δ := make([]float64, n-1)
+	for pts := mvnMinPts; ; pts *= 2 {
+		var s, s2 float64
+		for r := 0; r < mvnShifts; r++ {
+			for j := range Δ {
+				Δ[j] = src.Float64()
+			}
+			v := fZero
+			for k := 1; k <= pts; k++ {
+				for j := range w {
+					x := float64(k)*q[j] + Δ[j]
+					// the baker's transform periodizes the integrand
+					w[j] = abs(2*(x-floor(x)) - 1)
+				}
+				v += mvnIntegrand(C, a, b, w, y)
+			}
+			v /= float64(pts)
+			s += v
+			s2 += v * v
+		}
+		p = s / mvnShifts
+		err = 3 * sqrt(max(0, s2/mvnShifts-p*p)/(mvnShifts-1))
+		if err <= mvnAbsErr || pts >= mvnMaxPts {
+			return max(0, min(1, p)), err
+		}
+	}
+}
+
+// mvnArgs returns the covariance Σ as rows, and the bounds a, b centered on μ.
+func mvnArgs(μ, Σ, a, b *DenseMatrix) ([][]float64, []float64, []float64) {
+	n := μ.Rows()
+	S := make([][]float64, n)
+	ca, cb := make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		S[i] = make([]float64, n)
+		for j := 0; j < n; j++ {
+			S[i][j] = Σ.Get(i, j)
+		}
+		ca[i] = negInf
+		if a != nil {
+			ca[i] = a.Get(i, 0) - μ.Get(i, 0)
+		}
+		cb[i] = b.Get(i, 0) - μ.Get(i, 0)
+	}
+	return S, ca, cb
+}
+
+// MVNormalCDF returns the CDF P[X ≤ x] of the Multivariate normal distribution.
+func MVNormalCDF(μ, Σ *DenseMatrix) func(x *DenseMatrix) float64 {
+	return func(x *DenseMatrix) float64 {
+		p, _ := mvnRect(mvnArgs(μ, Σ, nil, x))
+		return p
+	}
+}
+
+// MVNormalCDFAt returns the value of CDF of the Multivariate normal distribution, at x.
+func MVNormalCDFAt(μ, Σ, x *DenseMatrix) float64 {
+	cdf := MVNormalCDF(μ, Σ)
+	return cdf(x)
+}
+
+// MVNormalRectCDF returns the probability P[a < X ≤ b] of the rectangle (a, b] under the Multivariate normal distribution; the bounds may be infinite.
+func MVNormalRectCDF(μ, Σ *DenseMatrix) func(a, b *DenseMatrix) float64 {
+	return func(a, b *DenseMatrix) float64 {
+		p, _ := mvnRect(mvnArgs(μ, Σ, a, b))
+		return p
+	}
+}
+
+// MVNormalRectCDFErr returns the probability P[a < X ≤ b] of the rectangle (a, b] under the Multivariate normal distribution, with its error estimate (three standard errors).
+func MVNormalRectCDFErr(μ, Σ, a, b *DenseMatrix) (p, err float64) {
+	return mvnRect(mvnArgs(μ, Σ, a, b))
+}
@@ -0,0 +1,140 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Multivariate Student's t distribution.
+// The Multivariate normal distribution with covariance Σ scaled by ν/W, W ~ χ²(ν): heavier tails than the Multivariate normal distribution,
+// for robust models of correlated data. It approaches the Multivariate normal distribution as ν → ∞; ν = 1 gives the multivariate Cauchy distribution.
+// Kotz, S., Nadarajah, S. (2004). Multivariate t Distributions and Their Applications. Cambridge University Press.
+//
+// Parameters:
+// μ ∈ ℝk		(Rk)	location
+// Σ ∈ ℝk✕k	(Rkxk)	scale matrix (positive-definite)
+// ν > 0		degrees of freedom
+//
+// Support:
+// x ∈ ℝk
+
+import (
+	. "github.com/skelterjohn/go.matrix"
+	"math/rand"
+)
+
+// MVStudentsTPDF returns the PDF of the Multivariate Student's t distribution.
+func MVStudentsTPDF(μ, Σ *DenseMatrix, ν float64) func(x *DenseMatrix) float64 {
+	lnPDF := MVStudentsTLnPDF(μ, Σ, ν)
+	return func(x *DenseMatrix) float64 {
+		return exp(lnPDF(x))
+	}
+}
+
+// MVStudentsTLnPDF returns the natural logarithm of the PDF of the Multivariate Student's t distribution.
+// The Cholesky factor of Σ is computed once, and reused at every x.
+func MVStudentsTLnPDF(μ, Σ *DenseMatrix, ν float64) func(x *DenseMatrix) float64 {
+	L, lnDetRt := mvChol(Σ)
+	p := float64(μ.Rows())
+	normalization := LnΓ((ν+p)/2) - LnΓ(ν/2) - p/2*log(ν*π) - lnDetRt
+	return func(x *DenseMatrix) float64 {
+		if ν <= 0 {
+			return NaN
+		}
+		return normalization - (ν+p)/2*log1p(mvMahalanobis2(L, μ, x)/ν)
+	}
+}
+
+// MVStudentsTPDFAt returns the value of PDF of Multivariate Student's t distribution at x.
+func MVStudentsTPDFAt(μ, Σ *DenseMatrix, ν float64, x *DenseMatrix) float64 {
+	pdf := MVStudentsTPDF(μ, Σ, ν)
+	return pdf(x)
+}
+
+// MVStudentsTNext returns random vector drawn from the Multivariate Student's t distribution.
+func MVStudentsTNext(μ, Σ *DenseMatrix, ν float64) *DenseMatrix {
+	return MVStudentsTNextR(GlobalRand, μ, Σ, ν)
+}
+
+// MVStudentsTNextR returns random vector drawn from the Multivariate Student's t distribution, using the random source src.
+func MVStudentsTNextR(src *rand.Rand, μ, Σ *DenseMatrix, ν float64) *DenseMatrix {
+	L, _ := mvChol(Σ)
+	return mvStudentsTNextR(src, μ, L, ν)
+}
+
+// mvStudentsTNextR returns random vector drawn from the Multivariate Student's t distribution, given the Cholesky factor L of Σ.
+func mvStudentsTNextR(src *rand.Rand, μ, L *DenseMatrix, ν float64) *DenseMatrix {
+	n := μ.Rows()
+	x := Zeros(n, 1)
+	mvStudentsTInto(src, μ, L, ν, make([]float64, n), func(i int, v float64) { x.Set(i, 0, v) })
+	return x
+}
+
+// mvStudentsTInto sets the components i of random vector drawn from the Multivariate Student's t distribution, given the Cholesky factor L of Σ,
+// with z the storage of its normal vector.
+func mvStudentsTInto(src *rand.Rand, μ, L *DenseMatrix, ν float64, z []float64, set func(i int, v float64)) {
+	n := μ.Rows()
+	for i := range z {
+		z[i] = NormalNextR(src, 0, 1)
+	}
+	// the normal vector L z, scaled by sqrt(ν/W), W ~ χ²(ν)
+	s := sqrt(ν / GammaNextR(src, ν/2, 2))
+	for i := 0; i < n; i++ {
+		v := fZero
+		for j := 0; j <= i; j++ {
+			v += L.Get(i, j) * z[j]
+		}
+		set(i, μ.Get(i, 0)+s*v)
+	}
+}
+
+// MVStudentsT returns the random vector generator with  Multivariate Student's t distribution.
+func MVStudentsT(μ, Σ *DenseMatrix, ν float64) func() *DenseMatrix {
+	return MVStudentsTR(GlobalRand, μ, Σ, ν)
+}
+
+// MVStudentsTR returns the random vector generator with  Multivariate Student's t distribution, using the random source src.
+func MVStudentsTR(src *rand.Rand, μ, Σ *DenseMatrix, ν float64) func() *DenseMatrix {
+	L, _ := mvChol(Σ)
+	return func() *DenseMatrix {
+		return mvStudentsTNextR(src, μ, L, ν)
+	}
+}
+
+// MVStudentsTFill fills each row of x, of as many columns as μ has rows, with random vector drawn from the Multivariate Student's t distribution.
+func MVStudentsTFill(μ, Σ *DenseMatrix, ν float64, x *DenseMatrix) {
+	MVStudentsTFillR(GlobalRand, μ, Σ, ν, x)
+}
+
+// MVStudentsTFillR fills each row of x, of as many columns as μ has rows, with random vector drawn from the Multivariate Student's t distribution,
+// using the random source src; Σ is factored once for all the draws.
+func MVStudentsTFillR(src *rand.Rand, μ, Σ *DenseMatrix, ν float64, x *DenseMatrix) {
+	L, _ := mvChol(Σ)
+	z := make([]float64, μ.Rows())
+	for r := 0; r < x.Rows(); r++ {
+		mvStudentsTInto(src, μ, L, ν, z, func(i int, v float64) { x.Set(r, i, v) })
+	}
+}
+
+// MVStudentsTMean returns the mean of the Multivariate Student's t distribution, defined for ν > 1.
+func MVStudentsTMean(μ, Σ *DenseMatrix, ν float64) *DenseMatrix {
+	if ν <= 1 {
+		x := μ.Copy()
+		x.Scale(NaN)
+		return x
+	}
+	return μ
+}
+
+// MVStudentsTMode returns the mode of the Multivariate Student's t distribution.
+func MVStudentsTMode(μ, Σ *DenseMatrix, ν float64) *DenseMatrix {
+	return μ
+}
+
+// MVStudentsTVar returns the covariance matrix ν/(ν-2) Σ of the Multivariate Student's t distribution, defined for ν > 2.
+func MVStudentsTVar(μ, Σ *DenseMatrix, ν float64) *DenseMatrix {
+	x := Σ.Copy()
+	if ν <= 2 {
+		x.Scale(NaN)
+		return x
+	}
+	x.Scale(ν / (ν - 2))
+	return x
+}
@@ -12,6 +12,10 @@ package dst
 // Support: 
 // k ∈ { 0, 1, 2, 3, … }		number of successes
 
+import (
+	"math/rand"
+)
+
 func do_search(p, pr float64, y, n, incr int64, z *float64) int64 {
 	if *z >= p {
 		// search to the left
@@ -47,8 +51,7 @@ func NegBinomialPMF(ρ float64, r int64) func(k int64) float64 {
 // NegBinomialLnPMF returns the natural logarithm of the PMF of the Negative binomial distribution. 
 func NegBinomialLnPMF(ρ float64, r int64) func(i int64) float64 {
 	return func(k int64) float64 {
-		rr := float64(r)
-		return logChoose(k+r-1, r-1) + log(ρ)*rr + log(1-ρ)*float64(k)
+		return logChoose(k+r-1, k) + log(1-ρ)*float64(r) + log(ρ)*float64(k)
 	}
 }
 
@@ -72,29 +75,42 @@ func NegBinomialCDFAt(ρ float64, r, k int64) float64 {
 	return cdf(k)
 }
 
-// NegBinomialQtl returns the inverse of the CDF (qquantile) of the Negative binomial distribution.
+// NegBinomialCDFTail returns the CDF of the Negative binomial distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func NegBinomialCDFTail(ρ float64, r int64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return BetaCDFTail(float64(k+1), float64(r), !lowerTail, logP)(ρ)
+	}
+}
+
+// NegBinomialQtl returns the inverse of the CDF (qquantile) of the Negative binomial distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
 func NegBinomialQtl(ρ float64, r int64) func(p float64) int64 {
 	return func(p float64) int64 {
 		var pp, qq, mu, sigma, gamma, z float64
 		var y int64
 		fr := float64(r)
-		if ρ <= 0 || ρ > 1 || fr <= 0 { // FIXME: fr = 0 is well defined
-			return int64(NaN)
+		if !(ρ >= 0 && ρ < 1) || fr <= 0 { // FIXME: fr = 0 is well defined
+			panic(ErrQtlParam)
+		}
+		if !(p >= 0 && p <= 1) {
+			panic(ErrQtlProb)
 		}
 
-		if ρ == 1 {
+		if ρ == 0 || p == 0 {
 			return 0
 		}
 
-		qq = 1.0 / ρ
-		pp = (1.0 - ρ) * qq
+		qq = 1.0 / (1 - ρ)
+		pp = ρ * qq
 		mu = fr * pp
 		sigma = sqrt(fr * pp * qq)
 		gamma = (qq + pp) / sigma
 
 		// temporary hack --- FIXME ---
 		if p+1.01*eps64 >= 1 {
-			return int64(NaN)
+			return posInfInt64
 		}
 
 		// y := approx.value (Cornish-Fisher expansion)
@@ -131,21 +147,56 @@ func NegBinomialQtlFor(ρ float64, r int64, p float64) int64 {
 	return qtl(p)
 }
 
+// NegBinomialQtlTail returns the inverse of NegBinomialCDFTail (quantile) of the Negative binomial distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func NegBinomialQtlTail(ρ float64, r int64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := NegBinomialCDFTail(ρ, r, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ρ >= 0 && ρ < 1) || r <= 0 {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, posInfInt64, lowerTail, logP)
+	}
+}
+
 // NegBinomialNext returns random number drawn from the Negative binomial distribution. 
 func NegBinomialNext(ρ float64, r int64) int64 {
+	return NegBinomialNextR(GlobalRand, ρ, r)
+}
+
+// NegBinomialNextR returns random number drawn from the Negative binomial distribution, using the random source src.
+func NegBinomialNextR(src *rand.Rand, ρ float64, r int64) int64 {
 	k := iZero
-	for r >= 0 {
-		i := BernoulliNext(ρ)
-		r -= i
-		k += (1 - i)
+	for r > 0 {
+		if BernoulliNextR(src, ρ) == 1 {
+			k++
+		} else {
+			r--
+		}
 	}
 	return k
 }
 
 // NegBinomial returns the random number generator with  Negative binomial distribution. 
 func NegBinomial(ρ float64, r int64) func() int64 {
+	return NegBinomialR(GlobalRand, ρ, r)
+}
+
+// NegBinomialR returns the random number generator with  Negative binomial distribution, using the random source src.
+func NegBinomialR(src *rand.Rand, ρ float64, r int64) func() int64 {
 	return func() int64 {
-		return NegBinomialNext(ρ, r)
+		return NegBinomialNextR(src, ρ, r)
+	}
+}
+
+// NegBinomialFill fills x with random numbers drawn from the Negative binomial distribution.
+func NegBinomialFill(ρ float64, r int64, x []int64) {
+	NegBinomialFillR(GlobalRand, ρ, r, x)
+}
+
+// NegBinomialFillR fills x with random numbers drawn from the Negative binomial distribution, using the random source src.
+func NegBinomialFillR(src *rand.Rand, ρ float64, r int64, x []int64) {
+	for i := range x {
+		x[i] = NegBinomialNextR(src, ρ, r)
 	}
 }
 
@@ -183,6 +234,24 @@ func NegBinomialExKurt(ρ float64, r int64) float64 {
 	return 6/rr + ((1-ρ)*(1-ρ))/(ρ*rr)
 }
 
+// NegBinomialEntropy returns the Shannon entropy of the Negative binomial distribution, by numerical summation.
+func NegBinomialEntropy(ρ float64, r int64) float64 {
+	return entropyDiscrete(NegBinomialDist{ρ, r})
+}
+
+// NegBinomialCF returns the characteristic function of the Negative binomial distribution.
+func NegBinomialCF(ρ float64, r int64, t float64) complex128 {
+	return cpow(complex(1-ρ, 0)/(1-complex(ρ, 0)*cexp(complex(0, t))), complex(float64(r), 0))
+}
+
+// NegBinomialCGF returns the cumulant generating function of the Negative binomial distribution; +Inf for t ≥ -ln ρ.
+func NegBinomialCGF(ρ float64, r int64, t float64) float64 {
+	if t >= -log(ρ) {
+		return posInf
+	}
+	return float64(r) * (log1p(-ρ) - log1p(-ρ*exp(t)))
+}
+
 // NegBinomialMGF returns the moment-generating function of the Negative binomial distribution. 
 func NegBinomialMGF(ρ float64, r int64, t float64) float64 {
 	return pow((1-ρ)/(1-ρ*exp(t)), float64(r))
@@ -192,3 +261,91 @@ func NegBinomialMGF(ρ float64, r int64, t float64) float64 {
 func NegBinomialPGF(ρ float64, r int64, z float64) float64 {
 	return pow((1-ρ)/(1-ρ*z), float64(r))
 }
+
+// NegBinomialFit returns the maximum-likelihood estimate of ρ of the Negative binomial distribution with r failures from the sample k: its mean over (its mean + r).
+func NegBinomialFit(r int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return NegBinomialLnPMF(θ[0], r) })
+	μ, _ := mleMeanVarInt(k)
+	return mleAt(lnL, []float64{μ / (μ + float64(r))})
+}
+
+// NegBinomialDist is the Negative binomial distribution: the number of events of probability ρ = Rho before R events of probability 1-ρ. It implements Discrete.
+type NegBinomialDist struct {
+	Rho float64
+	R   int64
+}
+
+// PMF returns the value of PMF of the Negative binomial distribution at k.
+func (d NegBinomialDist) PMF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return NegBinomialPMFAt(d.Rho, d.R, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Negative binomial distribution at k.
+func (d NegBinomialDist) LnPMF(k int64) float64 {
+	if k < 0 {
+		return negInf
+	}
+	return NegBinomialLnPMF(d.Rho, d.R)(k)
+}
+
+// CDF returns the value of CDF of the Negative binomial distribution at k.
+func (d NegBinomialDist) CDF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return NegBinomialCDFAt(d.Rho, d.R, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Negative binomial distribution at k.
+func (d NegBinomialDist) Surv(k int64) float64 {
+	return NegBinomialCDFTail(d.Rho, d.R, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Negative binomial distribution at k.
+func (d NegBinomialDist) LnCDF(k int64) float64 { return NegBinomialCDFTail(d.Rho, d.R, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Negative binomial distribution at k.
+func (d NegBinomialDist) LnSurv(k int64) float64 {
+	return NegBinomialCDFTail(d.Rho, d.R, false, true)(k)
+}
+
+// Qtl returns the quantile of the Negative binomial distribution for probability p.
+func (d NegBinomialDist) Qtl(p float64) int64 { return NegBinomialQtlFor(d.Rho, d.R, p) }
+
+// QtlTail returns the quantile of the Negative binomial distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d NegBinomialDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return NegBinomialQtlTail(d.Rho, d.R, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Negative binomial distribution.
+func (d NegBinomialDist) Rand() int64 { return NegBinomialNext(d.Rho, d.R) }
+
+// RandR returns random number drawn from the Negative binomial distribution, using the random source src.
+func (d NegBinomialDist) RandR(src *rand.Rand) int64 { return NegBinomialNextR(src, d.Rho, d.R) }
+
+// Mean returns the mean of the Negative binomial distribution.
+func (d NegBinomialDist) Mean() float64 { return NegBinomialMean(d.Rho, d.R) }
+
+// Var returns the variance of the Negative binomial distribution.
+func (d NegBinomialDist) Var() float64 { return NegBinomialVar(d.Rho, d.R) }
+
+// Skew returns the skewness of the Negative binomial distribution.
+func (d NegBinomialDist) Skew() float64 { return NegBinomialSkew(d.Rho, d.R) }
+
+// ExKurt returns the excess kurtosis of the Negative binomial distribution.
+func (d NegBinomialDist) ExKurt() float64 { return NegBinomialExKurt(d.Rho, d.R) }
+
+// Entropy returns the Shannon entropy of the Negative binomial distribution.
+func (d NegBinomialDist) Entropy() float64 { return NegBinomialEntropy(d.Rho, d.R) }
+
+// CF returns the characteristic function of the Negative binomial distribution at t.
+func (d NegBinomialDist) CF(t float64) complex128 { return NegBinomialCF(d.Rho, d.R, t) }
+
+// CGF returns the cumulant generating function of the Negative binomial distribution at t.
+func (d NegBinomialDist) CGF(t float64) float64 { return NegBinomialCGF(d.Rho, d.R, t) }
+
+// Support returns the support of the Negative binomial distribution.
+func (d NegBinomialDist) Support() (a, b int64) { return 0, posInfInt64 }
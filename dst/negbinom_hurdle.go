@@ -0,0 +1,294 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Hurdle negative binomial distribution.
+// The count is zero with probability ψ, and drawn from the Negative binomial distribution truncated to k > 0 otherwise:
+// overdispersed counts where whether the count clears the hurdle of zero is decided apart from its size.
+// Mullahy, J. (1986). Specification and testing of some modified count data models. Journal of Econometrics 33, 341-365.
+//
+// Parameters:
+// ψ ∈ [0, 1]	probability of zero
+// ρ ∈ (0, 1)	probability of the counted events
+// r ∈ N		number of the other events
+//
+// Support:
+// k ∈ {0, 1, 2, ... }
+
+import (
+	"math/rand"
+)
+
+// hurdleNegBinomialLnS0 returns the logarithm of P[X > 0] = 1 - (1-ρ)^r of the Negative binomial distribution.
+func hurdleNegBinomialLnS0(ρ float64, r int64) float64 {
+	return log1Exp(float64(r) * log1p(-ρ))
+}
+
+// hurdleNegBinomialW returns the weight (1-ψ) / P[X > 0] of the Negative binomial distribution in the moments of the Hurdle negative binomial distribution.
+func hurdleNegBinomialW(ψ, ρ float64, r int64) float64 {
+	return (1 - ψ) / exp(hurdleNegBinomialLnS0(ρ, r))
+}
+
+// HurdleNegBinomialPMF returns the PMF of the Hurdle negative binomial distribution.
+func HurdleNegBinomialPMF(ψ, ρ float64, r int64) func(k int64) float64 {
+	lnPMF := HurdleNegBinomialLnPMF(ψ, ρ, r)
+	return func(k int64) float64 {
+		return exp(lnPMF(k))
+	}
+}
+
+// HurdleNegBinomialLnPMF returns the natural logarithm of the PMF of the Hurdle negative binomial distribution.
+func HurdleNegBinomialLnPMF(ψ, ρ float64, r int64) func(k int64) float64 {
+	lnf := NegBinomialLnPMF(ρ, r)
+	lnS0 := hurdleNegBinomialLnS0(ρ, r)
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || ρ <= 0 || ρ >= 1 || r <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return negInf
+		}
+		return hurdleLnPMF(ψ, k, lnf(k), lnS0)
+	}
+}
+
+// HurdleNegBinomialPMFAt returns the value of PMF of Hurdle negative binomial distribution at k.
+func HurdleNegBinomialPMFAt(ψ, ρ float64, r int64, k int64) float64 {
+	pmf := HurdleNegBinomialPMF(ψ, ρ, r)
+	return pmf(k)
+}
+
+// HurdleNegBinomialCDF returns the CDF of the Hurdle negative binomial distribution.
+func HurdleNegBinomialCDF(ψ, ρ float64, r int64) func(k int64) float64 {
+	return HurdleNegBinomialCDFTail(ψ, ρ, r, true, false)
+}
+
+// HurdleNegBinomialCDFAt returns the value of CDF of the Hurdle negative binomial distribution, at k.
+func HurdleNegBinomialCDFAt(ψ, ρ float64, r int64, k int64) float64 {
+	cdf := HurdleNegBinomialCDF(ψ, ρ, r)
+	return cdf(k)
+}
+
+// HurdleNegBinomialCDFTail returns the CDF of the Hurdle negative binomial distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func HurdleNegBinomialCDFTail(ψ, ρ float64, r int64, lowerTail, logP bool) func(k int64) float64 {
+	lnS := NegBinomialCDFTail(ρ, r, false, true)
+	lnS0 := hurdleNegBinomialLnS0(ρ, r)
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || ρ <= 0 || ρ >= 1 || r <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		p := hurdleLnCDF(ψ, lnS(k)-lnS0, lowerTail)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// HurdleNegBinomialQtl returns the inverse of the CDF (quantile) of the Hurdle negative binomial distribution.
+func HurdleNegBinomialQtl(ψ, ρ float64, r int64) func(p float64) int64 {
+	return HurdleNegBinomialQtlTail(ψ, ρ, r, true, false)
+}
+
+// HurdleNegBinomialQtlFor returns the inverse of the CDF (quantile) of the Hurdle negative binomial distribution, for given probability.
+func HurdleNegBinomialQtlFor(ψ, ρ float64, r int64, p float64) int64 {
+	qtl := HurdleNegBinomialQtl(ψ, ρ, r)
+	return qtl(p)
+}
+
+// HurdleNegBinomialQtlTail returns the inverse of HurdleNegBinomialCDFTail (quantile) of the Hurdle negative binomial distribution;
+// it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func HurdleNegBinomialQtlTail(ψ, ρ float64, r int64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := HurdleNegBinomialCDFTail(ψ, ρ, r, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ψ >= 0 && ψ <= 1) || !(ρ > 0 && ρ < 1) || r <= 0 {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, posInfInt64, lowerTail, logP)
+	}
+}
+
+// HurdleNegBinomialNext returns random number drawn from the Hurdle negative binomial distribution.
+func HurdleNegBinomialNext(ψ, ρ float64, r int64) int64 {
+	return HurdleNegBinomialNextR(GlobalRand, ψ, ρ, r)
+}
+
+// HurdleNegBinomialNextR returns random number drawn from the Hurdle negative binomial distribution, using the random source src.
+func HurdleNegBinomialNextR(src *rand.Rand, ψ, ρ float64, r int64) int64 {
+	if src.Float64() < ψ {
+		return 0
+	}
+	return zeroTruncNextR(src, hurdleNegBinomialLnS0(ρ, r), func() int64 { return NegBinomialNextR(src, ρ, r) }, NegBinomialQtlTail(ρ, r, false, true))
+}
+
+// HurdleNegBinomial returns the random number generator with  Hurdle negative binomial distribution.
+func HurdleNegBinomial(ψ, ρ float64, r int64) func() int64 {
+	return HurdleNegBinomialR(GlobalRand, ψ, ρ, r)
+}
+
+// HurdleNegBinomialR returns the random number generator with  Hurdle negative binomial distribution, using the random source src.
+func HurdleNegBinomialR(src *rand.Rand, ψ, ρ float64, r int64) func() int64 {
+	return func() int64 { return HurdleNegBinomialNextR(src, ψ, ρ, r) }
+}
+
+// HurdleNegBinomialFill fills x with random numbers drawn from the Hurdle negative binomial distribution.
+func HurdleNegBinomialFill(ψ, ρ float64, r int64, x []int64) {
+	HurdleNegBinomialFillR(GlobalRand, ψ, ρ, r, x)
+}
+
+// HurdleNegBinomialFillR fills x with random numbers drawn from the Hurdle negative binomial distribution, using the random source src;
+// the sampler of the positive part is set up once for all the draws.
+func HurdleNegBinomialFillR(src *rand.Rand, ψ, ρ float64, r int64, x []int64) {
+	lnS0 := hurdleNegBinomialLnS0(ρ, r)
+	next := func() int64 { return NegBinomialNextR(src, ρ, r) }
+	qtl := NegBinomialQtlTail(ρ, r, false, true)
+	for i := range x {
+		if src.Float64() < ψ {
+			x[i] = 0
+			continue
+		}
+		x[i] = zeroTruncNextR(src, lnS0, next, qtl)
+	}
+}
+
+// HurdleNegBinomialMean returns the mean of the Hurdle negative binomial distribution.
+func HurdleNegBinomialMean(ψ, ρ float64, r int64) float64 {
+	return hurdleNegBinomialW(ψ, ρ, r) * NegBinomialMean(ρ, r)
+}
+
+// HurdleNegBinomialVar returns the variance of the Hurdle negative binomial distribution.
+func HurdleNegBinomialVar(ψ, ρ float64, r int64) float64 {
+	w, μ := hurdleNegBinomialW(ψ, ρ, r), NegBinomialMean(ρ, r)
+	return w*(NegBinomialVar(ρ, r)+μ*μ) - w*w*μ*μ
+}
+
+// HurdleNegBinomialStd returns the standard deviation of the Hurdle negative binomial distribution.
+func HurdleNegBinomialStd(ψ, ρ float64, r int64) float64 {
+	return sqrt(HurdleNegBinomialVar(ψ, ρ, r))
+}
+
+// HurdleNegBinomialSkew returns the skewness of the Hurdle negative binomial distribution.
+func HurdleNegBinomialSkew(ψ, ρ float64, r int64) float64 {
+	_, _, skew, _ := zeroModMoments(hurdleNegBinomialW(ψ, ρ, r), NegBinomialDist{ρ, r})
+	return skew
+}
+
+// HurdleNegBinomialExKurt returns the excess kurtosis of the Hurdle negative binomial distribution.
+func HurdleNegBinomialExKurt(ψ, ρ float64, r int64) float64 {
+	_, _, _, kurt := zeroModMoments(hurdleNegBinomialW(ψ, ρ, r), NegBinomialDist{ρ, r})
+	return kurt
+}
+
+// HurdleNegBinomialEntropy returns the Shannon entropy of the Hurdle negative binomial distribution, by numerical summation.
+func HurdleNegBinomialEntropy(ψ, ρ float64, r int64) float64 {
+	return entropyDiscrete(HurdleNegBinomialDist{ψ, ρ, r})
+}
+
+// HurdleNegBinomialCF returns the characteristic function of the Hurdle negative binomial distribution.
+func HurdleNegBinomialCF(ψ, ρ float64, r int64, t float64) complex128 {
+	l0 := float64(r) * log1p(-ρ)
+	return complex(ψ, 0) + complex((1-ψ)/-expm1(l0), 0)*(NegBinomialCF(ρ, r, t)-complex(exp(l0), 0))
+}
+
+// HurdleNegBinomialCGF returns the cumulant generating function of the Hurdle negative binomial distribution; +Inf for t ≥ -ln ρ.
+func HurdleNegBinomialCGF(ψ, ρ float64, r int64, t float64) float64 {
+	if t >= -log(ρ) {
+		return posInf
+	}
+	// the Negative binomial distribution truncated to k > 0: ln((M(t) - p0) / (1 - p0)), with p0 = (1-ρ)^r and M(t) / p0 = (1-ρe^t)^-r
+	l0 := float64(r) * log1p(-ρ)
+	k := l0 + lnExpm1(-float64(r)*log1p(-ρ*exp(t))) - log(-expm1(l0))
+	return logSumExp([]float64{log(ψ), log1p(-ψ) + k})
+}
+
+// HurdleNegBinomialFit returns the maximum-likelihood estimates of ψ, ρ of the Hurdle negative binomial distribution with r failures from the sample k.
+func HurdleNegBinomialFit(r int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return HurdleNegBinomialLnPMF(θ[0], θ[1], r) })
+	ψ, μ := hurdleStart(k)
+	return mleFit(lnL, []float64{ψ, μ / (μ + float64(r))}, []mleBound{mleUnit, mleUnit})
+}
+
+// HurdleNegBinomialDist is the Hurdle negative binomial distribution with the probability of zero ψ = Psi, and the Negative binomial distribution of the events of probability ρ = Rho before R other events, truncated to k > 0. It implements Discrete.
+type HurdleNegBinomialDist struct {
+	Psi, Rho float64
+	R        int64
+}
+
+// PMF returns the value of PMF of the Hurdle negative binomial distribution at k.
+func (d HurdleNegBinomialDist) PMF(k int64) float64 {
+	return HurdleNegBinomialPMFAt(d.Psi, d.Rho, d.R, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Hurdle negative binomial distribution at k.
+func (d HurdleNegBinomialDist) LnPMF(k int64) float64 {
+	return HurdleNegBinomialLnPMF(d.Psi, d.Rho, d.R)(k)
+}
+
+// CDF returns the value of CDF of the Hurdle negative binomial distribution at k.
+func (d HurdleNegBinomialDist) CDF(k int64) float64 {
+	return HurdleNegBinomialCDFAt(d.Psi, d.Rho, d.R, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Hurdle negative binomial distribution at k.
+func (d HurdleNegBinomialDist) Surv(k int64) float64 {
+	return HurdleNegBinomialCDFTail(d.Psi, d.Rho, d.R, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Hurdle negative binomial distribution at k.
+func (d HurdleNegBinomialDist) LnCDF(k int64) float64 {
+	return HurdleNegBinomialCDFTail(d.Psi, d.Rho, d.R, true, true)(k)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Hurdle negative binomial distribution at k.
+func (d HurdleNegBinomialDist) LnSurv(k int64) float64 {
+	return HurdleNegBinomialCDFTail(d.Psi, d.Rho, d.R, false, true)(k)
+}
+
+// Qtl returns the quantile of the Hurdle negative binomial distribution for probability p.
+func (d HurdleNegBinomialDist) Qtl(p float64) int64 {
+	return HurdleNegBinomialQtlFor(d.Psi, d.Rho, d.R, p)
+}
+
+// QtlTail returns the quantile of the Hurdle negative binomial distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d HurdleNegBinomialDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return HurdleNegBinomialQtlTail(d.Psi, d.Rho, d.R, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Hurdle negative binomial distribution.
+func (d HurdleNegBinomialDist) Rand() int64 { return HurdleNegBinomialNext(d.Psi, d.Rho, d.R) }
+
+// RandR returns random number drawn from the Hurdle negative binomial distribution, using the random source src.
+func (d HurdleNegBinomialDist) RandR(src *rand.Rand) int64 {
+	return HurdleNegBinomialNextR(src, d.Psi, d.Rho, d.R)
+}
+
+// Mean returns the mean of the Hurdle negative binomial distribution.
+func (d HurdleNegBinomialDist) Mean() float64 { return HurdleNegBinomialMean(d.Psi, d.Rho, d.R) }
+
+// Var returns the variance of the Hurdle negative binomial distribution.
+func (d HurdleNegBinomialDist) Var() float64 { return HurdleNegBinomialVar(d.Psi, d.Rho, d.R) }
+
+// Skew returns the skewness of the Hurdle negative binomial distribution.
+func (d HurdleNegBinomialDist) Skew() float64 { return HurdleNegBinomialSkew(d.Psi, d.Rho, d.R) }
+
+// ExKurt returns the excess kurtosis of the Hurdle negative binomial distribution.
+func (d HurdleNegBinomialDist) ExKurt() float64 { return HurdleNegBinomialExKurt(d.Psi, d.Rho, d.R) }
+
+// Entropy returns the Shannon entropy of the Hurdle negative binomial distribution.
+func (d HurdleNegBinomialDist) Entropy() float64 { return HurdleNegBinomialEntropy(d.Psi, d.Rho, d.R) }
+
+// CF returns the characteristic function of the Hurdle negative binomial distribution at t.
+func (d HurdleNegBinomialDist) CF(t float64) complex128 {
+	return HurdleNegBinomialCF(d.Psi, d.Rho, d.R, t)
+}
+
+// CGF returns the cumulant generating function of the Hurdle negative binomial distribution at t.
+func (d HurdleNegBinomialDist) CGF(t float64) float64 {
+	return HurdleNegBinomialCGF(d.Psi, d.Rho, d.R, t)
+}
+
+// Support returns the support of the Hurdle negative binomial distribution.
+func (d HurdleNegBinomialDist) Support() (a, b int64) { return 0, posInfInt64 }
@@ -0,0 +1,255 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Zero-inflated negative binomial distribution.
+// The count is zero with probability ψ (a structural zero), and drawn from the Negative binomial distribution otherwise:
+// overdispersed counts with more zeros than the Negative binomial distribution allows.
+// Greene, W. H. (1994). Accounting for excess zeros and sample selection in Poisson and negative binomial regression models. Working paper EC-94-10, New York University.
+//
+// Parameters:
+// ψ ∈ [0, 1]	probability of a structural zero
+// ρ ∈ (0, 1)	probability of the counted events
+// r ∈ N		number of the other events
+//
+// Support:
+// k ∈ {0, 1, 2, ... }
+
+import (
+	"math/rand"
+)
+
+// ZINegBinomialPMF returns the PMF of the Zero-inflated negative binomial distribution.
+func ZINegBinomialPMF(ψ, ρ float64, r int64) func(k int64) float64 {
+	lnPMF := ZINegBinomialLnPMF(ψ, ρ, r)
+	return func(k int64) float64 {
+		return exp(lnPMF(k))
+	}
+}
+
+// ZINegBinomialLnPMF returns the natural logarithm of the PMF of the Zero-inflated negative binomial distribution.
+func ZINegBinomialLnPMF(ψ, ρ float64, r int64) func(k int64) float64 {
+	lnf := NegBinomialLnPMF(ρ, r)
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || ρ <= 0 || ρ >= 1 || r <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return negInf
+		}
+		return zeroInflLnPMF(ψ, k, lnf(k))
+	}
+}
+
+// ZINegBinomialPMFAt returns the value of PMF of Zero-inflated negative binomial distribution at k.
+func ZINegBinomialPMFAt(ψ, ρ float64, r int64, k int64) float64 {
+	pmf := ZINegBinomialPMF(ψ, ρ, r)
+	return pmf(k)
+}
+
+// ZINegBinomialCDF returns the CDF of the Zero-inflated negative binomial distribution.
+func ZINegBinomialCDF(ψ, ρ float64, r int64) func(k int64) float64 {
+	return ZINegBinomialCDFTail(ψ, ρ, r, true, false)
+}
+
+// ZINegBinomialCDFAt returns the value of CDF of the Zero-inflated negative binomial distribution, at k.
+func ZINegBinomialCDFAt(ψ, ρ float64, r int64, k int64) float64 {
+	cdf := ZINegBinomialCDF(ψ, ρ, r)
+	return cdf(k)
+}
+
+// ZINegBinomialCDFTail returns the CDF of the Zero-inflated negative binomial distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ZINegBinomialCDFTail(ψ, ρ float64, r int64, lowerTail, logP bool) func(k int64) float64 {
+	lnP := NegBinomialCDFTail(ρ, r, lowerTail, true)
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || ρ <= 0 || ρ >= 1 || r <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		p := zeroInflLnCDF(ψ, lnP(k), lowerTail)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// ZINegBinomialQtl returns the inverse of the CDF (quantile) of the Zero-inflated negative binomial distribution.
+func ZINegBinomialQtl(ψ, ρ float64, r int64) func(p float64) int64 {
+	return ZINegBinomialQtlTail(ψ, ρ, r, true, false)
+}
+
+// ZINegBinomialQtlFor returns the inverse of the CDF (quantile) of the Zero-inflated negative binomial distribution, for given probability.
+func ZINegBinomialQtlFor(ψ, ρ float64, r int64, p float64) int64 {
+	qtl := ZINegBinomialQtl(ψ, ρ, r)
+	return qtl(p)
+}
+
+// ZINegBinomialQtlTail returns the inverse of ZINegBinomialCDFTail (quantile) of the Zero-inflated negative binomial distribution;
+// it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func ZINegBinomialQtlTail(ψ, ρ float64, r int64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := ZINegBinomialCDFTail(ψ, ρ, r, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ψ >= 0 && ψ <= 1) || !(ρ > 0 && ρ < 1) || r <= 0 {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, posInfInt64, lowerTail, logP)
+	}
+}
+
+// ZINegBinomialNext returns random number drawn from the Zero-inflated negative binomial distribution.
+func ZINegBinomialNext(ψ, ρ float64, r int64) int64 {
+	return ZINegBinomialNextR(GlobalRand, ψ, ρ, r)
+}
+
+// ZINegBinomialNextR returns random number drawn from the Zero-inflated negative binomial distribution, using the random source src.
+func ZINegBinomialNextR(src *rand.Rand, ψ, ρ float64, r int64) int64 {
+	if src.Float64() < ψ {
+		return 0
+	}
+	return NegBinomialNextR(src, ρ, r)
+}
+
+// ZINegBinomial returns the random number generator with  Zero-inflated negative binomial distribution.
+func ZINegBinomial(ψ, ρ float64, r int64) func() int64 {
+	return ZINegBinomialR(GlobalRand, ψ, ρ, r)
+}
+
+// ZINegBinomialR returns the random number generator with  Zero-inflated negative binomial distribution, using the random source src.
+func ZINegBinomialR(src *rand.Rand, ψ, ρ float64, r int64) func() int64 {
+	return func() int64 { return ZINegBinomialNextR(src, ψ, ρ, r) }
+}
+
+// ZINegBinomialFill fills x with random numbers drawn from the Zero-inflated negative binomial distribution.
+func ZINegBinomialFill(ψ, ρ float64, r int64, x []int64) {
+	ZINegBinomialFillR(GlobalRand, ψ, ρ, r, x)
+}
+
+// ZINegBinomialFillR fills x with random numbers drawn from the Zero-inflated negative binomial distribution, using the random source src.
+func ZINegBinomialFillR(src *rand.Rand, ψ, ρ float64, r int64, x []int64) {
+	for i := range x {
+		x[i] = ZINegBinomialNextR(src, ψ, ρ, r)
+	}
+}
+
+// ZINegBinomialMean returns the mean of the Zero-inflated negative binomial distribution.
+func ZINegBinomialMean(ψ, ρ float64, r int64) float64 {
+	return (1 - ψ) * NegBinomialMean(ρ, r)
+}
+
+// ZINegBinomialVar returns the variance of the Zero-inflated negative binomial distribution.
+func ZINegBinomialVar(ψ, ρ float64, r int64) float64 {
+	μ := NegBinomialMean(ρ, r)
+	return (1 - ψ) * (NegBinomialVar(ρ, r) + ψ*μ*μ)
+}
+
+// ZINegBinomialStd returns the standard deviation of the Zero-inflated negative binomial distribution.
+func ZINegBinomialStd(ψ, ρ float64, r int64) float64 {
+	return sqrt(ZINegBinomialVar(ψ, ρ, r))
+}
+
+// ZINegBinomialSkew returns the skewness of the Zero-inflated negative binomial distribution.
+func ZINegBinomialSkew(ψ, ρ float64, r int64) float64 {
+	_, _, skew, _ := zeroModMoments(1-ψ, NegBinomialDist{ρ, r})
+	return skew
+}
+
+// ZINegBinomialExKurt returns the excess kurtosis of the Zero-inflated negative binomial distribution.
+func ZINegBinomialExKurt(ψ, ρ float64, r int64) float64 {
+	_, _, _, kurt := zeroModMoments(1-ψ, NegBinomialDist{ρ, r})
+	return kurt
+}
+
+// ZINegBinomialEntropy returns the Shannon entropy of the Zero-inflated negative binomial distribution, by numerical summation.
+func ZINegBinomialEntropy(ψ, ρ float64, r int64) float64 {
+	return entropyDiscrete(ZINegBinomialDist{ψ, ρ, r})
+}
+
+// ZINegBinomialCF returns the characteristic function of the Zero-inflated negative binomial distribution.
+func ZINegBinomialCF(ψ, ρ float64, r int64, t float64) complex128 {
+	return complex(ψ, 0) + complex(1-ψ, 0)*NegBinomialCF(ρ, r, t)
+}
+
+// ZINegBinomialCGF returns the cumulant generating function of the Zero-inflated negative binomial distribution; +Inf for t ≥ -ln ρ.
+func ZINegBinomialCGF(ψ, ρ float64, r int64, t float64) float64 {
+	return logSumExp([]float64{log(ψ), log1p(-ψ) + NegBinomialCGF(ρ, r, t)})
+}
+
+// ZINegBinomialFit returns the maximum-likelihood estimates of ψ, ρ of the Zero-inflated negative binomial distribution with r failures from the sample k.
+func ZINegBinomialFit(r int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return ZINegBinomialLnPMF(θ[0], θ[1], r) })
+	ψ, μ := zeroInflStart(k)
+	return mleFit(lnL, []float64{ψ, μ / (μ + float64(r))}, []mleBound{mleUnit, mleUnit})
+}
+
+// ZINegBinomialDist is the Zero-inflated negative binomial distribution with the probability of a structural zero ψ = Psi, and the Negative binomial distribution of the events of probability ρ = Rho before R other events. It implements Discrete.
+type ZINegBinomialDist struct {
+	Psi, Rho float64
+	R        int64
+}
+
+// PMF returns the value of PMF of the Zero-inflated negative binomial distribution at k.
+func (d ZINegBinomialDist) PMF(k int64) float64 { return ZINegBinomialPMFAt(d.Psi, d.Rho, d.R, k) }
+
+// LnPMF returns the natural logarithm of the PMF of the Zero-inflated negative binomial distribution at k.
+func (d ZINegBinomialDist) LnPMF(k int64) float64 { return ZINegBinomialLnPMF(d.Psi, d.Rho, d.R)(k) }
+
+// CDF returns the value of CDF of the Zero-inflated negative binomial distribution at k.
+func (d ZINegBinomialDist) CDF(k int64) float64 { return ZINegBinomialCDFAt(d.Psi, d.Rho, d.R, k) }
+
+// Surv returns the value of the survival function 1 - CDF of the Zero-inflated negative binomial distribution at k.
+func (d ZINegBinomialDist) Surv(k int64) float64 {
+	return ZINegBinomialCDFTail(d.Psi, d.Rho, d.R, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Zero-inflated negative binomial distribution at k.
+func (d ZINegBinomialDist) LnCDF(k int64) float64 {
+	return ZINegBinomialCDFTail(d.Psi, d.Rho, d.R, true, true)(k)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Zero-inflated negative binomial distribution at k.
+func (d ZINegBinomialDist) LnSurv(k int64) float64 {
+	return ZINegBinomialCDFTail(d.Psi, d.Rho, d.R, false, true)(k)
+}
+
+// Qtl returns the quantile of the Zero-inflated negative binomial distribution for probability p.
+func (d ZINegBinomialDist) Qtl(p float64) int64 { return ZINegBinomialQtlFor(d.Psi, d.Rho, d.R, p) }
+
+// QtlTail returns the quantile of the Zero-inflated negative binomial distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ZINegBinomialDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return ZINegBinomialQtlTail(d.Psi, d.Rho, d.R, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Zero-inflated negative binomial distribution.
+func (d ZINegBinomialDist) Rand() int64 { return ZINegBinomialNext(d.Psi, d.Rho, d.R) }
+
+// RandR returns random number drawn from the Zero-inflated negative binomial distribution, using the random source src.
+func (d ZINegBinomialDist) RandR(src *rand.Rand) int64 {
+	return ZINegBinomialNextR(src, d.Psi, d.Rho, d.R)
+}
+
+// Mean returns the mean of the Zero-inflated negative binomial distribution.
+func (d ZINegBinomialDist) Mean() float64 { return ZINegBinomialMean(d.Psi, d.Rho, d.R) }
+
+// Var returns the variance of the Zero-inflated negative binomial distribution.
+func (d ZINegBinomialDist) Var() float64 { return ZINegBinomialVar(d.Psi, d.Rho, d.R) }
+
+// Skew returns the skewness of the Zero-inflated negative binomial distribution.
+func (d ZINegBinomialDist) Skew() float64 { return ZINegBinomialSkew(d.Psi, d.Rho, d.R) }
+
+// ExKurt returns the excess kurtosis of the Zero-inflated negative binomial distribution.
+func (d ZINegBinomialDist) ExKurt() float64 { return ZINegBinomialExKurt(d.Psi, d.Rho, d.R) }
+
+// Entropy returns the Shannon entropy of the Zero-inflated negative binomial distribution.
+func (d ZINegBinomialDist) Entropy() float64 { return ZINegBinomialEntropy(d.Psi, d.Rho, d.R) }
+
+// CF returns the characteristic function of the Zero-inflated negative binomial distribution at t.
+func (d ZINegBinomialDist) CF(t float64) complex128 { return ZINegBinomialCF(d.Psi, d.Rho, d.R, t) }
+
+// CGF returns the cumulant generating function of the Zero-inflated negative binomial distribution at t.
+func (d ZINegBinomialDist) CGF(t float64) float64 { return ZINegBinomialCGF(d.Psi, d.Rho, d.R, t) }
+
+// Support returns the support of the Zero-inflated negative binomial distribution.
+func (d ZINegBinomialDist) Support() (a, b int64) { return 0, posInfInt64 }
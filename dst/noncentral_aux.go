@@ -0,0 +1,79 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Noncentral distributions, helper functions.
+// The noncentral Chi-Squared, F and Beta distributions are Poisson mixtures of their central counterparts,
+// P[X ≤ x] = Σ Pois(i; λ/2) P[X_i ≤ x], with X_i central with i more degrees of freedom (2i for the Chi-Squared).
+// The series is summed on the log scale outwards from its largest term, as in Ding, C. G. (1992). Algorithm AS 275:
+// Computing the non-central chi-squared distribution function. Applied Statistics 41, 478-482, so that both tails keep their precision.
+
+// lnPoissonMix returns the logarithm of Σ Pois(i; μ) exp(lnT(i)), i = 0, 1, ..., for terms lnT given on the log scale.
+// The weighted terms should be log-concave in i, hence unimodal: the largest one is found by bisection,
+// and the sum runs outwards from it until the geometric bound on the rest is negligible.
+func lnPoissonMix(μ float64, lnT func(i float64) float64) float64 {
+	if μ == 0 {
+		return lnT(0)
+	}
+	lnμ := log(μ)
+
+	// the first i where the terms stop increasing, with Pois(i + 1; μ) = Pois(i; μ) μ/(i + 1)
+	past := func(i int64) bool { return lnT(float64(i+1))+lnμ-log(float64(i+1)) <= lnT(float64(i)) }
+	lo, hi := int64(0), int64(μ)
+	for !past(hi) {
+		lo, hi = hi+1, 2*hi+1
+	}
+	for lo < hi {
+		m := lo + (hi-lo)/2
+		if past(m) {
+			hi = m
+		} else {
+			lo = m + 1
+		}
+	}
+	w0 := dpois_raw_ln(float64(lo), μ)
+	top := w0 + lnT(float64(lo))
+	if isNaN(top) || isInf(top, 0) {
+		return top
+	}
+
+	// sums relative to the largest term, upwards then downwards
+	s := 1.0
+	for dir := int64(-1); dir <= 1; dir += 2 {
+		prev, w := top, w0
+		for i := lo + dir; i >= 0; i += dir {
+			if dir > 0 {
+				w += lnμ - log(float64(i))
+			} else {
+				w -= lnμ - log(float64(i+1))
+			}
+			ti := w + lnT(float64(i))
+			term := exp(ti - top)
+			s += term
+			if r := exp(ti - prev); r < 1 && term < eps64*s*(1-r) {
+				break
+			}
+			prev = ti
+		}
+	}
+	return top + log(s)
+}
+
+// ncBetaLnPDF returns the logarithm of the PDF of the noncentral Beta distribution at x, given also y = 1 - x.
+func ncBetaLnPDF(α, β, λ, x, y float64) float64 {
+	lnx, lny := log(x), log(y)
+	return lnPoissonMix(λ/2, func(i float64) float64 {
+		return -logB(α+i, β) + (α+i-1)*lnx + (β-1)*lny
+	})
+}
+
+// ncBetaLnCDF returns the logarithm of the CDF of the noncentral Beta distribution at x if lowerTail, of the survival function otherwise,
+// given also y = 1 - x; the Beta probabilities are taken at the smaller of x and y, where they keep their precision.
+func ncBetaLnCDF(α, β, λ, x, y float64, lowerTail bool) float64 {
+	return lnPoissonMix(λ/2, func(i float64) float64 {
+		if x > 0.5 {
+			return BetaCDFTail(β, α+i, !lowerTail, true)(y)
+		}
+		return BetaCDFTail(α+i, β, lowerTail, true)(x)
+	})
+}
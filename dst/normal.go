@@ -110,6 +110,13 @@ func NormalCDFAt(μ, σ, x float64) float64 {
 	return cdf(x)
 }
 
+// NormalCDFTail returns the CDF of the Normal distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func NormalCDFTail(μ, σ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		return pnorm((x-μ)/σ, lowerTail, logP)
+	}
+}
+
 // NormalQtl returns the inverse of the CDF (quantile) of the Normal distribution. 
 func NormalQtl(μ, σ float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -123,12 +130,39 @@ func NormalQtlFor(μ, σ, p float64) float64 {
 	return qtl(p)
 }
 
+// NormalQtlTail returns the inverse of NormalCDFTail (quantile) of the Normal distribution.
+func NormalQtlTail(μ, σ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		return μ + σ*qnorm(p, lowerTail, logP)
+	}
+}
+
 // NormalNext returns random number drawn from the Normal distribution. 
-func NormalNext(μ, σ float64) float64 { return rand.NormFloat64()*σ + μ }
+func NormalNext(μ, σ float64) float64 { return NormalNextR(GlobalRand, μ, σ) }
+
+// NormalNextR returns random number drawn from the Normal distribution, using the random source src.
+func NormalNextR(src *rand.Rand, μ, σ float64) float64 { return normalZig(src)*σ + μ }
 
 // Normal returns the random number generator with  Normal distribution. 
 func Normal(μ, σ float64) func() float64 {
-	return func() float64 { return NormalNext(μ, σ) }
+	return NormalR(GlobalRand, μ, σ)
+}
+
+// NormalR returns the random number generator with  Normal distribution, using the random source src.
+func NormalR(src *rand.Rand, μ, σ float64) func() float64 {
+	return func() float64 { return NormalNextR(src, μ, σ) }
+}
+
+// NormalFill fills x with random numbers drawn from the Normal distribution.
+func NormalFill(μ, σ float64, x []float64) {
+	NormalFillR(GlobalRand, μ, σ, x)
+}
+
+// NormalFillR fills x with random numbers drawn from the Normal distribution, using the random source src.
+func NormalFillR(src *rand.Rand, μ, σ float64, x []float64) {
+	for i := range x {
+		x[i] = NormalNextR(src, μ, σ)
+	}
 }
 
 // NormalMean returns the mean of the Normal distribution. 
@@ -166,7 +200,117 @@ func NormalExKurt(μ, σ float64) float64 {
 	return 0
 }
 
+// NormalEntropy returns the differential entropy of the Normal distribution.
+func NormalEntropy(μ, σ float64) float64 {
+	return log(σ) + 0.5 + M_LN_SQRT_2PI
+}
+
+// NormalCF returns the characteristic function of the Normal distribution.
+func NormalCF(μ, σ, t float64) complex128 {
+	return cexp(complex(-σ*σ*t*t/2, μ*t))
+}
+
+// NormalCGF returns the cumulant generating function of the Normal distribution.
+func NormalCGF(μ, σ, t float64) float64 {
+	return μ*t + σ*σ*t*t/2
+}
+
+// NormalKL returns the Kullback–Leibler divergence of the Normal distribution with μ2, σ2 from the one with μ1, σ1.
+func NormalKL(μ1, σ1, μ2, σ2 float64) float64 {
+	d := (μ1 - μ2) / σ2
+	r := σ1 / σ2
+	return (r*r+d*d-1)/2 - log(r)
+}
+
 // NormalMGF returns the moment-generating function of the Normal distribution. 
 func NormalMGF(μ, σ, t float64) float64 {
 	return exp(μ*t + σ*σ*t*t/2)
 }
+
+// NormalFromMeanStd returns the Normal distribution with mean μ and standard deviation σ.
+func NormalFromMeanStd(μ, σ float64) NormalDist {
+	if σ <= 0 {
+		return NormalDist{NaN, NaN}
+	}
+	return NormalDist{μ, σ}
+}
+
+// NormalFromModeStd returns the Normal distribution with mode m and standard deviation σ.
+func NormalFromModeStd(m, σ float64) NormalDist {
+	return NormalFromMeanStd(m, σ)
+}
+
+// NormalMatchQtls returns the Normal distribution whose quantiles for the probabilities p best match x.
+func NormalMatchQtls(p, x []float64) NormalDist {
+	μ, σ := qtlMeanStd(p, x)
+	e := matchQtls(func(θ []float64) Continuous { return NormalDist{θ[0], θ[1]} }, p, x, []float64{μ, σ}, []mleBound{mleReal, mlePos})
+	return NormalDist{e[0], e[1]}
+}
+
+// NormalFit returns the maximum-likelihood estimates of μ, σ of the Normal distribution from the sample x: its mean and its standard deviation with divisor n.
+func NormalFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return NormalLnPDF(θ[0], θ[1]) })
+	μ, σ2 := mleMeanVar(x)
+	return mleAt(lnL, []float64{μ, sqrt(σ2)})
+}
+
+// NormalDist is the Normal distribution with location μ = Mu and standard deviation σ = Sigma. It implements Continuous.
+type NormalDist struct {
+	Mu, Sigma float64
+}
+
+// PDF returns the value of PDF of the Normal distribution at x.
+func (d NormalDist) PDF(x float64) float64 { return NormalPDFAt(d.Mu, d.Sigma, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Normal distribution at x.
+func (d NormalDist) LnPDF(x float64) float64 { return NormalLnPDF(d.Mu, d.Sigma)(x) }
+
+// CDF returns the value of CDF of the Normal distribution at x.
+func (d NormalDist) CDF(x float64) float64 { return NormalCDFTail(d.Mu, d.Sigma, true, false)(x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Normal distribution at x.
+func (d NormalDist) Surv(x float64) float64 { return NormalCDFTail(d.Mu, d.Sigma, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Normal distribution at x.
+func (d NormalDist) LnCDF(x float64) float64 { return NormalCDFTail(d.Mu, d.Sigma, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Normal distribution at x.
+func (d NormalDist) LnSurv(x float64) float64 { return NormalCDFTail(d.Mu, d.Sigma, false, true)(x) }
+
+// Qtl returns the quantile of the Normal distribution for probability p.
+func (d NormalDist) Qtl(p float64) float64 { return NormalQtlFor(d.Mu, d.Sigma, p) }
+
+// QtlTail returns the quantile of the Normal distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d NormalDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return NormalQtlTail(d.Mu, d.Sigma, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Normal distribution.
+func (d NormalDist) Rand() float64 { return NormalNext(d.Mu, d.Sigma) }
+
+// RandR returns random number drawn from the Normal distribution, using the random source src.
+func (d NormalDist) RandR(src *rand.Rand) float64 { return NormalNextR(src, d.Mu, d.Sigma) }
+
+// Mean returns the mean of the Normal distribution.
+func (d NormalDist) Mean() float64 { return NormalMean(d.Mu, d.Sigma) }
+
+// Var returns the variance of the Normal distribution.
+func (d NormalDist) Var() float64 { return NormalVar(d.Mu, d.Sigma) }
+
+// Skew returns the skewness of the Normal distribution.
+func (d NormalDist) Skew() float64 { return NormalSkew(d.Mu, d.Sigma) }
+
+// ExKurt returns the excess kurtosis of the Normal distribution.
+func (d NormalDist) ExKurt() float64 { return NormalExKurt(d.Mu, d.Sigma) }
+
+// Entropy returns the differential entropy of the Normal distribution.
+func (d NormalDist) Entropy() float64 { return NormalEntropy(d.Mu, d.Sigma) }
+
+// CF returns the characteristic function of the Normal distribution at t.
+func (d NormalDist) CF(t float64) complex128 { return NormalCF(d.Mu, d.Sigma, t) }
+
+// CGF returns the cumulant generating function of the Normal distribution at t.
+func (d NormalDist) CGF(t float64) float64 { return NormalCGF(d.Mu, d.Sigma, t) }
+
+// Support returns the support of the Normal distribution.
+func (d NormalDist) Support() (a, b float64) { return negInf, posInf }
@@ -0,0 +1,203 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Normal distribution, helper functions for both tails and log-probabilities.
+
+// pnormBoth returns the lower and upper tail probabilities of the Standard Normal distribution at x,
+// or their logarithms if logP.
+// Cody, W. D. (1993). ALGORITHM 715: SPECFUN - A Portable FORTRAN Package of Special Function Routines and Test Drivers. ACM Transactions on Mathematical Software 19, 22-32.
+func pnormBoth(x float64, logP bool) (cum, ccum float64) {
+	var (
+		a = []float64{
+			2.2352520354606839287,
+			161.02823106855587881,
+			1067.6894854603709582,
+			18154.981253343561249,
+			0.065682337918207449113,
+		}
+		b = []float64{
+			47.20258190468824187,
+			976.09855173777669322,
+			10260.932208618978205,
+			45507.789335026729956,
+		}
+		c = []float64{
+			0.39894151208813466764,
+			8.8831497943883759412,
+			93.506656132177855979,
+			597.27027639480026226,
+			2494.5375852903726711,
+			6848.1904505362823326,
+			11602.651437647350124,
+			9842.7148383839780218,
+			1.0765576773720192317e-8,
+		}
+		d = []float64{
+			22.266688044328115691,
+			235.38790178262499861,
+			1519.377599407554805,
+			6485.558298266760755,
+			18615.571640885098091,
+			34900.952721145977266,
+			38912.003286093271411,
+			19685.429676859990727,
+		}
+		p = []float64{
+			0.21589853405795699,
+			0.1274011611602473639,
+			0.022235277870649807,
+			0.001421619193227893466,
+			2.9112874951168792e-5,
+			0.02307344176494017303,
+		}
+		q = []float64{
+			1.28426009614491121,
+			0.468238212480865118,
+			0.0659881378689285515,
+			0.00378239633202758244,
+			7.29751555083966205e-5,
+		}
+		xnum, xden, temp, xsq float64
+	)
+
+	if isNaN(x) {
+		return NaN, NaN
+	}
+
+	// small tail from the rational approximation temp, then the other one by complement
+	del := func(y float64) {
+		xsq = trunc(y*16) / 16
+		dl := (y - xsq) * (y + xsq)
+		if logP {
+			cum = (-xsq * xsq * 0.5) + (-dl * 0.5) + log(temp)
+			ccum = log1p(-exp(-xsq*xsq*0.5) * exp(-dl*0.5) * temp)
+		} else {
+			cum = exp(-xsq*xsq*0.5) * exp(-dl*0.5) * temp
+			ccum = 1 - cum
+		}
+		if x > 0 {
+			cum, ccum = ccum, cum
+		}
+	}
+
+	y := abs(x)
+	switch {
+	case y <= 0.67448975: // qnorm(3/4)
+		if y > eps64 {
+			xsq = x * x
+			xnum = a[4] * xsq
+			xden = xsq
+			for i := 0; i < 3; i++ {
+				xnum = (xnum + a[i]) * xsq
+				xden = (xden + b[i]) * xsq
+			}
+		}
+		temp = x * (xnum + a[3]) / (xden + b[3])
+		cum = 0.5 + temp
+		ccum = 0.5 - temp
+		if logP {
+			cum = log(cum)
+			ccum = log(ccum)
+		}
+	case y <= sqrt(32):
+		xnum = c[8] * y
+		xden = y
+		for i := 0; i < 7; i++ {
+			xnum = (xnum + c[i]) * y
+			xden = (xden + d[i]) * y
+		}
+		temp = (xnum + c[7]) / (xden + d[7])
+		del(y)
+	case (logP && y < 1e170) || (-37.5193 < x && x < 37.5193):
+		xsq = 1 / (x * x)
+		xnum = p[5] * xsq
+		xden = xsq
+		for i := 0; i < 4; i++ {
+			xnum = (xnum + p[i]) * xsq
+			xden = (xden + q[i]) * xsq
+		}
+		temp = xsq * (xnum + p[4]) / (xden + q[4])
+		temp = (M_1_SQRT_2PI - temp) / y
+		del(x)
+	default: // probabilities are 0 or 1
+		cum, ccum = 1, 0
+		if x < 0 {
+			cum, ccum = 0, 1
+		}
+		if logP {
+			cum, ccum = log(cum), log(ccum)
+		}
+	}
+	return
+}
+
+// pnorm returns the CDF of the Standard Normal distribution at x, on the tail and scale selected by lowerTail and logP.
+func pnorm(x float64, lowerTail, logP bool) float64 {
+	if isInf(x, 0) {
+		return pTailBounds(x > 0, lowerTail, logP)
+	}
+	cum, ccum := pnormBoth(x, logP)
+	if lowerTail {
+		return cum
+	}
+	return ccum
+}
+
+// qnorm returns the quantile of the Standard Normal distribution for p on the tail and scale selected by lowerTail and logP.
+// Wichura, M. J. (1988). Algorithm AS 241: The percentage points of the normal distribution. Applied Statistics 37, 477-484.
+func qnorm(p float64, lowerTail, logP bool) float64 {
+	if isNaN(p) || !pValid(p, logP) {
+		return NaN
+	}
+	if pEdge(p, false, lowerTail, logP) {
+		return negInf
+	}
+	if pEdge(p, true, lowerTail, logP) {
+		return posInf
+	}
+	q := pLower(p, lowerTail, logP) - 0.5
+	if abs(q) <= 0.425 {
+		return small(q)
+	}
+	// r = sqrt(-log(min(p, 1-p))), the smaller tail taken as given if possible
+	var r float64
+	if logP && ((lowerTail && q <= 0) || (!lowerTail && q > 0)) {
+		r = sqrt(-p)
+	} else if q > 0 {
+		r = sqrt(-log(pUpper(p, lowerTail, logP)))
+	} else {
+		r = sqrt(-log(pLower(p, lowerTail, logP)))
+	}
+	var x float64
+	switch {
+	case r <= 5:
+		x = intermediate(r)
+	case r <= 27:
+		x = tail(r)
+	case r >= 6.4e8:
+		// only reachable with log-probabilities
+		x = r * sqrt2
+	default:
+		// asymptotic expansion of the tail, Maechler, M. (2022). Asymptotic tail formulas for Gaussian quantiles. DPQ R package vignette.
+		s2 := 2 * r * r
+		x2 := s2 - log(2*π*s2)
+		if r < 36000 {
+			x2 = s2 - log(2*π*x2) - 2/(2+x2)
+			if r < 840 {
+				x2 = s2 - log(2*π*x2) + 2*log1p(-(1-1/(4+x2))/(2+x2))
+				if r < 109 {
+					x2 = s2 - log(2*π*x2) + 2*log1p(-(1-(1-5/(6+x2))/(4+x2))/(2+x2))
+					if r < 55 {
+						x2 = s2 - log(2*π*x2) + 2*log1p(-(1-(1-(5-9/(8+x2))/(6+x2))/(4+x2))/(2+x2))
+					}
+				}
+			}
+		}
+		x = sqrt(x2)
+	}
+	if q < 0 {
+		return -x
+	}
+	return x
+}
@@ -12,6 +12,10 @@ package dst
 // k x >= θ 
 // x ∈ (0, ∞)
 
+import (
+	"math/rand"
+)
+
 // ParetoChkParams checks parameters of the Pareto Type I distribution. 
 func ParetoChkParams(θ, α float64) bool {
 	ok := true
@@ -64,10 +68,20 @@ func ParetoCDFAt(θ, α, x float64) float64 {
 	return cdf(x)
 }
 
+// ParetoCDFTail returns the CDF of the Pareto distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ParetoCDFTail(θ, α float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x < θ {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnUpper(α*log(θ/x), lowerTail, logP)
+	}
+}
+
 // ParetoQtl returns the inverse of the CDF (quantile) of the Pareto Type I distribution. 
 func ParetoQtl(θ, α float64) func(p float64) float64 {
 	return func(p float64) float64 {
-		return pow(θ*(1-p), (-1 / α))
+		return θ * pow(1-p, (-1/α))
 	}
 }
 
@@ -77,15 +91,47 @@ func ParetoQtlFor(θ, α, p float64) float64 {
 	return cdf(p)
 }
 
+// ParetoQtlTail returns the inverse of ParetoCDFTail (quantile) of the Pareto distribution.
+func ParetoQtlTail(θ, α float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return θ * exp(-pLnUpper(p, lowerTail, logP)/α)
+	}
+}
+
 // ParetoNext returns random number drawn from the Pareto distribution. 
 func ParetoNext(θ, α float64) (x float64) {
-	p := UniformNext(0, 1)
+	return ParetoNextR(GlobalRand, θ, α)
+}
+
+// ParetoNextR returns random number drawn from the Pareto distribution, using the random source src.
+func ParetoNextR(src *rand.Rand, θ, α float64) (x float64) {
+	p := UniformNextR(src, 0, 1)
 	return ParetoQtlFor(θ, α, p)
 }
 
 // Pareto returns the random number generator with  Planck distribution. 
 func Pareto(θ, α float64) func() float64 {
-	return func() float64 { return ParetoNext(θ, α) }
+	return ParetoR(GlobalRand, θ, α)
+}
+
+// ParetoR returns the random number generator with  Pareto distribution, using the random source src.
+func ParetoR(src *rand.Rand, θ, α float64) func() float64 {
+	return func() float64 { return ParetoNextR(src, θ, α) }
+}
+
+// ParetoFill fills x with random numbers drawn from the Pareto distribution.
+func ParetoFill(θ, α float64, x []float64) {
+	ParetoFillR(GlobalRand, θ, α, x)
+}
+
+// ParetoFillR fills x with random numbers drawn from the Pareto distribution, using the random source src.
+func ParetoFillR(src *rand.Rand, θ, α float64, x []float64) {
+	for i := range x {
+		x[i] = ParetoNextR(src, θ, α)
+	}
 }
 
 // ParetoMean returns the mean of the Pareto Type I distribution. 
@@ -138,6 +184,28 @@ func ParetoExKurt(θ, α float64) float64 {
 	return 6 * (α*α*α + α*α - 6*α - 2) / (α * (α - 3) * (α - 4))
 }
 
+// ParetoEntropy returns the differential entropy of the Pareto distribution.
+func ParetoEntropy(θ, α float64) float64 {
+	return log(θ/α) + 1/α + 1
+}
+
+// ParetoCF returns the characteristic function of the Pareto distribution, by numerical integration.
+func ParetoCF(θ, α, t float64) complex128 {
+	return cfContinuous(ParetoDist{θ, α}, t)
+}
+
+// ParetoCGF returns the cumulant generating function of the Pareto distribution; +Inf for t > 0.
+func ParetoCGF(θ, α, t float64) float64 {
+	switch {
+	case t == 0:
+		return 0
+	case t > 0:
+		return posInf
+	}
+	// α (-θt)^α Γ(-α, -θt)
+	return log(α) + α*log(-θ*t) + log(upperΓ(-α, -θ*t))
+}
+
 // ParetoMGF returns the moment-generating function of the Pareto Type I distribution. 
 func ParetoMGF(θ, α, t float64) float64 {
 	if t >= 0 {
@@ -146,15 +214,129 @@ func ParetoMGF(θ, α, t float64) float64 {
 	return α * pow((-θ*t), α) * iΓ(-α, -θ*t)
 }
 
-/*
-// ParetoReparamMeanStd returns the parameters a, b of the Pareto distribution calculated from mean and standard deviation. 
-// To be used to reparametrize the Pareto distribution. 
+// ParetoReparamMeanStd returns the parameters θ, α of the Pareto distribution calculated from mean and standard deviation.
+// To be used to reparametrize the Pareto distribution.
 func ParetoReparamMeanStd(mean, std float64) (θ, α float64) {
+	if mean <= 0 || std <= 0 {
+		return NaN, NaN
+	}
+	// std²/mean² = 1/(α(α-2))
+	α = 1 + sqrt(1+mean*mean/(std*std))
+	θ = mean * (α - 1) / α
 	return
 }
-// ParetoReparamModeStd returns the parameters a, b of the Pareto distribution calculated from mean and standard deviation. 
-// To be used to reparametrize the Pareto distribution. 
+
+// ParetoReparamModeStd returns the parameters θ, α of the Pareto distribution calculated from mode and standard deviation.
+// To be used to reparametrize the Pareto distribution.
 func ParetoReparamModeStd(mode, std float64) (θ, α float64) {
+	if mode <= 0 || std <= 0 {
+		return NaN, NaN
+	}
+	// the mode is θ, and std²/θ² = α/((α-1)²(α-2)) decreases in α > 2
+	θ = mode
+	α = bisectFn(func(α float64) float64 { return -α / ((α - 1) * (α - 1) * (α - 2)) }, -std*std/(θ*θ), 2, posInf)
 	return
 }
-*/
+
+// ParetoFromMeanStd returns the Pareto distribution with mean μ and standard deviation σ.
+func ParetoFromMeanStd(μ, σ float64) ParetoDist {
+	θ, α := ParetoReparamMeanStd(μ, σ)
+	return ParetoDist{θ, α}
+}
+
+// ParetoFromModeStd returns the Pareto distribution with mode m and standard deviation σ.
+func ParetoFromModeStd(m, σ float64) ParetoDist {
+	θ, α := ParetoReparamModeStd(m, σ)
+	return ParetoDist{θ, α}
+}
+
+// ParetoMatchQtls returns the Pareto distribution whose quantiles for the probabilities p best match x.
+func ParetoMatchQtls(p, x []float64) ParetoDist {
+	// log x = log θ - log(1-p) / α
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return -log1p(-p) }), matchMap(x, log))
+	e := matchQtls(func(θ []float64) Continuous { return ParetoDist{θ[0], θ[1]} }, p, x, []float64{exp(a), 1 / b}, []mleBound{mlePos, mlePos})
+	return ParetoDist{e[0], e[1]}
+}
+
+// ParetoFit returns the maximum-likelihood estimates of θ, α of the Pareto distribution from the sample x:
+// θ is its minimum, on the bound of the likelihood, so that its standard error is NaN, and α = n / Σ log(x/θ).
+func ParetoFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return ParetoDist{θ[0], θ[1]}.LnPDF })
+	θ, _ := mleMinMax(x)
+	return mleAtFixed(lnL, []float64{θ, paretoShape(x, θ)}, []bool{true, false})
+}
+
+// paretoShape returns the maximum-likelihood estimate n / Σ log(x/θ) of the shape of the Pareto distribution from the sample x, given the minimum θ.
+func paretoShape(x []float64, θ float64) float64 {
+	s := fZero
+	for _, v := range x {
+		s += log(v / θ)
+	}
+	return float64(len(x)) / s
+}
+
+// ParetoDist is the Pareto distribution with scale θ = Theta and shape α = Alpha. It implements Continuous.
+type ParetoDist struct {
+	Theta, Alpha float64
+}
+
+// PDF returns the value of PDF of the Pareto distribution at x.
+func (d ParetoDist) PDF(x float64) float64 { return ParetoPDFAt(d.Theta, d.Alpha, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Pareto distribution at x.
+func (d ParetoDist) LnPDF(x float64) float64 {
+	if x < d.Theta {
+		return negInf
+	}
+	return log(d.Alpha) + d.Alpha*log(d.Theta) - (d.Alpha+1)*log(x)
+}
+
+// CDF returns the value of CDF of the Pareto distribution at x.
+func (d ParetoDist) CDF(x float64) float64 { return ParetoCDFAt(d.Theta, d.Alpha, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Pareto distribution at x.
+func (d ParetoDist) Surv(x float64) float64 { return ParetoCDFTail(d.Theta, d.Alpha, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Pareto distribution at x.
+func (d ParetoDist) LnCDF(x float64) float64 { return ParetoCDFTail(d.Theta, d.Alpha, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Pareto distribution at x.
+func (d ParetoDist) LnSurv(x float64) float64 { return ParetoCDFTail(d.Theta, d.Alpha, false, true)(x) }
+
+// Qtl returns the quantile of the Pareto distribution for probability p.
+func (d ParetoDist) Qtl(p float64) float64 { return ParetoQtlFor(d.Theta, d.Alpha, p) }
+
+// QtlTail returns the quantile of the Pareto distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ParetoDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return ParetoQtlTail(d.Theta, d.Alpha, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Pareto distribution.
+func (d ParetoDist) Rand() float64 { return ParetoNext(d.Theta, d.Alpha) }
+
+// RandR returns random number drawn from the Pareto distribution, using the random source src.
+func (d ParetoDist) RandR(src *rand.Rand) float64 { return ParetoNextR(src, d.Theta, d.Alpha) }
+
+// Mean returns the mean of the Pareto distribution.
+func (d ParetoDist) Mean() float64 { return ParetoMean(d.Theta, d.Alpha) }
+
+// Var returns the variance of the Pareto distribution.
+func (d ParetoDist) Var() float64 { return ParetoVar(d.Theta, d.Alpha) }
+
+// Skew returns the skewness of the Pareto distribution.
+func (d ParetoDist) Skew() float64 { return ParetoSkew(d.Theta, d.Alpha) }
+
+// ExKurt returns the excess kurtosis of the Pareto distribution.
+func (d ParetoDist) ExKurt() float64 { return ParetoExKurt(d.Theta, d.Alpha) }
+
+// Entropy returns the differential entropy of the Pareto distribution.
+func (d ParetoDist) Entropy() float64 { return ParetoEntropy(d.Theta, d.Alpha) }
+
+// CF returns the characteristic function of the Pareto distribution at t.
+func (d ParetoDist) CF(t float64) complex128 { return ParetoCF(d.Theta, d.Alpha, t) }
+
+// CGF returns the cumulant generating function of the Pareto distribution at t.
+func (d ParetoDist) CGF(t float64) float64 { return ParetoCGF(d.Theta, d.Alpha, t) }
+
+// Support returns the support of the Pareto distribution.
+func (d ParetoDist) Support() (a, b float64) { return d.Theta, posInf }
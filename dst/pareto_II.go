@@ -77,6 +77,16 @@ func ParetoIICDFAt(θ, α, q, x float64) float64 {
 	return cdf(x)
 }
 
+// ParetoIICDFTail returns the CDF of the Pareto Type II distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ParetoIICDFTail(θ, α float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnUpper(-α*log1p(x/θ), lowerTail, logP)
+	}
+}
+
 // ParetoIIQtl returns the inverse of the CDF (quantile) of the Pareto Type II distribution. 
 func ParetoIIQtl(θ, α float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -89,20 +99,52 @@ func ParetoIIQtl(θ, α float64) func(p float64) float64 {
 
 // ParetoIIQtlFor returns the inverse of the CDF (quantile) of the Pareto Type II distribution, for given probability.
 func ParetoIIQtlFor(θ, α, p float64) float64 {
-	cdf := ParetoQtl(θ, α)
+	cdf := ParetoIIQtl(θ, α)
 	return cdf(p)
 }
 
+// ParetoIIQtlTail returns the inverse of ParetoIICDFTail (quantile) of the Pareto Type II distribution.
+func ParetoIIQtlTail(θ, α float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return θ * expm1(-pLnUpper(p, lowerTail, logP)/α)
+	}
+}
+
 // ParetoIINext returns random number drawn from the Pareto Type II distribution. 
 func ParetoIINext(θ, α float64) float64 {
+	return ParetoIINextR(GlobalRand, θ, α)
+}
+
+// ParetoIINextR returns random number drawn from the Pareto Type II distribution, using the random source src.
+func ParetoIINextR(src *rand.Rand, θ, α float64) float64 {
 	qtl := ParetoIIQtl(θ, α)
-	p := rand.Float64()
+	p := src.Float64()
 	return qtl(p)
 }
 
 // ParetoII returns the random number generator with  Pareto Type II distribution. 
 func ParetoII(θ, α float64) func() float64 {
-	return func() float64 { return ParetoIINext(θ, α) }
+	return ParetoIIR(GlobalRand, θ, α)
+}
+
+// ParetoIIR returns the random number generator with  Pareto Type II distribution, using the random source src.
+func ParetoIIR(src *rand.Rand, θ, α float64) func() float64 {
+	return func() float64 { return ParetoIINextR(src, θ, α) }
+}
+
+// ParetoIIFill fills x with random numbers drawn from the Pareto Type II distribution.
+func ParetoIIFill(θ, α float64, x []float64) {
+	ParetoIIFillR(GlobalRand, θ, α, x)
+}
+
+// ParetoIIFillR fills x with random numbers drawn from the Pareto Type II distribution, using the random source src.
+func ParetoIIFillR(src *rand.Rand, θ, α float64, x []float64) {
+	for i := range x {
+		x[i] = ParetoIINextR(src, θ, α)
+	}
 }
 
 // ParetoIIMoment returns the n-th moment of the Pareto Type II distribution. 
@@ -121,15 +163,156 @@ func ParetoIIMean(θ, α float64) float64 {
 
 // ParetoIIVar returns the variance of the Pareto Type II distribution. 
 func ParetoIIVar(θ, α float64) float64 {
-	return ParetoIIMoment(θ, α, 2)
+	_, σ2, _, _ := paretoIIMoments(θ, α)
+	return σ2
 }
 
 // ParetoIISkew returns the skewness of the Pareto Type II distribution. 
 func ParetoIISkew(θ, α float64) float64 {
-	return ParetoIIMoment(θ, α, 3)
+	_, _, s, _ := paretoIIMoments(θ, α)
+	return s
 }
 
 // ParetoIIExKurt returns the excess kurtosis of the Pareto Type II distribution. 
 func ParetoIIExKurt(θ, α float64) float64 {
-	return ParetoIIMoment(θ, α, 4)
+	_, _, _, k := paretoIIMoments(θ, α)
+	return k
+}
+
+// ParetoIIEntropy returns the differential entropy of the Pareto Type II distribution.
+func ParetoIIEntropy(θ, α float64) float64 {
+	return log(θ/α) + 1/α + 1
+}
+
+// ParetoIICF returns the characteristic function of the Pareto Type II distribution, by numerical integration.
+func ParetoIICF(θ, α, t float64) complex128 {
+	return cfContinuous(ParetoIIDist{θ, α}, t)
+}
+
+// ParetoIICGF returns the cumulant generating function of the Pareto Type II distribution, by numerical integration; +Inf for t > 0.
+func ParetoIICGF(θ, α, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfContinuous(ParetoIIDist{θ, α}, t)
+}
+
+// paretoIIMoments returns the mean, variance, skewness and excess kurtosis computed from the raw moments.
+func paretoIIMoments(θ, α float64) (mean, σ2, skew, kurt float64) {
+	return rawMoments(ParetoIIMoment(θ, α, 1), ParetoIIMoment(θ, α, 2), ParetoIIMoment(θ, α, 3), ParetoIIMoment(θ, α, 4))
+}
+
+// ParetoIIFromMeanStd returns the Pareto Type II distribution with mean μ and standard deviation σ > μ.
+func ParetoIIFromMeanStd(μ, σ float64) ParetoIIDist {
+	if μ <= 0 || σ <= μ {
+		return ParetoIIDist{NaN, NaN}
+	}
+	α := 2 * σ * σ / (σ*σ - μ*μ)
+	return ParetoIIDist{μ * (α - 1), α}
+}
+
+// ParetoIIMatchQtls returns the Pareto Type II distribution whose quantiles for the probabilities p best match x.
+func ParetoIIMatchQtls(p, x []float64) ParetoIIDist {
+	θ := paretoIIQtlStart(p, x, 3)
+	e := matchQtls(func(θ []float64) Continuous { return ParetoIIDist{θ[0], θ[1]} }, p, x, []float64{θ, 3}, []mleBound{mlePos, mlePos})
+	return ParetoIIDist{e[0], e[1]}
+}
+
+// paretoIIQtlStart returns the scale θ of the Pareto Type II distribution with shape α whose quantiles for the probabilities p best match x,
+// x = θ ((1-p)^(-1/α) - 1), fitted through the origin.
+func paretoIIQtlStart(p, x []float64, α float64) float64 {
+	var sww, swx float64
+	for i, v := range p {
+		w := expm1(-log1p(-v) / α)
+		sww += w * w
+		swx += w * x[i]
+	}
+	return swx / sww
+}
+
+// ParetoIIFit returns the maximum-likelihood estimates of θ, α of the Pareto Type II distribution from the sample x.
+func ParetoIIFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return ParetoIIDist{θ[0], θ[1]}.LnPDF })
+	θ, α := paretoIIStart(x)
+	return mleFit(lnL, []float64{θ, α}, []mleBound{mlePos, mlePos})
+}
+
+// paretoIIStart returns the estimates of θ, α of the Pareto Type II distribution by the method of moments, α = 3 if the variance is too small.
+func paretoIIStart(x []float64) (θ, α float64) {
+	μ, σ2 := mleMeanVar(x)
+	// the variance is α / (α - 2) times the square of the mean
+	α = 3.0
+	if r := σ2 / (μ * μ); r > 1 {
+		α = 2 * r / (r - 1)
+	}
+	return μ * (α - 1), α
+}
+
+// ParetoIIDist is the Pareto Type II (Lomax) distribution with scale θ = Theta and shape α = Alpha. It implements Continuous.
+type ParetoIIDist struct {
+	Theta, Alpha float64
+}
+
+// PDF returns the value of PDF of the Pareto Type II distribution at x.
+func (d ParetoIIDist) PDF(x float64) float64 { return ParetoIIPDFAt(d.Theta, d.Alpha, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Pareto Type II distribution at x.
+func (d ParetoIIDist) LnPDF(x float64) float64 { return log(ParetoIIPDFAt(d.Theta, d.Alpha, x)) }
+
+// CDF returns the value of CDF of the Pareto Type II distribution at x.
+func (d ParetoIIDist) CDF(x float64) float64 {
+	return ParetoIICDFTail(d.Theta, d.Alpha, true, false)(x)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Pareto Type II distribution at x.
+func (d ParetoIIDist) Surv(x float64) float64 {
+	return ParetoIICDFTail(d.Theta, d.Alpha, false, false)(x)
 }
+
+// LnCDF returns the natural logarithm of the CDF of the Pareto Type II distribution at x.
+func (d ParetoIIDist) LnCDF(x float64) float64 {
+	return ParetoIICDFTail(d.Theta, d.Alpha, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Pareto Type II distribution at x.
+func (d ParetoIIDist) LnSurv(x float64) float64 {
+	return ParetoIICDFTail(d.Theta, d.Alpha, false, true)(x)
+}
+
+// Qtl returns the quantile of the Pareto Type II distribution for probability p.
+func (d ParetoIIDist) Qtl(p float64) float64 { return ParetoIIQtlFor(d.Theta, d.Alpha, p) }
+
+// QtlTail returns the quantile of the Pareto Type II distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ParetoIIDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return ParetoIIQtlTail(d.Theta, d.Alpha, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Pareto Type II distribution.
+func (d ParetoIIDist) Rand() float64 { return ParetoIINext(d.Theta, d.Alpha) }
+
+// RandR returns random number drawn from the Pareto Type II distribution, using the random source src.
+func (d ParetoIIDist) RandR(src *rand.Rand) float64 { return ParetoIINextR(src, d.Theta, d.Alpha) }
+
+// Mean returns the mean of the Pareto Type II distribution.
+func (d ParetoIIDist) Mean() float64 { return ParetoIIMean(d.Theta, d.Alpha) }
+
+// Var returns the variance of the Pareto Type II distribution.
+func (d ParetoIIDist) Var() float64 { return ParetoIIVar(d.Theta, d.Alpha) }
+
+// Skew returns the skewness of the Pareto Type II distribution.
+func (d ParetoIIDist) Skew() float64 { return ParetoIISkew(d.Theta, d.Alpha) }
+
+// ExKurt returns the excess kurtosis of the Pareto Type II distribution.
+func (d ParetoIIDist) ExKurt() float64 { return ParetoIIExKurt(d.Theta, d.Alpha) }
+
+// Entropy returns the differential entropy of the Pareto Type II distribution.
+func (d ParetoIIDist) Entropy() float64 { return ParetoIIEntropy(d.Theta, d.Alpha) }
+
+// CF returns the characteristic function of the Pareto Type II distribution at t.
+func (d ParetoIIDist) CF(t float64) complex128 { return ParetoIICF(d.Theta, d.Alpha, t) }
+
+// CGF returns the cumulant generating function of the Pareto Type II distribution at t.
+func (d ParetoIIDist) CGF(t float64) float64 { return ParetoIICGF(d.Theta, d.Alpha, t) }
+
+// Support returns the support of the Pareto Type II distribution.
+func (d ParetoIIDist) Support() (a, b float64) { return 0, posInf }
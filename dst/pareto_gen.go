@@ -24,7 +24,7 @@ func ParetoGPDF(shape1, shape2, scale float64) func(x float64) (p float64) {
 	// with u = v/(1 + v) = 1/(1 + 1/v), v = x/scale.
 	return func(x float64) (p float64) {
 		if x < 0 {
-			p = 0
+			return 0
 		} else if x == 0 {
 			if shape2 < 1 {
 				p = posInf
@@ -33,6 +33,7 @@ func ParetoGPDF(shape1, shape2, scale float64) func(x float64) (p float64) {
 			} else {
 				p = 1 / (scale * B(shape2, shape1))
 			}
+			return
 		}
 		tmp := log(x) - log(scale)
 		logu := -log1p(exp(-tmp))
@@ -66,6 +67,22 @@ func ParetoGCDFAt(shape1, shape2, scale, x float64) float64 {
 	return cdf(x)
 }
 
+// ParetoGCDFTail returns the CDF of the Generalized Pareto distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ParetoGCDFTail(shape1, shape2, scale float64, lowerTail, logP bool) func(x float64) float64 {
+	// x/(x+scale) is Beta(shape2, shape1), scale/(x+scale) is Beta(shape1, shape2)
+	lower := BetaCDFTail(shape2, shape1, true, logP)
+	upper := BetaCDFTail(shape1, shape2, true, logP)
+	return func(x float64) float64 {
+		if x < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		if lowerTail {
+			return lower(x / (x + scale))
+		}
+		return upper(scale / (x + scale))
+	}
+}
+
 // ParetoGQtl returns the inverse of the CDF (quantile) of the Generalized Pareto distribution. 
 func ParetoGQtl(shape1, shape2, scale float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -77,13 +94,44 @@ func ParetoGQtl(shape1, shape2, scale float64) func(p float64) float64 {
 	}
 }
 
-// ParetoGQtlFor returns the inverse of the CDF (quantile) of the Generalized Pareto distribution, for given probability.
+// ParetoGQtlTail returns the inverse of ParetoGCDFTail (quantile) of the Generalized Pareto distribution.
+func ParetoGQtlTail(shape1, shape2, scale float64, lowerTail, logP bool) func(p float64) float64 {
+	lower := BetaQtlTail(shape2, shape1, true, logP)
+	upper := BetaQtlTail(shape1, shape2, true, logP)
+	return func(p float64) float64 {
+		if lowerTail {
+			u := lower(p)
+			return scale * u / (1 - u)
+		}
+		v := upper(p)
+		return scale * (1 - v) / v
+	}
+}
+
+// ParetoGNext returns random number drawn from the Generalized Pareto distribution.
 func ParetoGNext(shape1, shape2, scale float64) float64 {
+	return ParetoGNextR(GlobalRand, shape1, shape2, scale)
+}
+
+// ParetoGNextR returns random number drawn from the Generalized Pareto distribution, using the random source src.
+func ParetoGNextR(src *rand.Rand, shape1, shape2, scale float64) float64 {
 	qtl := ParetoGQtl(shape1, shape2, scale)
-	p := rand.Float64()
+	p := src.Float64()
 	return qtl(p)
 }
 
+// ParetoGFill fills x with random numbers drawn from the Generalized Pareto distribution.
+func ParetoGFill(shape1, shape2, scale float64, x []float64) {
+	ParetoGFillR(GlobalRand, shape1, shape2, scale, x)
+}
+
+// ParetoGFillR fills x with random numbers drawn from the Generalized Pareto distribution, using the random source src.
+func ParetoGFillR(src *rand.Rand, shape1, shape2, scale float64, x []float64) {
+	for i := range x {
+		x[i] = ParetoGNextR(src, shape1, shape2, scale)
+	}
+}
+
 // ParetoGMoment returns the n-th moment of the Generalized Pareto distribution. 
 func ParetoGMoment(shape1, shape2, scale float64, order int) (x float64) {
 	o := float64(order)
@@ -102,15 +150,128 @@ func ParetoGMean(shape1, shape2, scale float64) float64 {
 
 // ParetoGVar returns the variance of the Generalized Pareto distribution. 
 func ParetoGVar(shape1, shape2, scale float64) float64 {
-	return ParetoGMoment(shape1, shape2, scale, 2)
+	_, σ2, _, _ := paretoGMoments(shape1, shape2, scale)
+	return σ2
 }
 
 // ParetoGSkew returns the skewness of the Generalized Pareto distribution. 
 func ParetoGSkew(shape1, shape2, scale float64) float64 {
-	return ParetoGMoment(shape1, shape2, scale, 3)
+	_, _, s, _ := paretoGMoments(shape1, shape2, scale)
+	return s
 }
 
 // ParetoGExKurt returns the excess kurtosis of the Generalized Pareto distribution. 
 func ParetoGExKurt(shape1, shape2, scale float64) float64 {
-	return ParetoGMoment(shape1, shape2, scale, 4)
+	_, _, _, k := paretoGMoments(shape1, shape2, scale)
+	return k
+}
+
+// ParetoGEntropy returns the differential entropy of the Generalized Pareto distribution, by numerical integration.
+func ParetoGEntropy(shape1, shape2, scale float64) float64 {
+	return entropyContinuous(ParetoGDist{shape1, shape2, scale})
 }
+
+// ParetoGCF returns the characteristic function of the Generalized Pareto distribution, by numerical integration.
+func ParetoGCF(shape1, shape2, scale, t float64) complex128 {
+	return cfContinuous(ParetoGDist{shape1, shape2, scale}, t)
+}
+
+// ParetoGCGF returns the cumulant generating function of the Generalized Pareto distribution, by numerical integration; +Inf for t > 0.
+func ParetoGCGF(shape1, shape2, scale, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfContinuous(ParetoGDist{shape1, shape2, scale}, t)
+}
+
+// paretoGMoments returns the mean, variance, skewness and excess kurtosis computed from the raw moments.
+func paretoGMoments(shape1, shape2, scale float64) (mean, σ2, skew, kurt float64) {
+	return rawMoments(ParetoGMoment(shape1, shape2, scale, 1), ParetoGMoment(shape1, shape2, scale, 2), ParetoGMoment(shape1, shape2, scale, 3), ParetoGMoment(shape1, shape2, scale, 4))
+}
+
+// ParetoGMatchQtls returns the Generalized Pareto distribution whose quantiles for the probabilities p best match x.
+func ParetoGMatchQtls(p, x []float64) ParetoGDist {
+	// shape2 = 1 is the Pareto Type II distribution
+	θ := paretoIIQtlStart(p, x, 3)
+	e := matchQtls(func(θ []float64) Continuous { return ParetoGDist{θ[0], θ[1], θ[2]} }, p, x, []float64{3, 1, θ}, []mleBound{mlePos, mlePos, mlePos})
+	return ParetoGDist{e[0], e[1], e[2]}
+}
+
+// ParetoGFit returns the maximum-likelihood estimates of shape1, shape2, scale of the Generalized Pareto distribution from the sample x,
+// starting from the Pareto Type II distribution, with shape2 = 1.
+func ParetoGFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return ParetoGDist{θ[0], θ[1], θ[2]}.LnPDF })
+	θ, α := paretoIIStart(x)
+	return mleFit(lnL, []float64{α, 1, θ}, []mleBound{mlePos, mlePos, mlePos})
+}
+
+// ParetoGDist is the Generalized Pareto distribution with shape parameters Shape1, Shape2 and scale Scale. It implements Continuous.
+type ParetoGDist struct {
+	Shape1, Shape2, Scale float64
+}
+
+// PDF returns the value of PDF of the Generalized Pareto distribution at x.
+func (d ParetoGDist) PDF(x float64) float64 { return ParetoGPDFAt(d.Shape1, d.Shape2, d.Scale, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Generalized Pareto distribution at x.
+func (d ParetoGDist) LnPDF(x float64) float64 {
+	return log(ParetoGPDFAt(d.Shape1, d.Shape2, d.Scale, x))
+}
+
+// CDF returns the value of CDF of the Generalized Pareto distribution at x.
+func (d ParetoGDist) CDF(x float64) float64 { return ParetoGCDFAt(d.Shape1, d.Shape2, d.Scale, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Generalized Pareto distribution at x.
+func (d ParetoGDist) Surv(x float64) float64 {
+	return ParetoGCDFTail(d.Shape1, d.Shape2, d.Scale, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Generalized Pareto distribution at x.
+func (d ParetoGDist) LnCDF(x float64) float64 {
+	return ParetoGCDFTail(d.Shape1, d.Shape2, d.Scale, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Generalized Pareto distribution at x.
+func (d ParetoGDist) LnSurv(x float64) float64 {
+	return ParetoGCDFTail(d.Shape1, d.Shape2, d.Scale, false, true)(x)
+}
+
+// Qtl returns the quantile of the Generalized Pareto distribution for probability p.
+func (d ParetoGDist) Qtl(p float64) float64 { return ParetoGQtl(d.Shape1, d.Shape2, d.Scale)(p) }
+
+// QtlTail returns the quantile of the Generalized Pareto distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ParetoGDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return ParetoGQtlTail(d.Shape1, d.Shape2, d.Scale, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Generalized Pareto distribution.
+func (d ParetoGDist) Rand() float64 { return ParetoGNext(d.Shape1, d.Shape2, d.Scale) }
+
+// RandR returns random number drawn from the Generalized Pareto distribution, using the random source src.
+func (d ParetoGDist) RandR(src *rand.Rand) float64 {
+	return ParetoGNextR(src, d.Shape1, d.Shape2, d.Scale)
+}
+
+// Mean returns the mean of the Generalized Pareto distribution.
+func (d ParetoGDist) Mean() float64 { return ParetoGMean(d.Shape1, d.Shape2, d.Scale) }
+
+// Var returns the variance of the Generalized Pareto distribution.
+func (d ParetoGDist) Var() float64 { return ParetoGVar(d.Shape1, d.Shape2, d.Scale) }
+
+// Skew returns the skewness of the Generalized Pareto distribution.
+func (d ParetoGDist) Skew() float64 { return ParetoGSkew(d.Shape1, d.Shape2, d.Scale) }
+
+// ExKurt returns the excess kurtosis of the Generalized Pareto distribution.
+func (d ParetoGDist) ExKurt() float64 { return ParetoGExKurt(d.Shape1, d.Shape2, d.Scale) }
+
+// Entropy returns the differential entropy of the Generalized Pareto distribution.
+func (d ParetoGDist) Entropy() float64 { return ParetoGEntropy(d.Shape1, d.Shape2, d.Scale) }
+
+// CF returns the characteristic function of the Generalized Pareto distribution at t.
+func (d ParetoGDist) CF(t float64) complex128 { return ParetoGCF(d.Shape1, d.Shape2, d.Scale, t) }
+
+// CGF returns the cumulant generating function of the Generalized Pareto distribution at t.
+func (d ParetoGDist) CGF(t float64) float64 { return ParetoGCGF(d.Shape1, d.Shape2, d.Scale, t) }
+
+// Support returns the support of the Generalized Pareto distribution.
+func (d ParetoGDist) Support() (a, b float64) { return 0, posInf }
@@ -69,6 +69,16 @@ func ParetoSingCDFAt(α, μ, x float64) float64 {
 	return cdf(x)
 }
 
+// ParetoSingCDFTail returns the CDF of the Single-parameter Pareto distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ParetoSingCDFTail(α, μ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x <= μ {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnUpper(α*log(μ/x), lowerTail, logP)
+	}
+}
+
 // ParetoSingQtl returns the inverse of the CDF (quantile) of the Single-parameter  Pareto distribution. 
 func ParetoSingQtl(α, μ float64) func(p float64) float64 {
 	return func(p float64) float64 {
@@ -82,16 +92,48 @@ func ParetoSingQtlFor(α, μ, p float64) float64 {
 	return cdf(p)
 }
 
+// ParetoSingQtlTail returns the inverse of ParetoSingCDFTail (quantile) of the Single-parameter Pareto distribution.
+func ParetoSingQtlTail(α, μ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return μ * exp(-pLnUpper(p, lowerTail, logP)/α)
+	}
+}
+
 // ParetoSingNext returns random number drawn from the Single-parameter  Pareto distribution. 
 func ParetoSingNext(α, μ float64) float64 {
+	return ParetoSingNextR(GlobalRand, α, μ)
+}
+
+// ParetoSingNextR returns random number drawn from the Single-parameter  Pareto distribution, using the random source src.
+func ParetoSingNextR(src *rand.Rand, α, μ float64) float64 {
 	qtl := ParetoSingQtl(α, μ)
-	p := rand.Float64()
+	p := src.Float64()
 	return qtl(p)
 }
 
 // ParetoSing returns the random number generator with  Single-parameter  Pareto distribution. 
 func ParetoSing(α, μ float64) func() float64 {
-	return func() float64 { return ParetoSingNext(α, μ) }
+	return ParetoSingR(GlobalRand, α, μ)
+}
+
+// ParetoSingR returns the random number generator with  Single-parameter  Pareto distribution, using the random source src.
+func ParetoSingR(src *rand.Rand, α, μ float64) func() float64 {
+	return func() float64 { return ParetoSingNextR(src, α, μ) }
+}
+
+// ParetoSingFill fills x with random numbers drawn from the Single-parameter Pareto distribution.
+func ParetoSingFill(α, μ float64, x []float64) {
+	ParetoSingFillR(GlobalRand, α, μ, x)
+}
+
+// ParetoSingFillR fills x with random numbers drawn from the Single-parameter Pareto distribution, using the random source src.
+func ParetoSingFillR(src *rand.Rand, α, μ float64, x []float64) {
+	for i := range x {
+		x[i] = ParetoSingNextR(src, α, μ)
+	}
 }
 
 // ParetoSingMoment returns the n-th moment of the Single-parameter  Pareto distribution. 
@@ -111,15 +153,139 @@ func ParetoSingMean(α, μ float64) float64 {
 
 // ParetoSingVar returns the variance of the Single-parameter  Pareto distribution. 
 func ParetoSingVar(α, μ float64) float64 {
-	return ParetoSingMoment(α, μ, 2)
+	_, σ2, _, _ := paretoSingMoments(α, μ)
+	return σ2
 }
 
 // ParetoSingSkew returns the skewness of the Single-parameter  Pareto distribution. 
 func ParetoSingSkew(α, μ float64) float64 {
-	return ParetoSingMoment(α, μ, 3)
+	_, _, s, _ := paretoSingMoments(α, μ)
+	return s
 }
 
 // ParetoSingExKurt returns the excess kurtosis of the Single-parameter  Pareto distribution. 
 func ParetoSingExKurt(α, μ float64) float64 {
-	return ParetoSingMoment(α, μ, 4)
+	_, _, _, k := paretoSingMoments(α, μ)
+	return k
+}
+
+// ParetoSingEntropy returns the differential entropy of the Single-parameter Pareto distribution.
+func ParetoSingEntropy(α, μ float64) float64 {
+	return log(μ/α) + 1/α + 1
+}
+
+// ParetoSingCF returns the characteristic function of the Single-parameter Pareto distribution, by numerical integration.
+func ParetoSingCF(α, μ, t float64) complex128 {
+	return cfContinuous(ParetoSingDist{α, μ}, t)
+}
+
+// ParetoSingCGF returns the cumulant generating function of the Single-parameter Pareto distribution, by numerical integration; +Inf for t > 0.
+func ParetoSingCGF(α, μ, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfContinuous(ParetoSingDist{α, μ}, t)
+}
+
+// paretoSingMoments returns the mean, variance, skewness and excess kurtosis computed from the raw moments.
+func paretoSingMoments(α, μ float64) (mean, σ2, skew, kurt float64) {
+	return rawMoments(ParetoSingMoment(α, μ, 1), ParetoSingMoment(α, μ, 2), ParetoSingMoment(α, μ, 3), ParetoSingMoment(α, μ, 4))
+}
+
+// ParetoSingFromMeanStd returns the Single-parameter Pareto distribution with mean m and standard deviation σ.
+func ParetoSingFromMeanStd(m, σ float64) ParetoSingDist {
+	μ, α := ParetoReparamMeanStd(m, σ)
+	return ParetoSingDist{α, μ}
 }
+
+// ParetoSingFromModeStd returns the Single-parameter Pareto distribution with mode m and standard deviation σ.
+func ParetoSingFromModeStd(m, σ float64) ParetoSingDist {
+	μ, α := ParetoReparamModeStd(m, σ)
+	return ParetoSingDist{α, μ}
+}
+
+// ParetoSingMatchQtls returns the Single-parameter Pareto distribution whose quantiles for the probabilities p best match x.
+func ParetoSingMatchQtls(p, x []float64) ParetoSingDist {
+	d := ParetoMatchQtls(p, x)
+	return ParetoSingDist{d.Alpha, d.Theta}
+}
+
+// ParetoSingFit returns the maximum-likelihood estimates of α, μ of the Single-parameter Pareto distribution from the sample x:
+// μ is its minimum, on the bound of the likelihood, so that its standard error is NaN, and α = n / Σ log(x/μ).
+func ParetoSingFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return ParetoSingDist{θ[0], θ[1]}.LnPDF })
+	μ, _ := mleMinMax(x)
+	return mleAtFixed(lnL, []float64{paretoShape(x, μ), μ}, []bool{false, true})
+}
+
+// ParetoSingDist is the Single-parameter Pareto distribution with shape α = Alpha and known minimum μ = Mu. It implements Continuous.
+type ParetoSingDist struct {
+	Alpha, Mu float64
+}
+
+// PDF returns the value of PDF of the Single-parameter Pareto distribution at x.
+func (d ParetoSingDist) PDF(x float64) float64 { return ParetoSingPDFAt(d.Alpha, d.Mu, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Single-parameter Pareto distribution at x.
+func (d ParetoSingDist) LnPDF(x float64) float64 {
+	if x < d.Mu {
+		return negInf
+	}
+	return log(d.Alpha) + d.Alpha*log(d.Mu) - (d.Alpha+1)*log(x)
+}
+
+// CDF returns the value of CDF of the Single-parameter Pareto distribution at x.
+func (d ParetoSingDist) CDF(x float64) float64 { return ParetoSingCDFAt(d.Alpha, d.Mu, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Single-parameter Pareto distribution at x.
+func (d ParetoSingDist) Surv(x float64) float64 {
+	return ParetoSingCDFTail(d.Alpha, d.Mu, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Single-parameter Pareto distribution at x.
+func (d ParetoSingDist) LnCDF(x float64) float64 {
+	return ParetoSingCDFTail(d.Alpha, d.Mu, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Single-parameter Pareto distribution at x.
+func (d ParetoSingDist) LnSurv(x float64) float64 {
+	return ParetoSingCDFTail(d.Alpha, d.Mu, false, true)(x)
+}
+
+// Qtl returns the quantile of the Single-parameter Pareto distribution for probability p.
+func (d ParetoSingDist) Qtl(p float64) float64 { return ParetoSingQtlFor(d.Alpha, d.Mu, p) }
+
+// QtlTail returns the quantile of the Single-parameter Pareto distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ParetoSingDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return ParetoSingQtlTail(d.Alpha, d.Mu, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Single-parameter Pareto distribution.
+func (d ParetoSingDist) Rand() float64 { return ParetoSingNext(d.Alpha, d.Mu) }
+
+// RandR returns random number drawn from the Single-parameter Pareto distribution, using the random source src.
+func (d ParetoSingDist) RandR(src *rand.Rand) float64 { return ParetoSingNextR(src, d.Alpha, d.Mu) }
+
+// Mean returns the mean of the Single-parameter Pareto distribution.
+func (d ParetoSingDist) Mean() float64 { return ParetoSingMean(d.Alpha, d.Mu) }
+
+// Var returns the variance of the Single-parameter Pareto distribution.
+func (d ParetoSingDist) Var() float64 { return ParetoSingVar(d.Alpha, d.Mu) }
+
+// Skew returns the skewness of the Single-parameter Pareto distribution.
+func (d ParetoSingDist) Skew() float64 { return ParetoSingSkew(d.Alpha, d.Mu) }
+
+// ExKurt returns the excess kurtosis of the Single-parameter Pareto distribution.
+func (d ParetoSingDist) ExKurt() float64 { return ParetoSingExKurt(d.Alpha, d.Mu) }
+
+// Entropy returns the differential entropy of the Single-parameter Pareto distribution.
+func (d ParetoSingDist) Entropy() float64 { return ParetoSingEntropy(d.Alpha, d.Mu) }
+
+// CF returns the characteristic function of the Single-parameter Pareto distribution at t.
+func (d ParetoSingDist) CF(t float64) complex128 { return ParetoSingCF(d.Alpha, d.Mu, t) }
+
+// CGF returns the cumulant generating function of the Single-parameter Pareto distribution at t.
+func (d ParetoSingDist) CGF(t float64) float64 { return ParetoSingCGF(d.Alpha, d.Mu, t) }
+
+// Support returns the support of the Single-parameter Pareto distribution.
+func (d ParetoSingDist) Support() (a, b float64) { return d.Mu, posInf }
@@ -61,19 +61,24 @@ func ParetoTapCDFAt(θ, α, taper, x float64) float64 {
 	return cdf(x)
 }
 
+// ParetoTapCDFTail returns the CDF of the Tapered Pareto distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ParetoTapCDFTail(θ, α, taper float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x < θ {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnUpper(α*log(θ/x)+(θ-x)/taper, lowerTail, logP)
+	}
+}
+
 // ParetoTapQtl returns the inverse of the CDF (quantile) of the Tapered Pareto distribution. 
 func ParetoTapQtl(θ, α, taper float64) func(p float64) float64 {
+	cdf, pdf := ParetoTapCDF(θ, α, taper), ParetoTapPDF(θ, α, taper)
 	return func(p float64) float64 {
-		tol := 1e-8
-		x := θ + 1
-
-		// solve using Newton-Raphson method
-		for {
-			delta := (ParetoTapCDFAt(θ, α, taper, x) - p) / ParetoTapPDFAt(θ, α, taper, x)
-			x -= delta
-			if abs(delta) < tol {
-				break
-			}
+		// Newton steps safeguarded by the bracket, which may otherwise overshoot below θ
+		x, err := InvertCDF(cdf, pdf, p, θ, posInf)
+		if err != nil {
+			return NaN
 		}
 		return x
 	}
@@ -85,30 +90,206 @@ func ParetoTapQtlFor(θ, α, taper, p float64) float64 {
 	return cdf(p)
 }
 
+// ParetoTapQtlTail returns the inverse of ParetoTapCDFTail (quantile) of the Tapered Pareto distribution.
+func ParetoTapQtlTail(θ, α, taper float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := ParetoTapCDFTail(θ, α, taper, lowerTail, logP)
+	return func(p float64) float64 {
+		return qtlTail(cdf, p, θ, posInf, lowerTail, logP)
+	}
+}
+
 // ParetoTapNext returns random number drawn from the Tapered Pareto distribution. 
 func ParetoTapNext(θ, α, taper float64) float64 {
+	return ParetoTapNextR(GlobalRand, θ, α, taper)
+}
+
+// ParetoTapNextR returns random number drawn from the Tapered Pareto distribution, using the random source src.
+func ParetoTapNextR(src *rand.Rand, θ, α, taper float64) float64 {
 	qtl := ParetoTapQtl(θ, α, taper)
-	p := rand.Float64()
+	p := src.Float64()
 	return qtl(p)
 }
 
 // ParetoTap returns the random number generator with  Tapered Pareto distribution. 
 func ParetoTap(θ, α, taper float64) func() float64 {
-	return func() float64 { return ParetoTapNext(θ, α, taper) }
+	return ParetoTapR(GlobalRand, θ, α, taper)
+}
+
+// ParetoTapR returns the random number generator with  Tapered Pareto distribution, using the random source src.
+func ParetoTapR(src *rand.Rand, θ, α, taper float64) func() float64 {
+	return func() float64 { return ParetoTapNextR(src, θ, α, taper) }
+}
+
+// ParetoTapFill fills x with random numbers drawn from the Tapered Pareto distribution.
+func ParetoTapFill(θ, α, taper float64, x []float64) {
+	ParetoTapFillR(GlobalRand, θ, α, taper, x)
+}
+
+// ParetoTapFillR fills x with random numbers drawn from the Tapered Pareto distribution, using the random source src.
+func ParetoTapFillR(src *rand.Rand, θ, α, taper float64, x []float64) {
+	for i := range x {
+		x[i] = ParetoTapNextR(src, θ, α, taper)
+	}
 }
 
-/*
+// ParetoTapMoment returns the n-th raw moment of the Tapered Pareto distribution.
+func ParetoTapMoment(θ, α, taper float64, order int) float64 {
+	r := float64(order)
+	return pow(θ, r) + r*pow(θ, α)*exp(θ/taper)*pow(taper, r-α)*upperΓ(r-α, θ/taper)
+}
 
+func paretoTapMoments(θ, α, taper float64) (mean, σ2, skew, kurt float64) {
+	return rawMoments(ParetoTapMoment(θ, α, taper, 1), ParetoTapMoment(θ, α, taper, 2), ParetoTapMoment(θ, α, taper, 3), ParetoTapMoment(θ, α, taper, 4))
+}
+
+// ParetoTapMean returns the mean of the Tapered Pareto distribution.
 func ParetoTapMean(θ, α, taper float64) float64 {
+	return ParetoTapMoment(θ, α, taper, 1)
 }
 
+// ParetoTapMedian returns the median of the Tapered Pareto distribution.
 func ParetoTapMedian(θ, α, taper float64) float64 {
+	return ParetoTapQtlFor(θ, α, taper, 0.5)
 }
 
-func ParetoTapMode(θ, α, taper float64) float64 {
+// ParetoTapVar returns the variance of the Tapered Pareto distribution.
+func ParetoTapVar(θ, α, taper float64) float64 {
+	_, σ2, _, _ := paretoTapMoments(θ, α, taper)
+	return σ2
 }
 
-func ParetoTapVar(θ, α, taper float64) float64 {
+// ParetoTapStd returns the standard deviation of the Tapered Pareto distribution.
+func ParetoTapStd(θ, α, taper float64) float64 {
+	return sqrt(ParetoTapVar(θ, α, taper))
+}
+
+// ParetoTapSkew returns the skewness of the Tapered Pareto distribution.
+func ParetoTapSkew(θ, α, taper float64) float64 {
+	_, _, skew, _ := paretoTapMoments(θ, α, taper)
+	return skew
+}
+
+// ParetoTapExKurt returns the excess kurtosis of the Tapered Pareto distribution.
+func ParetoTapExKurt(θ, α, taper float64) float64 {
+	_, _, _, kurt := paretoTapMoments(θ, α, taper)
+	return kurt
+}
+
+// ParetoTapEntropy returns the differential entropy of the Tapered Pareto distribution, by numerical integration.
+func ParetoTapEntropy(θ, α, taper float64) float64 {
+	return entropyContinuous(ParetoTapDist{θ, α, taper})
+}
+
+// ParetoTapCF returns the characteristic function of the Tapered Pareto distribution, by numerical integration.
+func ParetoTapCF(θ, α, taper, t float64) complex128 {
+	return cfContinuous(ParetoTapDist{θ, α, taper}, t)
+}
+
+// ParetoTapCGF returns the cumulant generating function of the Tapered Pareto distribution, by numerical integration; +Inf for t ≥ 1/taper.
+func ParetoTapCGF(θ, α, taper, t float64) float64 {
+	if t*taper >= 1 {
+		return posInf
+	}
+	return cgfContinuous(ParetoTapDist{θ, α, taper}, t)
 }
 
-*/
+// ParetoTapMatchQtls returns the Tapered Pareto distribution whose quantiles for the probabilities p best match x.
+func ParetoTapMatchQtls(p, x []float64) ParetoTapDist {
+	lo, _ := mleMinMax(x)
+	μ, _ := qtlMeanStd(p, x)
+	e := matchQtls(func(θ []float64) Continuous { return ParetoTapDist{θ[0], θ[1], θ[2]} }, p, x, []float64{lo / 2, 1, μ}, []mleBound{mlePos, mlePos, mlePos})
+	return ParetoTapDist{e[0], e[1], e[2]}
+}
+
+// ParetoTapFit returns the maximum-likelihood estimates of θ, α, taper of the Tapered Pareto distribution from the sample x:
+// θ is its minimum, on the bound of the likelihood, so that its standard error is NaN.
+func ParetoTapFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return ParetoTapDist{θ[0], θ[1], θ[2]}.LnPDF })
+	θ, _ := mleMinMax(x)
+	μ, _ := mleMeanVar(x)
+	return mleFitFixed(lnL, []float64{θ, paretoShape(x, θ), 2 * (μ - θ)}, []mleBound{mlePos, mlePos, mlePos}, []bool{true, false, false})
+}
+
+// ParetoTapDist is the Tapered Pareto distribution with minimum θ = Theta, shape α = Alpha and taper Taper. It implements Continuous.
+type ParetoTapDist struct {
+	Theta, Alpha, Taper float64
+}
+
+// PDF returns the value of PDF of the Tapered Pareto distribution at x.
+func (d ParetoTapDist) PDF(x float64) float64 {
+	if x < d.Theta {
+		return 0
+	}
+	return ParetoTapPDFAt(d.Theta, d.Alpha, d.Taper, x)
+}
+
+// LnPDF returns the natural logarithm of the PDF of the Tapered Pareto distribution at x.
+func (d ParetoTapDist) LnPDF(x float64) float64 {
+	if x < d.Theta {
+		return negInf
+	}
+	return log(ParetoTapPDFAt(d.Theta, d.Alpha, d.Taper, x))
+}
+
+// CDF returns the value of CDF of the Tapered Pareto distribution at x.
+func (d ParetoTapDist) CDF(x float64) float64 {
+	if x < d.Theta {
+		return 0
+	}
+	return ParetoTapCDFAt(d.Theta, d.Alpha, d.Taper, x)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Tapered Pareto distribution at x.
+func (d ParetoTapDist) Surv(x float64) float64 {
+	return ParetoTapCDFTail(d.Theta, d.Alpha, d.Taper, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Tapered Pareto distribution at x.
+func (d ParetoTapDist) LnCDF(x float64) float64 {
+	return ParetoTapCDFTail(d.Theta, d.Alpha, d.Taper, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Tapered Pareto distribution at x.
+func (d ParetoTapDist) LnSurv(x float64) float64 {
+	return ParetoTapCDFTail(d.Theta, d.Alpha, d.Taper, false, true)(x)
+}
+
+// Qtl returns the quantile of the Tapered Pareto distribution for probability p.
+func (d ParetoTapDist) Qtl(p float64) float64 { return ParetoTapQtlFor(d.Theta, d.Alpha, d.Taper, p) }
+
+// QtlTail returns the quantile of the Tapered Pareto distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ParetoTapDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return ParetoTapQtlTail(d.Theta, d.Alpha, d.Taper, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Tapered Pareto distribution.
+func (d ParetoTapDist) Rand() float64 { return ParetoTapNext(d.Theta, d.Alpha, d.Taper) }
+
+// RandR returns random number drawn from the Tapered Pareto distribution, using the random source src.
+func (d ParetoTapDist) RandR(src *rand.Rand) float64 {
+	return ParetoTapNextR(src, d.Theta, d.Alpha, d.Taper)
+}
+
+// Mean returns the mean of the Tapered Pareto distribution.
+func (d ParetoTapDist) Mean() float64 { return ParetoTapMean(d.Theta, d.Alpha, d.Taper) }
+
+// Var returns the variance of the Tapered Pareto distribution.
+func (d ParetoTapDist) Var() float64 { return ParetoTapVar(d.Theta, d.Alpha, d.Taper) }
+
+// Skew returns the skewness of the Tapered Pareto distribution.
+func (d ParetoTapDist) Skew() float64 { return ParetoTapSkew(d.Theta, d.Alpha, d.Taper) }
+
+// ExKurt returns the excess kurtosis of the Tapered Pareto distribution.
+func (d ParetoTapDist) ExKurt() float64 { return ParetoTapExKurt(d.Theta, d.Alpha, d.Taper) }
+
+// Entropy returns the differential entropy of the Tapered Pareto distribution.
+func (d ParetoTapDist) Entropy() float64 { return ParetoTapEntropy(d.Theta, d.Alpha, d.Taper) }
+
+// CF returns the characteristic function of the Tapered Pareto distribution at t.
+func (d ParetoTapDist) CF(t float64) complex128 { return ParetoTapCF(d.Theta, d.Alpha, d.Taper, t) }
+
+// CGF returns the cumulant generating function of the Tapered Pareto distribution at t.
+func (d ParetoTapDist) CGF(t float64) float64 { return ParetoTapCGF(d.Theta, d.Alpha, d.Taper, t) }
+
+// Support returns the support of the Tapered Pareto distribution.
+func (d ParetoTapDist) Support() (a, b float64) { return d.Theta, posInf }
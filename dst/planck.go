@@ -12,11 +12,18 @@ package dst
 // Support: 
 // x > 0.0
 
+import (
+	"math/rand"
+)
+
 // PlanckPDF returns the PDF of the Planck distribution. 
 func PlanckPDF(a, b float64) func(x float64) float64 {
 	// ζ() waiting for better implementation
 	ζ := ζ
 	return func(x float64) float64 {
+		if x <= 0 {
+			return 0
+		}
 		t1 := pow(b, a+1)
 		t2 := pow(x, a)
 		t3 := Γ(a+1) * ζ(a+1)
@@ -26,16 +33,257 @@ func PlanckPDF(a, b float64) func(x float64) float64 {
 	}
 }
 
+// PlanckLnPDF returns the natural logarithm of the PDF of the Planck distribution; the normalizing constant is computed once.
+func PlanckLnPDF(a, b float64) func(x float64) float64 {
+	c := (a+1)*log(b) - LnΓ(a+1) - log(ζ(a+1))
+	return func(x float64) float64 {
+		if x <= 0 {
+			return negInf
+		}
+		return c + a*log(x) - log(expm1(b*x))
+	}
+}
+
+// PlanckCDF returns the CDF of the Planck distribution.
+func PlanckCDF(a, b float64) func(x float64) float64 {
+	return PlanckCDFTail(a, b, true, false)
+}
+
+// PlanckCDFTail returns the CDF of the Planck distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func PlanckCDFTail(a, b float64, lowerTail, logP bool) func(x float64) float64 {
+	// Bernoulli numbers B_0 ... B_20
+	bn := []float64{1, -1.0 / 2, 1.0 / 6, 0, -1.0 / 30, 0, 1.0 / 42, 0, -1.0 / 30, 0, 5.0 / 66, 0, -691.0 / 2730, 0, 7.0 / 6, 0, -3617.0 / 510, 0, 43867.0 / 798, 0, -174611.0 / 330}
+	z := ζ(a + 1)
+	return func(x float64) float64 {
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		u := b * x
+		if u < 1 {
+			// 1/(e^u-1) expanded in Bernoulli numbers, integrated termwise
+			sum, f := 0.0, 1.0
+			for n := range bn {
+				if n > 0 {
+					f *= float64(n)
+				}
+				sum += bn[n] * pow(u, a+float64(n)) / (f * (a + float64(n)))
+			}
+			return pTail(sum/(Γ(a+1)*z), lowerTail, logP)
+		}
+		// survival function as a sum of gamma tails
+		s := 0.0
+		for k := 1.0; k < 1e5; k++ {
+			t := pow(k, -(a+1)) * pgamma_raw(k*u, a+1, false, false)
+			s += t
+			if t < eps64*s {
+				break
+			}
+		}
+		return pTail(s/z, !lowerTail, logP)
+	}
+}
+
+// PlanckCDFAt returns the value of CDF of the Planck distribution, at x.
+func PlanckCDFAt(a, b, x float64) float64 {
+	cdf := PlanckCDF(a, b)
+	return cdf(x)
+}
+
+// PlanckQtl returns the inverse of the CDF (quantile) of the Planck distribution.
+func PlanckQtl(a, b float64) func(p float64) float64 {
+	// the PDF from its logarithm, whose normalizing constant is computed once
+	cdf, lnPDF := PlanckCDF(a, b), PlanckLnPDF(a, b)
+	pdf := func(x float64) float64 { return exp(lnPDF(x)) }
+	μ := PlanckMean(a, b)
+	return func(p float64) float64 {
+		x, err := invertCDF(cdf, pdf, p, 0, posInf, μ)
+		if err != nil {
+			return NaN
+		}
+		return x
+	}
+}
+
+// PlanckQtlFor returns the inverse of the CDF (quantile) of the Planck distribution, for given probability.
+func PlanckQtlFor(a, b, p float64) float64 {
+	qtl := PlanckQtl(a, b)
+	return qtl(p)
+}
+
+// PlanckQtlTail returns the inverse of PlanckCDFTail (quantile) of the Planck distribution.
+func PlanckQtlTail(a, b float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := PlanckCDFTail(a, b, lowerTail, logP)
+	return func(p float64) float64 {
+		return qtlTail(cdf, p, 0, posInf, lowerTail, logP)
+	}
+}
+
 // PlanckNext returns random number drawn from the Planck distribution. 
 // Devroye 1986: 552.
 // Devroye, L. 1986: Non-Uniform Random Variate Generation. Springer-Verlag, New York. ISBN 0-387-96305-7.
 func PlanckNext(a, b float64) (x float64) {
-	g := GammaNext(a+1, 1) // OK, consulted with Luc Devroye
-	z := float64(ZetaNext(a + 1))
+	return PlanckNextR(GlobalRand, a, b)
+}
+
+// PlanckNextR returns random number drawn from the Planck distribution, using the random source src.
+func PlanckNextR(src *rand.Rand, a, b float64) (x float64) {
+	g := GammaNextR(src, a+1, 1) // OK, consulted with Luc Devroye
+	z := float64(ZetaNextR(src, a+1))
 	return g / (b * z)
 }
 
 // Planck returns the random number generator with  Planck distribution. 
 func Planck(a, b float64) func() float64 {
-	return func() float64 { return PlanckNext(a, b) }
+	return PlanckR(GlobalRand, a, b)
+}
+
+// PlanckR returns the random number generator with  Planck distribution, using the random source src.
+func PlanckR(src *rand.Rand, a, b float64) func() float64 {
+	return func() float64 { return PlanckNextR(src, a, b) }
+}
+
+// PlanckFill fills x with random numbers drawn from the Planck distribution.
+func PlanckFill(a, b float64, x []float64) {
+	PlanckFillR(GlobalRand, a, b, x)
+}
+
+// PlanckFillR fills x with random numbers drawn from the Planck distribution, using the random source src.
+func PlanckFillR(src *rand.Rand, a, b float64, x []float64) {
+	for i := range x {
+		x[i] = PlanckNextR(src, a, b)
+	}
+}
+
+// PlanckMoment returns the n-th raw moment of the Planck distribution.
+func PlanckMoment(a, b float64, order int) float64 {
+	r := float64(order)
+	return Γ(a+1+r) * ζ(a+1+r) / (pow(b, r) * Γ(a+1) * ζ(a+1))
+}
+
+func planckMoments(a, b float64) (mean, σ2, skew, kurt float64) {
+	return rawMoments(PlanckMoment(a, b, 1), PlanckMoment(a, b, 2), PlanckMoment(a, b, 3), PlanckMoment(a, b, 4))
+}
+
+// PlanckMean returns the mean of the Planck distribution.
+func PlanckMean(a, b float64) float64 {
+	return PlanckMoment(a, b, 1)
+}
+
+// PlanckVar returns the variance of the Planck distribution.
+func PlanckVar(a, b float64) float64 {
+	_, σ2, _, _ := planckMoments(a, b)
+	return σ2
 }
+
+// PlanckStd returns the standard deviation of the Planck distribution.
+func PlanckStd(a, b float64) float64 {
+	return sqrt(PlanckVar(a, b))
+}
+
+// PlanckSkew returns the skewness of the Planck distribution.
+func PlanckSkew(a, b float64) float64 {
+	_, _, skew, _ := planckMoments(a, b)
+	return skew
+}
+
+// PlanckExKurt returns the excess kurtosis of the Planck distribution.
+func PlanckExKurt(a, b float64) float64 {
+	_, _, _, kurt := planckMoments(a, b)
+	return kurt
+}
+
+// PlanckEntropy returns the differential entropy of the Planck distribution, by numerical integration.
+func PlanckEntropy(a, b float64) float64 {
+	return entropyLnPDF(PlanckLnPDF(a, b), PlanckQtl(a, b), 0, posInf)
+}
+
+// PlanckCF returns the characteristic function of the Planck distribution, by numerical integration.
+func PlanckCF(a, b, t float64) complex128 {
+	return cfLnPDF(PlanckLnPDF(a, b), PlanckQtl(a, b), 0, posInf, t)
+}
+
+// PlanckCGF returns the cumulant generating function of the Planck distribution; +Inf for t ≥ b.
+func PlanckCGF(a, b, t float64) float64 {
+	if t >= b {
+		return posInf
+	}
+	// Σ_k (k - t/b)^-(a+1) / ζ(a+1), expanding 1/(e^{bx} - 1) = Σ_k e^{-kbx}
+	return log(hurwitzζ(a+1, 1-t/b)) - log(hurwitzζ(a+1, 1))
+}
+
+// PlanckMatchQtls returns the Planck distribution whose quantiles for the probabilities p best match x.
+func PlanckMatchQtls(p, x []float64) PlanckDist {
+	// the mean is inversely proportional to b
+	μ, _ := qtlMeanStd(p, x)
+	e := matchQtls(func(θ []float64) Continuous { return PlanckDist{θ[0], θ[1]} }, p, x, []float64{2, PlanckMean(2, 1) / μ}, []mleBound{mlePos, mlePos})
+	return PlanckDist{e[0], e[1]}
+}
+
+// PlanckFit returns the maximum-likelihood estimates of a, b of the Planck distribution from the sample x.
+func PlanckFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return PlanckLnPDF(θ[0], θ[1]) })
+	μ, _ := mleMeanVar(x)
+	// the mean is inversely proportional to b
+	return mleFit(lnL, []float64{2, PlanckMean(2, 1) / μ}, []mleBound{mlePos, mlePos})
+}
+
+// PlanckDist is the Planck distribution with shape A and scale B. It implements Continuous.
+type PlanckDist struct {
+	A, B float64
+}
+
+// PDF returns the value of PDF of the Planck distribution at x.
+func (d PlanckDist) PDF(x float64) float64 { return PlanckPDF(d.A, d.B)(x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Planck distribution at x.
+func (d PlanckDist) LnPDF(x float64) float64 { return PlanckLnPDF(d.A, d.B)(x) }
+
+// CDF returns the value of CDF of the Planck distribution at x.
+func (d PlanckDist) CDF(x float64) float64 { return PlanckCDFAt(d.A, d.B, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Planck distribution at x.
+func (d PlanckDist) Surv(x float64) float64 { return PlanckCDFTail(d.A, d.B, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Planck distribution at x.
+func (d PlanckDist) LnCDF(x float64) float64 { return PlanckCDFTail(d.A, d.B, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Planck distribution at x.
+func (d PlanckDist) LnSurv(x float64) float64 { return PlanckCDFTail(d.A, d.B, false, true)(x) }
+
+// Qtl returns the quantile of the Planck distribution for probability p.
+func (d PlanckDist) Qtl(p float64) float64 { return PlanckQtlFor(d.A, d.B, p) }
+
+// QtlTail returns the quantile of the Planck distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d PlanckDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return PlanckQtlTail(d.A, d.B, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Planck distribution.
+func (d PlanckDist) Rand() float64 { return PlanckNext(d.A, d.B) }
+
+// RandR returns random number drawn from the Planck distribution, using the random source src.
+func (d PlanckDist) RandR(src *rand.Rand) float64 { return PlanckNextR(src, d.A, d.B) }
+
+// Mean returns the mean of the Planck distribution.
+func (d PlanckDist) Mean() float64 { return PlanckMean(d.A, d.B) }
+
+// Var returns the variance of the Planck distribution.
+func (d PlanckDist) Var() float64 { return PlanckVar(d.A, d.B) }
+
+// Skew returns the skewness of the Planck distribution.
+func (d PlanckDist) Skew() float64 { return PlanckSkew(d.A, d.B) }
+
+// ExKurt returns the excess kurtosis of the Planck distribution.
+func (d PlanckDist) ExKurt() float64 { return PlanckExKurt(d.A, d.B) }
+
+// Entropy returns the differential entropy of the Planck distribution.
+func (d PlanckDist) Entropy() float64 { return PlanckEntropy(d.A, d.B) }
+
+// CF returns the characteristic function of the Planck distribution at t.
+func (d PlanckDist) CF(t float64) complex128 { return PlanckCF(d.A, d.B, t) }
+
+// CGF returns the cumulant generating function of the Planck distribution at t.
+func (d PlanckDist) CGF(t float64) float64 { return PlanckCGF(d.A, d.B, t) }
+
+// Support returns the support of the Planck distribution.
+func (d PlanckDist) Support() (a, b float64) { return 0, posInf }
@@ -14,6 +14,10 @@ package dst
 // k ∈ {0, ... , n}
 // x ∈ (0, ∞)
 
+import (
+	"math/rand"
+)
+
 /*
 func PoissonLnPMF(λ float64) (foo func(i int64) float64) {
 	pmf := PoissonPMF(λ)
@@ -56,7 +60,7 @@ func PoissonLnPMF(λ float64) func(k int64) float64 {
 	return func(k int64) (p float64) {
 		i := float64(k)
 		a := log(λ) * i
-		b := log(Γ(i + 1))
+		b := LnΓ(i + 1)
 		p = a - b - λ
 		return p
 	}
@@ -95,6 +99,61 @@ func PoissonCDFAt(λ float64, k int64) float64 {
 	return cdf(k)
 }
 
+// PoissonCDFTail returns the CDF of the Poisson distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func PoissonCDFTail(λ float64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		if λ == 0 {
+			return pTailBounds(true, lowerTail, logP)
+		}
+		// P[X <= k] = P[Y > λ], Y ~ Gamma(k+1, 1)
+		return pgamma_raw(λ, float64(k+1), !lowerTail, logP)
+	}
+}
+
+// PoissonQtl returns the inverse of the CDF (quantile) of the Poisson distribution.
+// It panics with the error that PoissonQtlErr returns, if any.
+func PoissonQtl(λ float64) func(p float64) int64 {
+	qtl := PoissonQtlErr(λ)
+	return func(p float64) int64 {
+		k, err := qtl(p)
+		if err != nil {
+			panic(err)
+		}
+		return k
+	}
+}
+
+// PoissonQtlErr returns the inverse of the CDF (quantile) of the Poisson distribution, with the error of the inversion, if any.
+func PoissonQtlErr(λ float64) func(p float64) (int64, error) {
+	cdf := PoissonCDFTail(λ, true, false)
+	return func(p float64) (int64, error) {
+		if !(λ >= 0) || isInf(λ, 1) {
+			return 0, ErrQtlParam
+		}
+		return InvertCDFDiscrete(cdf, p, 0, posInfInt64, λ, sqrt(λ), 1/sqrt(λ))
+	}
+}
+
+// PoissonQtlFor returns the inverse of the CDF (quantile) of the Poisson distribution, for given probability.
+func PoissonQtlFor(λ, p float64) int64 {
+	qtl := PoissonQtl(λ)
+	return qtl(p)
+}
+
+// PoissonQtlTail returns the inverse of PoissonCDFTail (quantile) of the Poisson distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func PoissonQtlTail(λ float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := PoissonCDFTail(λ, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(λ >= 0) || isInf(λ, 1) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, posInfInt64, lowerTail, logP)
+	}
+}
+
 // LnPoissonCDFAn returns the natural logarithm of the CDF of the Poisson distribution. Analytic solution, less precision.
 func LnPoissonCDFAn(λ float64) func(k int64) float64 {
 	return func(k int64) float64 {
@@ -105,6 +164,11 @@ func LnPoissonCDFAn(λ float64) func(k int64) float64 {
 
 // PoissonNext2 returns random number drawn from the Poisson distribution (old version). 
 func PoissonNext2(λ float64) int64 {
+	return PoissonNext2R(GlobalRand, λ)
+}
+
+// PoissonNext2R returns random number drawn from the Poisson distribution (old version), using the random source src.
+func PoissonNext2R(src *rand.Rand, λ float64) int64 {
 	var k int64
 	if λ < 100 { // Knuth algorithm for small λ
 		// Donald E. Knuth (1969). Seminumerical Algorithms. The Art of Computer Programming, Volume 2. Addison Wesley.
@@ -112,21 +176,27 @@ func PoissonNext2(λ float64) int64 {
 		k = iZero
 		t := exp(-λ)
 		p := fOne
-		for ; p > t; p *= UniformNext(0, 1) {
+		for ; p > t; p *= UniformNextR(src, 0, 1) {
 			k++
 		}
 		k -= 1
 
 	} else { // use Normal approximation
-		k = int64(iround(NormalNext(λ, sqrt(λ))))
+		k = int64(iround(NormalNextR(src, λ, sqrt(λ))))
 	}
 	return k
 }
 
 // Poisson returns the random number generator with  Poisson distribution. 
 func Poisson(λ float64) func() int64 {
+	return PoissonR(GlobalRand, λ)
+}
+
+// PoissonR returns the random number generator with  Poisson distribution, using the random source src.
+func PoissonR(src *rand.Rand, λ float64) func() int64 {
+	ps := newPoissonSampler(λ)
 	return func() int64 {
-		return PoissonNext(λ)
+		return ps.next(src)
 	}
 }
 
@@ -159,3 +229,101 @@ func PoissonSkew(λ float64, k int64) float64 {
 func PoissonExKurt(λ float64, k int64) float64 {
 	return 1 / λ
 }
+
+// PoissonEntropy returns the Shannon entropy of the Poisson distribution, by numerical summation.
+func PoissonEntropy(λ float64) float64 {
+	return entropyDiscrete(PoissonDist{λ})
+}
+
+// PoissonCF returns the characteristic function of the Poisson distribution.
+func PoissonCF(λ, t float64) complex128 {
+	return cexp(complex(λ*(cos(t)-1), λ*sin(t)))
+}
+
+// PoissonCGF returns the cumulant generating function of the Poisson distribution.
+func PoissonCGF(λ, t float64) float64 {
+	return λ * expm1(t)
+}
+
+// PoissonFit returns the maximum-likelihood estimate of λ of the Poisson distribution from the sample k: its mean.
+func PoissonFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return PoissonLnPMF(θ[0]) })
+	μ, _ := mleMeanVarInt(k)
+	return mleAt(lnL, []float64{μ})
+}
+
+// PoissonDist is the Poisson distribution with mean λ = Lambda. It implements Discrete.
+type PoissonDist struct {
+	Lambda float64
+}
+
+// PMF returns the value of PMF of the Poisson distribution at k.
+func (d PoissonDist) PMF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return PoissonPMFAt(d.Lambda, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Poisson distribution at k.
+func (d PoissonDist) LnPMF(k int64) float64 {
+	if k < 0 {
+		return negInf
+	}
+	return PoissonLnPMF(d.Lambda)(k)
+}
+
+// CDF returns the value of CDF of the Poisson distribution at k.
+func (d PoissonDist) CDF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return PoissonCDFAt(d.Lambda, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Poisson distribution at k.
+func (d PoissonDist) Surv(k int64) float64 { return PoissonCDFTail(d.Lambda, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Poisson distribution at k.
+func (d PoissonDist) LnCDF(k int64) float64 { return PoissonCDFTail(d.Lambda, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Poisson distribution at k.
+func (d PoissonDist) LnSurv(k int64) float64 { return PoissonCDFTail(d.Lambda, false, true)(k) }
+
+// Qtl returns the quantile of the Poisson distribution for probability p.
+func (d PoissonDist) Qtl(p float64) int64 { return PoissonQtlFor(d.Lambda, p) }
+
+// QtlTail returns the quantile of the Poisson distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d PoissonDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return PoissonQtlTail(d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Poisson distribution.
+func (d PoissonDist) Rand() int64 { return PoissonNext(d.Lambda) }
+
+// RandR returns random number drawn from the Poisson distribution, using the random source src.
+func (d PoissonDist) RandR(src *rand.Rand) int64 { return PoissonNextR(src, d.Lambda) }
+
+// Mean returns the mean of the Poisson distribution.
+func (d PoissonDist) Mean() float64 { return d.Lambda }
+
+// Var returns the variance of the Poisson distribution.
+func (d PoissonDist) Var() float64 { return d.Lambda }
+
+// Skew returns the skewness of the Poisson distribution.
+func (d PoissonDist) Skew() float64 { return pow(d.Lambda, -0.5) }
+
+// ExKurt returns the excess kurtosis of the Poisson distribution.
+func (d PoissonDist) ExKurt() float64 { return 1 / d.Lambda }
+
+// Entropy returns the Shannon entropy of the Poisson distribution.
+func (d PoissonDist) Entropy() float64 { return PoissonEntropy(d.Lambda) }
+
+// CF returns the characteristic function of the Poisson distribution at t.
+func (d PoissonDist) CF(t float64) complex128 { return PoissonCF(d.Lambda, t) }
+
+// CGF returns the cumulant generating function of the Poisson distribution at t.
+func (d PoissonDist) CGF(t float64) float64 { return PoissonCGF(d.Lambda, t) }
+
+// Support returns the support of the Poisson distribution.
+func (d PoissonDist) Support() (a, b int64) { return 0, posInfInt64 }
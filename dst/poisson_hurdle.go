@@ -0,0 +1,272 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Hurdle Poisson distribution.
+// The count is zero with probability ψ, and drawn from the Poisson distribution with mean λ truncated to k > 0 otherwise:
+// whether the count clears the hurdle of zero is decided apart from its size, so there may be more or fewer zeros than under the Poisson distribution.
+// Mullahy, J. (1986). Specification and testing of some modified count data models. Journal of Econometrics 33, 341-365.
+//
+// Parameters:
+// ψ ∈ [0, 1]	probability of zero
+// λ > 0		mean of the Poisson distribution before truncation
+//
+// Support:
+// k ∈ {0, 1, 2, ... }
+
+import (
+	"math/rand"
+)
+
+// hurdlePoissonW returns the weight (1-ψ) / P[X > 0] of the Poisson distribution in the moments of the Hurdle Poisson distribution.
+func hurdlePoissonW(ψ, λ float64) float64 {
+	return (1 - ψ) / -expm1(-λ)
+}
+
+// HurdlePoissonPMF returns the PMF of the Hurdle Poisson distribution.
+func HurdlePoissonPMF(ψ, λ float64) func(k int64) float64 {
+	lnPMF := HurdlePoissonLnPMF(ψ, λ)
+	return func(k int64) float64 {
+		return exp(lnPMF(k))
+	}
+}
+
+// HurdlePoissonLnPMF returns the natural logarithm of the PMF of the Hurdle Poisson distribution.
+func HurdlePoissonLnPMF(ψ, λ float64) func(k int64) float64 {
+	lnf := PoissonLnPMF(λ)
+	lnS0 := log(-expm1(-λ))
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || λ <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return negInf
+		}
+		return hurdleLnPMF(ψ, k, lnf(k), lnS0)
+	}
+}
+
+// HurdlePoissonPMFAt returns the value of PMF of Hurdle Poisson distribution at k.
+func HurdlePoissonPMFAt(ψ, λ float64, k int64) float64 {
+	pmf := HurdlePoissonPMF(ψ, λ)
+	return pmf(k)
+}
+
+// HurdlePoissonCDF returns the CDF of the Hurdle Poisson distribution.
+func HurdlePoissonCDF(ψ, λ float64) func(k int64) float64 {
+	return HurdlePoissonCDFTail(ψ, λ, true, false)
+}
+
+// HurdlePoissonCDFAt returns the value of CDF of the Hurdle Poisson distribution, at k.
+func HurdlePoissonCDFAt(ψ, λ float64, k int64) float64 {
+	cdf := HurdlePoissonCDF(ψ, λ)
+	return cdf(k)
+}
+
+// HurdlePoissonCDFTail returns the CDF of the Hurdle Poisson distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func HurdlePoissonCDFTail(ψ, λ float64, lowerTail, logP bool) func(k int64) float64 {
+	lnS := PoissonCDFTail(λ, false, true)
+	lnS0 := log(-expm1(-λ))
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || λ <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		p := hurdleLnCDF(ψ, lnS(k)-lnS0, lowerTail)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// HurdlePoissonQtl returns the inverse of the CDF (quantile) of the Hurdle Poisson distribution.
+func HurdlePoissonQtl(ψ, λ float64) func(p float64) int64 {
+	return HurdlePoissonQtlTail(ψ, λ, true, false)
+}
+
+// HurdlePoissonQtlFor returns the inverse of the CDF (quantile) of the Hurdle Poisson distribution, for given probability.
+func HurdlePoissonQtlFor(ψ, λ float64, p float64) int64 {
+	qtl := HurdlePoissonQtl(ψ, λ)
+	return qtl(p)
+}
+
+// HurdlePoissonQtlTail returns the inverse of HurdlePoissonCDFTail (quantile) of the Hurdle Poisson distribution;
+// it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func HurdlePoissonQtlTail(ψ, λ float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := HurdlePoissonCDFTail(ψ, λ, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ψ >= 0 && ψ <= 1) || !(λ > 0) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, posInfInt64, lowerTail, logP)
+	}
+}
+
+// HurdlePoissonNext returns random number drawn from the Hurdle Poisson distribution.
+func HurdlePoissonNext(ψ, λ float64) int64 {
+	return HurdlePoissonNextR(GlobalRand, ψ, λ)
+}
+
+// HurdlePoissonNextR returns random number drawn from the Hurdle Poisson distribution, using the random source src.
+func HurdlePoissonNextR(src *rand.Rand, ψ, λ float64) int64 {
+	if src.Float64() < ψ {
+		return 0
+	}
+	return zeroTruncNextR(src, log(-expm1(-λ)), func() int64 { return PoissonNextR(src, λ) }, PoissonQtlTail(λ, false, true))
+}
+
+// HurdlePoisson returns the random number generator with  Hurdle Poisson distribution.
+func HurdlePoisson(ψ, λ float64) func() int64 {
+	return HurdlePoissonR(GlobalRand, ψ, λ)
+}
+
+// HurdlePoissonR returns the random number generator with  Hurdle Poisson distribution, using the random source src.
+func HurdlePoissonR(src *rand.Rand, ψ, λ float64) func() int64 {
+	return func() int64 { return HurdlePoissonNextR(src, ψ, λ) }
+}
+
+// HurdlePoissonFill fills x with random numbers drawn from the Hurdle Poisson distribution.
+func HurdlePoissonFill(ψ, λ float64, x []int64) {
+	HurdlePoissonFillR(GlobalRand, ψ, λ, x)
+}
+
+// HurdlePoissonFillR fills x with random numbers drawn from the Hurdle Poisson distribution, using the random source src;
+// the sampler of the positive part is set up once for all the draws.
+func HurdlePoissonFillR(src *rand.Rand, ψ, λ float64, x []int64) {
+	ps := newPoissonSampler(λ)
+	lnS0 := log(-expm1(-λ))
+	next := func() int64 { return ps.next(src) }
+	qtl := PoissonQtlTail(λ, false, true)
+	for i := range x {
+		if src.Float64() < ψ {
+			x[i] = 0
+			continue
+		}
+		x[i] = zeroTruncNextR(src, lnS0, next, qtl)
+	}
+}
+
+// HurdlePoissonMean returns the mean of the Hurdle Poisson distribution.
+func HurdlePoissonMean(ψ, λ float64) float64 {
+	return hurdlePoissonW(ψ, λ) * λ
+}
+
+// HurdlePoissonVar returns the variance of the Hurdle Poisson distribution.
+func HurdlePoissonVar(ψ, λ float64) float64 {
+	w := hurdlePoissonW(ψ, λ)
+	return w*λ*(1+λ) - w*w*λ*λ
+}
+
+// HurdlePoissonStd returns the standard deviation of the Hurdle Poisson distribution.
+func HurdlePoissonStd(ψ, λ float64) float64 {
+	return sqrt(HurdlePoissonVar(ψ, λ))
+}
+
+// HurdlePoissonSkew returns the skewness of the Hurdle Poisson distribution.
+func HurdlePoissonSkew(ψ, λ float64) float64 {
+	_, _, skew, _ := zeroModMoments(hurdlePoissonW(ψ, λ), PoissonDist{λ})
+	return skew
+}
+
+// HurdlePoissonExKurt returns the excess kurtosis of the Hurdle Poisson distribution.
+func HurdlePoissonExKurt(ψ, λ float64) float64 {
+	_, _, _, kurt := zeroModMoments(hurdlePoissonW(ψ, λ), PoissonDist{λ})
+	return kurt
+}
+
+// HurdlePoissonEntropy returns the Shannon entropy of the Hurdle Poisson distribution, by numerical summation.
+func HurdlePoissonEntropy(ψ, λ float64) float64 {
+	return entropyDiscrete(HurdlePoissonDist{ψ, λ})
+}
+
+// HurdlePoissonCF returns the characteristic function of the Hurdle Poisson distribution.
+func HurdlePoissonCF(ψ, λ, t float64) complex128 {
+	p0 := exp(-λ)
+	return complex(ψ, 0) + complex((1-ψ)/-expm1(-λ), 0)*(PoissonCF(λ, t)-complex(p0, 0))
+}
+
+// HurdlePoissonCGF returns the cumulant generating function of the Hurdle Poisson distribution.
+func HurdlePoissonCGF(ψ, λ, t float64) float64 {
+	// the Poisson distribution truncated to k > 0: ln((e^{λ(e^t-1)} - e^-λ) / (1 - e^-λ))
+	k := -λ + lnExpm1(λ*exp(t)) - log(-expm1(-λ))
+	return logSumExp([]float64{log(ψ), log1p(-ψ) + k})
+}
+
+// HurdlePoissonFit returns the maximum-likelihood estimates of ψ, λ of the Hurdle Poisson distribution from the sample k.
+func HurdlePoissonFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return HurdlePoissonLnPMF(θ[0], θ[1]) })
+	ψ, μ := hurdleStart(k)
+	return mleFit(lnL, []float64{ψ, μ}, []mleBound{mleUnit, mlePos})
+}
+
+// HurdlePoissonDist is the Hurdle Poisson distribution with the probability of zero ψ = Psi and the Poisson mean λ = Lambda. It implements Discrete.
+type HurdlePoissonDist struct {
+	Psi, Lambda float64
+}
+
+// PMF returns the value of PMF of the Hurdle Poisson distribution at k.
+func (d HurdlePoissonDist) PMF(k int64) float64 { return HurdlePoissonPMFAt(d.Psi, d.Lambda, k) }
+
+// LnPMF returns the natural logarithm of the PMF of the Hurdle Poisson distribution at k.
+func (d HurdlePoissonDist) LnPMF(k int64) float64 { return HurdlePoissonLnPMF(d.Psi, d.Lambda)(k) }
+
+// CDF returns the value of CDF of the Hurdle Poisson distribution at k.
+func (d HurdlePoissonDist) CDF(k int64) float64 { return HurdlePoissonCDFAt(d.Psi, d.Lambda, k) }
+
+// Surv returns the value of the survival function 1 - CDF of the Hurdle Poisson distribution at k.
+func (d HurdlePoissonDist) Surv(k int64) float64 {
+	return HurdlePoissonCDFTail(d.Psi, d.Lambda, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Hurdle Poisson distribution at k.
+func (d HurdlePoissonDist) LnCDF(k int64) float64 {
+	return HurdlePoissonCDFTail(d.Psi, d.Lambda, true, true)(k)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Hurdle Poisson distribution at k.
+func (d HurdlePoissonDist) LnSurv(k int64) float64 {
+	return HurdlePoissonCDFTail(d.Psi, d.Lambda, false, true)(k)
+}
+
+// Qtl returns the quantile of the Hurdle Poisson distribution for probability p.
+func (d HurdlePoissonDist) Qtl(p float64) int64 { return HurdlePoissonQtlFor(d.Psi, d.Lambda, p) }
+
+// QtlTail returns the quantile of the Hurdle Poisson distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d HurdlePoissonDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return HurdlePoissonQtlTail(d.Psi, d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Hurdle Poisson distribution.
+func (d HurdlePoissonDist) Rand() int64 { return HurdlePoissonNext(d.Psi, d.Lambda) }
+
+// RandR returns random number drawn from the Hurdle Poisson distribution, using the random source src.
+func (d HurdlePoissonDist) RandR(src *rand.Rand) int64 {
+	return HurdlePoissonNextR(src, d.Psi, d.Lambda)
+}
+
+// Mean returns the mean of the Hurdle Poisson distribution.
+func (d HurdlePoissonDist) Mean() float64 { return HurdlePoissonMean(d.Psi, d.Lambda) }
+
+// Var returns the variance of the Hurdle Poisson distribution.
+func (d HurdlePoissonDist) Var() float64 { return HurdlePoissonVar(d.Psi, d.Lambda) }
+
+// Skew returns the skewness of the Hurdle Poisson distribution.
+func (d HurdlePoissonDist) Skew() float64 { return HurdlePoissonSkew(d.Psi, d.Lambda) }
+
+// ExKurt returns the excess kurtosis of the Hurdle Poisson distribution.
+func (d HurdlePoissonDist) ExKurt() float64 { return HurdlePoissonExKurt(d.Psi, d.Lambda) }
+
+// Entropy returns the Shannon entropy of the Hurdle Poisson distribution.
+func (d HurdlePoissonDist) Entropy() float64 { return HurdlePoissonEntropy(d.Psi, d.Lambda) }
+
+// CF returns the characteristic function of the Hurdle Poisson distribution at t.
+func (d HurdlePoissonDist) CF(t float64) complex128 { return HurdlePoissonCF(d.Psi, d.Lambda, t) }
+
+// CGF returns the cumulant generating function of the Hurdle Poisson distribution at t.
+func (d HurdlePoissonDist) CGF(t float64) float64 { return HurdlePoissonCGF(d.Psi, d.Lambda, t) }
+
+// Support returns the support of the Hurdle Poisson distribution.
+func (d HurdlePoissonDist) Support() (a, b int64) { return 0, posInfInt64 }
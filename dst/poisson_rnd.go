@@ -12,6 +12,96 @@ import (
 
 // PoissonNext returns random number drawn from the Poisson distribution. 
 func PoissonNext(λ float64) int64 {
+	return PoissonNextR(GlobalRand, λ)
+}
+
+// PoissonNextR returns random number drawn from the Poisson distribution, using the random source src.
+func PoissonNextR(src *rand.Rand, λ float64) int64 {
+	ps := newPoissonSampler(λ)
+	return ps.next(src)
+}
+
+// PoissonFill fills x with random numbers drawn from the Poisson distribution.
+func PoissonFill(λ float64, x []int64) {
+	PoissonFillR(GlobalRand, λ, x)
+}
+
+// PoissonFillR fills x with random numbers drawn from the Poisson distribution, using the random source src;
+// the constants of the sampler are computed once for all the draws.
+func PoissonFillR(src *rand.Rand, λ float64, x []int64) {
+	ps := newPoissonSampler(λ)
+	for i := range x {
+		x[i] = ps.next(src)
+	}
+}
+
+// poissonSampler holds the constants of the sampler for λ, which R keeps in static variables between the calls:
+// those of the normal approximation and of its Hermite corrections if λ >= 10, and the table of the cumulative probabilities
+// otherwise, which grows with the draws.
+type poissonSampler struct {
+	λ float64
+
+	// λ >= 10
+	bigMu                    bool
+	s, d, bigL               float64
+	omega, c, c0, c1, c2, c3 float64
+
+	// λ < 10
+	m, l     int
+	p, q, p0 float64
+	pp       [36]float64
+}
+
+// newPoissonSampler returns the sampler of the Poisson distribution with mean λ.
+func newPoissonSampler(λ float64) poissonSampler {
+	const one_7 = 0.1428571428571428571
+	const one_24 = 0.0416666666666666667
+
+	if isInf(λ, 1) || λ < 0.0 {
+		//		return NaN
+		panic("bad lambda")
+	}
+	ps := poissonSampler{λ: λ}
+	if λ == 0 {
+		return ps
+	}
+	if λ >= 10 {
+		ps.bigMu = true
+
+		// Case A. (recalculation of s,d,l	because λ has changed):
+		// The poisson probabilities pk exceed the discrete normal
+		// probabilities fk whenever k >= m(λ).
+		ps.s = sqrt(λ)
+		ps.d = 6. * λ * λ
+		ps.bigL = floor(λ - 1.1484)
+		// = an upper bound to m(λ) for all λ >= 10.
+
+		// Step P. preparations for steps Q and H.
+		ps.omega = M_1_SQRT_2PI / ps.s
+
+		// The quantities b1, b2, c3, c2, c1, c0 are for the Hermite
+		// approximations to the discrete normal probabilities fk.
+		b1 := one_24 / λ
+		b2 := 0.3 * b1 * b1
+		ps.c3 = one_7 * b1 * b2
+		ps.c2 = b2 - 15.*ps.c3
+		ps.c1 = b1 - 6.*b2 + 45.*ps.c3
+		ps.c0 = 1. - b1 + 3.*b2 - 15.*ps.c3
+		ps.c = 0.1069 / λ // guarantees majorization by the 'hat'-function.
+		return ps
+	}
+
+	// Case B. (start new table and calculate p0 if necessary)
+	ps.m = imax2(1, int(λ))
+	ps.l = 0 // pp[] is already ok up to pp[l]
+	ps.p = exp(-λ)
+	ps.p0 = ps.p
+	ps.q = ps.p
+	return ps
+}
+
+// next returns random number drawn from the Poisson distribution, using the random source src.
+func (ps *poissonSampler) next(src *rand.Rand) int64 {
 	const (
 		a0     = -0.5
 		a1     = 0.3333333
@@ -21,177 +111,110 @@ func PoissonNext(λ float64) int64 {
 		a5     = 0.1421878
 		a6     = -0.1384794
 		a7     = 0.1250060
-		one_7  = 0.1428571428571428571
 		one_12 = 0.0833333333333333333
-		one_24 = 0.0416666666666666667
 	)
 
 	var (
-		b1, b2, c, c0, c1, c2, c3       float64
-		p0, p, q, s, d, omega           float64
-		big_l                           float64 // integer "w/o overflow"
-		del, fx, fy, g, px, py, t, v, x float64
-		m, l, k                         int
+		del, fx, fy, px, py, t, v, x float64
+		k                            int
 	)
 
 	// Factorial Table (0:9)! 
 	fact := []float64{1., 1., 2., 6., 24., 120., 720., 5040., 40320., 362880.}
-	pp := make([]float64, 36)
+	λ := ps.λ
+	s, d := ps.s, ps.d
 	difmuk := 0.0
 	E := 0.0
 	fk := 0.0
 	u := 0.0
 	pois := -1.0
 
-	if isInf(λ, 1) || λ < 0.0 {
-		//		return NaN
-		panic("bad lambda")
-	}
-
 	if λ == 0.0 {
 		return 0
 	}
 
-	k = 0
 	kflag := false
-	big_mu := false
-	new_big_mu := false
 	stepF := false
 
-	muprev := 0.0
-	muprev2 := 0.0
-
-	if λ >= 10 {
-		big_mu = true
-		new_big_mu = false
-	} else {
-		big_mu = false
-	}
-
-	//	if !(big_mu && mu == muprev) { // maybe compute new pars
-
-	if big_mu {
-		new_big_mu = true
-
-		// Case A. (recalculation of s,d,l	because λ has changed):
-		// The poisson probabilities pk exceed the discrete normal
-		// probabilities fk whenever k >= m(λ).
-
-		muprev = λ
-		s = sqrt(λ)
-		d = 6. * λ * λ
-		big_l = floor(λ - 1.1484)
-		// = an upper bound to m(λ) for all λ >= 10.
-	} else { // Small λ ( < 10) -- not using normal approx.
-
-		// Case B. (start new table and calculate p0 if necessary)
-
-		if λ != muprev {
-			muprev = λ
-			m = imax2(1, int(λ))
-			l = 0 // pp[] is already ok up to pp[l]
-			p = exp(-λ)
-			p0 = p
-			q = p
-		}
-
+	if !ps.bigMu { // Small λ ( < 10) -- not using normal approx.
+		pp := &ps.pp
 		for {
 			// Step U. uniform sample for inversion method
-			u := rand.Float64()
-			if u <= p0 {
+			u := src.Float64()
+			if u <= ps.p0 {
 				return 0
 			}
 
 			// Step T. table comparison until the end pp[l] of the
 			//   pp-table of cumulative poisson probabilities
 			//   (0.458 > ~= pp[9](= 0.45792971447) for λ=10 )
-			if l != 0 {
+			if ps.l != 0 {
 				kk := 1
 				if u > 0.458 {
-					kk = imin2(l, m)
+					kk = imin2(ps.l, ps.m)
 				}
-				for k = kk; k <= l; k++ {
+				for k = kk; k <= ps.l; k++ {
 					if u <= pp[k] {
 						return int64(k)
 					}
 				}
-				if l == 35 { // u > pp[35]
+				if ps.l == 35 { // u > pp[35]
 					continue
 				}
 			}
 			// Step C. creation of new poisson
 			//   probabilities p[l..] and their cumulatives q =: pp[k] 
-			l++
-			for k = l; k <= 35; k++ {
-				p *= λ / float64(k)
-				q += p
-				pp[k] = q
-				if u <= q {
-					l = k
+			ps.l++
+			for k = ps.l; k <= 35; k++ {
+				ps.p *= λ / float64(k)
+				ps.q += ps.p
+				pp[k] = ps.q
+				if u <= ps.q {
+					ps.l = k
 					return int64(k)
 				}
 			}
-			l = 35
+			ps.l = 35
 		} // end(for)
 	} // λ < 10
-	//	} // end initialize persistent vars
 
 	// Only if λ >= 10
 
 	// Step N. normal sample
-	g = λ + s*rand.NormFloat64() // norm_rand() ~ N(0,1), standard normal
+	g := λ + s*normalZig(src) // norm_rand() ~ N(0,1), standard normal
 
 	if g >= 0. {
 		pois = floor(g)
 		// Step I. immediate acceptance if pois is large enough
-		if pois >= big_l {
+		if pois >= ps.bigL {
 			return int64(pois)
 		}
 		// Step S. squeeze acceptance
 		fk = pois
 		difmuk = λ - fk
-		u = rand.Float64() // ~ U(0,1) - sample
+		u = src.Float64() // ~ U(0,1) - sample
 		if d*u >= difmuk*difmuk*difmuk {
 			return int64(pois)
 		}
 	}
 
-	// Step P. preparations for steps Q and H.
-	//   (recalculations of parameters if necessary)
-
-	if new_big_mu || λ != muprev2 {
-		// Careful! muprev2 is not always == muprev
-		//  because one might have exited in step I or S
-		muprev2 = λ
-		omega = M_1_SQRT_2PI / s
-
-		// The quantities b1, b2, c3, c2, c1, c0 are for the Hermite
-		// approximations to the discrete normal probabilities fk.
-
-		b1 = one_24 / λ
-		b2 = 0.3 * b1 * b1
-		c3 = one_7 * b1 * b2
-		c2 = b2 - 15.*c3
-		c1 = b1 - 6.*b2 + 45.*c3
-		c0 = 1. - b1 + 3.*b2 - 15.*c3
-		c = 0.1069 / λ // guarantees majorization by the 'hat'-function.
-	}
+	// Step P. the constants are those of the sampler
+	c, c0, c1, c2, c3, omega := ps.c, ps.c0, ps.c1, ps.c2, ps.c3, ps.omega
 	if g >= 0. {
 		// 'Subroutine' F is called (kflag=0 for correct return)
 		kflag = false
 		//		goto Step_F
 		stepF = true
 	}
-
 	for {
 		if !stepF {
 			// Step E. Exponential Sample
 
-			E = rand.ExpFloat64() // ~ Exp(1) (standard exponential)
+			E = expZig(src) // ~ Exp(1) (standard exponential)
 
 			//  sample t from the laplace 'hat'
 			//    (if t <= -0.6744 then pk < fk for all λ >= 10.)
-			u = 2*rand.Float64() - 1.
+			u = 2*src.Float64() - 1.
 			t = 1.8 + fsign(E, u)
 		}
 		if t > -0.6744 || stepF {
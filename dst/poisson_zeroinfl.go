@@ -0,0 +1,255 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Zero-inflated Poisson distribution.
+// The count is zero with probability ψ (a structural zero), and drawn from the Poisson distribution with mean λ otherwise:
+// counts with more zeros than the Poisson distribution allows, such as the zeros of a population where the event cannot happen at all.
+// Lambert, D. (1992). Zero-inflated Poisson regression, with an application to defects in manufacturing. Technometrics 34, 1-14.
+//
+// Parameters:
+// ψ ∈ [0, 1]	probability of a structural zero
+// λ > 0		mean of the Poisson counts
+//
+// Support:
+// k ∈ {0, 1, 2, ... }
+
+import (
+	"math/rand"
+)
+
+// ZIPoissonPMF returns the PMF of the Zero-inflated Poisson distribution.
+func ZIPoissonPMF(ψ, λ float64) func(k int64) float64 {
+	lnPMF := ZIPoissonLnPMF(ψ, λ)
+	return func(k int64) float64 {
+		return exp(lnPMF(k))
+	}
+}
+
+// ZIPoissonLnPMF returns the natural logarithm of the PMF of the Zero-inflated Poisson distribution.
+func ZIPoissonLnPMF(ψ, λ float64) func(k int64) float64 {
+	lnf := PoissonLnPMF(λ)
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || λ <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return negInf
+		}
+		return zeroInflLnPMF(ψ, k, lnf(k))
+	}
+}
+
+// ZIPoissonPMFAt returns the value of PMF of Zero-inflated Poisson distribution at k.
+func ZIPoissonPMFAt(ψ, λ float64, k int64) float64 {
+	pmf := ZIPoissonPMF(ψ, λ)
+	return pmf(k)
+}
+
+// ZIPoissonCDF returns the CDF of the Zero-inflated Poisson distribution.
+func ZIPoissonCDF(ψ, λ float64) func(k int64) float64 {
+	return ZIPoissonCDFTail(ψ, λ, true, false)
+}
+
+// ZIPoissonCDFAt returns the value of CDF of the Zero-inflated Poisson distribution, at k.
+func ZIPoissonCDFAt(ψ, λ float64, k int64) float64 {
+	cdf := ZIPoissonCDF(ψ, λ)
+	return cdf(k)
+}
+
+// ZIPoissonCDFTail returns the CDF of the Zero-inflated Poisson distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ZIPoissonCDFTail(ψ, λ float64, lowerTail, logP bool) func(k int64) float64 {
+	lnP := PoissonCDFTail(λ, lowerTail, true)
+	return func(k int64) float64 {
+		if ψ < 0 || ψ > 1 || λ <= 0 {
+			return NaN
+		}
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		p := zeroInflLnCDF(ψ, lnP(k), lowerTail)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// ZIPoissonQtl returns the inverse of the CDF (quantile) of the Zero-inflated Poisson distribution.
+func ZIPoissonQtl(ψ, λ float64) func(p float64) int64 {
+	return ZIPoissonQtlTail(ψ, λ, true, false)
+}
+
+// ZIPoissonQtlFor returns the inverse of the CDF (quantile) of the Zero-inflated Poisson distribution, for given probability.
+func ZIPoissonQtlFor(ψ, λ float64, p float64) int64 {
+	qtl := ZIPoissonQtl(ψ, λ)
+	return qtl(p)
+}
+
+// ZIPoissonQtlTail returns the inverse of ZIPoissonCDFTail (quantile) of the Zero-inflated Poisson distribution;
+// it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func ZIPoissonQtlTail(ψ, λ float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := ZIPoissonCDFTail(ψ, λ, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ψ >= 0 && ψ <= 1) || !(λ > 0) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, posInfInt64, lowerTail, logP)
+	}
+}
+
+// ZIPoissonNext returns random number drawn from the Zero-inflated Poisson distribution.
+func ZIPoissonNext(ψ, λ float64) int64 {
+	return ZIPoissonNextR(GlobalRand, ψ, λ)
+}
+
+// ZIPoissonNextR returns random number drawn from the Zero-inflated Poisson distribution, using the random source src.
+func ZIPoissonNextR(src *rand.Rand, ψ, λ float64) int64 {
+	if src.Float64() < ψ {
+		return 0
+	}
+	return PoissonNextR(src, λ)
+}
+
+// ZIPoisson returns the random number generator with  Zero-inflated Poisson distribution.
+func ZIPoisson(ψ, λ float64) func() int64 {
+	return ZIPoissonR(GlobalRand, ψ, λ)
+}
+
+// ZIPoissonR returns the random number generator with  Zero-inflated Poisson distribution, using the random source src.
+func ZIPoissonR(src *rand.Rand, ψ, λ float64) func() int64 {
+	return func() int64 { return ZIPoissonNextR(src, ψ, λ) }
+}
+
+// ZIPoissonFill fills x with random numbers drawn from the Zero-inflated Poisson distribution.
+func ZIPoissonFill(ψ, λ float64, x []int64) {
+	ZIPoissonFillR(GlobalRand, ψ, λ, x)
+}
+
+// ZIPoissonFillR fills x with random numbers drawn from the Zero-inflated Poisson distribution, using the random source src.
+func ZIPoissonFillR(src *rand.Rand, ψ, λ float64, x []int64) {
+	ps := newPoissonSampler(λ)
+	for i := range x {
+		if src.Float64() < ψ {
+			x[i] = 0
+			continue
+		}
+		x[i] = ps.next(src)
+	}
+}
+
+// ZIPoissonMean returns the mean of the Zero-inflated Poisson distribution.
+func ZIPoissonMean(ψ, λ float64) float64 {
+	return (1 - ψ) * λ
+}
+
+// ZIPoissonVar returns the variance of the Zero-inflated Poisson distribution.
+func ZIPoissonVar(ψ, λ float64) float64 {
+	return (1 - ψ) * λ * (1 + ψ*λ)
+}
+
+// ZIPoissonStd returns the standard deviation of the Zero-inflated Poisson distribution.
+func ZIPoissonStd(ψ, λ float64) float64 {
+	return sqrt(ZIPoissonVar(ψ, λ))
+}
+
+// ZIPoissonSkew returns the skewness of the Zero-inflated Poisson distribution.
+func ZIPoissonSkew(ψ, λ float64) float64 {
+	_, _, skew, _ := zeroModMoments(1-ψ, PoissonDist{λ})
+	return skew
+}
+
+// ZIPoissonExKurt returns the excess kurtosis of the Zero-inflated Poisson distribution.
+func ZIPoissonExKurt(ψ, λ float64) float64 {
+	_, _, _, kurt := zeroModMoments(1-ψ, PoissonDist{λ})
+	return kurt
+}
+
+// ZIPoissonEntropy returns the Shannon entropy of the Zero-inflated Poisson distribution, by numerical summation.
+func ZIPoissonEntropy(ψ, λ float64) float64 {
+	return entropyDiscrete(ZIPoissonDist{ψ, λ})
+}
+
+// ZIPoissonCF returns the characteristic function of the Zero-inflated Poisson distribution.
+func ZIPoissonCF(ψ, λ, t float64) complex128 {
+	return complex(ψ, 0) + complex(1-ψ, 0)*PoissonCF(λ, t)
+}
+
+// ZIPoissonCGF returns the cumulant generating function of the Zero-inflated Poisson distribution.
+func ZIPoissonCGF(ψ, λ, t float64) float64 {
+	return logSumExp([]float64{log(ψ), log1p(-ψ) + PoissonCGF(λ, t)})
+}
+
+// ZIPoissonFit returns the maximum-likelihood estimates of ψ, λ of the Zero-inflated Poisson distribution from the sample k.
+func ZIPoissonFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return ZIPoissonLnPMF(θ[0], θ[1]) })
+	ψ, μ := zeroInflStart(k)
+	return mleFit(lnL, []float64{ψ, μ}, []mleBound{mleUnit, mlePos})
+}
+
+// ZIPoissonDist is the Zero-inflated Poisson distribution with the probability of a structural zero ψ = Psi and the Poisson mean λ = Lambda. It implements Discrete.
+type ZIPoissonDist struct {
+	Psi, Lambda float64
+}
+
+// PMF returns the value of PMF of the Zero-inflated Poisson distribution at k.
+func (d ZIPoissonDist) PMF(k int64) float64 { return ZIPoissonPMFAt(d.Psi, d.Lambda, k) }
+
+// LnPMF returns the natural logarithm of the PMF of the Zero-inflated Poisson distribution at k.
+func (d ZIPoissonDist) LnPMF(k int64) float64 { return ZIPoissonLnPMF(d.Psi, d.Lambda)(k) }
+
+// CDF returns the value of CDF of the Zero-inflated Poisson distribution at k.
+func (d ZIPoissonDist) CDF(k int64) float64 { return ZIPoissonCDFAt(d.Psi, d.Lambda, k) }
+
+// Surv returns the value of the survival function 1 - CDF of the Zero-inflated Poisson distribution at k.
+func (d ZIPoissonDist) Surv(k int64) float64 {
+	return ZIPoissonCDFTail(d.Psi, d.Lambda, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Zero-inflated Poisson distribution at k.
+func (d ZIPoissonDist) LnCDF(k int64) float64 {
+	return ZIPoissonCDFTail(d.Psi, d.Lambda, true, true)(k)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Zero-inflated Poisson distribution at k.
+func (d ZIPoissonDist) LnSurv(k int64) float64 {
+	return ZIPoissonCDFTail(d.Psi, d.Lambda, false, true)(k)
+}
+
+// Qtl returns the quantile of the Zero-inflated Poisson distribution for probability p.
+func (d ZIPoissonDist) Qtl(p float64) int64 { return ZIPoissonQtlFor(d.Psi, d.Lambda, p) }
+
+// QtlTail returns the quantile of the Zero-inflated Poisson distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ZIPoissonDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return ZIPoissonQtlTail(d.Psi, d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Zero-inflated Poisson distribution.
+func (d ZIPoissonDist) Rand() int64 { return ZIPoissonNext(d.Psi, d.Lambda) }
+
+// RandR returns random number drawn from the Zero-inflated Poisson distribution, using the random source src.
+func (d ZIPoissonDist) RandR(src *rand.Rand) int64 { return ZIPoissonNextR(src, d.Psi, d.Lambda) }
+
+// Mean returns the mean of the Zero-inflated Poisson distribution.
+func (d ZIPoissonDist) Mean() float64 { return ZIPoissonMean(d.Psi, d.Lambda) }
+
+// Var returns the variance of the Zero-inflated Poisson distribution.
+func (d ZIPoissonDist) Var() float64 { return ZIPoissonVar(d.Psi, d.Lambda) }
+
+// Skew returns the skewness of the Zero-inflated Poisson distribution.
+func (d ZIPoissonDist) Skew() float64 { return ZIPoissonSkew(d.Psi, d.Lambda) }
+
+// ExKurt returns the excess kurtosis of the Zero-inflated Poisson distribution.
+func (d ZIPoissonDist) ExKurt() float64 { return ZIPoissonExKurt(d.Psi, d.Lambda) }
+
+// Entropy returns the Shannon entropy of the Zero-inflated Poisson distribution.
+func (d ZIPoissonDist) Entropy() float64 { return ZIPoissonEntropy(d.Psi, d.Lambda) }
+
+// CF returns the characteristic function of the Zero-inflated Poisson distribution at t.
+func (d ZIPoissonDist) CF(t float64) complex128 { return ZIPoissonCF(d.Psi, d.Lambda, t) }
+
+// CGF returns the cumulant generating function of the Zero-inflated Poisson distribution at t.
+func (d ZIPoissonDist) CGF(t float64) float64 { return ZIPoissonCGF(d.Psi, d.Lambda, t) }
+
+// Support returns the support of the Zero-inflated Poisson distribution.
+func (d ZIPoissonDist) Support() (a, b int64) { return 0, posInfInt64 }
@@ -12,6 +12,10 @@ package dst
 // Support: 
 // k ∈ { 0, 1, 2, 3, … }		number of successes
 
+import (
+	"math/rand"
+)
+
 func f_search(p, pr, y, n, incr float64, z *float64) float64 {
 	if *z >= p {
 		/* search to the left */
@@ -39,9 +43,9 @@ func f_search(p, pr, y, n, incr float64, z *float64) float64 {
 
 // PolyaPMF returns the PMF of the Pólya distribution. 
 func PolyaPMF(ρ, r float64) func(k int64) float64 {
+	lnPMF := PolyaLnPMF(ρ, r)
 	return func(k int64) float64 {
-		kk := float64(k)
-		return (Γ(kk+r) / (float64(fact(k)) * Γ(r))) * pow(1-ρ, r) * pow(ρ, float64(k))
+		return exp(lnPMF(k))
 	}
 }
 
@@ -51,6 +55,14 @@ func PolyaPMFAt(ρ, r float64, k int64) float64 {
 	return pmf(k)
 }
 
+// PolyaLnPMF returns the natural logarithm of the PMF of the Pólya distribution.
+func PolyaLnPMF(ρ, r float64) func(k int64) float64 {
+	return func(k int64) float64 {
+		kk := float64(k)
+		return LnΓ(kk+r) - LnΓ(kk+1) - LnΓ(r) + r*log(1-ρ) + kk*log(ρ)
+	}
+}
+
 // PolyaCDF returns the CDF of the Pólya distribution. 
 func PolyaCDF(ρ, r float64) func(k int64) float64 {
 	return func(k int64) float64 {
@@ -65,6 +77,51 @@ func PolyaCDFAt(ρ, r float64, k int64) float64 {
 	return cdf(k)
 }
 
+// PolyaCDFTail returns the CDF of the Pólya distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func PolyaCDFTail(ρ, r float64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		if k < 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return BetaCDFTail(float64(k+1), r, !lowerTail, logP)(ρ)
+	}
+}
+
+// PolyaNext returns random number drawn from the Pólya distribution, as a Gamma mixture of Poisson distributions.
+func PolyaNext(ρ, r float64) int64 {
+	return PolyaNextR(GlobalRand, ρ, r)
+}
+
+// PolyaNextR returns random number drawn from the Pólya distribution, as a Gamma mixture of Poisson distributions, using the random source src.
+func PolyaNextR(src *rand.Rand, ρ, r float64) int64 {
+	return PoissonNextR(src, GammaNextR(src, r, 1)*ρ/(1-ρ))
+}
+
+// Polya returns the random number generator with  Pólya distribution.
+func Polya(ρ, r float64) func() int64 {
+	return PolyaR(GlobalRand, ρ, r)
+}
+
+// PolyaR returns the random number generator with  Pólya distribution, using the random source src.
+func PolyaR(src *rand.Rand, ρ, r float64) func() int64 {
+	gs := newGammaSampler(r, 1)
+	return func() int64 { return PoissonNextR(src, gs.next(src)*ρ/(1-ρ)) }
+}
+
+// PolyaFill fills x with random numbers drawn from the Pólya distribution, as a Gamma mixture of Poisson distributions.
+func PolyaFill(ρ, r float64, x []int64) {
+	PolyaFillR(GlobalRand, ρ, r, x)
+}
+
+// PolyaFillR fills x with random numbers drawn from the Pólya distribution, as a Gamma mixture of Poisson distributions, using the random source src;
+// the Gamma sampler of the mixing mean is set up once; the Poisson draws depend on it.
+func PolyaFillR(src *rand.Rand, ρ, r float64, x []int64) {
+	next := PolyaR(src, ρ, r)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
 // PolyaMean returns the mean of the Pólya distribution. 
 func PolyaMean(ρ, r float64) float64 {
 	return ρ * r / (1 - ρ)
@@ -98,6 +155,24 @@ func PolyaExKurt(ρ, r float64) float64 {
 	return 6/r + ((1-ρ)*(1-ρ))/(ρ*r)
 }
 
+// PolyaEntropy returns the Shannon entropy of the Pólya distribution, by numerical summation.
+func PolyaEntropy(ρ, r float64) float64 {
+	return entropyDiscrete(PolyaDist{ρ, r})
+}
+
+// PolyaCF returns the characteristic function of the Pólya distribution.
+func PolyaCF(ρ, r, t float64) complex128 {
+	return cpow(complex(1-ρ, 0)/(1-complex(ρ, 0)*cexp(complex(0, t))), complex(r, 0))
+}
+
+// PolyaCGF returns the cumulant generating function of the Pólya distribution; +Inf for t ≥ -ln ρ.
+func PolyaCGF(ρ, r, t float64) float64 {
+	if t >= -log(ρ) {
+		return posInf
+	}
+	return r * (log1p(-ρ) - log1p(-ρ*exp(t)))
+}
+
 // PolyaMGF returns the moment-generating function of the Pólya distribution. 
 func PolyaMGF(ρ, r float64, t float64) float64 {
 	return pow((1-ρ)/(1-ρ*exp(t)), r)
@@ -108,29 +183,32 @@ func PolyaPGF(ρ, r float64, z float64) float64 {
 	return pow((1-ρ)/(1-ρ*z), r)
 }
 
-// PolyaQtl returns the inverse of the CDF (quantile) of the Pólya distribution.
+// PolyaQtl returns the inverse of the CDF (quantile) of the Pólya distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
 func PolyaQtl(ρ, r float64) func(p float64) int64 {
 	return func(p float64) int64 {
-		var eps, pp, qq, mu, sigma, gamma, z, y float64
+		var pp, qq, mu, sigma, gamma, z, y float64
 		fr := float64(r)
 
-		if ρ <= 0 || ρ > 1 || fr <= 0 { // FIXME: fr = 0 is well defined
-			return int64(NaN)
+		if !(ρ >= 0 && ρ < 1) || !(fr > 0) { // FIXME: fr = 0 is well defined
+			panic(ErrQtlParam)
+		}
+		if !(p >= 0 && p <= 1) {
+			panic(ErrQtlProb)
 		}
 
-		if ρ == 1 {
+		if ρ == 0 || p == 0 {
 			return 0
 		}
 
-		qq = 1.0 / ρ
-		pp = (1.0 - ρ) * qq
+		qq = 1.0 / (1 - ρ)
+		pp = ρ * qq
 		mu = fr * pp
 		sigma = sqrt(fr * pp * qq)
 		gamma = (qq + pp) / sigma
 
 		// temporary hack --- FIXME ---
-		if p+1.01*eps >= 1. {
-			return int64(NaN)
+		if p+1.01*eps64 >= 1 {
+			return posInfInt64
 		}
 
 		// approximate by Cornish-Fisher expansion
@@ -139,7 +217,7 @@ func PolyaQtl(ρ, r float64) func(p float64) int64 {
 		z = PolyaCDFAt(ρ, r, int64(y))
 
 		// fuzz to ensure left continuity
-		p *= 1 - 64*eps
+		p *= 1 - 64*eps64
 
 		// If the C-F value is not too large a simple search is OK
 		if y < 1e5 {
@@ -166,3 +244,111 @@ func PolyaQtlFor(ρ, r float64, p float64) int64 {
 	qtl := PolyaQtl(ρ, r)
 	return qtl(p)
 }
+
+// PolyaQtlTail returns the inverse of PolyaCDFTail (quantile) of the Pólya distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func PolyaQtlTail(ρ, r float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := PolyaCDFTail(ρ, r, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(ρ >= 0 && ρ < 1) || !(r > 0) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 0, posInfInt64, lowerTail, logP)
+	}
+}
+
+// PolyaFromMeanStd returns the Pólya distribution with mean μ and standard deviation σ > sqrt(μ).
+func PolyaFromMeanStd(μ, σ float64) PolyaDist {
+	if μ <= 0 || σ*σ <= μ {
+		return PolyaDist{NaN, NaN}
+	}
+	ρ := 1 - μ/(σ*σ)
+	return PolyaDist{ρ, μ * (1 - ρ) / ρ}
+}
+
+// PolyaFit returns the maximum-likelihood estimates of ρ, r of the Pólya distribution from the sample k, starting from the method of moments.
+func PolyaFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return PolyaDist{θ[0], θ[1]}.LnPMF })
+	// the mean is r ρ / (1 - ρ), the variance the mean over 1 - ρ
+	μ, σ2 := mleMeanVarInt(k)
+	ρ := 0.1
+	if σ2 > μ*1.1 {
+		ρ = 1 - μ/σ2
+	}
+	return mleFit(lnL, []float64{ρ, μ * (1 - ρ) / ρ}, []mleBound{mleUnit, mlePos})
+}
+
+// PolyaDist is the Pólya distribution (Negative binomial with real-valued R) with parameters ρ = Rho and R. It implements Discrete.
+type PolyaDist struct {
+	Rho, R float64
+}
+
+// PMF returns the value of PMF of the Pólya distribution at k.
+func (d PolyaDist) PMF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return PolyaPMFAt(d.Rho, d.R, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Pólya distribution at k.
+func (d PolyaDist) LnPMF(k int64) float64 {
+	if k < 0 {
+		return negInf
+	}
+	return PolyaLnPMF(d.Rho, d.R)(k)
+}
+
+// CDF returns the value of CDF of the Pólya distribution at k.
+func (d PolyaDist) CDF(k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return PolyaCDFAt(d.Rho, d.R, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Pólya distribution at k.
+func (d PolyaDist) Surv(k int64) float64 { return PolyaCDFTail(d.Rho, d.R, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Pólya distribution at k.
+func (d PolyaDist) LnCDF(k int64) float64 { return PolyaCDFTail(d.Rho, d.R, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Pólya distribution at k.
+func (d PolyaDist) LnSurv(k int64) float64 { return PolyaCDFTail(d.Rho, d.R, false, true)(k) }
+
+// Qtl returns the quantile of the Pólya distribution for probability p.
+func (d PolyaDist) Qtl(p float64) int64 { return PolyaQtlFor(d.Rho, d.R, p) }
+
+// QtlTail returns the quantile of the Pólya distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d PolyaDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return PolyaQtlTail(d.Rho, d.R, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Pólya distribution.
+func (d PolyaDist) Rand() int64 { return PolyaNext(d.Rho, d.R) }
+
+// RandR returns random number drawn from the Pólya distribution, using the random source src.
+func (d PolyaDist) RandR(src *rand.Rand) int64 { return PolyaNextR(src, d.Rho, d.R) }
+
+// Mean returns the mean of the Pólya distribution.
+func (d PolyaDist) Mean() float64 { return PolyaMean(d.Rho, d.R) }
+
+// Var returns the variance of the Pólya distribution.
+func (d PolyaDist) Var() float64 { return PolyaVar(d.Rho, d.R) }
+
+// Skew returns the skewness of the Pólya distribution.
+func (d PolyaDist) Skew() float64 { return PolyaSkew(d.Rho, d.R) }
+
+// ExKurt returns the excess kurtosis of the Pólya distribution.
+func (d PolyaDist) ExKurt() float64 { return PolyaExKurt(d.Rho, d.R) }
+
+// Entropy returns the Shannon entropy of the Pólya distribution.
+func (d PolyaDist) Entropy() float64 { return PolyaEntropy(d.Rho, d.R) }
+
+// CF returns the characteristic function of the Pólya distribution at t.
+func (d PolyaDist) CF(t float64) complex128 { return PolyaCF(d.Rho, d.R, t) }
+
+// CGF returns the cumulant generating function of the Pólya distribution at t.
+func (d PolyaDist) CGF(t float64) float64 { return PolyaCGF(d.Rho, d.R, t) }
+
+// Support returns the support of the Pólya distribution.
+func (d PolyaDist) Support() (a, b int64) { return 0, posInfInt64 }
@@ -0,0 +1,38 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Quasi-random (low-discrepancy) sequences, for quasi-Monte Carlo integration.
+// Sobol(d) and Halton(d) return the generators of the points of the sequences in the unit cube [0, 1)^d, one point per call;
+// SobolOwen and HaltonOwen randomize them by Owen's nested uniform scrambling, which keeps their low discrepancy,
+// makes every point uniformly distributed, and so the averages over the points unbiased, with an error that can be estimated
+// from independent replicates (different random sources).
+// QuasiQtl transforms the points by quantile functions, such as NormalQtl(0, 1), BetaQtl(α, β) or GammaQtl(k, θ),
+// into quasi-random draws from these distributions.
+// Ref.: Owen, A. B. (1995). Randomly permuted (t,m,s)-nets and (t,s)-sequences. In Monte Carlo and Quasi-Monte Carlo Methods
+// in Scientific Computing, Lecture Notes in Statistics 106, 299-317.
+
+// QuasiQtl returns the generator of the points of next, each coordinate transformed by its quantile function:
+// qtl[i] for the coordinate i, or qtl[0] for all the coordinates if only one is given.
+func QuasiQtl(next func() []float64, qtl ...func(p float64) float64) func() []float64 {
+	return func() []float64 {
+		u := next()
+		x := make([]float64, len(u))
+		for i, p := range u {
+			q := qtl[0]
+			if len(qtl) > 1 {
+				q = qtl[i]
+			}
+			x[i] = q(p)
+		}
+		return x
+	}
+}
+
+// qmcMix returns the hash of h, the finalizer of the SplitMix64 generator, which maps nearby values to independent-looking ones.
+func qmcMix(h uint64) uint64 {
+	h += 0x9e3779b97f4a7c15
+	h = (h ^ (h >> 30)) * 0xbf58476d1ce4e5b9
+	h = (h ^ (h >> 27)) * 0x94d049bb133111eb
+	return h ^ (h >> 31)
+}
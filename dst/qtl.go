@@ -0,0 +1,318 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Numerical inversion of CDFs.
+// A continuous CDF is bracketed from a starting point by steps doubling towards infinite ends of the support,
+// then inverted by Newton steps on the PDF, safeguarded by bisection (Brent's method if the PDF is not given).
+// A discrete CDF is searched from the Cornish–Fisher approximation of the quantile, by doubling steps and bisection.
+// The Qtl functions of the families built on them return NaN where these return an error; the discrete ones, which have no such value,
+// panic with ErrQtlProb or ErrQtlParam instead, as the samplers panic on bad parameters, and QtlDiscrete or a QtlErr function returns the error.
+// Ref.: Brent, R. P. (1973). Algorithms for Minimization without Derivatives. Prentice-Hall, Englewood Cliffs: ch. 4.
+// Ref.: Cornish, E. A., Fisher, R. A. (1938). Moments and cumulants in the specification of distributions. Revue de l'Institut International de Statistique 5(4), 307–320.
+
+import (
+	"errors"
+)
+
+// Errors of the inversion of a CDF.
+var (
+	ErrQtlParam    = errors.New("dst: parameters out of range")
+	ErrQtlProb     = errors.New("dst: probability not in [0, 1]")
+	ErrQtlBracket  = errors.New("dst: quantile not bracketed by the support")
+	ErrQtlConverge = errors.New("dst: quantile did not converge")
+)
+
+// qtlMaxIter bounds the steps of the bracketing and of the inversion; halving the float64 range to its resolution takes about 2100.
+const qtlMaxIter = 2200
+
+// InvertCDF returns the quantile for probability p of the continuous distribution with the CDF cdf and the PDF pdf, which may be nil,
+// on the support [a, b], possibly infinite: the smallest x with cdf(x) ≥ p, to the precision of float64.
+func InvertCDF(cdf, pdf func(x float64) float64, p, a, b float64) (float64, error) {
+	return invertCDF(cdf, pdf, p, a, b, NaN)
+}
+
+// QtlContinuous returns the quantile of the distribution d for probability p, by inversion of its CDF.
+func QtlContinuous(d Continuous, p float64) (float64, error) {
+	a, b := d.Support()
+	return InvertCDF(d.CDF, d.PDF, p, a, b)
+}
+
+// invertCDF is InvertCDF, starting from x0 if it lies inside the support.
+func invertCDF(cdf, pdf func(x float64) float64, p, a, b, x0 float64) (float64, error) {
+	switch {
+	case !(p >= 0 && p <= 1):
+		return NaN, ErrQtlProb
+	case !(a <= b):
+		return NaN, ErrQtlBracket
+	case p == 0:
+		return a, nil
+	case p == 1:
+		return b, nil
+	}
+	f := func(x float64) float64 { return cdf(x) - p }
+	lo, flo, hi, fhi, err := qtlBracket(f, a, b, x0)
+	if err != nil {
+		return NaN, err
+	}
+	if pdf == nil {
+		return brent(f, lo, flo, hi, fhi)
+	}
+	return newtonSafe(f, pdf, lo, flo, hi, fhi)
+}
+
+// qtlBracket returns lo < hi with f(lo) < 0 ≤ f(hi) within [a, b], for a nondecreasing f, searching from x0.
+func qtlBracket(f func(x float64) float64, a, b, x0 float64) (lo, flo, hi, fhi float64, err error) {
+	if !(x0 > a && x0 < b) {
+		switch {
+		case !isInf(a, 0) && !isInf(b, 0):
+			x0 = a + (b-a)/2
+		case !isInf(a, 0):
+			x0 = a + max(1, abs(a))
+		case !isInf(b, 0):
+			x0 = b - max(1, abs(b))
+		default:
+			x0 = 0
+		}
+	}
+	lo, hi = a, b
+	if isInf(a, 0) || isInf(b, 0) {
+		if fx := f(x0); fx < 0 {
+			lo, flo = x0, fx
+		} else {
+			hi, fhi = x0, fx
+		}
+	}
+	// double the step towards the infinite ends, until the sign changes
+	for step, i := max(1, abs(hi)), 0; isInf(lo, -1); step, i = step*2, i+1 {
+		x := hi - step
+		if isInf(x, -1) || i == qtlMaxIter {
+			return lo, flo, hi, fhi, ErrQtlBracket
+		}
+		if fx := f(x); fx < 0 {
+			lo, flo = x, fx
+		} else {
+			hi, fhi = x, fx
+		}
+	}
+	for step, i := max(1, abs(lo)), 0; isInf(hi, 1); step, i = step*2, i+1 {
+		x := lo + step
+		if isInf(x, 1) || i == qtlMaxIter {
+			return lo, flo, hi, fhi, ErrQtlBracket
+		}
+		if fx := f(x); fx < 0 {
+			lo, flo = x, fx
+		} else {
+			hi, fhi = x, fx
+		}
+	}
+	if lo == a {
+		flo = f(a)
+	}
+	if hi == b {
+		fhi = f(b)
+	}
+	if !(flo <= 0 && fhi >= 0) {
+		return lo, flo, hi, fhi, ErrQtlBracket
+	}
+	return lo, flo, hi, fhi, nil
+}
+
+// newtonSafe returns the root of the nondecreasing f with the derivative df, bracketed by lo and hi,
+// by Newton steps that fall back to bisection if they leave the bracket or do not halve it fast enough.
+func newtonSafe(f, df func(x float64) float64, lo, flo, hi, fhi float64) (float64, error) {
+	if flo == 0 {
+		return lo, nil
+	}
+	if fhi == 0 {
+		return hi, nil
+	}
+	x := lo + (hi-lo)/2
+	dxOld, dx := hi-lo, hi-lo
+	fx, d := f(x), df(x)
+	for i := 0; i < qtlMaxIter; i++ {
+		if fx == 0 {
+			return x, nil
+		}
+		if fx < 0 {
+			lo = x
+		} else {
+			hi = x
+		}
+		xn := x - fx/d
+		if xn == x && !isInf(d, 0) {
+			// the Newton step is below the resolution of x
+			return x, nil
+		}
+		if d > 0 && xn > lo && xn < hi && abs(2*fx) < abs(dxOld*d) {
+			dxOld, dx = dx, xn-x
+			x = xn
+		} else {
+			dxOld, dx = dx, (hi-lo)/2
+			x = lo + dx
+		}
+		if x == lo || x == hi || abs(dx) <= 2*eps64*abs(x) {
+			return x, nil
+		}
+		fx, d = f(x), df(x)
+		if isNaN(fx) {
+			return NaN, ErrQtlConverge
+		}
+	}
+	return x, ErrQtlConverge
+}
+
+// brent returns the root of f bracketed by a and b, by Brent's method: inverse quadratic interpolation, secant and bisection steps.
+func brent(f func(x float64) float64, a, fa, b, fb float64) (float64, error) {
+	if fa == 0 {
+		return a, nil
+	}
+	c, fc := a, fa
+	d := b - a
+	e := d
+	for i := 0; i < qtlMaxIter; i++ {
+		if fb == 0 {
+			return b, nil
+		}
+		if (fb > 0) == (fc > 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if abs(fc) < abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol := 2 * eps64 * abs(b)
+		m := (c - b) / 2
+		if abs(m) <= tol || b+m == b {
+			return b, nil
+		}
+		if abs(e) >= tol && abs(fa) > abs(fb) {
+			// interpolation
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < min(3*m*q-abs(tol*q), abs(e*q)) {
+				e, d = d, p/q
+			} else {
+				d, e = m, m
+			}
+		} else {
+			d, e = m, m
+		}
+		a, fa = b, fb
+		if abs(d) > tol {
+			b += d
+		} else if m > 0 {
+			b += tol
+		} else {
+			b -= tol
+		}
+		fb = f(b)
+		if isNaN(fb) {
+			return NaN, ErrQtlConverge
+		}
+	}
+	return b, ErrQtlConverge
+}
+
+// InvertCDFDiscrete returns the quantile for probability p of the discrete distribution with the CDF cdf on the support {a, ..., b},
+// the smallest k with cdf(k) ≥ p; the search starts from the Cornish–Fisher approximation of the mean μ, standard deviation σ and skewness γ,
+// from a if they are not finite. With an error, it returns a, the lower end of the support.
+func InvertCDFDiscrete(cdf func(k int64) float64, p float64, a, b int64, μ, σ, γ float64) (int64, error) {
+	k0 := a
+	if p > 0 && p < 1 && !isNaN(μ+σ) && !isInf(μ+σ, 0) {
+		z := qnorm(p, true, false)
+		if !isNaN(γ) && !isInf(γ, 0) {
+			z += γ * (z*z - 1) / 6
+		}
+		x := floor(μ + σ*z + 0.5)
+		switch {
+		case x >= float64(b):
+			k0 = b
+		case x > float64(a):
+			k0 = int64(x)
+		}
+	}
+	return invertCDFDiscrete(cdf, p, a, b, k0)
+}
+
+// QtlDiscrete returns the quantile of the distribution d for probability p, by inversion of its CDF.
+func QtlDiscrete(d Discrete, p float64) (int64, error) {
+	a, b := d.Support()
+	return InvertCDFDiscrete(d.CDF, p, a, b, d.Mean(), sqrt(d.Var()), d.Skew())
+}
+
+// invertCDFDiscrete is InvertCDFDiscrete, starting from k0 in {a, ..., b}.
+func invertCDFDiscrete(cdf func(k int64) float64, p float64, a, b, k0 int64) (int64, error) {
+	switch {
+	case !(p >= 0 && p <= 1):
+		return a, ErrQtlProb
+	case a > b:
+		return a, ErrQtlBracket
+	case p == 0:
+		return a, nil
+	case p == 1:
+		return b, nil
+	}
+	// fuzz to ensure left continuity, as in qtlSearch
+	p *= 1 - 64*eps64
+	// lo < hi with cdf(lo) < p ≤ cdf(hi), or hi == a
+	var lo, hi int64
+	if cdf(k0) >= p {
+		hi = k0
+		for step := int64(1); hi > a; step *= 2 {
+			if step >= hi-a {
+				lo = a
+			} else {
+				lo = hi - step
+			}
+			if cdf(lo) < p {
+				break
+			}
+			hi = lo
+		}
+		if hi == a {
+			return a, nil
+		}
+	} else {
+		lo = k0
+		for step := int64(1); ; step *= 2 {
+			if lo == b {
+				return a, ErrQtlBracket
+			}
+			if step >= b-lo {
+				hi = b
+			} else {
+				hi = lo + step
+			}
+			if cdf(hi) >= p {
+				break
+			}
+			lo = hi
+		}
+	}
+	for hi-lo > 1 {
+		k := lo + (hi-lo)/2
+		if cdf(k) >= p {
+			hi = k
+		} else {
+			lo = k
+		}
+	}
+	return hi, nil
+}
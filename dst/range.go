@@ -15,10 +15,176 @@ func LnRangePMF(n int64) func(i int64) float64 {
 	}
 }
 func RangeNext(n int64) int64 {
-	return rand.Int63n(n)
+	return RangeNextR(GlobalRand, n)
+}
+
+// RangeNextR returns random integer drawn uniformly from {0, ..., n-1}, using the random source src.
+func RangeNextR(src *rand.Rand, n int64) int64 {
+	return src.Int63n(n)
 }
 func Range(n int64) func() int64 {
+	return RangeR(GlobalRand, n)
+}
+
+// RangeR returns the random number generator with  uniform distribution on {0, ..., n-1}, using the random source src.
+func RangeR(src *rand.Rand, n int64) func() int64 {
 	return func() int64 {
-		return RangeNext(n)
+		return RangeNextR(src, n)
+	}
+}
+
+// RangeFill fills x with random integers drawn uniformly from {0, ..., n-1}.
+func RangeFill(n int64, x []int64) {
+	RangeFillR(GlobalRand, n, x)
+}
+
+// RangeFillR fills x with random integers drawn uniformly from {0, ..., n-1}, using the random source src.
+func RangeFillR(src *rand.Rand, n int64, x []int64) {
+	for i := range x {
+		x[i] = RangeNextR(src, n)
+	}
+}
+
+// RangeEntropy returns the Shannon entropy of the discrete Uniform distribution.
+func RangeEntropy(n int64) float64 {
+	return log(float64(n))
+}
+
+// RangeCF returns the characteristic function of the discrete Uniform distribution.
+func RangeCF(n int64, t float64) complex128 {
+	// e^{it(n-1)/2} sin(nt/2) / (n sin(t/2))
+	nn := float64(n)
+	s := sin(t / 2)
+	if s == 0 {
+		return 1
+	}
+	return cexp(complex(0, t*(nn-1)/2)) * complex(sin(nn*t/2)/(nn*s), 0)
+}
+
+// RangeCGF returns the cumulant generating function of the discrete Uniform distribution.
+func RangeCGF(n int64, t float64) float64 {
+	// ln((e^{nt} - 1) / (n (e^t - 1))), symmetric as K(t) = (n-1)t + K(-t)
+	nn := float64(n)
+	switch {
+	case t == 0 || n == 1:
+		return 0
+	case t > 0:
+		return (nn-1)*t + RangeCGF(n, -t)
+	}
+	return log(expm1(nn*t) / (nn * expm1(t)))
+}
+
+// RangeFit returns the maximum-likelihood estimate of n of the discrete Uniform distribution on {0, ..., n-1} from the sample k: its maximum + 1,
+// on the bound of the likelihood, so that its standard error is NaN.
+func RangeFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return RangeDist{int64(θ[0])}.LnPMF })
+	_, b := mleMinMaxInt(k)
+	return mleAtFixed(lnL, []float64{float64(b + 1)}, mleAllFixed(1))
+}
+
+// RangeDist is the discrete Uniform distribution on {0, ..., N-1}. It implements Discrete.
+type RangeDist struct {
+	N int64
+}
+
+// PMF returns the value of PMF of the discrete Uniform distribution at k.
+func (d RangeDist) PMF(k int64) float64 {
+	if k < 0 || k >= d.N {
+		return 0
+	}
+	return RangePMF(d.N)(k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the discrete Uniform distribution at k.
+func (d RangeDist) LnPMF(k int64) float64 {
+	if k < 0 || k >= d.N {
+		return negInf
 	}
+	return LnRangePMF(d.N)(k)
 }
+
+// CDF returns the value of CDF of the discrete Uniform distribution at k.
+func (d RangeDist) CDF(k int64) float64 {
+	switch {
+	case k < 0:
+		return 0
+	case k >= d.N:
+		return 1
+	}
+	return float64(k+1) / float64(d.N)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the discrete Uniform distribution at k.
+func (d RangeDist) Surv(k int64) float64 { return d.tail(k, false, false) }
+
+// LnCDF returns the natural logarithm of the CDF of the discrete Uniform distribution at k.
+func (d RangeDist) LnCDF(k int64) float64 { return d.tail(k, true, true) }
+
+// LnSurv returns the natural logarithm of the survival function of the discrete Uniform distribution at k.
+func (d RangeDist) LnSurv(k int64) float64 { return d.tail(k, false, true) }
+
+func (d RangeDist) tail(k int64, lowerTail, logP bool) float64 {
+	switch {
+	case k < 0:
+		return pTailBounds(false, lowerTail, logP)
+	case k >= d.N:
+		return pTailBounds(true, lowerTail, logP)
+	}
+	return pTail2(float64(k+1)/float64(d.N), float64(d.N-1-k)/float64(d.N), lowerTail, logP)
+}
+
+// Qtl returns the quantile of the discrete Uniform distribution for probability p; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func (d RangeDist) Qtl(p float64) int64 {
+	switch {
+	case d.N < 1:
+		panic(ErrQtlParam)
+	case !(p >= 0 && p <= 1):
+		panic(ErrQtlProb)
+	}
+	return imax(0, int64(ceil(p*float64(d.N)))-1)
+}
+
+// QtlTail returns the quantile of the discrete Uniform distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d RangeDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	if d.N < 1 {
+		panic(ErrQtlParam)
+	}
+	cdf := func(k int64) float64 { return d.tail(k, lowerTail, logP) }
+	return qtlSearchTail(cdf, p, 0, d.N-1, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the discrete Uniform distribution.
+func (d RangeDist) Rand() int64 { return RangeNext(d.N) }
+
+// RandR returns random number drawn from the discrete Uniform distribution, using the random source src.
+func (d RangeDist) RandR(src *rand.Rand) int64 { return RangeNextR(src, d.N) }
+
+// Mean returns the mean of the discrete Uniform distribution.
+func (d RangeDist) Mean() float64 { return float64(d.N-1) / 2 }
+
+// Var returns the variance of the discrete Uniform distribution.
+func (d RangeDist) Var() float64 {
+	n := float64(d.N)
+	return (n*n - 1) / 12
+}
+
+// Skew returns the skewness of the discrete Uniform distribution.
+func (d RangeDist) Skew() float64 { return 0 }
+
+// ExKurt returns the excess kurtosis of the discrete Uniform distribution.
+func (d RangeDist) ExKurt() float64 {
+	n := float64(d.N)
+	return -6 * (n*n + 1) / (5 * (n*n - 1))
+}
+
+// Entropy returns the Shannon entropy of the discrete Uniform distribution.
+func (d RangeDist) Entropy() float64 { return RangeEntropy(d.N) }
+
+// CF returns the characteristic function of the discrete Uniform distribution at t.
+func (d RangeDist) CF(t float64) complex128 { return RangeCF(d.N, t) }
+
+// CGF returns the cumulant generating function of the discrete Uniform distribution at t.
+func (d RangeDist) CGF(t float64) float64 { return RangeCGF(d.N, t) }
+
+// Support returns the support of the discrete Uniform distribution.
+func (d RangeDist) Support() (a, b int64) { return 0, d.N - 1 }
@@ -0,0 +1,257 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Reversed Weibull distribution (type III extreme value distribution).
+// The limiting distribution of the maximum of many samples from a distribution bounded above, such as the Uniform or Beta distributions. If X is Weibull(α, σ), then μ - X is RevWeibull(α, σ, μ).
+//
+// Parameters:
+// α > 0		shape
+// σ > 0		scale
+// μ ∈ R		location (maximum)
+//
+// Support:
+// x ∈ (-∞; μ]
+
+import (
+	"math/rand"
+)
+
+// RevWeibullPDF returns the PDF of the reversed Weibull distribution.
+func RevWeibullPDF(α, σ, μ float64) func(x float64) float64 {
+	pdf := WeibullPDF(α, σ)
+	return func(x float64) float64 {
+		return pdf(μ - x)
+	}
+}
+
+// RevWeibullLnPDF returns the natural logarithm of the PDF of the reversed Weibull distribution.
+func RevWeibullLnPDF(α, σ, μ float64) func(x float64) float64 {
+	pdf := WeibullLnPDF(α, σ)
+	return func(x float64) float64 {
+		return pdf(μ - x)
+	}
+}
+
+// RevWeibullPDFAt returns the value of PDF of reversed Weibull distribution at x.
+func RevWeibullPDFAt(α, σ, μ, x float64) float64 {
+	pdf := RevWeibullPDF(α, σ, μ)
+	return pdf(x)
+}
+
+// RevWeibullCDF returns the CDF of the reversed Weibull distribution.
+func RevWeibullCDF(α, σ, μ float64) func(x float64) float64 {
+	return RevWeibullCDFTail(α, σ, μ, true, false)
+}
+
+// RevWeibullCDFAt returns the value of CDF of the reversed Weibull distribution, at x.
+func RevWeibullCDFAt(α, σ, μ, x float64) float64 {
+	cdf := RevWeibullCDF(α, σ, μ)
+	return cdf(x)
+}
+
+// RevWeibullCDFTail returns the CDF of the reversed Weibull distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func RevWeibullCDFTail(α, σ, μ float64, lowerTail, logP bool) func(x float64) float64 {
+	// the lower tail is the upper tail of the Weibull distribution at μ - x
+	cdf := WeibullCDFTail(α, σ, !lowerTail, logP)
+	return func(x float64) float64 {
+		if x >= μ {
+			return pTailBounds(true, lowerTail, logP)
+		}
+		return cdf(μ - x)
+	}
+}
+
+// RevWeibullQtl returns the inverse of the CDF (quantile) of the reversed Weibull distribution.
+func RevWeibullQtl(α, σ, μ float64) func(p float64) float64 {
+	return RevWeibullQtlTail(α, σ, μ, true, false)
+}
+
+// RevWeibullQtlFor returns the inverse of the CDF (quantile) of the reversed Weibull distribution, for given probability.
+func RevWeibullQtlFor(α, σ, μ, p float64) float64 {
+	qtl := RevWeibullQtl(α, σ, μ)
+	return qtl(p)
+}
+
+// RevWeibullQtlTail returns the inverse of RevWeibullCDFTail (quantile) of the reversed Weibull distribution.
+func RevWeibullQtlTail(α, σ, μ float64, lowerTail, logP bool) func(p float64) float64 {
+	qtl := WeibullQtlTail(α, σ, !lowerTail, logP)
+	return func(p float64) float64 {
+		return μ - qtl(p)
+	}
+}
+
+// RevWeibullNext returns random number drawn from the reversed Weibull distribution.
+func RevWeibullNext(α, σ, μ float64) float64 { return RevWeibullNextR(GlobalRand, α, σ, μ) }
+
+// RevWeibullNextR returns random number drawn from the reversed Weibull distribution, using the random source src.
+func RevWeibullNextR(src *rand.Rand, α, σ, μ float64) float64 {
+	return μ - WeibullNextR(src, α, σ)
+}
+
+// RevWeibull returns the random number generator with  reversed Weibull distribution.
+func RevWeibull(α, σ, μ float64) func() float64 { return RevWeibullR(GlobalRand, α, σ, μ) }
+
+// RevWeibullR returns the random number generator with  reversed Weibull distribution, using the random source src.
+func RevWeibullR(src *rand.Rand, α, σ, μ float64) func() float64 {
+	return func() float64 { return RevWeibullNextR(src, α, σ, μ) }
+}
+
+// RevWeibullFill fills x with random numbers drawn from the reversed Weibull distribution.
+func RevWeibullFill(α, σ, μ float64, x []float64) {
+	RevWeibullFillR(GlobalRand, α, σ, μ, x)
+}
+
+// RevWeibullFillR fills x with random numbers drawn from the reversed Weibull distribution, using the random source src.
+func RevWeibullFillR(src *rand.Rand, α, σ, μ float64, x []float64) {
+	for i := range x {
+		x[i] = RevWeibullNextR(src, α, σ, μ)
+	}
+}
+
+// RevWeibullMean returns the mean of the reversed Weibull distribution.
+func RevWeibullMean(α, σ, μ float64) float64 {
+	return μ - WeibullMean(α, σ)
+}
+
+// RevWeibullMedian returns the median of the reversed Weibull distribution.
+func RevWeibullMedian(α, σ, μ float64) float64 {
+	return μ - WeibullMedian(α, σ)
+}
+
+// RevWeibullMode returns the mode of the reversed Weibull distribution.
+func RevWeibullMode(α, σ, μ float64) float64 {
+	return μ - WeibullMode(α, σ)
+}
+
+// RevWeibullVar returns the variance of the reversed Weibull distribution.
+func RevWeibullVar(α, σ, μ float64) float64 {
+	return WeibullVar(α, σ)
+}
+
+// RevWeibullStd returns the standard deviation of the reversed Weibull distribution.
+func RevWeibullStd(α, σ, μ float64) float64 {
+	return WeibullStd(α, σ)
+}
+
+// RevWeibullSkew returns the skewness of the reversed Weibull distribution.
+func RevWeibullSkew(α, σ, μ float64) float64 {
+	return -WeibullSkew(α, σ)
+}
+
+// RevWeibullExKurt returns the excess kurtosis of the reversed Weibull distribution.
+func RevWeibullExKurt(α, σ, μ float64) float64 {
+	return WeibullExKurt(α, σ)
+}
+
+// RevWeibullEntropy returns the differential entropy of the reversed Weibull distribution.
+func RevWeibullEntropy(α, σ, μ float64) float64 {
+	return WeibullEntropy(α, σ)
+}
+
+// RevWeibullCF returns the characteristic function of the reversed Weibull distribution, by numerical integration.
+func RevWeibullCF(α, σ, μ, t float64) complex128 {
+	return cexp(complex(0, μ*t)) * WeibullCF(α, σ, -t)
+}
+
+// RevWeibullCGF returns the cumulant generating function of the reversed Weibull distribution.
+func RevWeibullCGF(α, σ, μ, t float64) float64 {
+	return μ*t + WeibullCGF(α, σ, -t)
+}
+
+// RevWeibullMGF returns the moment-generating function of the reversed Weibull distribution.
+func RevWeibullMGF(α, σ, μ, t float64) float64 {
+	return exp(μ*t) * WeibullMGF(α, σ, -t)
+}
+
+// RevWeibullMatchQtls returns the reversed Weibull distribution whose quantiles for the probabilities p best match x.
+func RevWeibullMatchQtls(p, x []float64) RevWeibullDist {
+	lo, hi := mleMinMax(x)
+	μ := hi + (hi-lo)/10
+	// log(μ-x) = log σ + log(-log p) / α
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return log(-log(p)) }), matchMap(x, func(x float64) float64 { return log(μ - x) }))
+	e := matchQtls(func(θ []float64) Continuous { return RevWeibullDist{θ[0], θ[1], θ[2]} }, p, x, []float64{1 / b, exp(a), μ}, []mleBound{mlePos, mlePos, mleReal})
+	return RevWeibullDist{e[0], e[1], e[2]}
+}
+
+// RevWeibullFit returns the maximum-likelihood estimates of α, σ, μ of the Reversed Weibull distribution from the sample x, with μ above its maximum.
+func RevWeibullFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return RevWeibullLnPDF(θ[0], θ[1], θ[2]) })
+	_, b := mleMinMax(x)
+	μ := b + (b-empiricalQtl(empiricalSorted(x), 7, 0.5))/2
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = μ - v
+	}
+	α, σ := weibullFit(y)
+	return mleFit(lnL, []float64{α, σ, μ}, []mleBound{mlePos, mlePos, {b, posInf}})
+}
+
+// RevWeibullDist is the reversed Weibull distribution with shape α = Alpha, scale σ = Sigma and location μ = Mu. It implements Continuous.
+type RevWeibullDist struct {
+	Alpha, Sigma, Mu float64
+}
+
+// PDF returns the value of PDF of the reversed Weibull distribution at x.
+func (d RevWeibullDist) PDF(x float64) float64 { return RevWeibullPDFAt(d.Alpha, d.Sigma, d.Mu, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the reversed Weibull distribution at x.
+func (d RevWeibullDist) LnPDF(x float64) float64 { return RevWeibullLnPDF(d.Alpha, d.Sigma, d.Mu)(x) }
+
+// CDF returns the value of CDF of the reversed Weibull distribution at x.
+func (d RevWeibullDist) CDF(x float64) float64 { return RevWeibullCDFAt(d.Alpha, d.Sigma, d.Mu, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the reversed Weibull distribution at x.
+func (d RevWeibullDist) Surv(x float64) float64 {
+	return RevWeibullCDFTail(d.Alpha, d.Sigma, d.Mu, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the reversed Weibull distribution at x.
+func (d RevWeibullDist) LnCDF(x float64) float64 {
+	return RevWeibullCDFTail(d.Alpha, d.Sigma, d.Mu, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the reversed Weibull distribution at x.
+func (d RevWeibullDist) LnSurv(x float64) float64 {
+	return RevWeibullCDFTail(d.Alpha, d.Sigma, d.Mu, false, true)(x)
+}
+
+// Qtl returns the quantile of the reversed Weibull distribution for probability p.
+func (d RevWeibullDist) Qtl(p float64) float64 { return RevWeibullQtlFor(d.Alpha, d.Sigma, d.Mu, p) }
+
+// QtlTail returns the quantile of the reversed Weibull distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d RevWeibullDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return RevWeibullQtlTail(d.Alpha, d.Sigma, d.Mu, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the reversed Weibull distribution.
+func (d RevWeibullDist) Rand() float64 { return RevWeibullNext(d.Alpha, d.Sigma, d.Mu) }
+
+// RandR returns random number drawn from the reversed Weibull distribution, using the random source src.
+func (d RevWeibullDist) RandR(src *rand.Rand) float64 {
+	return RevWeibullNextR(src, d.Alpha, d.Sigma, d.Mu)
+}
+
+// Mean returns the mean of the reversed Weibull distribution.
+func (d RevWeibullDist) Mean() float64 { return RevWeibullMean(d.Alpha, d.Sigma, d.Mu) }
+
+// Var returns the variance of the reversed Weibull distribution.
+func (d RevWeibullDist) Var() float64 { return RevWeibullVar(d.Alpha, d.Sigma, d.Mu) }
+
+// Skew returns the skewness of the reversed Weibull distribution.
+func (d RevWeibullDist) Skew() float64 { return RevWeibullSkew(d.Alpha, d.Sigma, d.Mu) }
+
+// ExKurt returns the excess kurtosis of the reversed Weibull distribution.
+func (d RevWeibullDist) ExKurt() float64 { return RevWeibullExKurt(d.Alpha, d.Sigma, d.Mu) }
+
+// Entropy returns the differential entropy of the reversed Weibull distribution.
+func (d RevWeibullDist) Entropy() float64 { return RevWeibullEntropy(d.Alpha, d.Sigma, d.Mu) }
+
+// CF returns the characteristic function of the reversed Weibull distribution at t.
+func (d RevWeibullDist) CF(t float64) complex128 { return RevWeibullCF(d.Alpha, d.Sigma, d.Mu, t) }
+
+// CGF returns the cumulant generating function of the reversed Weibull distribution at t.
+func (d RevWeibullDist) CGF(t float64) float64 { return RevWeibullCGF(d.Alpha, d.Sigma, d.Mu, t) }
+
+// Support returns the support of the reversed Weibull distribution.
+func (d RevWeibullDist) Support() (a, b float64) { return negInf, d.Mu }
@@ -0,0 +1,335 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Skew-normal distribution.
+// Extends the Normal distribution by the shape α, which sets the asymmetry: right-skewed if α > 0, left-skewed if α < 0, and the Normal distribution if α = 0.
+// Azzalini, A. (1985). A class of distributions which includes the normal ones. Scandinavian Journal of Statistics 12, 171-178.
+//
+// Parameters:
+// ξ ∈ R		location
+// ω > 0		scale
+// α ∈ R		shape
+//
+// Support:
+// x ∈ R
+
+import (
+	"math/rand"
+)
+
+// owenTLn returns the logarithm of (1/π) ∫ exp(-h² / 2cos²θ) dθ over [θ0; θ1] ⊆ [0; π/2]. It is 2T(h, tan θ1) with Owen's T function if θ0 = 0,
+// and 2(T(h, ∞) - T(h, tan θ0)) if θ1 = π/2; computed this way, neither needs a difference.
+// Owen, D. B. (1956). Tables for computing bivariate normal probabilities. Annals of Mathematical Statistics 27, 1075-1090.
+func owenTLn(h, θ0, θ1 float64) float64 {
+	if θ1 <= θ0 {
+		return negInf
+	}
+	// the integrand relative to its largest value, at θ0, over φ = θ - θ0;
+	// tan θ - tan θ0 = sin φ / cos θ cos θ0 keeps its precision where the integrand peaks
+	t0, c0 := tan(θ0), cos(θ0)
+	f := func(φ float64) float64 {
+		θ := θ0 + φ
+		return exp(-h * h / 2 * sin(φ) / (cos(θ) * c0) * (tan(θ) + t0))
+	}
+	return log(integrateRel(f, 0, θ1-θ0)/π) - h*h*(1+t0*t0)/2
+}
+
+// skewNormalLnCDF returns the logarithm of the CDF of the standard Skew-normal distribution at z, Φ(z) - 2T(z, α).
+func skewNormalLnCDF(z, α float64) float64 {
+	if α > 0 {
+		// [z > 0] erf(z / √2) + 2(T(z, ∞) - T(z, α))
+		lnI := owenTLn(z, atan(α), π/2)
+		if z > 0 {
+			return log(erf(z/sqrt2) + exp(lnI))
+		}
+		return lnI
+	}
+	// Φ(z) + 2T(z, -α)
+	return logspace_add(pnorm(z, true, true), owenTLn(z, 0, atan(-α)))
+}
+
+// SkewNormalPDF returns the PDF of the Skew-normal distribution.
+func SkewNormalPDF(ξ, ω, α float64) func(x float64) float64 {
+	lnPDF := SkewNormalLnPDF(ξ, ω, α)
+	return func(x float64) float64 {
+		return exp(lnPDF(x))
+	}
+}
+
+// SkewNormalLnPDF returns the natural logarithm of the PDF of the Skew-normal distribution.
+func SkewNormalLnPDF(ξ, ω, α float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ω <= 0 {
+			return NaN
+		}
+		z := (x - ξ) / ω
+		return Ln2 - log(ω) - z*z/2 - M_LN_SQRT_2PI + pnorm(α*z, true, true)
+	}
+}
+
+// SkewNormalPDFAt returns the value of PDF of Skew-normal distribution at x.
+func SkewNormalPDFAt(ξ, ω, α, x float64) float64 {
+	pdf := SkewNormalPDF(ξ, ω, α)
+	return pdf(x)
+}
+
+// SkewNormalCDF returns the CDF of the Skew-normal distribution.
+func SkewNormalCDF(ξ, ω, α float64) func(x float64) float64 {
+	return SkewNormalCDFTail(ξ, ω, α, true, false)
+}
+
+// SkewNormalCDFAt returns the value of CDF of the Skew-normal distribution, at x.
+func SkewNormalCDFAt(ξ, ω, α, x float64) float64 {
+	cdf := SkewNormalCDF(ξ, ω, α)
+	return cdf(x)
+}
+
+// SkewNormalCDFTail returns the CDF of the Skew-normal distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func SkewNormalCDFTail(ξ, ω, α float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ω <= 0 {
+			return NaN
+		}
+		if isInf(x, 0) {
+			return pTailBounds(x > 0, lowerTail, logP)
+		}
+		// the upper tail is the lower one of the mirror image
+		z, a := (x-ξ)/ω, α
+		if !lowerTail {
+			z, a = -z, -a
+		}
+		p := skewNormalLnCDF(z, a)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// SkewNormalQtl returns the inverse of the CDF (quantile) of the Skew-normal distribution.
+func SkewNormalQtl(ξ, ω, α float64) func(p float64) float64 {
+	return SkewNormalQtlTail(ξ, ω, α, true, false)
+}
+
+// SkewNormalQtlFor returns the inverse of the CDF (quantile) of the Skew-normal distribution, for given probability.
+func SkewNormalQtlFor(ξ, ω, α, p float64) float64 {
+	qtl := SkewNormalQtl(ξ, ω, α)
+	return qtl(p)
+}
+
+// SkewNormalQtlTail returns the inverse of SkewNormalCDFTail (quantile) of the Skew-normal distribution.
+func SkewNormalQtlTail(ξ, ω, α float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := SkewNormalCDFTail(ξ, ω, α, lowerTail, logP)
+	return func(p float64) float64 {
+		if ω <= 0 {
+			return NaN
+		}
+		return qtlTail(cdf, p, negInf, posInf, lowerTail, logP)
+	}
+}
+
+// SkewNormalNext returns random number drawn from the Skew-normal distribution.
+func SkewNormalNext(ξ, ω, α float64) float64 { return SkewNormalNextR(GlobalRand, ξ, ω, α) }
+
+// SkewNormalNextR returns random number drawn from the Skew-normal distribution, using the random source src.
+func SkewNormalNextR(src *rand.Rand, ξ, ω, α float64) float64 {
+	// the normal U0 given U1 > 0 for the normal (U0, U1) with correlation δ
+	δ := α / sqrt(1+α*α)
+	z := δ*abs(normalZig(src)) + sqrt(1-δ*δ)*normalZig(src)
+	return ξ + ω*z
+}
+
+// SkewNormal returns the random number generator with  Skew-normal distribution.
+func SkewNormal(ξ, ω, α float64) func() float64 { return SkewNormalR(GlobalRand, ξ, ω, α) }
+
+// SkewNormalR returns the random number generator with  Skew-normal distribution, using the random source src.
+func SkewNormalR(src *rand.Rand, ξ, ω, α float64) func() float64 {
+	return func() float64 { return SkewNormalNextR(src, ξ, ω, α) }
+}
+
+// SkewNormalFill fills x with random numbers drawn from the Skew-normal distribution.
+func SkewNormalFill(ξ, ω, α float64, x []float64) {
+	SkewNormalFillR(GlobalRand, ξ, ω, α, x)
+}
+
+// SkewNormalFillR fills x with random numbers drawn from the Skew-normal distribution, using the random source src.
+func SkewNormalFillR(src *rand.Rand, ξ, ω, α float64, x []float64) {
+	for i := range x {
+		x[i] = SkewNormalNextR(src, ξ, ω, α)
+	}
+}
+
+// SkewNormalMean returns the mean of the Skew-normal distribution.
+func SkewNormalMean(ξ, ω, α float64) float64 {
+	δ := α / sqrt(1+α*α)
+	return ξ + ω*δ*sqrt(2/π)
+}
+
+// SkewNormalMedian returns the median of the Skew-normal distribution.
+func SkewNormalMedian(ξ, ω, α float64) float64 {
+	return SkewNormalQtlFor(ξ, ω, α, 0.5)
+}
+
+// SkewNormalMode returns the mode of the Skew-normal distribution.
+func SkewNormalMode(ξ, ω, α float64) float64 {
+	if α == 0 {
+		return ξ
+	}
+	// the standard mode solves z = |α| φ(|α|z) / Φ(|α|z), in [0; 1]
+	a := abs(α)
+	z := bisectFn(func(z float64) float64 {
+		return z - a*exp(-a*a*z*z/2-M_LN_SQRT_2PI-pnorm(a*z, true, true))
+	}, 0, 0, 1)
+	if α < 0 {
+		z = -z
+	}
+	return ξ + ω*z
+}
+
+// SkewNormalVar returns the variance of the Skew-normal distribution.
+func SkewNormalVar(ξ, ω, α float64) float64 {
+	δ := α / sqrt(1+α*α)
+	return ω * ω * (1 - 2*δ*δ/π)
+}
+
+// SkewNormalStd returns the standard deviation of the Skew-normal distribution.
+func SkewNormalStd(ξ, ω, α float64) float64 {
+	return sqrt(SkewNormalVar(ξ, ω, α))
+}
+
+// SkewNormalSkew returns the skewness of the Skew-normal distribution.
+func SkewNormalSkew(ξ, ω, α float64) float64 {
+	δ := α / sqrt(1+α*α)
+	m := δ * sqrt(2/π)
+	return (4 - π) / 2 * m * m * m / pow(1-m*m, 1.5)
+}
+
+// SkewNormalExKurt returns the excess kurtosis of the Skew-normal distribution.
+func SkewNormalExKurt(ξ, ω, α float64) float64 {
+	δ := α / sqrt(1+α*α)
+	m := δ * sqrt(2/π)
+	return 2 * (π - 3) * m * m * m * m / ((1 - m*m) * (1 - m*m))
+}
+
+// SkewNormalEntropy returns the differential entropy of the Skew-normal distribution, by numerical integration.
+func SkewNormalEntropy(ξ, ω, α float64) float64 {
+	return entropyLnPDF(SkewNormalLnPDF(ξ, ω, α), SkewNormalQtl(ξ, ω, α), negInf, posInf)
+}
+
+// SkewNormalCF returns the characteristic function of the Skew-normal distribution.
+func SkewNormalCF(ξ, ω, α, t float64) complex128 {
+	// e^{iξt - ω²t²/2} (1 + i erfi(δωt/√2)), with e^{-x²} erfi(x) = 2 D(x) / √π
+	δ := α / sqrt(1+α*α)
+	u := ω * t
+	im := 2 / sqrt(π) * dawson(δ*u/sqrt2) * exp(-u*u*(1-δ*δ)/2)
+	return cexp(complex(0, ξ*t)) * complex(exp(-u*u/2), im)
+}
+
+// SkewNormalCGF returns the cumulant generating function of the Skew-normal distribution.
+func SkewNormalCGF(ξ, ω, α, t float64) float64 {
+	δ := α / sqrt(1+α*α)
+	return Ln2 + ξ*t + ω*ω*t*t/2 + pnorm(δ*ω*t, true, true)
+}
+
+// SkewNormalMGF returns the moment-generating function of the Skew-normal distribution.
+func SkewNormalMGF(ξ, ω, α, t float64) float64 {
+	δ := α / sqrt(1+α*α)
+	return 2 * exp(ξ*t+ω*ω*t*t/2) * pnorm(δ*ω*t, true, false)
+}
+
+// SkewNormalMatchQtls returns the Skew-normal distribution whose quantiles for the probabilities p best match x.
+func SkewNormalMatchQtls(p, x []float64) SkewNormalDist {
+	μ, σ := qtlMeanStd(p, x)
+	e := matchQtls(func(θ []float64) Continuous { return SkewNormalDist{θ[0], θ[1], θ[2]} }, p, x, []float64{μ, σ, 0}, []mleBound{mleReal, mlePos, mleReal})
+	return SkewNormalDist{e[0], e[1], e[2]}
+}
+
+// SkewNormalFit returns the maximum-likelihood estimates of ξ, ω, α of the Skew-normal distribution from the sample x.
+func SkewNormalFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return SkewNormalLnPDF(θ[0], θ[1], θ[2]) })
+	ξ, ω, α := skewNormalStart(x)
+	return mleFit(lnL, []float64{ξ, ω, α}, []mleBound{mleReal, mlePos, mleReal})
+}
+
+// skewNormalStart returns the estimates of ξ, ω, α of the Skew-normal distribution by the method of moments, |δ| = |α| / √(1+α²) kept below 0.95.
+func skewNormalStart(x []float64) (ξ, ω, α float64) {
+	μ, m2, m3, _ := empiricalMoments(x)
+	γ := m3 / pow(m2, 1.5)
+	g := pow(abs(γ), 2.0/3)
+	δ := min(sqrt(π/2*g/(g+pow((4-π)/2, 2.0/3))), 0.95)
+	if γ < 0 {
+		δ = -δ
+	}
+	ω = sqrt(m2 / (1 - 2*δ*δ/π))
+	return μ - ω*δ*sqrt(2/π), ω, δ / sqrt(1-δ*δ)
+}
+
+// SkewNormalDist is the Skew-normal distribution with location ξ = Xi, scale ω = Omega and shape α = Alpha. It implements Continuous.
+type SkewNormalDist struct {
+	Xi, Omega, Alpha float64
+}
+
+// PDF returns the value of PDF of the Skew-normal distribution at x.
+func (d SkewNormalDist) PDF(x float64) float64 { return SkewNormalPDFAt(d.Xi, d.Omega, d.Alpha, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Skew-normal distribution at x.
+func (d SkewNormalDist) LnPDF(x float64) float64 { return SkewNormalLnPDF(d.Xi, d.Omega, d.Alpha)(x) }
+
+// CDF returns the value of CDF of the Skew-normal distribution at x.
+func (d SkewNormalDist) CDF(x float64) float64 { return SkewNormalCDFAt(d.Xi, d.Omega, d.Alpha, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Skew-normal distribution at x.
+func (d SkewNormalDist) Surv(x float64) float64 {
+	return SkewNormalCDFTail(d.Xi, d.Omega, d.Alpha, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Skew-normal distribution at x.
+func (d SkewNormalDist) LnCDF(x float64) float64 {
+	return SkewNormalCDFTail(d.Xi, d.Omega, d.Alpha, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Skew-normal distribution at x.
+func (d SkewNormalDist) LnSurv(x float64) float64 {
+	return SkewNormalCDFTail(d.Xi, d.Omega, d.Alpha, false, true)(x)
+}
+
+// Qtl returns the quantile of the Skew-normal distribution for probability p.
+func (d SkewNormalDist) Qtl(p float64) float64 { return SkewNormalQtlFor(d.Xi, d.Omega, d.Alpha, p) }
+
+// QtlTail returns the quantile of the Skew-normal distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d SkewNormalDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return SkewNormalQtlTail(d.Xi, d.Omega, d.Alpha, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Skew-normal distribution.
+func (d SkewNormalDist) Rand() float64 { return SkewNormalNext(d.Xi, d.Omega, d.Alpha) }
+
+// RandR returns random number drawn from the Skew-normal distribution, using the random source src.
+func (d SkewNormalDist) RandR(src *rand.Rand) float64 {
+	return SkewNormalNextR(src, d.Xi, d.Omega, d.Alpha)
+}
+
+// Mean returns the mean of the Skew-normal distribution.
+func (d SkewNormalDist) Mean() float64 { return SkewNormalMean(d.Xi, d.Omega, d.Alpha) }
+
+// Var returns the variance of the Skew-normal distribution.
+func (d SkewNormalDist) Var() float64 { return SkewNormalVar(d.Xi, d.Omega, d.Alpha) }
+
+// Skew returns the skewness of the Skew-normal distribution.
+func (d SkewNormalDist) Skew() float64 { return SkewNormalSkew(d.Xi, d.Omega, d.Alpha) }
+
+// ExKurt returns the excess kurtosis of the Skew-normal distribution.
+func (d SkewNormalDist) ExKurt() float64 { return SkewNormalExKurt(d.Xi, d.Omega, d.Alpha) }
+
+// Entropy returns the differential entropy of the Skew-normal distribution.
+func (d SkewNormalDist) Entropy() float64 { return SkewNormalEntropy(d.Xi, d.Omega, d.Alpha) }
+
+// CF returns the characteristic function of the Skew-normal distribution at t.
+func (d SkewNormalDist) CF(t float64) complex128 { return SkewNormalCF(d.Xi, d.Omega, d.Alpha, t) }
+
+// CGF returns the cumulant generating function of the Skew-normal distribution at t.
+func (d SkewNormalDist) CGF(t float64) float64 { return SkewNormalCGF(d.Xi, d.Omega, d.Alpha, t) }
+
+// Support returns the support of the Skew-normal distribution.
+func (d SkewNormalDist) Support() (a, b float64) { return negInf, posInf }
@@ -0,0 +1,380 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Skew-t distribution.
+// The Skew-normal distribution divided by the square root of an independent χ²(ν)/ν, as the Student's t distribution is the Normal one; it joins heavy tails to asymmetry.
+// Azzalini, A., Capitanio, A. (2003). Distributions generated by perturbation of symmetry with emphasis on a multivariate skew t-distribution. Journal of the Royal Statistical Society B 65, 367-389.
+//
+// Parameters:
+// ξ ∈ R		location
+// ω > 0		scale
+// α ∈ R		shape
+// ν > 0		degrees of freedom
+//
+// Support:
+// x ∈ R
+
+import (
+	"math/rand"
+)
+
+// skewTLnPDF0 returns the logarithm of the PDF of the standard Skew-t distribution at z.
+func skewTLnPDF0(z, α, ν float64) float64 {
+	return Ln2 + StudentsTLnPDF(ν)(z) + StudentsTCDFTail(ν+1, true, true)(α*z*sqrt((ν+1)/(ν+z*z)))
+}
+
+// skewTLnCDF returns the logarithm of the CDF of the standard Skew-t distribution at z, or NaN if its integral has not converged.
+func skewTLnCDF(z, α, ν float64) float64 {
+	switch {
+	case z > 0:
+		// the complement of the upper tail, which is the lower one of the mirror image
+		return log1Exp(skewTLnCDF(-z, -α, ν))
+	case z == 0:
+		// P[Z ≤ 0] does not depend on ν
+		return log(atan2(1, α) / π)
+	case z <= -1:
+		return skewTLnTail(z, α, ν)
+	}
+	// the tail up to -1, and [-1; z] with the PDF relative to the larger of its ends, against underflow
+	c := max(skewTLnPDF0(-1, α, ν), skewTLnPDF0(z, α, ν))
+	s := integrateRel(func(s float64) float64 { return exp(skewTLnPDF0(s, α, ν) - c) }, -1, z)
+	return logspace_add(skewTLnTail(-1, α, ν), log(s)+c)
+}
+
+// skewTLnTail returns the logarithm of the CDF of the standard Skew-t distribution at z ≤ -1.
+// Over s = z / u^k, k = max(1, 2/ν), the PDF on (-∞; z] is mapped on u ∈ (0; 1], where its tail of order |s|^-(ν+1)
+// becomes u^(kν-1), which vanishes at 0. The integrand is taken relative to its value at u = 1, the ratio of the Student's t densities
+// in closed form, so that it keeps the same shape and its precision however far z lies.
+func skewTLnTail(z, α, ν float64) float64 {
+	k := max(1, 2/ν)
+	lnT := func(s float64) float64 { return StudentsTCDFTail(ν+1, true, true)(α * s * sqrt((ν+1)/(ν+s*s))) }
+	c := lnT(z)
+	s := integrateRel(func(u float64) float64 {
+		x := z / pow(u, k)
+		if isInf(x, -1) {
+			return 0
+		}
+		return exp(-(ν+1)/2*log1p((x-z)*(x+z)/(ν+z*z)) + lnT(x) - c - (k+1)*log(u))
+	}, 0, 1)
+	return log(s) + skewTLnPDF0(z, α, ν) + log(-z*k)
+}
+
+// skewTMoments returns δ = α / √(1 + α²) and the mean μ of the standard Skew-t distribution.
+func skewTMoments(α, ν float64) (δ, μ float64) {
+	δ = α / sqrt(1+α*α)
+	return δ, δ * sqrt(ν/π) * exp(LnΓ((ν-1)/2)-LnΓ(ν/2))
+}
+
+// SkewTPDF returns the PDF of the Skew-t distribution.
+func SkewTPDF(ξ, ω, α, ν float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ω <= 0 || ν <= 0 {
+			return NaN
+		}
+		return exp(skewTLnPDF0((x-ξ)/ω, α, ν)) / ω
+	}
+}
+
+// SkewTLnPDF returns the natural logarithm of the PDF of the Skew-t distribution.
+func SkewTLnPDF(ξ, ω, α, ν float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ω <= 0 || ν <= 0 {
+			return NaN
+		}
+		return skewTLnPDF0((x-ξ)/ω, α, ν) - log(ω)
+	}
+}
+
+// SkewTPDFAt returns the value of PDF of Skew-t distribution at x.
+func SkewTPDFAt(ξ, ω, α, ν, x float64) float64 {
+	pdf := SkewTPDF(ξ, ω, α, ν)
+	return pdf(x)
+}
+
+// SkewTCDF returns the CDF of the Skew-t distribution.
+func SkewTCDF(ξ, ω, α, ν float64) func(x float64) float64 {
+	return SkewTCDFTail(ξ, ω, α, ν, true, false)
+}
+
+// SkewTCDFAt returns the value of CDF of the Skew-t distribution, at x.
+func SkewTCDFAt(ξ, ω, α, ν, x float64) float64 {
+	cdf := SkewTCDF(ξ, ω, α, ν)
+	return cdf(x)
+}
+
+// SkewTCDFTail returns the CDF of the Skew-t distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func SkewTCDFTail(ξ, ω, α, ν float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ω <= 0 || ν <= 0 {
+			return NaN
+		}
+		if isInf(x, 0) {
+			return pTailBounds(x > 0, lowerTail, logP)
+		}
+		// the upper tail is the lower one of the mirror image
+		z, a := (x-ξ)/ω, α
+		if !lowerTail {
+			z, a = -z, -a
+		}
+		p := skewTLnCDF(z, a, ν)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// SkewTQtl returns the inverse of the CDF (quantile) of the Skew-t distribution.
+func SkewTQtl(ξ, ω, α, ν float64) func(p float64) float64 {
+	return SkewTQtlTail(ξ, ω, α, ν, true, false)
+}
+
+// SkewTQtlFor returns the inverse of the CDF (quantile) of the Skew-t distribution, for given probability.
+func SkewTQtlFor(ξ, ω, α, ν, p float64) float64 {
+	qtl := SkewTQtl(ξ, ω, α, ν)
+	return qtl(p)
+}
+
+// SkewTQtlTail returns the inverse of SkewTCDFTail (quantile) of the Skew-t distribution.
+// It solves for the smaller of the two tails, by Newton steps on the logarithm of the CDF, on the mirror image for the upper tail.
+func SkewTQtlTail(ξ, ω, α, ν float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if ω <= 0 || ν <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		if pEdge(p, false, lowerTail, logP) {
+			return negInf
+		}
+		if pEdge(p, true, lowerTail, logP) {
+			return posInf
+		}
+		lnP, a, sign := pLnLower(p, lowerTail, logP), α, 1.0
+		if lnU := pLnUpper(p, lowerTail, logP); lnU < lnP {
+			lnP, a, sign = lnU, -α, -1
+		}
+		// newtonSafe asks for f and df at the same z in turn
+		zc, lc := NaN, NaN
+		lnCDF := func(z float64) float64 {
+			if z != zc {
+				zc, lc = z, skewTLnCDF(z, a, ν)
+			}
+			return lc
+		}
+		f := func(z float64) float64 { return lnCDF(z) - lnP }
+		df := func(z float64) float64 { return exp(skewTLnPDF0(z, a, ν) - lnCDF(z)) }
+		lo, flo, hi, fhi, err := qtlBracket(f, negInf, posInf, StudentsTQtlTail(ν, true, true)(lnP))
+		if err != nil {
+			return NaN
+		}
+		z, err := newtonSafe(f, df, lo, flo, hi, fhi)
+		if err != nil {
+			return NaN
+		}
+		return ξ + ω*sign*z
+	}
+}
+
+// SkewTNext returns random number drawn from the Skew-t distribution.
+func SkewTNext(ξ, ω, α, ν float64) float64 { return SkewTNextR(GlobalRand, ξ, ω, α, ν) }
+
+// SkewTNextR returns random number drawn from the Skew-t distribution, using the random source src.
+func SkewTNextR(src *rand.Rand, ξ, ω, α, ν float64) float64 {
+	return ξ + ω*SkewNormalNextR(src, 0, 1, α)/sqrt(GammaNextR(src, ν/2, 2/ν))
+}
+
+// SkewT returns the random number generator with  Skew-t distribution.
+func SkewT(ξ, ω, α, ν float64) func() float64 { return SkewTR(GlobalRand, ξ, ω, α, ν) }
+
+// SkewTR returns the random number generator with  Skew-t distribution, using the random source src.
+func SkewTR(src *rand.Rand, ξ, ω, α, ν float64) func() float64 {
+	gs := newGammaSampler(ν/2, 2/ν)
+	return func() float64 { return ξ + ω*SkewNormalNextR(src, 0, 1, α)/sqrt(gs.next(src)) }
+}
+
+// SkewTFill fills x with random numbers drawn from the Skew-t distribution.
+func SkewTFill(ξ, ω, α, ν float64, x []float64) {
+	SkewTFillR(GlobalRand, ξ, ω, α, ν, x)
+}
+
+// SkewTFillR fills x with random numbers drawn from the Skew-t distribution, using the random source src;
+// the Gamma sampler of the scale mixture is set up once for all the draws.
+func SkewTFillR(src *rand.Rand, ξ, ω, α, ν float64, x []float64) {
+	next := SkewTR(src, ξ, ω, α, ν)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// SkewTMean returns the mean of the Skew-t distribution.
+func SkewTMean(ξ, ω, α, ν float64) float64 {
+	if ν <= 1 {
+		return NaN
+	}
+	_, μ := skewTMoments(α, ν)
+	return ξ + ω*μ
+}
+
+// SkewTMedian returns the median of the Skew-t distribution.
+func SkewTMedian(ξ, ω, α, ν float64) float64 {
+	return SkewTQtlFor(ξ, ω, α, ν, 0.5)
+}
+
+// SkewTMode returns the mode of the Skew-t distribution.
+func SkewTMode(ξ, ω, α, ν float64) float64 {
+	if α == 0 {
+		return ξ
+	}
+	// the root of the derivative of LnPDF, on the side of the origin given by α
+	lnPDF := SkewTLnPDF(0, 1, abs(α), ν)
+	d := func(z float64) float64 {
+		h := 1e-5 * (1 + z)
+		return (lnPDF(z-h) - lnPDF(z+h)) / (2 * h)
+	}
+	hi := 1.0
+	for d(hi) < 0 {
+		hi *= 2
+	}
+	z := bisectFn(d, 0, 0, hi)
+	if α < 0 {
+		z = -z
+	}
+	return ξ + ω*z
+}
+
+// SkewTVar returns the variance of the Skew-t distribution.
+func SkewTVar(ξ, ω, α, ν float64) float64 {
+	if ν <= 2 {
+		return NaN
+	}
+	_, μ := skewTMoments(α, ν)
+	return ω * ω * (ν/(ν-2) - μ*μ)
+}
+
+// SkewTStd returns the standard deviation of the Skew-t distribution.
+func SkewTStd(ξ, ω, α, ν float64) float64 {
+	return sqrt(SkewTVar(ξ, ω, α, ν))
+}
+
+// SkewTSkew returns the skewness of the Skew-t distribution.
+func SkewTSkew(ξ, ω, α, ν float64) float64 {
+	if ν <= 3 {
+		return NaN
+	}
+	δ, μ := skewTMoments(α, ν)
+	v := ν/(ν-2) - μ*μ
+	return μ * (ν*(3-δ*δ)/(ν-3) - 3*ν/(ν-2) + 2*μ*μ) / pow(v, 1.5)
+}
+
+// SkewTExKurt returns the excess kurtosis of the Skew-t distribution.
+func SkewTExKurt(ξ, ω, α, ν float64) float64 {
+	if ν <= 4 {
+		return NaN
+	}
+	δ, μ := skewTMoments(α, ν)
+	v := ν/(ν-2) - μ*μ
+	return (3*ν*ν/((ν-2)*(ν-4))-4*μ*μ*ν*(3-δ*δ)/(ν-3)+6*μ*μ*ν/(ν-2)-3*μ*μ*μ*μ)/(v*v) - 3
+}
+
+// SkewTEntropy returns the differential entropy of the Skew-t distribution, by numerical integration.
+func SkewTEntropy(ξ, ω, α, ν float64) float64 {
+	return entropyLnPDF(SkewTLnPDF(ξ, ω, α, ν), SkewTQtl(ξ, ω, α, ν), negInf, posInf)
+}
+
+// SkewTCF returns the characteristic function of the Skew-t distribution, by numerical integration.
+func SkewTCF(ξ, ω, α, ν, t float64) complex128 {
+	return cfLnPDF(SkewTLnPDF(ξ, ω, α, ν), SkewTQtl(ξ, ω, α, ν), negInf, posInf, t)
+}
+
+// SkewTCGF returns the cumulant generating function of the Skew-t distribution, +Inf for t ≠ 0.
+func SkewTCGF(ξ, ω, α, ν, t float64) float64 {
+	if t == 0 {
+		return 0
+	}
+	return posInf
+}
+
+// SkewTMGF does not exist.
+
+// SkewTMatchQtls returns the Skew-t distribution whose quantiles for the probabilities p best match x.
+func SkewTMatchQtls(p, x []float64) SkewTDist {
+	μ, σ := qtlMeanStd(p, x)
+	e := matchQtls(func(θ []float64) Continuous { return SkewTDist{θ[0], θ[1], θ[2], θ[3]} }, p, x, []float64{μ, σ, 0, 5}, []mleBound{mleReal, mlePos, mleReal, mlePos})
+	return SkewTDist{e[0], e[1], e[2], e[3]}
+}
+
+// SkewTFit returns the maximum-likelihood estimates of ξ, ω, α, ν of the Skew-t distribution from the sample x, starting from the Skew-normal distribution with ν = 10.
+func SkewTFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return SkewTLnPDF(θ[0], θ[1], θ[2], θ[3]) })
+	ξ, ω, α := skewNormalStart(x)
+	return mleFit(lnL, []float64{ξ, ω, α, 10}, []mleBound{mleReal, mlePos, mleReal, mlePos})
+}
+
+// SkewTDist is the Skew-t distribution with location ξ = Xi, scale ω = Omega, shape α = Alpha and ν = Nu degrees of freedom. It implements Continuous.
+type SkewTDist struct {
+	Xi, Omega, Alpha, Nu float64
+}
+
+// PDF returns the value of PDF of the Skew-t distribution at x.
+func (d SkewTDist) PDF(x float64) float64 { return SkewTPDFAt(d.Xi, d.Omega, d.Alpha, d.Nu, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Skew-t distribution at x.
+func (d SkewTDist) LnPDF(x float64) float64 { return SkewTLnPDF(d.Xi, d.Omega, d.Alpha, d.Nu)(x) }
+
+// CDF returns the value of CDF of the Skew-t distribution at x.
+func (d SkewTDist) CDF(x float64) float64 { return SkewTCDFAt(d.Xi, d.Omega, d.Alpha, d.Nu, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Skew-t distribution at x.
+func (d SkewTDist) Surv(x float64) float64 {
+	return SkewTCDFTail(d.Xi, d.Omega, d.Alpha, d.Nu, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Skew-t distribution at x.
+func (d SkewTDist) LnCDF(x float64) float64 {
+	return SkewTCDFTail(d.Xi, d.Omega, d.Alpha, d.Nu, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Skew-t distribution at x.
+func (d SkewTDist) LnSurv(x float64) float64 {
+	return SkewTCDFTail(d.Xi, d.Omega, d.Alpha, d.Nu, false, true)(x)
+}
+
+// Qtl returns the quantile of the Skew-t distribution for probability p.
+func (d SkewTDist) Qtl(p float64) float64 { return SkewTQtlFor(d.Xi, d.Omega, d.Alpha, d.Nu, p) }
+
+// QtlTail returns the quantile of the Skew-t distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d SkewTDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return SkewTQtlTail(d.Xi, d.Omega, d.Alpha, d.Nu, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Skew-t distribution.
+func (d SkewTDist) Rand() float64 { return SkewTNext(d.Xi, d.Omega, d.Alpha, d.Nu) }
+
+// RandR returns random number drawn from the Skew-t distribution, using the random source src.
+func (d SkewTDist) RandR(src *rand.Rand) float64 {
+	return SkewTNextR(src, d.Xi, d.Omega, d.Alpha, d.Nu)
+}
+
+// Mean returns the mean of the Skew-t distribution.
+func (d SkewTDist) Mean() float64 { return SkewTMean(d.Xi, d.Omega, d.Alpha, d.Nu) }
+
+// Var returns the variance of the Skew-t distribution.
+func (d SkewTDist) Var() float64 { return SkewTVar(d.Xi, d.Omega, d.Alpha, d.Nu) }
+
+// Skew returns the skewness of the Skew-t distribution.
+func (d SkewTDist) Skew() float64 { return SkewTSkew(d.Xi, d.Omega, d.Alpha, d.Nu) }
+
+// ExKurt returns the excess kurtosis of the Skew-t distribution.
+func (d SkewTDist) ExKurt() float64 { return SkewTExKurt(d.Xi, d.Omega, d.Alpha, d.Nu) }
+
+// Entropy returns the differential entropy of the Skew-t distribution.
+func (d SkewTDist) Entropy() float64 { return SkewTEntropy(d.Xi, d.Omega, d.Alpha, d.Nu) }
+
+// CF returns the characteristic function of the Skew-t distribution at t.
+func (d SkewTDist) CF(t float64) complex128 { return SkewTCF(d.Xi, d.Omega, d.Alpha, d.Nu, t) }
+
+// CGF returns the cumulant generating function of the Skew-t distribution at t.
+func (d SkewTDist) CGF(t float64) float64 { return SkewTCGF(d.Xi, d.Omega, d.Alpha, d.Nu, t) }
+
+// Support returns the support of the Skew-t distribution.
+func (d SkewTDist) Support() (a, b float64) { return negInf, posInf }
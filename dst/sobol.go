@@ -0,0 +1,228 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Sobol sequence.
+// The base-2 digital sequence of Sobol, generated in Gray-code order with 32 bits of precision (up to 2^32 points).
+// The coordinate j > 1 is built on the j-1st primitive polynomial over GF(2), in the order of their degrees;
+// its initial direction numbers are those of Joe and Kuo for the dimensions up to 21, and odd numbers drawn once,
+// from a generator of fixed seed, for the higher dimensions, as Jäckel's: the sequence stays a (t, s)-sequence,
+// but some of its two-dimensional projections beyond the dimension 21 are less uniform.
+// Ref.: Sobol, I. M. (1967). On the distribution of points in a cube and the approximate evaluation of integrals.
+// USSR Computational Mathematics and Mathematical Physics 7, 86-112.
+// Ref.: Jäckel, P. (2002). Monte Carlo Methods in Finance. Wiley, ch. 8.
+// Ref.: Joe, S., Kuo, F. Y. (2008). Constructing Sobol sequences with better two-dimensional projections. SIAM Journal on Scientific Computing 30, 2635-2654.
+//
+// Parameters:
+// d ∈ {1, 2, ...}	dimension
+//
+// Support:
+// x ∈ [0, 1)^d
+
+import (
+	"math/rand"
+)
+
+const sobolBits = 32
+
+// sobolJoeKuo holds the degree s, the coefficients a and the initial direction numbers m of the dimensions 2 to 21 of Joe and Kuo (new-joe-kuo-6.21201).
+var sobolJoeKuo = []struct {
+	s, a uint
+	m    []uint32
+}{
+	{1, 0, []uint32{1}},
+	{2, 1, []uint32{1, 3}},
+	{3, 1, []uint32{1, 3, 1}},
+	{3, 2, []uint32{1, 1, 1}},
+	{4, 1, []uint32{1, 1, 3, 3}},
+	{4, 4, []uint32{1, 3, 5, 13}},
+	{5, 2, []uint32{1, 1, 5, 5, 17}},
+	{5, 4, []uint32{1, 1, 5, 5, 5}},
+	{5, 7, []uint32{1, 1, 7, 11, 19}},
+	{5, 11, []uint32{1, 1, 5, 1, 1}},
+	{5, 13, []uint32{1, 1, 1, 3, 11}},
+	{5, 14, []uint32{1, 3, 5, 5, 31}},
+	{6, 1, []uint32{1, 3, 3, 9, 7, 49}},
+	{6, 13, []uint32{1, 1, 1, 15, 21, 21}},
+	{6, 16, []uint32{1, 3, 1, 13, 27, 49}},
+	{6, 19, []uint32{1, 1, 1, 15, 7, 5}},
+	{6, 22, []uint32{1, 3, 1, 15, 13, 25}},
+	{6, 25, []uint32{1, 1, 5, 5, 19, 61}},
+	{7, 1, []uint32{1, 3, 7, 11, 23, 15, 103}},
+	{7, 4, []uint32{1, 3, 7, 13, 13, 15, 69}},
+}
+
+// sobolDirections returns the direction numbers v[j][k] = m_k 2^(32-k) of the coordinates j of the Sobol sequence in d dimensions.
+func sobolDirections(d int) [][sobolBits]uint32 {
+	v := make([][sobolBits]uint32, d)
+	for k := range v[0] {
+		v[0][k] = 1 << uint(sobolBits-1-k)
+	}
+	if d == 1 {
+		return v
+	}
+	polys := gf2Primitive(d - 1)
+	src := qmcMix(0x5eed)
+	for j := 1; j < d; j++ {
+		s, a := polys[j-1].s, polys[j-1].a
+		var m [sobolBits]uint32
+		if j-1 < len(sobolJoeKuo) {
+			copy(m[:], sobolJoeKuo[j-1].m)
+		} else {
+			// odd m_k < 2^k
+			for k := uint(0); k < s && k < sobolBits; k++ {
+				src = qmcMix(src)
+				m[k] = uint32(src>>uint(63-k)) | 1
+			}
+		}
+		// m_k = 2 a_1 m_(k-1) ^ 4 a_2 m_(k-2) ^ ... ^ 2^(s-1) a_(s-1) m_(k-s+1) ^ 2^s m_(k-s) ^ m_(k-s)
+		for k := s; k < sobolBits; k++ {
+			x := m[k-s] ^ m[k-s]<<s
+			for i := uint(1); i < s; i++ {
+				if (a>>(s-1-i))&1 == 1 {
+					x ^= m[k-i] << i
+				}
+			}
+			m[k] = x
+		}
+		for k := range m {
+			v[j][k] = m[k] << uint(sobolBits-1-k)
+		}
+	}
+	return v
+}
+
+// gf2Poly is the polynomial x^s + a_1 x^(s-1) + ... + a_(s-1) x + 1 over GF(2), with the bits of a the coefficients a_1, ..., a_(s-1).
+type gf2Poly struct {
+	s, a uint
+}
+
+// gf2Primitive returns the first n primitive polynomials over GF(2), by degree and then by a.
+func gf2Primitive(n int) []gf2Poly {
+	var p []gf2Poly
+	for s := uint(1); len(p) < n && s < 63; s++ {
+		for a := uint(0); a < 1<<(s-1) && len(p) < n; a++ {
+			if gf2IsPrimitive(1<<s|uint64(a)<<1|1, s) {
+				p = append(p, gf2Poly{s, a})
+			}
+		}
+	}
+	return p
+}
+
+// gf2IsPrimitive reports whether the polynomial f of degree s over GF(2), its coefficients the bits of f, is primitive:
+// x has the order 2^s - 1 modulo f.
+func gf2IsPrimitive(f uint64, s uint) bool {
+	order := uint64(1)<<s - 1
+	if gf2PowX(order, f, s) != 1 {
+		return false
+	}
+	// x^(order/q) != 1 for the prime factors q of the order
+	r := order
+	for q := uint64(2); q*q <= r; q++ {
+		if r%q == 0 {
+			if gf2PowX(order/q, f, s) == 1 {
+				return false
+			}
+			for r%q == 0 {
+				r /= q
+			}
+		}
+	}
+	return r == 1 || gf2PowX(order/r, f, s) != 1
+}
+
+// gf2PowX returns x^e modulo the polynomial f of degree s over GF(2).
+func gf2PowX(e, f uint64, s uint) uint64 {
+	mul := func(a, b uint64) uint64 {
+		var c uint64
+		for ; b != 0; b >>= 1 {
+			if b&1 == 1 {
+				c ^= a
+			}
+			a <<= 1
+			if a>>s&1 == 1 {
+				a ^= f
+			}
+		}
+		return c
+	}
+	r, x := uint64(1), uint64(2)
+	if s == 1 {
+		x = 2 ^ f
+	}
+	for ; e != 0; e >>= 1 {
+		if e&1 == 1 {
+			r = mul(r, x)
+		}
+		x = mul(x, x)
+	}
+	return r
+}
+
+// Sobol returns the generator of the Sobol sequence in d dimensions, from its second point: the first, the origin, has
+// infinite quantiles for distributions unbounded below.
+func Sobol(d int) func() []float64 {
+	v := sobolDirections(d)
+	x := make([]uint32, d)
+	n := uint32(0)
+	return func() []float64 {
+		// Gray code: the next point differs in the direction of the lowest zero bit of n
+		c := 0
+		for (n>>uint(c))&1 == 1 {
+			c++
+		}
+		n++
+		u := make([]float64, d)
+		for j := range x {
+			x[j] ^= v[j][c]
+			u[j] = float64(x[j]) / (1 << sobolBits)
+		}
+		return u
+	}
+}
+
+// SobolOwen returns the generator of the Sobol sequence in d dimensions, scrambled by Owen's nested uniform scrambling
+// drawn from the random source src, from its first point.
+func SobolOwen(src *rand.Rand, d int) func() []float64 {
+	v := sobolDirections(d)
+	seed := make([]uint64, d)
+	for j := range seed {
+		seed[j] = src.Uint64()
+	}
+	x := make([]uint32, d)
+	n := uint32(0)
+	first := true
+	return func() []float64 {
+		if !first {
+			c := 0
+			for (n>>uint(c))&1 == 1 {
+				c++
+			}
+			n++
+			for j := range x {
+				x[j] ^= v[j][c]
+			}
+		}
+		first = false
+		u := make([]float64, d)
+		for j := range x {
+			u[j] = owenScramble2(x[j], seed[j])
+		}
+		return u
+	}
+}
+
+// owenScramble2 returns the point of [0, 1) with the binary digits x, each flipped by a random bit that depends on the seed
+// and on the digits above it; the digits below the 32 given ones, all zero, become random.
+func owenScramble2(x uint32, seed uint64) float64 {
+	var y uint32
+	h := seed
+	for b := sobolBits - 1; b >= 0; b-- {
+		bit := (x >> uint(b)) & 1
+		y |= (bit ^ uint32(qmcMix(h)&1)) << uint(b)
+		h = qmcMix(h ^ uint64(bit+1))
+	}
+	// the 21 digits below, to fill the precision of a float64
+	return (float64(y) + float64(qmcMix(h)>>43)/(1<<21)) / (1 << sobolBits)
+}
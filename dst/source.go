@@ -0,0 +1,28 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Random sources.
+// Every sampler XxxNext has a variant XxxNextR taking a *rand.Rand as its first argument,
+// so that simulations can be made reproducible (rand.New(rand.NewSource(seed)))
+// and run concurrently, each goroutine with its own *rand.Rand.
+// XxxNext draws from the global source of math/rand, like it always did.
+// XxxFill and XxxFillR draw in bulk, into a slice (or the rows of a matrix) of the caller, and allocate nothing per draw;
+// where the sampler of a family has a setup (Gamma, Beta, Binomial, Poisson, ...), their doc comment says what is set up once for all the draws,
+// the others draw as many XxxNextR.
+// The distribution types have likewise Rand and RandR; NewStreams and Stream derive the sources of parallel tasks from one seed.
+
+import (
+	"math/rand"
+)
+
+// globalSource draws from the global source of math/rand; unlike a *rand.Rand, it is safe for concurrent use.
+type globalSource struct{}
+
+func (globalSource) Int63() int64    { return rand.Int63() }
+func (globalSource) Uint64() uint64  { return rand.Uint64() }
+func (globalSource) Seed(seed int64) { rand.Seed(seed) }
+
+// GlobalRand is the random source of all XxxNext samplers, and of the simulations of package bayes;
+// it draws from the global source of math/rand, and may be shared by goroutines.
+var GlobalRand = rand.New(globalSource{})
@@ -0,0 +1,135 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Parallel random streams.
+// The global source of math/rand serializes the goroutines on a mutex, and the order in which they draw from it,
+// hence the results, depends on their scheduling. A simulation run in parallel is reproducible instead when every task
+// draws from its own stream, derived from one seed by the index of the task: Stream(seed, i), or the successive streams
+// of NewStreams(seed). The streams are *rand.Rand, which every sampler XxxNextR, XxxR, XxxFillR, and the method RandR
+// of the distributions, accept as their source.
+// The streams of a factory are disjoint segments of the period 2^256 - 1 of the generator xoshiro256** of Blackman and Vigna,
+// 2^128 draws long: Jump advances a generator from one to the next. The factories returned by Split, for nested parallel tasks,
+// start instead from the seed hashed with the path of splits that lead to them: at random points of the period, where the chance
+// that streams of different factories overlap is negligible (less than 2^-60 for a million factories of a million streams).
+// Ref.: Blackman, D., Vigna, S. (2021). Scrambled linear pseudorandom number generators. ACM Transactions on Mathematical Software 47(4), 36.
+
+import (
+	"math/rand"
+)
+
+// Xoshiro is the random generator xoshiro256**, a rand.Source64; it is not safe for concurrent use.
+type Xoshiro struct {
+	s [4]uint64
+}
+
+// NewXoshiro returns the generator xoshiro256** seeded by seed.
+func NewXoshiro(seed int64) *Xoshiro {
+	x := new(Xoshiro)
+	x.Seed(seed)
+	return x
+}
+
+// Seed sets the state of the generator from the seed, expanded by SplitMix64, which never gives the all-zero state.
+func (x *Xoshiro) Seed(seed int64) {
+	h := uint64(seed)
+	for i := range x.s {
+		h += 0x9e3779b97f4a7c15
+		z := h
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		x.s[i] = z ^ (z >> 31)
+	}
+}
+
+// Uint64 returns the next pseudo-random 64-bit value.
+func (x *Xoshiro) Uint64() uint64 {
+	s := &x.s
+	r := rotl(s[1]*5, 7) * 9
+	t := s[1] << 17
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = rotl(s[3], 45)
+	return r
+}
+
+// Int63 returns the next pseudo-random non-negative 63-bit value.
+func (x *Xoshiro) Int63() int64 {
+	return int64(x.Uint64() >> 1)
+}
+
+// Jump advances the generator by 2^128 draws.
+func (x *Xoshiro) Jump() {
+	x.jump([4]uint64{0x180ec6d33cfd0aba, 0xd5a61266f0c9392c, 0xa9582618e03fc9aa, 0x39abdc4529b1661c})
+}
+
+// LongJump advances the generator by 2^192 draws.
+func (x *Xoshiro) LongJump() {
+	x.jump([4]uint64{0x76e15d3efefdcbbf, 0xc5004e441c522fb3, 0x77710069854ee241, 0x39109bb02acbe635})
+}
+
+// jump advances the generator by the number of draws whose polynomial, modulo the characteristic polynomial of the generator, has the coefficients c.
+func (x *Xoshiro) jump(c [4]uint64) {
+	var t [4]uint64
+	for _, w := range c {
+		for b := uint(0); b < 64; b++ {
+			if w&(1<<b) != 0 {
+				for i := range t {
+					t[i] ^= x.s[i]
+				}
+			}
+			x.Uint64()
+		}
+	}
+	x.s = t
+}
+
+// rotl returns x rotated left by k bits.
+func rotl(x uint64, k uint) uint64 {
+	return x<<k | x>>(64-k)
+}
+
+// Streams is a factory of independent random streams derived from one seed; it is not safe for concurrent use,
+// so that the streams must be dealt out before the goroutines start, in an order that does not depend on their scheduling.
+type Streams struct {
+	key    uint64  // the seed, or the hash of the key of the parent factory and the rank of the split
+	next   Xoshiro // the start of the next stream
+	splits uint64  // the number of factories split from this one
+}
+
+// NewStreams returns the factory of the random streams derived from the seed.
+func NewStreams(seed int64) *Streams {
+	return newStreams(uint64(seed))
+}
+
+// newStreams returns the factory of the random streams derived from the key.
+func newStreams(key uint64) *Streams {
+	s := &Streams{key: key}
+	s.next.Seed(int64(key))
+	return s
+}
+
+// Next returns the next random stream of the factory, 2^128 draws after the previous one.
+func (s *Streams) Next() *rand.Rand {
+	x := s.next
+	s.next.Jump()
+	return rand.New(&x)
+}
+
+// Split returns a new factory, for the nested parallel tasks of a task; the same splits of the same factory return the same factories.
+func (s *Streams) Split() *Streams {
+	s.splits++
+	return newStreams(qmcMix(s.key ^ qmcMix(s.splits)))
+}
+
+// Stream returns the random stream i, from 0, of the factory NewStreams(seed), without drawing the streams before it.
+func Stream(seed int64, i int) *rand.Rand {
+	x := NewXoshiro(seed)
+	for ; i > 0; i-- {
+		x.Jump()
+	}
+	return rand.New(x)
+}
@@ -11,6 +11,10 @@ package dst
 // Support: 
 // x ∈ (-∞, +∞) (real)
 
+import (
+	"math/rand"
+)
+
 // StudentsTPDF returns the PDF of the Student's t distribution. 
 func StudentsTPDF(ν float64) func(x float64) float64 {
 	normalization := Γ((ν+1)/2) / (sqrt(ν*π) * Γ(ν/2))
@@ -71,6 +75,52 @@ func StudentsTCDFAt(ν, x float64) float64 {
 	return cdf(x)
 }
 
+// StudentsTCDFTail returns the CDF of the Student's t distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func StudentsTCDFTail(ν float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		var val float64
+		if isNaN(x) || isNaN(ν) {
+			return x + ν
+		}
+		if ν <= 0 {
+			return NaN
+		}
+		if isInf(x, 0) {
+			return pTailBounds(x > 0, lowerTail, logP)
+		}
+
+		// val is twice the smaller tail
+		nx := 1 + (x/ν)*x
+		switch {
+		case nx > 1e100: // Abramowitz & Stegun 26.5.4, as in StudentsTCDF
+			val = -0.5*ν*(2*log(abs(x))-log(ν)) - logB(0.5*ν, 0.5) - log(0.5*ν)
+			if !logP {
+				val = exp(val)
+			}
+		case ν > x*x:
+			val = BetaCDFTail(0.5, ν/2, false, logP)(x * x / (ν + x*x))
+		default:
+			val = BetaCDFTail(ν/2, 0.5, true, logP)(1 / nx)
+		}
+
+		lt := lowerTail
+		if x <= 0 {
+			lt = !lt
+		}
+		if logP {
+			if lt {
+				return log1p(-0.5 * exp(val))
+			}
+			return val - Ln2
+		}
+		val /= 2
+		if lt {
+			return 0.5 - val + 0.5
+		}
+		return val
+	}
+}
+
 // StudentsTQtl returns the inverse of the CDF (quantile) of the Student's t distribution. 
 func StudentsTQtl(ν float64) func(p float64) float64 {
 	// Hill, G.W (1970) "Algorithm 396: Student's t-quantiles"
@@ -87,6 +137,12 @@ func StudentsTQtl(ν float64) func(p float64) float64 {
 		if ν <= 0 || p < 0 || p > 1 {
 			return NaN
 		}
+		if p == 0 {
+			return negInf
+		}
+		if p == 1 {
+			return posInf
+		}
 
 		/*
 			    if (ν < 1) { // based on qnt
@@ -143,15 +199,14 @@ func StudentsTQtl(ν float64) func(p float64) float64 {
 						q = sqrt(2/(p*(2-p)) - 2)
 					}
 				} else { // p << 1, q = 1/sqrt(p) = ...
-					return posInf
-
+					q = posInf
 				}
 			} else if ν < 1+eps { // df ~= 1  (df < 1 excluded above): Cauchy
 				if p > 0 {
 					q = 1 / tan(p*π/2) // == - tan((p+1) * π/2) -- suffers for p ~= 0
 
 				} else { // p = 0, but maybe = 2*exp(p) !
-					return posInf
+					q = posInf
 				}
 			} else { //-- usual case;  including, e.g.,  df = 1.1
 				x := 0.0
@@ -219,15 +274,66 @@ func StudentsTQtlFor(ν, p float64) float64 {
 	return qtl(p)
 }
 
+// StudentsTQtlTail returns the inverse of StudentsTCDFTail (quantile) of the Student's t distribution.
+func StudentsTQtlTail(ν float64, lowerTail, logP bool) func(p float64) float64 {
+	qtl := StudentsTQtl(ν)
+	cdf := StudentsTCDFTail(ν, lowerTail, logP)
+	return func(p float64) float64 {
+		if isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		if pEdge(p, false, lowerTail, logP) {
+			return negInf
+		}
+		if pEdge(p, true, lowerTail, logP) {
+			return posInf
+		}
+		// by symmetry, from the smaller tail
+		lower := pLower(p, lowerTail, logP)
+		upper := pUpper(p, lowerTail, logP)
+		switch {
+		case lower < 1e-10 || upper < 1e-10:
+			// far in the tails, where the algorithm of Hill loses precision
+			return qtlTail(cdf, p, negInf, posInf, lowerTail, logP)
+		case lower <= 0.5:
+			return qtl(lower)
+		}
+		return -qtl(upper)
+	}
+}
+
 // StudentsTNext returns random number drawn from the Student's t distribution. 
 func StudentsTNext(ν float64) float64 {
-	return NormalNext(0, 1) * sqrt(ν/GammaNext(ν/2, 2))
+	return StudentsTNextR(GlobalRand, ν)
+}
+
+// StudentsTNextR returns random number drawn from the Student's t distribution, using the random source src.
+func StudentsTNextR(src *rand.Rand, ν float64) float64 {
+	return NormalNextR(src, 0, 1) * sqrt(ν/GammaNextR(src, ν/2, 2))
 }
 
 // StudentsT returns the random number generator with  Student's t distribution. 
 func StudentsT(ν float64) func() float64 {
-	return func() float64 {
-		return StudentsTNext(ν)
+	return StudentsTR(GlobalRand, ν)
+}
+
+// StudentsTR returns the random number generator with  Student's t distribution, using the random source src.
+func StudentsTR(src *rand.Rand, ν float64) func() float64 {
+	gs := newGammaSampler(ν/2, 2)
+	return func() float64 { return NormalNextR(src, 0, 1) * sqrt(ν/gs.next(src)) }
+}
+
+// StudentsTFill fills x with random numbers drawn from the Student's t distribution.
+func StudentsTFill(ν float64, x []float64) {
+	StudentsTFillR(GlobalRand, ν, x)
+}
+
+// StudentsTFillR fills x with random numbers drawn from the Student's t distribution, using the random source src;
+// the Gamma sampler of the denominator is set up once for all the draws.
+func StudentsTFillR(src *rand.Rand, ν float64, x []float64) {
+	next := StudentsTR(src, ν)
+	for i := range x {
+		x[i] = next()
 	}
 }
 
@@ -251,7 +357,7 @@ func StudentsTMedian(ν float64) float64 {
 
 // StudentsTVar returns the variance of the StudentsT Type I distribution. 
 func StudentsTVar(ν float64) float64 {
-	if ν >= 1 {
+	if ν <= 1 {
 		return NaN
 	}
 	if ν > 2 {
@@ -262,7 +368,7 @@ func StudentsTVar(ν float64) float64 {
 
 // StudentsTStd returns the standard deviation of the StudentsT Type I distribution. 
 func StudentsTStd(ν float64) float64 {
-	if ν >= 1 {
+	if ν <= 1 {
 		return NaN
 	}
 	if ν > 2 {
@@ -289,3 +395,108 @@ func StudentsTExKurt(ν float64) float64 {
 	}
 	return 6 / (ν - 4)
 }
+
+// StudentsTEntropy returns the differential entropy of the Student's t distribution.
+func StudentsTEntropy(ν float64) float64 {
+	return (ν+1)/2*(digamma((ν+1)/2)-digamma(ν/2)) + log(ν)/2 + logB(ν/2, 0.5)
+}
+
+// StudentsTCF returns the characteristic function of the Student's t distribution.
+func StudentsTCF(ν, t float64) complex128 {
+	if t == 0 {
+		return 1
+	}
+	// K_{ν/2}(√ν|t|) (√ν|t|)^(ν/2) / (Γ(ν/2) 2^(ν/2-1))
+	x := sqrt(ν) * abs(t)
+	return complex(exp(ν/2*log(x)+besselKLn(ν/2, x)-LnΓ(ν/2)-(ν/2-1)*Ln2), 0)
+}
+
+// StudentsTCGF returns the cumulant generating function of the Student's t distribution, +Inf for t ≠ 0.
+func StudentsTCGF(ν, t float64) float64 {
+	if t == 0 {
+		return 0
+	}
+	return posInf
+}
+
+// StudentsTMatchQtls returns the Student's t distribution whose quantiles for the probabilities p best match x.
+func StudentsTMatchQtls(p, x []float64) StudentsTDist {
+	e := matchQtls(func(θ []float64) Continuous { return StudentsTDist{θ[0]} }, p, x, []float64{5}, []mleBound{mlePos})
+	return StudentsTDist{e[0]}
+}
+
+// StudentsTFit returns the maximum-likelihood estimate of ν of Student's t distribution from the sample x, starting from its excess kurtosis 6 / (ν - 4).
+func StudentsTFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return StudentsTLnPDF(θ[0]) })
+	return mleFit(lnL, []float64{studentsTStart(x)}, []mleBound{mlePos})
+}
+
+// studentsTStart returns the estimate of ν of Student's t distribution from the excess kurtosis of the sample x, 30 if it is not positive.
+func studentsTStart(x []float64) float64 {
+	_, m2, _, m4 := empiricalMoments(x)
+	if k := m4/(m2*m2) - 3; k > 0 {
+		return 4 + 6/k
+	}
+	return 30
+}
+
+// StudentsTDist is the Student's t-distribution with ν = Nu degrees of freedom. It implements Continuous.
+type StudentsTDist struct {
+	Nu float64
+}
+
+// PDF returns the value of PDF of the Student's t distribution at x.
+func (d StudentsTDist) PDF(x float64) float64 { return StudentsTPDF(d.Nu)(x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Student's t distribution at x.
+func (d StudentsTDist) LnPDF(x float64) float64 { return StudentsTLnPDF(d.Nu)(x) }
+
+// CDF returns the value of CDF of the Student's t distribution at x.
+func (d StudentsTDist) CDF(x float64) float64 { return StudentsTCDFAt(d.Nu, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Student's t distribution at x.
+func (d StudentsTDist) Surv(x float64) float64 { return StudentsTCDFTail(d.Nu, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Student's t distribution at x.
+func (d StudentsTDist) LnCDF(x float64) float64 { return StudentsTCDFTail(d.Nu, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Student's t distribution at x.
+func (d StudentsTDist) LnSurv(x float64) float64 { return StudentsTCDFTail(d.Nu, false, true)(x) }
+
+// Qtl returns the quantile of the Student's t distribution for probability p.
+func (d StudentsTDist) Qtl(p float64) float64 { return StudentsTQtlFor(d.Nu, p) }
+
+// QtlTail returns the quantile of the Student's t distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d StudentsTDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return StudentsTQtlTail(d.Nu, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Student's t distribution.
+func (d StudentsTDist) Rand() float64 { return StudentsTNext(d.Nu) }
+
+// RandR returns random number drawn from the Student's t distribution, using the random source src.
+func (d StudentsTDist) RandR(src *rand.Rand) float64 { return StudentsTNextR(src, d.Nu) }
+
+// Mean returns the mean of the Student's t distribution.
+func (d StudentsTDist) Mean() float64 { return StudentsTMean(d.Nu) }
+
+// Var returns the variance of the Student's t distribution.
+func (d StudentsTDist) Var() float64 { return StudentsTVar(d.Nu) }
+
+// Skew returns the skewness of the Student's t distribution.
+func (d StudentsTDist) Skew() float64 { return StudentsTSkew(d.Nu) }
+
+// ExKurt returns the excess kurtosis of the Student's t distribution.
+func (d StudentsTDist) ExKurt() float64 { return StudentsTExKurt(d.Nu) }
+
+// Entropy returns the differential entropy of the Student's t distribution.
+func (d StudentsTDist) Entropy() float64 { return StudentsTEntropy(d.Nu) }
+
+// CF returns the characteristic function of the Student's t distribution at t.
+func (d StudentsTDist) CF(t float64) complex128 { return StudentsTCF(d.Nu, t) }
+
+// CGF returns the cumulant generating function of the Student's t distribution at t.
+func (d StudentsTDist) CGF(t float64) float64 { return StudentsTCGF(d.Nu, t) }
+
+// Support returns the support of the Student's t distribution.
+func (d StudentsTDist) Support() (a, b float64) { return negInf, posInf }
@@ -0,0 +1,465 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Noncentral Student's t distribution.
+// The distribution of (Z + δ) / √(V/ν), with Z Standard Normal and V Chi-Squared with ν degrees of freedom:
+// the distribution of the t statistic under the alternative, for calculations of power of t-tests.
+// It is Student's t distribution if δ = 0.
+//
+// Parameters:
+// ν > 0		degrees of freedom (real)
+// δ ∈ R		noncentrality
+//
+// Support:
+// x ∈ R
+
+import (
+	"math/rand"
+)
+
+// noncentralStudentsTLnS returns the logarithm of the density of y = log S, S = √(V/ν), V Chi-Squared with ν degrees of freedom.
+func noncentralStudentsTLnS(ν float64) func(y float64) float64 {
+	c := Ln2 + ν/2*log(ν/2) - LnΓ(ν/2)
+	return func(y float64) float64 {
+		return c + ν*y - ν*exp(2*y)/2
+	}
+}
+
+// normalHazard returns φ(x)/(1 - Φ(x)), the inverse Mills ratio, asymptotically x + 1/x where the two logarithms would cancel.
+func normalHazard(x float64) float64 {
+	if x > 1e3 {
+		return x + 1/x
+	}
+	return exp(-x*x/2 - M_LN_SQRT_2PI - pnorm(x, false, true))
+}
+
+// noncentralStudentsTLnXY returns the logarithms of x = t²/(ν + t²) and y = ν/(ν + t²), for t > 0, without overflow or underflow.
+func noncentralStudentsTLnXY(t, ν float64) (lnx, lny float64) {
+	if t*t < ν {
+		r := log1p(t * t / ν)
+		return 2*log(t) - log(ν) - r, -r
+	}
+	r := log1p(ν / t / t)
+	return -r, log(ν) - 2*log(t) - r
+}
+
+// noncentralStudentsTLnCDF returns the logarithm of the lower tail of the noncentral Student's t distribution at t if lowerTail, of the upper tail otherwise.
+// For t < 0, it is the other tail at -t with noncentrality -δ. For t ≥ 0, P[T ≤ t] = Φ(-δ) + Σ p_j I_x(j + 1/2, ν/2) + q_j I_x(j + 1, ν/2),
+// x = t²/(ν + t²), and P[T > t] the same series with the complements of the Beta probabilities: p_j = Pois(j; δ²/2)/2 are the weights of a
+// noncentral Beta, and q_j = δ Pois(j; δ²/2) j! / (2√2 Γ(j + 3/2)) have the sign of δ. Both parts are summed by lnPoissonMix;
+// where the negative one cancels most of the rest, in the far tails, the tail is the integral of the normal tail at t e^y - δ
+// over the density of y = log S, S = √(V/ν).
+// Lenth, R. V. (1989). Algorithm AS 243: Cumulative distribution function of the non-central t distribution. Applied Statistics 38, 185-189.
+func noncentralStudentsTLnCDF(t, ν, δ float64, lowerTail bool) float64 {
+	if t < 0 {
+		return noncentralStudentsTLnCDF(-t, ν, -δ, !lowerTail)
+	}
+	if ν > 4e5 || δ*δ > 2*Ln2*1021 {
+		// Abramowitz & Stegun 26.7.10
+		s := 1 / (4 * ν)
+		return pnorm((t*(1-s)-δ)/sqrt(1+t*t*2*s), lowerTail, true)
+	}
+	if t == 0 {
+		return pnorm(-δ, lowerTail, true)
+	}
+	lnx, lny := noncentralStudentsTLnXY(t, ν)
+	x, y := exp(lnx), exp(lny)
+	if y > 0 {
+		b := ν / 2
+		pos, neg := -Ln2+ncBetaLnCDF(0.5, b, δ*δ, x, y, lowerTail), negInf
+		if lowerTail {
+			pos = logspace_add(pnorm(-δ, true, true), pos)
+		}
+		if δ != 0 {
+			q := log(abs(δ)) - 1.5*Ln2 + lnPoissonMix(δ*δ/2, func(i float64) float64 {
+				if x > 0.5 {
+					return LnΓ(i+1) - LnΓ(i+1.5) + BetaCDFTail(b, i+1, !lowerTail, true)(y)
+				}
+				return LnΓ(i+1) - LnΓ(i+1.5) + BetaCDFTail(i+1, b, lowerTail, true)(x)
+			})
+			if δ > 0 {
+				pos = logspace_add(pos, q)
+			} else {
+				neg = q
+			}
+		}
+		// at most three digits lost
+		if pos-neg >= 1e-3 {
+			return logspace_sub(pos, neg)
+		}
+	}
+	return noncentralStudentsTLnTail(t, ν, δ, lowerTail)
+}
+
+// noncentralStudentsTLnTail returns the logarithm of the lower tail of the noncentral Student's t distribution at t ≥ 0 if lowerTail, of the upper tail otherwise,
+// P[Z + δ ≶ tS] with S = √(V/ν), as the integral of the normal tail at t e^y - δ over the density of y = log S.
+func noncentralStudentsTLnTail(t, ν, δ float64, lowerTail bool) float64 {
+	// the derivative of the log of the normal tail at x is ∓ the hazard at ±x
+	sign := -1.0
+	if lowerTail {
+		sign = 1
+	}
+	lnS := noncentralStudentsTLnS(ν)
+	return integrateLnUnimodal(func(y float64) float64 {
+		return lnS(y) + pnorm(t*exp(y)-δ, lowerTail, true)
+	}, func(y float64) float64 {
+		w := t * exp(y)
+		return ν*(1-exp(2*y)) + sign*w*normalHazard(-sign*(w-δ))
+	}, -700, 20)
+}
+
+// NoncentralStudentsTPDF returns the PDF of the noncentral Student's t distribution.
+func NoncentralStudentsTPDF(ν, δ float64) func(x float64) float64 {
+	lnPDF := NoncentralStudentsTLnPDF(ν, δ)
+	return func(x float64) float64 {
+		return exp(lnPDF(x))
+	}
+}
+
+// NoncentralStudentsTLnPDF returns the natural logarithm of the PDF of the noncentral Student's t distribution.
+// It is the series of noncentralStudentsTLnCDF differentiated term by term, a mixture of Beta densities at t²/(ν + t²) with the weights p_j and q_j;
+// where the negative part cancels most of the rest, it is E[S φ(xS - δ)], S = √(V/ν), integrated over the density of y = log S.
+func NoncentralStudentsTLnPDF(ν, δ float64) func(x float64) float64 {
+	b := ν / 2
+	return func(x float64) float64 {
+		switch {
+		case ν <= 0:
+			return NaN
+		case isInf(x, 0):
+			return negInf
+		case ν > 1e8:
+			z := x - δ
+			return -z*z/2 - M_LN_SQRT_2PI
+		case x == 0:
+			return LnΓ((ν+1)/2) - LnΓ(ν/2) - 0.5*(log(π)+log(ν)+δ*δ)
+		}
+		// -T is noncentral t with -δ
+		t, d := x, δ
+		if t < 0 {
+			t, d = -t, -δ
+		}
+		lnx, lny := noncentralStudentsTLnXY(t, ν)
+		// the density is dx/dt = 2tν/(ν + t²)² times that of the mixture of Beta distributions
+		c := log(t) + 2*lny - log(ν)
+		pos := c + lnPoissonMix(d*d/2, func(i float64) float64 {
+			return (i-0.5)*lnx + (b-1)*lny - logB(i+0.5, b)
+		})
+		if d == 0 {
+			return pos
+		}
+		q := c + log(abs(d)) - 0.5*Ln2 + lnPoissonMix(d*d/2, func(i float64) float64 {
+			return LnΓ(i+1) - LnΓ(i+1.5) + i*lnx + (b-1)*lny - logB(i+1, b)
+		})
+		if d > 0 {
+			return logspace_add(pos, q)
+		}
+		if pos-q >= 1e-3 {
+			return logspace_sub(pos, q)
+		}
+		lnS := noncentralStudentsTLnS(ν)
+		return integrateLnUnimodal(func(y float64) float64 {
+			z := t*exp(y) - d
+			return lnS(y) + y - z*z/2 - M_LN_SQRT_2PI
+		}, func(y float64) float64 {
+			w := t * exp(y)
+			return ν + 1 - ν*exp(2*y) - (w-d)*w
+		}, -700, 20)
+	}
+}
+
+// NoncentralStudentsTPDFAt returns the value of PDF of noncentral Student's t distribution at x.
+func NoncentralStudentsTPDFAt(ν, δ, x float64) float64 {
+	pdf := NoncentralStudentsTPDF(ν, δ)
+	return pdf(x)
+}
+
+// NoncentralStudentsTCDF returns the CDF of the noncentral Student's t distribution.
+func NoncentralStudentsTCDF(ν, δ float64) func(x float64) float64 {
+	return NoncentralStudentsTCDFTail(ν, δ, true, false)
+}
+
+// NoncentralStudentsTCDFAt returns the value of CDF of the noncentral Student's t distribution, at x.
+func NoncentralStudentsTCDFAt(ν, δ, x float64) float64 {
+	cdf := NoncentralStudentsTCDF(ν, δ)
+	return cdf(x)
+}
+
+// NoncentralStudentsTCDFTail returns the CDF of the noncentral Student's t distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func NoncentralStudentsTCDFTail(ν, δ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if ν <= 0 {
+			return NaN
+		}
+		if isInf(x, 0) {
+			return pTailBounds(x > 0, lowerTail, logP)
+		}
+		p := noncentralStudentsTLnCDF(x, ν, δ, lowerTail)
+		if logP {
+			return p
+		}
+		return exp(p)
+	}
+}
+
+// NoncentralStudentsTQtl returns the inverse of the CDF (quantile) of the noncentral Student's t distribution.
+func NoncentralStudentsTQtl(ν, δ float64) func(p float64) float64 {
+	return NoncentralStudentsTQtlTail(ν, δ, true, false)
+}
+
+// NoncentralStudentsTQtlFor returns the inverse of the CDF (quantile) of the noncentral Student's t distribution, for given probability.
+func NoncentralStudentsTQtlFor(ν, δ, p float64) float64 {
+	qtl := NoncentralStudentsTQtl(ν, δ)
+	return qtl(p)
+}
+
+// NoncentralStudentsTQtlTail returns the inverse of NoncentralStudentsTCDFTail (quantile) of the noncentral Student's t distribution.
+// It solves for the logarithm of the smaller tail, that of the upper one being the lower tail of -T, noncentral with -δ, by safeguarded Newton steps.
+func NoncentralStudentsTQtlTail(ν, δ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if ν <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		if pEdge(p, false, lowerTail, logP) {
+			return negInf
+		}
+		if pEdge(p, true, lowerTail, logP) {
+			return posInf
+		}
+		lnP, d, sign := pLnLower(p, lowerTail, logP), δ, 1.0
+		if lnU := pLnUpper(p, lowerTail, logP); lnU < lnP {
+			lnP, d, sign = lnU, -δ, -1
+		}
+		lnPDF := NoncentralStudentsTLnPDF(ν, d)
+		// newtonSafe asks for f and df at the same t in turn
+		tc, lc := NaN, NaN
+		lnCDF := func(t float64) float64 {
+			if t != tc {
+				tc, lc = t, noncentralStudentsTLnCDF(t, ν, d, true)
+			}
+			return lc
+		}
+		f := func(t float64) float64 { return lnCDF(t) - lnP }
+		df := func(t float64) float64 { return exp(lnPDF(t) - lnCDF(t)) }
+		lo, flo, hi, fhi, err := qtlBracket(f, negInf, posInf, d+StudentsTQtlTail(ν, true, true)(lnP))
+		if err != nil {
+			return NaN
+		}
+		t, err := newtonSafe(f, df, lo, flo, hi, fhi)
+		if err != nil {
+			return NaN
+		}
+		return sign * t
+	}
+}
+
+// NoncentralStudentsTNext returns random number drawn from the noncentral Student's t distribution.
+func NoncentralStudentsTNext(ν, δ float64) float64 {
+	return NoncentralStudentsTNextR(GlobalRand, ν, δ)
+}
+
+// NoncentralStudentsTNextR returns random number drawn from the noncentral Student's t distribution, using the random source src.
+func NoncentralStudentsTNextR(src *rand.Rand, ν, δ float64) float64 {
+	return NormalNextR(src, δ, 1) / sqrt(GammaNextR(src, ν/2, 2)/ν)
+}
+
+// NoncentralStudentsT returns the random number generator with  noncentral Student's t distribution.
+func NoncentralStudentsT(ν, δ float64) func() float64 {
+	return NoncentralStudentsTR(GlobalRand, ν, δ)
+}
+
+// NoncentralStudentsTR returns the random number generator with  noncentral Student's t distribution, using the random source src.
+func NoncentralStudentsTR(src *rand.Rand, ν, δ float64) func() float64 {
+	gs := newGammaSampler(ν/2, 2)
+	return func() float64 { return NormalNextR(src, δ, 1) / sqrt(gs.next(src)/ν) }
+}
+
+// NoncentralStudentsTFill fills x with random numbers drawn from the noncentral Student's t distribution.
+func NoncentralStudentsTFill(ν, δ float64, x []float64) {
+	NoncentralStudentsTFillR(GlobalRand, ν, δ, x)
+}
+
+// NoncentralStudentsTFillR fills x with random numbers drawn from the noncentral Student's t distribution, using the random source src;
+// the Gamma sampler of the denominator is set up once for all the draws.
+func NoncentralStudentsTFillR(src *rand.Rand, ν, δ float64, x []float64) {
+	next := NoncentralStudentsTR(src, ν, δ)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// noncentralStudentsTRaw returns the raw moments E[X^k], k = 1, ..., 4, of the noncentral Student's t distribution,
+// (ν/2)^(k/2) Γ((ν-k)/2) / Γ(ν/2) E[(Z + δ)^k]; they are finite for ν > k.
+func noncentralStudentsTRaw(ν, δ float64) (m [5]float64) {
+	z := [5]float64{1, δ, δ*δ + 1, δ*δ*δ + 3*δ, δ*δ*δ*δ + 6*δ*δ + 3}
+	m[0] = 1
+	for k := 1; k <= 4; k++ {
+		h := float64(k) / 2
+		m[k] = exp(h*log(ν/2)+LnΓ(ν/2-h)-LnΓ(ν/2)) * z[k]
+	}
+	return
+}
+
+// NoncentralStudentsTMean returns the mean of the noncentral Student's t distribution.
+func NoncentralStudentsTMean(ν, δ float64) float64 {
+	if ν <= 1 {
+		return NaN
+	}
+	return noncentralStudentsTRaw(ν, δ)[1]
+}
+
+// NoncentralStudentsTVar returns the variance of the noncentral Student's t distribution.
+func NoncentralStudentsTVar(ν, δ float64) float64 {
+	if ν <= 1 {
+		return NaN
+	}
+	if ν <= 2 {
+		return posInf
+	}
+	m := noncentralStudentsTRaw(ν, δ)
+	return m[2] - m[1]*m[1]
+}
+
+// NoncentralStudentsTStd returns the standard deviation of the noncentral Student's t distribution.
+func NoncentralStudentsTStd(ν, δ float64) float64 {
+	return sqrt(NoncentralStudentsTVar(ν, δ))
+}
+
+// NoncentralStudentsTSkew returns the skewness of the noncentral Student's t distribution.
+func NoncentralStudentsTSkew(ν, δ float64) float64 {
+	if ν <= 3 {
+		return NaN
+	}
+	m := noncentralStudentsTRaw(ν, δ)
+	_, _, skew, _ := rawMoments(m[1], m[2], m[3], 0)
+	return skew
+}
+
+// NoncentralStudentsTExKurt returns the excess kurtosis of the noncentral Student's t distribution.
+func NoncentralStudentsTExKurt(ν, δ float64) float64 {
+	if ν <= 2 {
+		return NaN
+	}
+	if ν <= 4 {
+		return posInf
+	}
+	m := noncentralStudentsTRaw(ν, δ)
+	_, _, _, kurt := rawMoments(m[1], m[2], m[3], m[4])
+	return kurt
+}
+
+// NoncentralStudentsTEntropy returns the differential entropy of the noncentral Student's t distribution, by numerical integration.
+func NoncentralStudentsTEntropy(ν, δ float64) float64 {
+	return entropyLnPDF(NoncentralStudentsTLnPDF(ν, δ), NoncentralStudentsTQtl(ν, δ), negInf, posInf)
+}
+
+// NoncentralStudentsTCF returns the characteristic function of the noncentral Student's t distribution, by numerical integration.
+func NoncentralStudentsTCF(ν, δ, t float64) complex128 {
+	return cfLnPDF(NoncentralStudentsTLnPDF(ν, δ), NoncentralStudentsTQtl(ν, δ), negInf, posInf, t)
+}
+
+// NoncentralStudentsTCGF returns the cumulant generating function of the noncentral Student's t distribution, +Inf for t ≠ 0.
+func NoncentralStudentsTCGF(ν, δ, t float64) float64 {
+	if t == 0 {
+		return 0
+	}
+	return posInf
+}
+
+// NoncentralStudentsTMGF does not exist: the noncentral Student's t distribution has only ν - 1 moments.
+
+// NoncentralStudentsTMatchQtls returns the noncentral Student's t distribution whose quantiles for the probabilities p best match x.
+func NoncentralStudentsTMatchQtls(p, x []float64) NoncentralStudentsTDist {
+	μ, _ := qtlMeanStd(p, x)
+	e := matchQtls(func(θ []float64) Continuous { return NoncentralStudentsTDist{θ[0], θ[1]} }, p, x, []float64{5, μ}, []mleBound{mlePos, mleReal})
+	return NoncentralStudentsTDist{e[0], e[1]}
+}
+
+// NoncentralStudentsTFit returns the maximum-likelihood estimates of ν, δ of the Noncentral Student's t distribution from the sample x.
+// Each evaluation of the likelihood sums the series of the density at every point, some 10 µs each: a few seconds for a sample of thousands.
+func NoncentralStudentsTFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return NoncentralStudentsTLnPDF(θ[0], θ[1]) })
+	μ, _ := mleMeanVar(x)
+	return mleFit(lnL, []float64{studentsTStart(x), μ}, []mleBound{mlePos, mleReal})
+}
+
+// NoncentralStudentsTDist is the noncentral Student's t distribution with ν = Nu degrees of freedom and noncentrality δ = Delta. It implements Continuous.
+type NoncentralStudentsTDist struct {
+	Nu, Delta float64
+}
+
+// PDF returns the value of PDF of the noncentral Student's t distribution at x.
+func (d NoncentralStudentsTDist) PDF(x float64) float64 {
+	return NoncentralStudentsTPDFAt(d.Nu, d.Delta, x)
+}
+
+// LnPDF returns the natural logarithm of the PDF of the noncentral Student's t distribution at x.
+func (d NoncentralStudentsTDist) LnPDF(x float64) float64 {
+	return NoncentralStudentsTLnPDF(d.Nu, d.Delta)(x)
+}
+
+// CDF returns the value of CDF of the noncentral Student's t distribution at x.
+func (d NoncentralStudentsTDist) CDF(x float64) float64 {
+	return NoncentralStudentsTCDFAt(d.Nu, d.Delta, x)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the noncentral Student's t distribution at x.
+func (d NoncentralStudentsTDist) Surv(x float64) float64 {
+	return NoncentralStudentsTCDFTail(d.Nu, d.Delta, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the noncentral Student's t distribution at x.
+func (d NoncentralStudentsTDist) LnCDF(x float64) float64 {
+	return NoncentralStudentsTCDFTail(d.Nu, d.Delta, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the noncentral Student's t distribution at x.
+func (d NoncentralStudentsTDist) LnSurv(x float64) float64 {
+	return NoncentralStudentsTCDFTail(d.Nu, d.Delta, false, true)(x)
+}
+
+// Qtl returns the quantile of the noncentral Student's t distribution for probability p.
+func (d NoncentralStudentsTDist) Qtl(p float64) float64 {
+	return NoncentralStudentsTQtlFor(d.Nu, d.Delta, p)
+}
+
+// QtlTail returns the quantile of the noncentral Student's t distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d NoncentralStudentsTDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return NoncentralStudentsTQtlTail(d.Nu, d.Delta, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the noncentral Student's t distribution.
+func (d NoncentralStudentsTDist) Rand() float64 { return NoncentralStudentsTNext(d.Nu, d.Delta) }
+
+// RandR returns random number drawn from the noncentral Student's t distribution, using the random source src.
+func (d NoncentralStudentsTDist) RandR(src *rand.Rand) float64 {
+	return NoncentralStudentsTNextR(src, d.Nu, d.Delta)
+}
+
+// Mean returns the mean of the noncentral Student's t distribution.
+func (d NoncentralStudentsTDist) Mean() float64 { return NoncentralStudentsTMean(d.Nu, d.Delta) }
+
+// Var returns the variance of the noncentral Student's t distribution.
+func (d NoncentralStudentsTDist) Var() float64 { return NoncentralStudentsTVar(d.Nu, d.Delta) }
+
+// Skew returns the skewness of the noncentral Student's t distribution.
+func (d NoncentralStudentsTDist) Skew() float64 { return NoncentralStudentsTSkew(d.Nu, d.Delta) }
+
+// ExKurt returns the excess kurtosis of the noncentral Student's t distribution.
+func (d NoncentralStudentsTDist) ExKurt() float64 { return NoncentralStudentsTExKurt(d.Nu, d.Delta) }
+
+// Entropy returns the differential entropy of the noncentral Student's t distribution.
+func (d NoncentralStudentsTDist) Entropy() float64 { return NoncentralStudentsTEntropy(d.Nu, d.Delta) }
+
+// CF returns the characteristic function of the noncentral Student's t distribution at t.
+func (d NoncentralStudentsTDist) CF(t float64) complex128 {
+	return NoncentralStudentsTCF(d.Nu, d.Delta, t)
+}
+
+// CGF returns the cumulant generating function of the noncentral Student's t distribution at t.
+func (d NoncentralStudentsTDist) CGF(t float64) float64 {
+	return NoncentralStudentsTCGF(d.Nu, d.Delta, t)
+}
+
+// Support returns the support of the noncentral Student's t distribution.
+func (d NoncentralStudentsTDist) Support() (a, b float64) { return negInf, posInf }
@@ -0,0 +1,206 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Upper tails and log-probabilities.
+// Besides XxxCDF, every family provides XxxCDFTail(..., lowerTail, logP bool), which returns
+// the lower-tail probability P[X ≤ x] if lowerTail, the upper-tail (survival) probability P[X > x] otherwise,
+// and its natural logarithm if logP. XxxQtlTail(..., lowerTail, logP bool) is the inverse,
+// accepting the probability on the same tail and scale. These mirror the lower_tail and log_p arguments of R.
+// Where the family allows it, the tail in question is computed directly, not as 1 - CDF,
+// so that probabilities far out in the tails keep their precision.
+
+// pTail returns the lower-tail probability p on the tail and scale selected by lowerTail and logP; precision lost in 1 - p is not recovered.
+func pTail(p float64, lowerTail, logP bool) float64 {
+	if !lowerTail {
+		if logP {
+			return log1p(-p)
+		}
+		return 0.5 - p + 0.5
+	}
+	if logP {
+		return log(p)
+	}
+	return p
+}
+
+// pTail2 returns the probability on the tail and scale selected by lowerTail and logP, given both tails, lower and upper, each computed directly.
+func pTail2(lower, upper float64, lowerTail, logP bool) float64 {
+	p := upper
+	if lowerTail {
+		p = lower
+	}
+	if logP {
+		return log(p)
+	}
+	return p
+}
+
+// pTailLn returns the probability on the tail and scale selected by lowerTail and logP, given the logarithms of both tails.
+func pTailLn(lnLower, lnUpper float64, lowerTail, logP bool) float64 {
+	p := lnUpper
+	if lowerTail {
+		p = lnLower
+	}
+	if logP {
+		return p
+	}
+	return exp(p)
+}
+
+// pTailLnUpper returns the probability on the tail and scale selected by lowerTail and logP, given the logarithm of the upper tail.
+func pTailLnUpper(lnUpper float64, lowerTail, logP bool) float64 {
+	if lowerTail {
+		if logP {
+			return log1Exp(lnUpper)
+		}
+		return -expm1(lnUpper)
+	}
+	if logP {
+		return lnUpper
+	}
+	return exp(lnUpper)
+}
+
+// pTailLnLower returns the probability on the tail and scale selected by lowerTail and logP, given the logarithm of the lower tail.
+func pTailLnLower(lnLower float64, lowerTail, logP bool) float64 {
+	return pTailLnUpper(lnLower, !lowerTail, logP)
+}
+
+// pTailBounds returns the probability on the tail and scale selected by lowerTail and logP
+// of the lower end (top == false) or of the upper end (top == true) of the support.
+func pTailBounds(top, lowerTail, logP bool) float64 {
+	if top == lowerTail {
+		if logP {
+			return 0
+		}
+		return 1
+	}
+	if logP {
+		return negInf
+	}
+	return 0
+}
+
+// pValid reports whether p is a probability on the scale selected by logP.
+func pValid(p float64, logP bool) bool {
+	if logP {
+		return p <= 0
+	}
+	return p >= 0 && p <= 1
+}
+
+// pLinear returns the probability p, given as logarithm if logP, on the linear scale.
+func pLinear(p float64, logP bool) float64 {
+	if logP {
+		return exp(p)
+	}
+	return p
+}
+
+// pLower returns the lower-tail probability, given p on the tail and scale selected by lowerTail and logP.
+func pLower(p float64, lowerTail, logP bool) float64 {
+	if logP {
+		if lowerTail {
+			return exp(p)
+		}
+		return -expm1(p)
+	}
+	if lowerTail {
+		return p
+	}
+	return 0.5 - p + 0.5
+}
+
+// pUpper returns the upper-tail probability, given p on the tail and scale selected by lowerTail and logP.
+func pUpper(p float64, lowerTail, logP bool) float64 {
+	return pLower(p, !lowerTail, logP)
+}
+
+// pLnLower returns the logarithm of the lower-tail probability, given p on the tail and scale selected by lowerTail and logP.
+func pLnLower(p float64, lowerTail, logP bool) float64 {
+	if lowerTail {
+		if logP {
+			return p
+		}
+		return log(p)
+	}
+	if logP {
+		return log1Exp(p)
+	}
+	return log1p(-p)
+}
+
+// pLnUpper returns the logarithm of the upper-tail probability, given p on the tail and scale selected by lowerTail and logP.
+func pLnUpper(p float64, lowerTail, logP bool) float64 {
+	return pLnLower(p, !lowerTail, logP)
+}
+
+// pEdge reports whether p is the probability of the lower end (top == false) or of the upper end (top == true) of the support.
+func pEdge(p float64, top, lowerTail, logP bool) bool {
+	return p == pTailBounds(top, lowerTail, logP)
+}
+
+// cdfTail returns the CDF on the tail and scale selected by lowerTail and logP, from the lower-tail CDF;
+// precision lost in 1 - CDF is not recovered. It serves families with no better formula for the upper tail.
+func cdfTail(cdf func(x float64) float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		return pTail(cdf(x), lowerTail, logP)
+	}
+}
+
+// cdfTailInt is cdfTail for a discrete CDF.
+func cdfTailInt(cdf func(k int64) float64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		return pTail(cdf(k), lowerTail, logP)
+	}
+}
+
+// qtlTail inverts the CDF cdf, given on the tail and scale selected by lowerTail and logP, by bisection on [a, b].
+func qtlTail(cdf func(x float64) float64, p, a, b float64, lowerTail, logP bool) float64 {
+	if isNaN(p) || !pValid(p, logP) {
+		return NaN
+	}
+	if pEdge(p, false, lowerTail, logP) {
+		return a
+	}
+	if pEdge(p, true, lowerTail, logP) {
+		return b
+	}
+	if lowerTail {
+		return bisectFn(cdf, p, a, b)
+	}
+	// the upper tail decreases, so bisect its negative
+	return bisectFn(func(x float64) float64 { return -cdf(x) }, -p, a, b)
+}
+
+// qtlSearchTail inverts the discrete CDF cdf, given on the tail and scale selected by lowerTail and logP,
+// searching upwards from the lower end a of the support {a, ..., b}: it returns the smallest k with P[X ≤ k] ≥ p.
+// It panics with ErrQtlProb for an invalid p, and with ErrQtlParam if the CDF is NaN, as it is for invalid parameters.
+func qtlSearchTail(cdf func(k int64) float64, p float64, a, b int64, lowerTail, logP bool) int64 {
+	if isNaN(p) || !pValid(p, logP) {
+		panic(ErrQtlProb)
+	}
+	if isNaN(cdf(a)) {
+		panic(ErrQtlParam)
+	}
+	if pEdge(p, true, lowerTail, logP) {
+		return b
+	}
+	// fuzz to ensure left continuity, as in qtlSearch
+	const fuzz = 64 * eps64
+	if lowerTail {
+		if logP {
+			p *= 1 + fuzz
+		} else {
+			p *= 1 - fuzz
+		}
+		return searchUp(func(k int64) bool { return cdf(k) >= p }, a)
+	}
+	if logP {
+		p *= 1 - fuzz
+	} else {
+		p *= 1 + fuzz
+	}
+	return searchUp(func(k int64) bool { return cdf(k) <= p }, a)
+}
@@ -0,0 +1,398 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Truncated distributions.
+// Any Continuous distribution restricted to [A, B], or Discrete one restricted to {A, ..., B}, and renormalized:
+// TruncDist{GammaDist{2, 1}, 1, posInf} is the Gamma distribution conditioned on x ≥ 1,
+// TruncDiscreteDist{PoissonDist{3}, 1, posInfInt64} the zero-truncated Poisson distribution.
+// Probabilities are taken as differences on the tail where the bounds lie, so that truncation far in the tails keeps its precision.
+// Random numbers are drawn by rejection if the truncated interval is likely enough, by inversion of the CDF otherwise,
+// and by Robert's algorithm for the Normal distribution (TruncNormalNext).
+
+import (
+	"math/rand"
+)
+
+// lnProbBetween returns the logarithm of P[x < X ≤ y], given the logarithms of the CDF and of the survival function at x and y;
+// the difference is taken on the upper tail if x is above the median, on the lower one otherwise.
+func lnProbBetween(lnFx, lnSx, lnFy, lnSy float64) float64 {
+	if lnFx < -Ln2 {
+		if lnFy == negInf {
+			return negInf
+		}
+		return logspace_sub(lnFy, lnFx)
+	}
+	if lnSx == negInf {
+		return negInf
+	}
+	return logspace_sub(lnSx, lnSy)
+}
+
+// TruncDist is the continuous distribution D truncated to [A, B]. It implements Continuous.
+type TruncDist struct {
+	D    Continuous
+	A, B float64
+}
+
+// bounds returns the bounds of the truncated distribution, within the support of D.
+func (t TruncDist) bounds() (a, b float64) {
+	a, b = t.D.Support()
+	return max(a, t.A), min(b, t.B)
+}
+
+// lnMass returns the logarithm of P[x < X ≤ y] under D, for x ≤ y within its support.
+func (t TruncDist) lnMass(x, y float64) float64 {
+	a, b := t.D.Support()
+	lnFx, lnSx, lnFy, lnSy := negInf, 0.0, 0.0, negInf
+	if x > a {
+		lnFx, lnSx = t.D.LnCDF(x), t.D.LnSurv(x)
+	}
+	if y < b {
+		lnFy, lnSy = t.D.LnCDF(y), t.D.LnSurv(y)
+	}
+	return lnProbBetween(lnFx, lnSx, lnFy, lnSy)
+}
+
+// lnZ returns the logarithm of the probability of [A, B] under D.
+func (t TruncDist) lnZ() float64 {
+	a, b := t.bounds()
+	return t.lnMass(a, b)
+}
+
+// PDF returns the value of PDF of the truncated distribution at x.
+func (t TruncDist) PDF(x float64) float64 { return exp(t.LnPDF(x)) }
+
+// LnPDF returns the natural logarithm of the PDF of the truncated distribution at x.
+func (t TruncDist) LnPDF(x float64) float64 {
+	a, b := t.bounds()
+	if x < a || x > b {
+		return negInf
+	}
+	return t.D.LnPDF(x) - t.lnZ()
+}
+
+// CDF returns the value of CDF of the truncated distribution at x.
+func (t TruncDist) CDF(x float64) float64 { return exp(t.LnCDF(x)) }
+
+// Surv returns the value of the survival function 1 - CDF of the truncated distribution at x.
+func (t TruncDist) Surv(x float64) float64 { return exp(t.LnSurv(x)) }
+
+// LnCDF returns the natural logarithm of the CDF of the truncated distribution at x.
+func (t TruncDist) LnCDF(x float64) float64 {
+	a, b := t.bounds()
+	switch {
+	case x <= a:
+		return negInf
+	case x >= b:
+		return 0
+	}
+	return t.lnMass(a, x) - t.lnZ()
+}
+
+// LnSurv returns the natural logarithm of the survival function of the truncated distribution at x.
+func (t TruncDist) LnSurv(x float64) float64 {
+	a, b := t.bounds()
+	switch {
+	case x <= a:
+		return 0
+	case x >= b:
+		return negInf
+	}
+	return t.lnMass(x, b) - t.lnZ()
+}
+
+// Qtl returns the quantile of the truncated distribution for probability p.
+func (t TruncDist) Qtl(p float64) float64 { return t.QtlTail(p, true, false) }
+
+// QtlTail returns the quantile of the truncated distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (t TruncDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	a, b := t.bounds()
+	switch {
+	case isNaN(p) || !pValid(p, logP):
+		return NaN
+	case pEdge(p, false, lowerTail, logP):
+		return a
+	case pEdge(p, true, lowerTail, logP):
+		return b
+	}
+	// F(x) = F(a) + p Z, or S(x) = S(b) + (1 - p) Z, on the tail where a lies
+	lnZ := t.lnZ()
+	da, db := t.D.Support()
+	lnFa, lnSb := negInf, negInf
+	if a > da {
+		lnFa = t.D.LnCDF(a)
+	}
+	if b < db {
+		lnSb = t.D.LnSurv(b)
+	}
+	var x float64
+	if lnFa < -Ln2 {
+		x = t.D.QtlTail(min(0, logspace_add(lnFa, pLnLower(p, lowerTail, logP)+lnZ)), true, true)
+	} else {
+		x = t.D.QtlTail(min(0, logspace_add(lnSb, pLnUpper(p, lowerTail, logP)+lnZ)), false, true)
+	}
+	return max(a, min(b, x))
+}
+
+// Rand returns random number drawn from the truncated distribution.
+func (t TruncDist) Rand() float64 { return t.RandR(GlobalRand) }
+
+// RandR returns random number drawn from the truncated distribution, using the random source src.
+func (t TruncDist) RandR(src *rand.Rand) float64 {
+	a, b := t.bounds()
+	if d, ok := t.D.(NormalDist); ok {
+		return TruncNormalNextR(src, d.Mu, d.Sigma, a, b)
+	}
+	if t.lnZ() > -Ln2 {
+		// by rejection, in less than two trials on average
+		for {
+			if x := t.D.RandR(src); a <= x && x <= b {
+				return x
+			}
+		}
+	}
+	return t.Qtl(src.Float64())
+}
+
+// unbounded reports whether the truncated distribution keeps an unbounded tail of D.
+func (t TruncDist) unbounded() bool {
+	a, b := t.bounds()
+	return isInf(a, -1) || isInf(b, 1)
+}
+
+// moments returns the mean, variance, skewness and excess kurtosis of the truncated distribution, by numerical integration
+// over all but 1e-15 of each unbounded tail, in the units of the interquartile range from the median.
+func (t TruncDist) moments() (μ, σ2, skew, kurt float64) {
+	a, b := t.bounds()
+	if isInf(a, -1) {
+		a = t.Qtl(1e-15)
+	}
+	if isInf(b, 1) {
+		b = t.QtlTail(1e-15, false, false)
+	}
+	c, s := t.Qtl(0.5), t.Qtl(0.75)-t.Qtl(0.25)
+	if s <= 0 {
+		s = b - a
+	}
+	lnZ := t.lnZ()
+	var m [5]float64
+	for k := range m {
+		m[k] = integrate(func(y float64) float64 {
+			f := exp(t.D.LnPDF(c+s*y)-lnZ) * s
+			if isInf(f, 0) || isNaN(f) {
+				// an integrable singularity at a bound
+				return 0
+			}
+			return pow(y, float64(k)) * f
+		}, (a-c)/s, (b-c)/s)
+	}
+	μ, σ2, skew, kurt = rawMoments(m[1]/m[0], m[2]/m[0], m[3]/m[0], m[4]/m[0])
+	return c + s*μ, s * s * σ2, skew, kurt
+}
+
+// Mean returns the mean of the truncated distribution.
+func (t TruncDist) Mean() float64 {
+	if v := t.D.Mean(); t.unbounded() && (isNaN(v) || isInf(v, 0)) {
+		return v
+	}
+	μ, _, _, _ := t.moments()
+	return μ
+}
+
+// Var returns the variance of the truncated distribution.
+func (t TruncDist) Var() float64 {
+	if v := t.D.Var(); t.unbounded() && (isNaN(v) || isInf(v, 0)) {
+		return v
+	}
+	_, σ2, _, _ := t.moments()
+	return σ2
+}
+
+// Skew returns the skewness of the truncated distribution.
+func (t TruncDist) Skew() float64 {
+	if v := t.D.Skew(); t.unbounded() && (isNaN(v) || isInf(v, 0)) {
+		return v
+	}
+	_, _, s, _ := t.moments()
+	return s
+}
+
+// ExKurt returns the excess kurtosis of the truncated distribution.
+func (t TruncDist) ExKurt() float64 {
+	if v := t.D.ExKurt(); t.unbounded() && (isNaN(v) || isInf(v, 0)) {
+		return v
+	}
+	_, _, _, k := t.moments()
+	return k
+}
+
+// Entropy returns the differential entropy of the truncated distribution.
+func (t TruncDist) Entropy() float64 { return entropyContinuous(t) }
+
+// CF returns the characteristic function of the truncated distribution at s.
+func (t TruncDist) CF(s float64) complex128 { return cfContinuous(t, s) }
+
+// CGF returns the cumulant generating function of the truncated distribution at s, +Inf where that of D diverges on a tail left in full.
+func (t TruncDist) CGF(s float64) float64 {
+	a, b := t.bounds()
+	if (s > 0 && isInf(b, 1) || s < 0 && isInf(a, -1)) && isInf(t.D.CGF(s), 1) {
+		return posInf
+	}
+	return cgfContinuous(t, s)
+}
+
+// Support returns the support of the truncated distribution.
+func (t TruncDist) Support() (a, b float64) { return t.bounds() }
+
+// TruncDiscreteDist is the discrete distribution D truncated to {A, ..., B}. It implements Discrete.
+type TruncDiscreteDist struct {
+	D    Discrete
+	A, B int64
+}
+
+// bounds returns the bounds of the truncated distribution, within the support of D.
+func (t TruncDiscreteDist) bounds() (a, b int64) {
+	a, b = t.D.Support()
+	return imax(a, t.A), imin(b, t.B)
+}
+
+// lnMass returns the logarithm of P[j < K ≤ k] under D, for j < k with j + 1 and k within its support.
+func (t TruncDiscreteDist) lnMass(j, k int64) float64 {
+	a, b := t.D.Support()
+	lnFj, lnSj, lnFk, lnSk := negInf, 0.0, 0.0, negInf
+	if j >= a {
+		lnFj, lnSj = t.D.LnCDF(j), t.D.LnSurv(j)
+	}
+	if k < b {
+		lnFk, lnSk = t.D.LnCDF(k), t.D.LnSurv(k)
+	}
+	return lnProbBetween(lnFj, lnSj, lnFk, lnSk)
+}
+
+// lnZ returns the logarithm of the probability of {A, ..., B} under D.
+func (t TruncDiscreteDist) lnZ() float64 {
+	a, b := t.bounds()
+	return t.lnMass(a-1, b)
+}
+
+// PMF returns the value of PMF of the truncated distribution at k.
+func (t TruncDiscreteDist) PMF(k int64) float64 { return exp(t.LnPMF(k)) }
+
+// LnPMF returns the natural logarithm of the PMF of the truncated distribution at k.
+func (t TruncDiscreteDist) LnPMF(k int64) float64 {
+	a, b := t.bounds()
+	if k < a || k > b {
+		return negInf
+	}
+	return t.D.LnPMF(k) - t.lnZ()
+}
+
+// CDF returns the value of CDF of the truncated distribution at k.
+func (t TruncDiscreteDist) CDF(k int64) float64 { return exp(t.LnCDF(k)) }
+
+// Surv returns the value of the survival function 1 - CDF of the truncated distribution at k.
+func (t TruncDiscreteDist) Surv(k int64) float64 { return exp(t.LnSurv(k)) }
+
+// LnCDF returns the natural logarithm of the CDF of the truncated distribution at k.
+func (t TruncDiscreteDist) LnCDF(k int64) float64 {
+	a, b := t.bounds()
+	switch {
+	case k < a:
+		return negInf
+	case k >= b:
+		return 0
+	}
+	return t.lnMass(a-1, k) - t.lnZ()
+}
+
+// LnSurv returns the natural logarithm of the survival function of the truncated distribution at k.
+func (t TruncDiscreteDist) LnSurv(k int64) float64 {
+	a, b := t.bounds()
+	switch {
+	case k < a:
+		return 0
+	case k >= b:
+		return negInf
+	}
+	return t.lnMass(k, b) - t.lnZ()
+}
+
+// Qtl returns the quantile of the truncated distribution for probability p.
+func (t TruncDiscreteDist) Qtl(p float64) int64 { return t.QtlTail(p, true, false) }
+
+// QtlTail returns the quantile of the truncated distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (t TruncDiscreteDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	a, b := t.bounds()
+	cdf := func(k int64) float64 {
+		if lowerTail {
+			return pTailLn(t.LnCDF(k), 0, true, logP)
+		}
+		return pTailLn(0, t.LnSurv(k), false, logP)
+	}
+	return qtlSearchTail(cdf, p, a, b, lowerTail, logP)
+}
+
+// Rand returns random number drawn from the truncated distribution.
+func (t TruncDiscreteDist) Rand() int64 { return t.RandR(GlobalRand) }
+
+// RandR returns random number drawn from the truncated distribution, using the random source src.
+func (t TruncDiscreteDist) RandR(src *rand.Rand) int64 {
+	a, b := t.bounds()
+	if t.lnZ() > -2*Ln2 {
+		// by rejection, in less than four trials on average
+		for {
+			if k := t.D.RandR(src); a <= k && k <= b {
+				return k
+			}
+		}
+	}
+	return t.Qtl(src.Float64())
+}
+
+// moments returns the mean, variance, skewness and excess kurtosis of the truncated distribution, summing over all but 1e-17 of an unbounded tail.
+func (t TruncDiscreteDist) moments() (μ, σ2, skew, kurt float64) {
+	a, b := t.bounds()
+	return discreteMoments(t.PMF, a, imin(b, t.QtlTail(1e-17, false, false)))
+}
+
+// Mean returns the mean of the truncated distribution.
+func (t TruncDiscreteDist) Mean() float64 {
+	μ, _, _, _ := t.moments()
+	return μ
+}
+
+// Var returns the variance of the truncated distribution.
+func (t TruncDiscreteDist) Var() float64 {
+	_, σ2, _, _ := t.moments()
+	return σ2
+}
+
+// Skew returns the skewness of the truncated distribution.
+func (t TruncDiscreteDist) Skew() float64 {
+	_, _, s, _ := t.moments()
+	return s
+}
+
+// ExKurt returns the excess kurtosis of the truncated distribution.
+func (t TruncDiscreteDist) ExKurt() float64 {
+	_, _, _, k := t.moments()
+	return k
+}
+
+// Entropy returns the Shannon entropy of the truncated distribution.
+func (t TruncDiscreteDist) Entropy() float64 { return entropyDiscrete(t) }
+
+// CF returns the characteristic function of the truncated distribution at s.
+func (t TruncDiscreteDist) CF(s float64) complex128 { return cfDiscrete(t, s) }
+
+// CGF returns the cumulant generating function of the truncated distribution at s, +Inf where that of D diverges on the right tail left in full.
+func (t TruncDiscreteDist) CGF(s float64) float64 {
+	if _, b := t.bounds(); s > 0 && b == posInfInt64 && isInf(t.D.CGF(s), 1) {
+		return posInf
+	}
+	return cgfDiscrete(t, s)
+}
+
+// Support returns the support of the truncated distribution.
+func (t TruncDiscreteDist) Support() (a, b int64) { return t.bounds() }
@@ -0,0 +1,90 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Truncated normal distribution, random numbers.
+// The Normal distribution restricted to [a, b]; TruncDist{NormalDist{μ, σ}, a, b} gives its PDF, CDF, quantiles and moments,
+// and draws its random numbers by TruncNormalNext, efficient however far in the tails the interval lies.
+//
+// Parameters:
+// μ ∈ R		location of the untruncated distribution
+// σ > 0		scale of the untruncated distribution
+// a < b		bounds, possibly infinite
+//
+// Support:
+// x ∈ [a; b]
+
+import (
+	"math/rand"
+)
+
+// truncStdNormalNextR returns random number drawn from the Standard normal distribution truncated to [a, b], using the random source src.
+// Robert, C. P. (1995). Simulation of truncated normal variables. Statistics and Computing 5, 121-125.
+func truncStdNormalNextR(src *rand.Rand, a, b float64) float64 {
+	if b <= 0 {
+		return -truncStdNormalNextR(src, -b, -a)
+	}
+	if a < 0 {
+		// the interval holds the mode
+		if b-a >= sqrt(2*π) {
+			for {
+				if z := normalZig(src); a <= z && z <= b {
+					return z
+				}
+			}
+		}
+		for {
+			z := a + (b-a)*src.Float64()
+			if src.Float64() <= exp(-z*z/2) {
+				return z
+			}
+		}
+	}
+	// 0 ≤ a < b: the optimal translated exponential proposal, unless the interval is short enough for the uniform one
+	λ := (a + sqrt(a*a+4)) / 2
+	if b-a < exp((a*a-a*sqrt(a*a+4))/4+0.5)/λ {
+		for {
+			z := a + (b-a)*src.Float64()
+			if src.Float64() <= exp((a*a-z*z)/2) {
+				return z
+			}
+		}
+	}
+	for {
+		z := a + expZig(src)/λ
+		if z <= b && src.Float64() <= exp(-(z-λ)*(z-λ)/2) {
+			return z
+		}
+	}
+}
+
+// TruncNormalNext returns random number drawn from the Normal distribution truncated to [a, b].
+func TruncNormalNext(μ, σ, a, b float64) float64 { return TruncNormalNextR(GlobalRand, μ, σ, a, b) }
+
+// TruncNormalNextR returns random number drawn from the Normal distribution truncated to [a, b], using the random source src.
+func TruncNormalNextR(src *rand.Rand, μ, σ, a, b float64) float64 {
+	if σ <= 0 || !(a < b) {
+		return NaN
+	}
+	return μ + σ*truncStdNormalNextR(src, (a-μ)/σ, (b-μ)/σ)
+}
+
+// TruncNormal returns the random number generator with  Normal distribution truncated to [a, b].
+func TruncNormal(μ, σ, a, b float64) func() float64 { return TruncNormalR(GlobalRand, μ, σ, a, b) }
+
+// TruncNormalR returns the random number generator with  Normal distribution truncated to [a, b], using the random source src.
+func TruncNormalR(src *rand.Rand, μ, σ, a, b float64) func() float64 {
+	return func() float64 { return TruncNormalNextR(src, μ, σ, a, b) }
+}
+
+// TruncNormalFill fills x with random numbers drawn from the Normal distribution truncated to [a, b].
+func TruncNormalFill(μ, σ, a, b float64, x []float64) {
+	TruncNormalFillR(GlobalRand, μ, σ, a, b, x)
+}
+
+// TruncNormalFillR fills x with random numbers drawn from the Normal distribution truncated to [a, b], using the random source src.
+func TruncNormalFillR(src *rand.Rand, μ, σ, a, b float64, x []float64) {
+	for i := range x {
+		x[i] = TruncNormalNextR(src, μ, σ, a, b)
+	}
+}
@@ -29,7 +29,7 @@ func UniformPDF(a, b float64) func(x float64) float64 {
 // UniformLnPDF returns the natural logarithm of the PDF of the Uniform distribution. 
 func UniformLnPDF(a, b float64) func(x float64) float64 {
 	return func(x float64) float64 {
-		if a <= x && x <= a {
+		if a <= x && x <= b {
 			return log(1 / (b - a))
 		}
 		return negInf
@@ -61,14 +61,78 @@ func UniformCDFAt(a, b, x float64) float64 {
 	return cdf(x)
 }
 
+// UniformCDFTail returns the CDF of the Uniform distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func UniformCDFTail(a, b float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		switch {
+		case x < a:
+			return pTailBounds(false, lowerTail, logP)
+		case x > b:
+			return pTailBounds(true, lowerTail, logP)
+		}
+		return pTail2((x-a)/(b-a), (b-x)/(b-a), lowerTail, logP)
+	}
+}
+
+// UniformQtl returns the inverse of the CDF (quantile) of the Uniform distribution.
+func UniformQtl(a, b float64) func(p float64) float64 {
+	return func(p float64) float64 {
+		if p < 0 || p > 1 {
+			return NaN
+		}
+		return a + p*(b-a)
+	}
+}
+
+// UniformQtlFor returns the inverse of the CDF (quantile) of the Uniform distribution, for given probability.
+func UniformQtlFor(a, b, p float64) float64 {
+	qtl := UniformQtl(a, b)
+	return qtl(p)
+}
+
+// UniformQtlTail returns the inverse of UniformCDFTail (quantile) of the Uniform distribution.
+func UniformQtlTail(a, b float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		if lowerTail {
+			return a + pLinear(p, logP)*(b-a)
+		}
+		return b - pLinear(p, logP)*(b-a)
+	}
+}
+
 // UniformNext returns random number drawn from the Uniform distribution. 
 func UniformNext(a, b float64) float64 {
-	return a + (b-a)*rand.Float64()
+	return UniformNextR(GlobalRand, a, b)
+}
+
+// UniformNextR returns random number drawn from the Uniform distribution, using the random source src.
+func UniformNextR(src *rand.Rand, a, b float64) float64 {
+	return a + (b-a)*src.Float64()
 }
 
 // Uniform returns the random number generator with  Uniform distribution. 
 func Uniform(a, b float64) func() float64 {
-	return func() float64 { return UniformNext(a, b) }
+	return UniformR(GlobalRand, a, b)
+}
+
+// UniformR returns the random number generator with  Uniform distribution, using the random source src.
+func UniformR(src *rand.Rand, a, b float64) func() float64 {
+	return func() float64 { return UniformNextR(src, a, b) }
+}
+
+// UniformFill fills x with random numbers drawn from the Uniform distribution.
+func UniformFill(a, b float64, x []float64) {
+	UniformFillR(GlobalRand, a, b, x)
+}
+
+// UniformFillR fills x with random numbers drawn from the Uniform distribution, using the random source src.
+func UniformFillR(src *rand.Rand, a, b float64, x []float64) {
+	for i := range x {
+		x[i] = UniformNextR(src, a, b)
+	}
 }
 
 // UniformMean returns the mean of the Uniform distribution. 
@@ -101,6 +165,31 @@ func UniformExKurt(a, b float64) float64 {
 	return -6.0 / 5
 }
 
+// UniformEntropy returns the differential entropy of the Uniform distribution.
+func UniformEntropy(a, b float64) float64 {
+	return log(b - a)
+}
+
+// UniformCF returns the characteristic function of the Uniform distribution.
+func UniformCF(a, b, t float64) complex128 {
+	// e^{it(a+b)/2} sin(h) / h, with h = t(b-a)/2
+	h, s := t*(b-a)/2, fOne
+	if h != 0 {
+		s = sin(h) / h
+	}
+	return cexp(complex(0, t*(a+b)/2)) * complex(s, 0)
+}
+
+// UniformCGF returns the cumulant generating function of the Uniform distribution.
+func UniformCGF(a, b, t float64) float64 {
+	// t(a+b)/2 + ln(sinh(h) / h), with h = |t|(b-a)/2
+	h := abs(t) * (b - a) / 2
+	if h == 0 {
+		return 0
+	}
+	return t*(a+b)/2 + h + log(-expm1(-2*h)/(2*h))
+}
+
 // UniformMGF returns the moment-generating function of the Uniform distribution. 
 func UniformMGF(a, b, t float64) float64 {
 	return (exp(t*b) - exp(t*a)) / (t * (b - a))
@@ -130,3 +219,89 @@ func UniformReparamMeanStd(mean, std float64) (a, b float64) {
 	b = 1.7320508075688771*std + mean
 	return
 }
+
+// UniformFromMeanStd returns the Uniform distribution with mean μ and standard deviation σ.
+func UniformFromMeanStd(μ, σ float64) UniformDist {
+	if σ <= 0 {
+		return UniformDist{NaN, NaN}
+	}
+	a, b := UniformReparamMeanStd(μ, σ)
+	return UniformDist{a, b}
+}
+
+// UniformMatchQtls returns the Uniform distribution whose quantiles for the probabilities p best match x.
+func UniformMatchQtls(p, x []float64) UniformDist {
+	// the quantiles are linear in p
+	a, b := matchLine(p, x)
+	e := matchQtls(func(θ []float64) Continuous { return UniformDist{θ[0], θ[1]} }, p, x, []float64{a, a + b}, []mleBound{mleReal, mleReal})
+	return UniformDist{e[0], e[1]}
+}
+
+// UniformFit returns the maximum-likelihood estimates of a, b of the Uniform distribution from the sample x: its minimum and maximum,
+// on the bounds of the likelihood, so that their standard errors are NaN.
+func UniformFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return UniformLnPDF(θ[0], θ[1]) })
+	a, b := mleMinMax(x)
+	return mleAtFixed(lnL, []float64{a, b}, mleAllFixed(2))
+}
+
+// UniformDist is the continuous Uniform distribution on [A, B]. It implements Continuous.
+type UniformDist struct {
+	A, B float64
+}
+
+// PDF returns the value of PDF of the Uniform distribution at x.
+func (d UniformDist) PDF(x float64) float64 { return UniformPDFAt(d.A, d.B, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Uniform distribution at x.
+func (d UniformDist) LnPDF(x float64) float64 { return UniformLnPDF(d.A, d.B)(x) }
+
+// CDF returns the value of CDF of the Uniform distribution at x.
+func (d UniformDist) CDF(x float64) float64 { return UniformCDFAt(d.A, d.B, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Uniform distribution at x.
+func (d UniformDist) Surv(x float64) float64 { return UniformCDFTail(d.A, d.B, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the Uniform distribution at x.
+func (d UniformDist) LnCDF(x float64) float64 { return UniformCDFTail(d.A, d.B, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the Uniform distribution at x.
+func (d UniformDist) LnSurv(x float64) float64 { return UniformCDFTail(d.A, d.B, false, true)(x) }
+
+// Qtl returns the quantile of the Uniform distribution for probability p.
+func (d UniformDist) Qtl(p float64) float64 { return UniformQtlFor(d.A, d.B, p) }
+
+// QtlTail returns the quantile of the Uniform distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d UniformDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return UniformQtlTail(d.A, d.B, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Uniform distribution.
+func (d UniformDist) Rand() float64 { return UniformNext(d.A, d.B) }
+
+// RandR returns random number drawn from the Uniform distribution, using the random source src.
+func (d UniformDist) RandR(src *rand.Rand) float64 { return UniformNextR(src, d.A, d.B) }
+
+// Mean returns the mean of the Uniform distribution.
+func (d UniformDist) Mean() float64 { return UniformMean(d.A, d.B) }
+
+// Var returns the variance of the Uniform distribution.
+func (d UniformDist) Var() float64 { return UniformVar(d.A, d.B) }
+
+// Skew returns the skewness of the Uniform distribution.
+func (d UniformDist) Skew() float64 { return UniformSkew(d.A, d.B) }
+
+// ExKurt returns the excess kurtosis of the Uniform distribution.
+func (d UniformDist) ExKurt() float64 { return UniformExKurt(d.A, d.B) }
+
+// Entropy returns the differential entropy of the Uniform distribution.
+func (d UniformDist) Entropy() float64 { return UniformEntropy(d.A, d.B) }
+
+// CF returns the characteristic function of the Uniform distribution at t.
+func (d UniformDist) CF(t float64) complex128 { return UniformCF(d.A, d.B, t) }
+
+// CGF returns the cumulant generating function of the Uniform distribution at t.
+func (d UniformDist) CGF(t float64) float64 { return UniformCGF(d.A, d.B, t) }
+
+// Support returns the support of the Uniform distribution.
+func (d UniformDist) Support() (a, b float64) { return d.A, d.B }
@@ -0,0 +1,314 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// von Mises distribution (circular normal distribution).
+// The close analogue of the Normal distribution on the circle; the angle of a wind direction, or the time of the day of an event, are typical examples. It is the Uniform distribution on the circle if κ = 0.
+//
+// Parameters:
+// μ ∈ R		mean direction
+// κ ≥ 0		concentration
+//
+// Support:
+// x ∈ [μ - π; μ + π]
+
+import (
+	"math/rand"
+)
+
+// vonMisesMoments returns the trigonometric moments a_j = I_j(κ) / I_0(κ) of the von Mises distribution, up to negligible ones.
+func vonMisesMoments(κ float64) []float64 {
+	if !(κ > 0) {
+		return nil
+	}
+	// a_j ≈ exp(-j² / 2κ) for large κ
+	n := 16 + int(10*sqrt(κ))
+	// ratios r_j = I_j / I_{j-1} by the backward recurrence r_j = 1 / (2j / κ + r_{j+1})
+	a := make([]float64, n)
+	r := 0.0
+	for j := 2*n + 20; j > 0; j-- {
+		r = 1 / (2*float64(j)/κ + r)
+		if j <= n {
+			a[j-1] = r
+		}
+	}
+	for j := 1; j < n; j++ {
+		a[j] *= a[j-1]
+	}
+	return a
+}
+
+// VonMisesPDF returns the PDF of the von Mises distribution.
+func VonMisesPDF(μ, κ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if κ < 0 {
+			return NaN
+		}
+		θ := x - μ
+		if θ < -π || θ > π {
+			return 0
+		}
+		return exp(κ*(cos(θ)-1)) / (2 * π * besselIe(0, κ))
+	}
+}
+
+// VonMisesLnPDF returns the natural logarithm of the PDF of the von Mises distribution.
+func VonMisesLnPDF(μ, κ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if κ < 0 {
+			return NaN
+		}
+		θ := x - μ
+		if θ < -π || θ > π {
+			return negInf
+		}
+		return κ*(cos(θ)-1) - log(2*π*besselIe(0, κ))
+	}
+}
+
+// VonMisesPDFAt returns the value of PDF of von Mises distribution at x.
+func VonMisesPDFAt(μ, κ, x float64) float64 {
+	pdf := VonMisesPDF(μ, κ)
+	return pdf(x)
+}
+
+// VonMisesCDF returns the CDF of the von Mises distribution.
+func VonMisesCDF(μ, κ float64) func(x float64) float64 {
+	return VonMisesCDFTail(μ, κ, true, false)
+}
+
+// VonMisesCDFAt returns the value of CDF of the von Mises distribution, at x.
+func VonMisesCDFAt(μ, κ, x float64) float64 {
+	cdf := VonMisesCDF(μ, κ)
+	return cdf(x)
+}
+
+// VonMisesCDFTail returns the CDF of the von Mises distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func VonMisesCDFTail(μ, κ float64, lowerTail, logP bool) func(x float64) float64 {
+	a := vonMisesMoments(κ)
+	return func(x float64) float64 {
+		if κ < 0 {
+			return NaN
+		}
+		// the upper tail by symmetry
+		θ := x - μ
+		return pTail2(circCDF(a, θ), circCDF(a, -θ), lowerTail, logP)
+	}
+}
+
+// VonMisesQtl returns the inverse of the CDF (quantile) of the von Mises distribution.
+func VonMisesQtl(μ, κ float64) func(p float64) float64 {
+	return VonMisesQtlTail(μ, κ, true, false)
+}
+
+// VonMisesQtlFor returns the inverse of the CDF (quantile) of the von Mises distribution, for given probability.
+func VonMisesQtlFor(μ, κ, p float64) float64 {
+	qtl := VonMisesQtl(μ, κ)
+	return qtl(p)
+}
+
+// VonMisesQtlTail returns the inverse of VonMisesCDFTail (quantile) of the von Mises distribution.
+func VonMisesQtlTail(μ, κ float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := VonMisesCDFTail(μ, κ, lowerTail, logP)
+	return func(p float64) float64 {
+		if κ < 0 {
+			return NaN
+		}
+		return qtlTail(cdf, p, μ-π, μ+π, lowerTail, logP)
+	}
+}
+
+// VonMisesNext returns random number drawn from the von Mises distribution.
+func VonMisesNext(μ, κ float64) float64 { return VonMisesNextR(GlobalRand, μ, κ) }
+
+// VonMisesNextR returns random number drawn from the von Mises distribution, using the random source src.
+func VonMisesNextR(src *rand.Rand, μ, κ float64) float64 {
+	// Best, D. J., Fisher, N. I. (1979). Efficient simulation of the von Mises distribution. Applied Statistics 28, 152-157.
+	if κ < 1e-8 {
+		return μ + π*(2*src.Float64()-1)
+	}
+	τ := 1 + sqrt(1+4*κ*κ)
+	ρ := (τ - sqrt(2*τ)) / (2 * κ)
+	r := (1 + ρ*ρ) / (2 * ρ)
+	var f float64
+	for {
+		z := cos(π * src.Float64())
+		f = (1 + r*z) / (r + z)
+		c := κ * (r - f)
+		u := src.Float64()
+		if c*(2-c) > u || log(c/u)+1-c >= 0 {
+			break
+		}
+	}
+	if src.Float64() < 0.5 {
+		return μ - acos(f)
+	}
+	return μ + acos(f)
+}
+
+// VonMises returns the random number generator with  von Mises distribution.
+func VonMises(μ, κ float64) func() float64 { return VonMisesR(GlobalRand, μ, κ) }
+
+// VonMisesR returns the random number generator with  von Mises distribution, using the random source src.
+func VonMisesR(src *rand.Rand, μ, κ float64) func() float64 {
+	return func() float64 { return VonMisesNextR(src, μ, κ) }
+}
+
+// VonMisesFill fills x with random numbers drawn from the von Mises distribution.
+func VonMisesFill(μ, κ float64, x []float64) {
+	VonMisesFillR(GlobalRand, μ, κ, x)
+}
+
+// VonMisesFillR fills x with random numbers drawn from the von Mises distribution, using the random source src.
+func VonMisesFillR(src *rand.Rand, μ, κ float64, x []float64) {
+	for i := range x {
+		x[i] = VonMisesNextR(src, μ, κ)
+	}
+}
+
+// VonMisesCircMean returns the circular mean (mean direction) of the von Mises distribution, in [-π; π).
+func VonMisesCircMean(μ, κ float64) float64 {
+	return wrapAngle(μ)
+}
+
+// VonMisesCircVar returns the circular variance 1 - E[cos(θ - μ)] of the von Mises distribution.
+func VonMisesCircVar(μ, κ float64) float64 {
+	return 1 - besselIe(1, κ)/besselIe(0, κ)
+}
+
+// VonMisesMean returns the mean of the von Mises distribution.
+func VonMisesMean(μ, κ float64) float64 {
+	return μ
+}
+
+// VonMisesMedian returns the median of the von Mises distribution.
+func VonMisesMedian(μ, κ float64) float64 {
+	return μ
+}
+
+// VonMisesMode returns the mode of the von Mises distribution.
+func VonMisesMode(μ, κ float64) float64 {
+	return μ
+}
+
+// VonMisesVar returns the variance of the von Mises distribution.
+func VonMisesVar(μ, κ float64) float64 {
+	v, _ := circVarKurt(vonMisesMoments(κ))
+	return v
+}
+
+// VonMisesStd returns the standard deviation of the von Mises distribution.
+func VonMisesStd(μ, κ float64) float64 {
+	return sqrt(VonMisesVar(μ, κ))
+}
+
+// VonMisesSkew returns the skewness of the von Mises distribution.
+func VonMisesSkew(μ, κ float64) float64 {
+	return 0
+}
+
+// VonMisesExKurt returns the excess kurtosis of the von Mises distribution.
+func VonMisesExKurt(μ, κ float64) float64 {
+	_, k := circVarKurt(vonMisesMoments(κ))
+	return k
+}
+
+// VonMisesEntropy returns the differential entropy of the von Mises distribution.
+func VonMisesEntropy(μ, κ float64) float64 {
+	i0, i1 := besselIe(0, κ), besselIe(1, κ)
+	// the exponentially scaled Bessel functions: ln I0(κ) = ln i0 + κ
+	return log(2*π*i0) + κ*(1-i1/i0)
+}
+
+// VonMisesCF returns the characteristic function of the von Mises distribution on [μ-π, μ+π], by numerical integration for t not an integer.
+func VonMisesCF(μ, κ, t float64) complex128 {
+	if t == trunc(t) {
+		// the trigonometric moment e^{itμ} I_t(κ) / I_0(κ)
+		return cexp(complex(0, t*μ)) * complex(besselIe(abs(t), κ)/besselIe(0, κ), 0)
+	}
+	return cfLnPDF(VonMisesLnPDF(μ, κ), VonMisesQtl(μ, κ), μ-π, μ+π, t)
+}
+
+// VonMisesCGF returns the cumulant generating function of the von Mises distribution on [μ-π, μ+π], by numerical integration.
+func VonMisesCGF(μ, κ, t float64) float64 {
+	return cgfLnPDF(VonMisesLnPDF(μ, κ), VonMisesQtl(μ, κ), μ-π, μ+π, t)
+}
+
+// VonMisesMGF is not defined for the angles; see VonMisesCircMean and VonMisesCircVar for the trigonometric moments.
+
+// VonMisesFit returns the maximum-likelihood estimates of μ, κ of the von Mises distribution from the sample of angles x, starting from its mean direction.
+func VonMisesFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return circLnPDF(θ[0], VonMisesLnPDF(θ[0], θ[1])) })
+	μ, R := circSampleMean(x)
+	// Banerjee's approximation of the inverse of I1(κ) / I0(κ)
+	return mleFit(lnL, []float64{μ, R * (2 - R*R) / (1 - R*R)}, []mleBound{mleReal, mlePos})
+}
+
+// VonMisesDist is the von Mises distribution with mean direction μ = Mu and concentration κ = Kappa. It implements Continuous.
+type VonMisesDist struct {
+	Mu, Kappa float64
+}
+
+// PDF returns the value of PDF of the von Mises distribution at x.
+func (d VonMisesDist) PDF(x float64) float64 { return VonMisesPDFAt(d.Mu, d.Kappa, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the von Mises distribution at x.
+func (d VonMisesDist) LnPDF(x float64) float64 { return VonMisesLnPDF(d.Mu, d.Kappa)(x) }
+
+// CDF returns the value of CDF of the von Mises distribution at x.
+func (d VonMisesDist) CDF(x float64) float64 { return VonMisesCDFAt(d.Mu, d.Kappa, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the von Mises distribution at x.
+func (d VonMisesDist) Surv(x float64) float64 { return VonMisesCDFTail(d.Mu, d.Kappa, false, false)(x) }
+
+// LnCDF returns the natural logarithm of the CDF of the von Mises distribution at x.
+func (d VonMisesDist) LnCDF(x float64) float64 { return VonMisesCDFTail(d.Mu, d.Kappa, true, true)(x) }
+
+// LnSurv returns the natural logarithm of the survival function of the von Mises distribution at x.
+func (d VonMisesDist) LnSurv(x float64) float64 {
+	return VonMisesCDFTail(d.Mu, d.Kappa, false, true)(x)
+}
+
+// Qtl returns the quantile of the von Mises distribution for probability p.
+func (d VonMisesDist) Qtl(p float64) float64 { return VonMisesQtlFor(d.Mu, d.Kappa, p) }
+
+// QtlTail returns the quantile of the von Mises distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d VonMisesDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return VonMisesQtlTail(d.Mu, d.Kappa, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the von Mises distribution.
+func (d VonMisesDist) Rand() float64 { return VonMisesNext(d.Mu, d.Kappa) }
+
+// RandR returns random number drawn from the von Mises distribution, using the random source src.
+func (d VonMisesDist) RandR(src *rand.Rand) float64 { return VonMisesNextR(src, d.Mu, d.Kappa) }
+
+// Mean returns the mean of the von Mises distribution.
+func (d VonMisesDist) Mean() float64 { return VonMisesMean(d.Mu, d.Kappa) }
+
+// Var returns the variance of the von Mises distribution.
+func (d VonMisesDist) Var() float64 { return VonMisesVar(d.Mu, d.Kappa) }
+
+// Skew returns the skewness of the von Mises distribution.
+func (d VonMisesDist) Skew() float64 { return VonMisesSkew(d.Mu, d.Kappa) }
+
+// ExKurt returns the excess kurtosis of the von Mises distribution.
+func (d VonMisesDist) ExKurt() float64 { return VonMisesExKurt(d.Mu, d.Kappa) }
+
+// Entropy returns the differential entropy of the von Mises distribution.
+func (d VonMisesDist) Entropy() float64 { return VonMisesEntropy(d.Mu, d.Kappa) }
+
+// CF returns the characteristic function of the von Mises distribution at t.
+func (d VonMisesDist) CF(t float64) complex128 { return VonMisesCF(d.Mu, d.Kappa, t) }
+
+// CGF returns the cumulant generating function of the von Mises distribution at t.
+func (d VonMisesDist) CGF(t float64) float64 { return VonMisesCGF(d.Mu, d.Kappa, t) }
+
+// Support returns the support of the von Mises distribution.
+func (d VonMisesDist) Support() (a, b float64) { return d.Mu - π, d.Mu + π }
+
+// CircMean returns the circular mean (mean direction) of the von Mises distribution.
+func (d VonMisesDist) CircMean() float64 { return VonMisesCircMean(d.Mu, d.Kappa) }
+
+// CircVar returns the circular variance of the von Mises distribution.
+func (d VonMisesDist) CircVar() float64 { return VonMisesCircVar(d.Mu, d.Kappa) }
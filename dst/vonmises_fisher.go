@@ -0,0 +1,189 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// von Mises–Fisher distribution.
+// The analogue of the von Mises distribution on the unit sphere in ℝp, used for directional data; for p = 2 it is the von Mises distribution of the angle.
+//
+// Parameters:
+// μ ∈ ℝp, |μ| = 1	mean direction
+// κ ≥ 0		concentration
+//
+// Support:
+// x ∈ ℝp, |x| = 1
+
+import (
+	"math/rand"
+)
+
+// vonMisesFisherLnC returns the logarithm of the normalizing constant of the von Mises–Fisher distribution on the unit sphere in ℝp.
+func vonMisesFisherLnC(p int, κ float64) float64 {
+	h := float64(p) / 2
+	if κ == 0 {
+		// the inverse of the area of the sphere
+		return LnΓ(h) - Ln2 - h*log(π)
+	}
+	return (h-1)*log(κ) - h*log(2*π) - log(besselIe(h-1, κ)) - κ
+}
+
+// VonMisesFisherPDF returns the PDF of the von Mises–Fisher distribution.
+func VonMisesFisherPDF(μ []float64, κ float64) func(x []float64) float64 {
+	lnPDF := VonMisesFisherLnPDF(μ, κ)
+	return func(x []float64) float64 {
+		return exp(lnPDF(x))
+	}
+}
+
+// VonMisesFisherLnPDF returns the natural logarithm of the PDF of the von Mises–Fisher distribution.
+func VonMisesFisherLnPDF(μ []float64, κ float64) func(x []float64) float64 {
+	p := len(μ)
+	lnC := vonMisesFisherLnC(p, κ)
+	return func(x []float64) float64 {
+		if κ < 0 || p < 2 {
+			return NaN
+		}
+		if len(x) != p {
+			return negInf
+		}
+		dot := 0.0
+		for i := range x {
+			dot += μ[i] * x[i]
+		}
+		return lnC + κ*dot
+	}
+}
+
+// VonMisesFisherPDFAt returns the value of PDF of von Mises–Fisher distribution at x.
+func VonMisesFisherPDFAt(μ []float64, κ float64, x []float64) float64 {
+	pdf := VonMisesFisherPDF(μ, κ)
+	return pdf(x)
+}
+
+// VonMisesFisherNext returns random number drawn from the von Mises–Fisher distribution.
+func VonMisesFisherNext(μ []float64, κ float64) []float64 {
+	return VonMisesFisherNextR(GlobalRand, μ, κ)
+}
+
+// VonMisesFisherNextR returns random number drawn from the von Mises–Fisher distribution, using the random source src.
+// Wood, A. T. A. (1994). Simulation of the von Mises Fisher distribution. Communications in Statistics - Simulation and Computation 23, 157-164.
+func VonMisesFisherNextR(src *rand.Rand, μ []float64, κ float64) []float64 {
+	vs := newVonMisesFisherSampler(μ, κ)
+	x := make([]float64, len(μ))
+	vs.into(src, x)
+	return x
+}
+
+// vonMisesFisherSampler holds the constants of the rejection sampler of the component along μ,
+// and the vector of the Householder reflection taking the last axis to μ.
+type vonMisesFisherSampler struct {
+	κ, d, b, x0, c float64
+	u              []float64
+	uu             float64
+}
+
+// newVonMisesFisherSampler returns the sampler of the von Mises–Fisher distribution.
+func newVonMisesFisherSampler(μ []float64, κ float64) vonMisesFisherSampler {
+	p := len(μ)
+	d := float64(p - 1)
+	b := d / (2*κ + sqrt(4*κ*κ+d*d))
+	x0 := (1 - b) / (1 + b)
+	c := κ*x0 + d*log(1-x0*x0)
+	u := make([]float64, p)
+	copy(u, μ)
+	for i := range u {
+		u[i] = -u[i]
+	}
+	u[p-1] += 1
+	uu := 0.0
+	for i := range u {
+		uu += u[i] * u[i]
+	}
+	return vonMisesFisherSampler{κ, d, b, x0, c, u, uu}
+}
+
+// into stores in x random vector drawn from the von Mises–Fisher distribution, using the random source src.
+func (vs *vonMisesFisherSampler) into(src *rand.Rand, x []float64) {
+	κ, d, b, x0, c := vs.κ, vs.d, vs.b, vs.x0, vs.c
+	p := len(x)
+
+	// the component w along μ, by rejection from a transformed Beta variate
+	var w float64
+	for {
+		z := BetaNextR(src, d/2, d/2)
+		w = (1 - (1+b)*z) / (1 - (1-b)*z)
+		if κ*w+d*log(1-x0*w)-c >= log(src.Float64()) {
+			break
+		}
+	}
+
+	// a uniform direction orthogonal to the last axis, then x = (sqrt(1 - w²) v, w)
+	norm := 0.0
+	for i := 0; i < p-1; i++ {
+		x[i] = normalZig(src)
+		norm += x[i] * x[i]
+	}
+	s := sqrt(1-w*w) / sqrt(norm)
+	for i := 0; i < p-1; i++ {
+		x[i] *= s
+	}
+	x[p-1] = w
+
+	// the Householder reflection taking the last axis to μ
+	u := vs.u
+	ux := 0.0
+	for i := range u {
+		ux += u[i] * x[i]
+	}
+	if vs.uu > 0 {
+		for i := range x {
+			x[i] -= 2 * ux / vs.uu * u[i]
+		}
+	}
+}
+
+// VonMisesFisher returns the random number generator with  von Mises–Fisher distribution.
+func VonMisesFisher(μ []float64, κ float64) func() []float64 {
+	return VonMisesFisherR(GlobalRand, μ, κ)
+}
+
+// VonMisesFisherR returns the random number generator with  von Mises–Fisher distribution, using the random source src.
+func VonMisesFisherR(src *rand.Rand, μ []float64, κ float64) func() []float64 {
+	vs := newVonMisesFisherSampler(μ, κ)
+	return func() []float64 {
+		x := make([]float64, len(μ))
+		vs.into(src, x)
+		return x
+	}
+}
+
+// VonMisesFisherFill fills each row of x with random vector drawn from the von Mises–Fisher distribution.
+func VonMisesFisherFill(μ []float64, κ float64, x [][]float64) {
+	VonMisesFisherFillR(GlobalRand, μ, κ, x)
+}
+
+// VonMisesFisherFillR fills each row of x with random vector drawn from the von Mises–Fisher distribution, using the random source src.
+func VonMisesFisherFillR(src *rand.Rand, μ []float64, κ float64, x [][]float64) {
+	vs := newVonMisesFisherSampler(μ, κ)
+	for _, xi := range x {
+		vs.into(src, xi)
+	}
+}
+
+// VonMisesFisherMean returns the mean of the von Mises–Fisher distribution, A_p(κ) μ with the mean resultant length A_p(κ) = I_{p/2}(κ) / I_{p/2-1}(κ).
+func VonMisesFisherMean(μ []float64, κ float64) []float64 {
+	h := float64(len(μ)) / 2
+	r := 0.0
+	if κ > 0 {
+		r = besselIe(h, κ) / besselIe(h-1, κ)
+	}
+	m := make([]float64, len(μ))
+	for i := range μ {
+		m[i] = r * μ[i]
+	}
+	return m
+}
+
+// VonMisesFisherMode returns the mode of the von Mises–Fisher distribution.
+func VonMisesFisherMode(μ []float64, κ float64) []float64 {
+	return μ
+}
@@ -0,0 +1,385 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Weibull distribution.
+// Models the time to failure when the failure rate changes as a power of time: it decreases if κ < 1, is constant if κ = 1 (Exponential distribution), and increases if κ > 1.
+//
+// Parameters:
+// κ > 0		shape
+// λ > 0		scale
+//
+// Support:
+// x ∈ [0; ∞)
+
+import (
+	"math/rand"
+)
+
+// WeibullPDF returns the PDF of the Weibull distribution.
+func WeibullPDF(κ, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if κ <= 0 || λ <= 0 {
+			return NaN
+		}
+		switch {
+		case x < 0:
+			return 0
+		case x == 0 && κ == 1:
+			return 1 / λ
+		case x == 0 && κ < 1:
+			return posInf
+		case x == 0:
+			return 0
+		}
+		z := x / λ
+		return κ / λ * pow(z, κ-1) * exp(-pow(z, κ))
+	}
+}
+
+// WeibullLnPDF returns the natural logarithm of the PDF of the Weibull distribution.
+func WeibullLnPDF(κ, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if κ <= 0 || λ <= 0 {
+			return NaN
+		}
+		switch {
+		case x < 0:
+			return negInf
+		case x == 0 && κ == 1:
+			return -log(λ)
+		case x == 0 && κ < 1:
+			return posInf
+		case x == 0:
+			return negInf
+		}
+		z := x / λ
+		return log(κ/λ) + (κ-1)*log(z) - pow(z, κ)
+	}
+}
+
+// WeibullPDFAt returns the value of PDF of Weibull distribution at x.
+func WeibullPDFAt(κ, λ, x float64) float64 {
+	pdf := WeibullPDF(κ, λ)
+	return pdf(x)
+}
+
+// WeibullCDF returns the CDF of the Weibull distribution.
+func WeibullCDF(κ, λ float64) func(x float64) float64 {
+	return WeibullCDFTail(κ, λ, true, false)
+}
+
+// WeibullCDFAt returns the value of CDF of the Weibull distribution, at x.
+func WeibullCDFAt(κ, λ, x float64) float64 {
+	cdf := WeibullCDF(κ, λ)
+	return cdf(x)
+}
+
+// WeibullCDFTail returns the CDF of the Weibull distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func WeibullCDFTail(κ, λ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if κ <= 0 || λ <= 0 {
+			return NaN
+		}
+		if x <= 0 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		return pTailLnUpper(-pow(x/λ, κ), lowerTail, logP)
+	}
+}
+
+// WeibullQtl returns the inverse of the CDF (quantile) of the Weibull distribution.
+func WeibullQtl(κ, λ float64) func(p float64) float64 {
+	return WeibullQtlTail(κ, λ, true, false)
+}
+
+// WeibullQtlFor returns the inverse of the CDF (quantile) of the Weibull distribution, for given probability.
+func WeibullQtlFor(κ, λ, p float64) float64 {
+	qtl := WeibullQtl(κ, λ)
+	return qtl(p)
+}
+
+// WeibullQtlTail returns the inverse of WeibullCDFTail (quantile) of the Weibull distribution.
+func WeibullQtlTail(κ, λ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if κ <= 0 || λ <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		return λ * pow(-pLnUpper(p, lowerTail, logP), 1/κ)
+	}
+}
+
+// WeibullNext returns random number drawn from the Weibull distribution.
+func WeibullNext(κ, λ float64) float64 { return WeibullNextR(GlobalRand, κ, λ) }
+
+// WeibullNextR returns random number drawn from the Weibull distribution, using the random source src.
+func WeibullNextR(src *rand.Rand, κ, λ float64) float64 {
+	return λ * pow(expZig(src), 1/κ)
+}
+
+// Weibull returns the random number generator with  Weibull distribution.
+func Weibull(κ, λ float64) func() float64 { return WeibullR(GlobalRand, κ, λ) }
+
+// WeibullR returns the random number generator with  Weibull distribution, using the random source src.
+func WeibullR(src *rand.Rand, κ, λ float64) func() float64 {
+	return func() float64 { return WeibullNextR(src, κ, λ) }
+}
+
+// WeibullFill fills x with random numbers drawn from the Weibull distribution.
+func WeibullFill(κ, λ float64, x []float64) {
+	WeibullFillR(GlobalRand, κ, λ, x)
+}
+
+// WeibullFillR fills x with random numbers drawn from the Weibull distribution, using the random source src.
+func WeibullFillR(src *rand.Rand, κ, λ float64, x []float64) {
+	for i := range x {
+		x[i] = WeibullNextR(src, κ, λ)
+	}
+}
+
+// WeibullHazard returns the hazard function (failure rate) PDF / (1 - CDF) of the Weibull distribution.
+func WeibullHazard(κ, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if κ <= 0 || λ <= 0 {
+			return NaN
+		}
+		if x < 0 {
+			return 0
+		}
+		return κ / λ * pow(x/λ, κ-1)
+	}
+}
+
+// WeibullHazardAt returns the value of the hazard function of the Weibull distribution, at x.
+func WeibullHazardAt(κ, λ, x float64) float64 {
+	h := WeibullHazard(κ, λ)
+	return h(x)
+}
+
+// WeibullMean returns the mean of the Weibull distribution.
+func WeibullMean(κ, λ float64) float64 {
+	return λ * Γ(1+1/κ)
+}
+
+// WeibullMedian returns the median of the Weibull distribution.
+func WeibullMedian(κ, λ float64) float64 {
+	return λ * pow(Ln2, 1/κ)
+}
+
+// WeibullMode returns the mode of the Weibull distribution.
+func WeibullMode(κ, λ float64) float64 {
+	if κ <= 1 {
+		return 0
+	}
+	return λ * pow((κ-1)/κ, 1/κ)
+}
+
+// WeibullVar returns the variance of the Weibull distribution.
+func WeibullVar(κ, λ float64) float64 {
+	g1, g2 := Γ(1+1/κ), Γ(1+2/κ)
+	return λ * λ * (g2 - g1*g1)
+}
+
+// WeibullStd returns the standard deviation of the Weibull distribution.
+func WeibullStd(κ, λ float64) float64 {
+	return sqrt(WeibullVar(κ, λ))
+}
+
+// WeibullSkew returns the skewness of the Weibull distribution.
+func WeibullSkew(κ, λ float64) float64 {
+	g1, g2, g3 := Γ(1+1/κ), Γ(1+2/κ), Γ(1+3/κ)
+	return (g3 - 3*g1*g2 + 2*g1*g1*g1) / pow(g2-g1*g1, 1.5)
+}
+
+// WeibullExKurt returns the excess kurtosis of the Weibull distribution.
+func WeibullExKurt(κ, λ float64) float64 {
+	g1, g2, g3, g4 := Γ(1+1/κ), Γ(1+2/κ), Γ(1+3/κ), Γ(1+4/κ)
+	v := g2 - g1*g1
+	return (g4-4*g1*g3+6*g1*g1*g2-3*g1*g1*g1*g1)/(v*v) - 3
+}
+
+// WeibullEntropy returns the differential entropy of the Weibull distribution.
+func WeibullEntropy(κ, λ float64) float64 {
+	return eulerγ*(1-1/κ) + log(λ/κ) + 1
+}
+
+// WeibullCF returns the characteristic function of the Weibull distribution, by numerical integration.
+func WeibullCF(κ, λ, t float64) complex128 {
+	return cfLnPDF(WeibullLnPDF(κ, λ), WeibullQtl(κ, λ), 0, posInf, t)
+}
+
+// WeibullCGF returns the cumulant generating function of the Weibull distribution.
+func WeibullCGF(κ, λ, t float64) float64 {
+	return log(WeibullMGF(κ, λ, t))
+}
+
+// WeibullMGF returns the moment-generating function of the Weibull distribution.
+func WeibullMGF(κ, λ, t float64) float64 {
+	switch {
+	case t == 0:
+		return 1
+	case t > 0 && (κ < 1 || (κ == 1 && t*λ >= 1)):
+		return posInf
+	case t > 0:
+		// Σ (tλ)^n Γ(1+n/κ) / n!, all terms positive
+		sum := 1.0
+		for n := 1.0; n < 1e5; n++ {
+			term := exp(n*log(t*λ) + LnΓ(1+n/κ) - LnΓ(n+1))
+			sum += term
+			if term < eps64*sum {
+				break
+			}
+		}
+		return sum
+	}
+	// E[exp(tλ U^(1/κ))] with U = -log V, V ~ Uniform(0, 1); the series alternates and cancels for t < 0
+	return integrate(func(v float64) float64 {
+		return exp(t * λ * pow(-log(v), 1/κ))
+	}, 0, 1)
+}
+
+// WeibullFromMeanStd returns the Weibull distribution with mean μ and standard deviation σ.
+func WeibullFromMeanStd(μ, σ float64) WeibullDist {
+	if μ <= 0 || σ <= 0 {
+		return WeibullDist{NaN, NaN}
+	}
+	// the logarithm of 1 plus the squared coefficient of variation, log Γ(1+2/κ) - 2 log Γ(1+1/κ), decreases in κ
+	κ := bisectFn(func(κ float64) float64 { return 2*LnΓ(1+1/κ) - LnΓ(1+2/κ) }, -log1p(σ*σ/(μ*μ)), 0.01, posInf)
+	return WeibullDist{κ, μ / Γ(1+1/κ)}
+}
+
+// WeibullFromModeStd returns the Weibull distribution with mode m > 0 and standard deviation σ.
+func WeibullFromModeStd(m, σ float64) WeibullDist {
+	if m <= 0 || σ <= 0 {
+		return WeibullDist{NaN, NaN}
+	}
+	// the ratio of the standard deviation to the mode decreases in κ > 1
+	κ := bisectFn(func(κ float64) float64 { return -WeibullStd(κ, 1) / WeibullMode(κ, 1) }, -σ/m, 1, posInf)
+	return WeibullDist{κ, m / WeibullMode(κ, 1)}
+}
+
+// WeibullMatchQtls returns the Weibull distribution whose quantiles for the probabilities p best match x.
+func WeibullMatchQtls(p, x []float64) WeibullDist {
+	// log x = log λ + log(-log(1-p)) / κ
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return log(-log1p(-p)) }), matchMap(x, log))
+	e := matchQtls(func(θ []float64) Continuous { return WeibullDist{θ[0], θ[1]} }, p, x, []float64{1 / b, exp(a)}, []mleBound{mlePos, mlePos})
+	return WeibullDist{e[0], e[1]}
+}
+
+// WeibullFit returns the maximum-likelihood estimates of κ, λ of the Weibull distribution from the sample x.
+func WeibullFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return WeibullLnPDF(θ[0], θ[1]) })
+	κ, λ := weibullFit(x)
+	return mleAt(lnL, []float64{κ, λ})
+}
+
+// weibullFit returns the maximum-likelihood estimates of κ, λ of the Weibull distribution from the sample x,
+// solving the profile equation Σ x^κ log x / Σ x^κ - 1/κ = mean(log x) by Newton's method; then λ = mean(x^κ)^(1/κ).
+func weibullFit(x []float64) (κ, λ float64) {
+	// x is scaled by its maximum, against overflow of x^κ
+	_, xm := mleMinMax(x)
+	n := float64(len(x))
+	y := make([]float64, len(x))
+	var l, l2 float64
+	for i, v := range x {
+		y[i] = log(v / xm)
+		l += y[i]
+		l2 += y[i] * y[i]
+	}
+	l /= n
+	// Menon's estimate, π / √6 over the standard deviation of log x
+	κ = 1.2825498301618641 / sqrt(l2/n-l*l)
+	sum := func(κ float64) (a, b, c float64) {
+		for _, v := range y {
+			w := exp(κ * v)
+			a += w * v
+			b += w
+			c += w * v * v
+		}
+		return
+	}
+	for i := 0; i < 100; i++ {
+		a, b, c := sum(κ)
+		d := (a/b - 1/κ - l) / (c/b - (a/b)*(a/b) + 1/(κ*κ))
+		if κ-d <= 0 {
+			κ /= 2
+		} else {
+			κ -= d
+		}
+		if abs(d) < 1e-14*κ {
+			break
+		}
+	}
+	_, b, _ := sum(κ)
+	return κ, xm * pow(b/n, 1/κ)
+}
+
+// WeibullDist is the Weibull distribution with shape κ = Kappa and scale λ = Lambda. It implements Continuous.
+type WeibullDist struct {
+	Kappa, Lambda float64
+}
+
+// PDF returns the value of PDF of the Weibull distribution at x.
+func (d WeibullDist) PDF(x float64) float64 { return WeibullPDFAt(d.Kappa, d.Lambda, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Weibull distribution at x.
+func (d WeibullDist) LnPDF(x float64) float64 { return WeibullLnPDF(d.Kappa, d.Lambda)(x) }
+
+// CDF returns the value of CDF of the Weibull distribution at x.
+func (d WeibullDist) CDF(x float64) float64 { return WeibullCDFAt(d.Kappa, d.Lambda, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Weibull distribution at x.
+func (d WeibullDist) Surv(x float64) float64 {
+	return WeibullCDFTail(d.Kappa, d.Lambda, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Weibull distribution at x.
+func (d WeibullDist) LnCDF(x float64) float64 {
+	return WeibullCDFTail(d.Kappa, d.Lambda, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Weibull distribution at x.
+func (d WeibullDist) LnSurv(x float64) float64 {
+	return WeibullCDFTail(d.Kappa, d.Lambda, false, true)(x)
+}
+
+// Hazard returns the value of the hazard function of the Weibull distribution at x.
+func (d WeibullDist) Hazard(x float64) float64 { return WeibullHazardAt(d.Kappa, d.Lambda, x) }
+
+// Qtl returns the quantile of the Weibull distribution for probability p.
+func (d WeibullDist) Qtl(p float64) float64 { return WeibullQtlFor(d.Kappa, d.Lambda, p) }
+
+// QtlTail returns the quantile of the Weibull distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d WeibullDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return WeibullQtlTail(d.Kappa, d.Lambda, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Weibull distribution.
+func (d WeibullDist) Rand() float64 { return WeibullNext(d.Kappa, d.Lambda) }
+
+// RandR returns random number drawn from the Weibull distribution, using the random source src.
+func (d WeibullDist) RandR(src *rand.Rand) float64 { return WeibullNextR(src, d.Kappa, d.Lambda) }
+
+// Mean returns the mean of the Weibull distribution.
+func (d WeibullDist) Mean() float64 { return WeibullMean(d.Kappa, d.Lambda) }
+
+// Var returns the variance of the Weibull distribution.
+func (d WeibullDist) Var() float64 { return WeibullVar(d.Kappa, d.Lambda) }
+
+// Skew returns the skewness of the Weibull distribution.
+func (d WeibullDist) Skew() float64 { return WeibullSkew(d.Kappa, d.Lambda) }
+
+// ExKurt returns the excess kurtosis of the Weibull distribution.
+func (d WeibullDist) ExKurt() float64 { return WeibullExKurt(d.Kappa, d.Lambda) }
+
+// Entropy returns the differential entropy of the Weibull distribution.
+func (d WeibullDist) Entropy() float64 { return WeibullEntropy(d.Kappa, d.Lambda) }
+
+// CF returns the characteristic function of the Weibull distribution at t.
+func (d WeibullDist) CF(t float64) complex128 { return WeibullCF(d.Kappa, d.Lambda, t) }
+
+// CGF returns the cumulant generating function of the Weibull distribution at t.
+func (d WeibullDist) CGF(t float64) float64 { return WeibullCGF(d.Kappa, d.Lambda, t) }
+
+// Support returns the support of the Weibull distribution.
+func (d WeibullDist) Support() (a, b float64) { return 0, posInf }
@@ -0,0 +1,269 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Three-parameter Weibull distribution.
+// The Weibull distribution shifted by the location μ, a guaranteed minimum lifetime: X - μ is Weibull(κ, λ).
+//
+// Parameters:
+// κ > 0		shape
+// λ > 0		scale
+// μ ∈ R		location (threshold)
+//
+// Support:
+// x ∈ [μ; ∞)
+
+import (
+	"math/rand"
+)
+
+// Weibull3PDF returns the PDF of the three-parameter Weibull distribution.
+func Weibull3PDF(κ, λ, μ float64) func(x float64) float64 {
+	pdf := WeibullPDF(κ, λ)
+	return func(x float64) float64 {
+		return pdf(x - μ)
+	}
+}
+
+// Weibull3LnPDF returns the natural logarithm of the PDF of the three-parameter Weibull distribution.
+func Weibull3LnPDF(κ, λ, μ float64) func(x float64) float64 {
+	pdf := WeibullLnPDF(κ, λ)
+	return func(x float64) float64 {
+		return pdf(x - μ)
+	}
+}
+
+// Weibull3PDFAt returns the value of PDF of three-parameter Weibull distribution at x.
+func Weibull3PDFAt(κ, λ, μ, x float64) float64 {
+	pdf := Weibull3PDF(κ, λ, μ)
+	return pdf(x)
+}
+
+// Weibull3CDF returns the CDF of the three-parameter Weibull distribution.
+func Weibull3CDF(κ, λ, μ float64) func(x float64) float64 {
+	return Weibull3CDFTail(κ, λ, μ, true, false)
+}
+
+// Weibull3CDFAt returns the value of CDF of the three-parameter Weibull distribution, at x.
+func Weibull3CDFAt(κ, λ, μ, x float64) float64 {
+	cdf := Weibull3CDF(κ, λ, μ)
+	return cdf(x)
+}
+
+// Weibull3CDFTail returns the CDF of the three-parameter Weibull distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func Weibull3CDFTail(κ, λ, μ float64, lowerTail, logP bool) func(x float64) float64 {
+	cdf := WeibullCDFTail(κ, λ, lowerTail, logP)
+	return func(x float64) float64 {
+		return cdf(x - μ)
+	}
+}
+
+// Weibull3Qtl returns the inverse of the CDF (quantile) of the three-parameter Weibull distribution.
+func Weibull3Qtl(κ, λ, μ float64) func(p float64) float64 {
+	return Weibull3QtlTail(κ, λ, μ, true, false)
+}
+
+// Weibull3QtlFor returns the inverse of the CDF (quantile) of the three-parameter Weibull distribution, for given probability.
+func Weibull3QtlFor(κ, λ, μ, p float64) float64 {
+	qtl := Weibull3Qtl(κ, λ, μ)
+	return qtl(p)
+}
+
+// Weibull3QtlTail returns the inverse of Weibull3CDFTail (quantile) of the three-parameter Weibull distribution.
+func Weibull3QtlTail(κ, λ, μ float64, lowerTail, logP bool) func(p float64) float64 {
+	qtl := WeibullQtlTail(κ, λ, lowerTail, logP)
+	return func(p float64) float64 {
+		return μ + qtl(p)
+	}
+}
+
+// Weibull3Next returns random number drawn from the three-parameter Weibull distribution.
+func Weibull3Next(κ, λ, μ float64) float64 { return Weibull3NextR(GlobalRand, κ, λ, μ) }
+
+// Weibull3NextR returns random number drawn from the three-parameter Weibull distribution, using the random source src.
+func Weibull3NextR(src *rand.Rand, κ, λ, μ float64) float64 { return μ + WeibullNextR(src, κ, λ) }
+
+// Weibull3 returns the random number generator with  three-parameter Weibull distribution.
+func Weibull3(κ, λ, μ float64) func() float64 { return Weibull3R(GlobalRand, κ, λ, μ) }
+
+// Weibull3R returns the random number generator with  three-parameter Weibull distribution, using the random source src.
+func Weibull3R(src *rand.Rand, κ, λ, μ float64) func() float64 {
+	return func() float64 { return Weibull3NextR(src, κ, λ, μ) }
+}
+
+// Weibull3Fill fills x with random numbers drawn from the three-parameter Weibull distribution.
+func Weibull3Fill(κ, λ, μ float64, x []float64) {
+	Weibull3FillR(GlobalRand, κ, λ, μ, x)
+}
+
+// Weibull3FillR fills x with random numbers drawn from the three-parameter Weibull distribution, using the random source src.
+func Weibull3FillR(src *rand.Rand, κ, λ, μ float64, x []float64) {
+	for i := range x {
+		x[i] = Weibull3NextR(src, κ, λ, μ)
+	}
+}
+
+// Weibull3Hazard returns the hazard function (failure rate) PDF / (1 - CDF) of the three-parameter Weibull distribution.
+func Weibull3Hazard(κ, λ, μ float64) func(x float64) float64 {
+	h := WeibullHazard(κ, λ)
+	return func(x float64) float64 {
+		return h(x - μ)
+	}
+}
+
+// Weibull3HazardAt returns the value of the hazard function of the three-parameter Weibull distribution, at x.
+func Weibull3HazardAt(κ, λ, μ, x float64) float64 {
+	h := Weibull3Hazard(κ, λ, μ)
+	return h(x)
+}
+
+// Weibull3Mean returns the mean of the three-parameter Weibull distribution.
+func Weibull3Mean(κ, λ, μ float64) float64 {
+	return μ + WeibullMean(κ, λ)
+}
+
+// Weibull3Median returns the median of the three-parameter Weibull distribution.
+func Weibull3Median(κ, λ, μ float64) float64 {
+	return μ + WeibullMedian(κ, λ)
+}
+
+// Weibull3Mode returns the mode of the three-parameter Weibull distribution.
+func Weibull3Mode(κ, λ, μ float64) float64 {
+	return μ + WeibullMode(κ, λ)
+}
+
+// Weibull3Var returns the variance of the three-parameter Weibull distribution.
+func Weibull3Var(κ, λ, μ float64) float64 {
+	return WeibullVar(κ, λ)
+}
+
+// Weibull3Std returns the standard deviation of the three-parameter Weibull distribution.
+func Weibull3Std(κ, λ, μ float64) float64 {
+	return WeibullStd(κ, λ)
+}
+
+// Weibull3Skew returns the skewness of the three-parameter Weibull distribution.
+func Weibull3Skew(κ, λ, μ float64) float64 {
+	return WeibullSkew(κ, λ)
+}
+
+// Weibull3ExKurt returns the excess kurtosis of the three-parameter Weibull distribution.
+func Weibull3ExKurt(κ, λ, μ float64) float64 {
+	return WeibullExKurt(κ, λ)
+}
+
+// Weibull3Entropy returns the differential entropy of the three-parameter Weibull distribution.
+func Weibull3Entropy(κ, λ, μ float64) float64 {
+	return WeibullEntropy(κ, λ)
+}
+
+// Weibull3CF returns the characteristic function of the three-parameter Weibull distribution, by numerical integration.
+func Weibull3CF(κ, λ, μ, t float64) complex128 {
+	return cexp(complex(0, μ*t)) * WeibullCF(κ, λ, t)
+}
+
+// Weibull3CGF returns the cumulant generating function of the three-parameter Weibull distribution.
+func Weibull3CGF(κ, λ, μ, t float64) float64 {
+	return μ*t + WeibullCGF(κ, λ, t)
+}
+
+// Weibull3MGF returns the moment-generating function of the three-parameter Weibull distribution.
+func Weibull3MGF(κ, λ, μ, t float64) float64 {
+	return exp(t*μ) * WeibullMGF(κ, λ, t)
+}
+
+// Weibull3MatchQtls returns the 3-parameter Weibull distribution whose quantiles for the probabilities p best match x.
+func Weibull3MatchQtls(p, x []float64) Weibull3Dist {
+	lo, hi := mleMinMax(x)
+	μ := lo - (hi-lo)/10
+	// log(x-μ) = log λ + log(-log(1-p)) / κ
+	a, b := matchLine(matchMap(p, func(p float64) float64 { return log(-log1p(-p)) }), matchMap(x, func(x float64) float64 { return log(x - μ) }))
+	e := matchQtls(func(θ []float64) Continuous { return Weibull3Dist{θ[0], θ[1], θ[2]} }, p, x, []float64{1 / b, exp(a), μ}, []mleBound{mlePos, mlePos, mleReal})
+	return Weibull3Dist{e[0], e[1], e[2]}
+}
+
+// Weibull3Fit returns the maximum-likelihood estimates of κ, λ, μ of the Three-parameter Weibull distribution from the sample x, with μ below its minimum.
+// The likelihood is unbounded as μ approaches the minimum if κ < 1; the estimates are then those of the local maximum near the starting values.
+func Weibull3Fit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return Weibull3LnPDF(θ[0], θ[1], θ[2]) })
+	a, _ := mleMinMax(x)
+	μ := a - (empiricalQtl(empiricalSorted(x), 7, 0.5)-a)/2
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = v - μ
+	}
+	κ, λ := weibullFit(y)
+	return mleFit(lnL, []float64{κ, λ, μ}, []mleBound{mlePos, mlePos, {negInf, a}})
+}
+
+// Weibull3Dist is the three-parameter Weibull distribution with shape κ = Kappa, scale λ = Lambda and location μ = Mu. It implements Continuous.
+type Weibull3Dist struct {
+	Kappa, Lambda, Mu float64
+}
+
+// PDF returns the value of PDF of the three-parameter Weibull distribution at x.
+func (d Weibull3Dist) PDF(x float64) float64 { return Weibull3PDFAt(d.Kappa, d.Lambda, d.Mu, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the three-parameter Weibull distribution at x.
+func (d Weibull3Dist) LnPDF(x float64) float64 { return Weibull3LnPDF(d.Kappa, d.Lambda, d.Mu)(x) }
+
+// CDF returns the value of CDF of the three-parameter Weibull distribution at x.
+func (d Weibull3Dist) CDF(x float64) float64 { return Weibull3CDFAt(d.Kappa, d.Lambda, d.Mu, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the three-parameter Weibull distribution at x.
+func (d Weibull3Dist) Surv(x float64) float64 {
+	return Weibull3CDFTail(d.Kappa, d.Lambda, d.Mu, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the three-parameter Weibull distribution at x.
+func (d Weibull3Dist) LnCDF(x float64) float64 {
+	return Weibull3CDFTail(d.Kappa, d.Lambda, d.Mu, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the three-parameter Weibull distribution at x.
+func (d Weibull3Dist) LnSurv(x float64) float64 {
+	return Weibull3CDFTail(d.Kappa, d.Lambda, d.Mu, false, true)(x)
+}
+
+// Hazard returns the value of the hazard function of the three-parameter Weibull distribution at x.
+func (d Weibull3Dist) Hazard(x float64) float64 { return Weibull3HazardAt(d.Kappa, d.Lambda, d.Mu, x) }
+
+// Qtl returns the quantile of the three-parameter Weibull distribution for probability p.
+func (d Weibull3Dist) Qtl(p float64) float64 { return Weibull3QtlFor(d.Kappa, d.Lambda, d.Mu, p) }
+
+// QtlTail returns the quantile of the three-parameter Weibull distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d Weibull3Dist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return Weibull3QtlTail(d.Kappa, d.Lambda, d.Mu, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the three-parameter Weibull distribution.
+func (d Weibull3Dist) Rand() float64 { return Weibull3Next(d.Kappa, d.Lambda, d.Mu) }
+
+// RandR returns random number drawn from the three-parameter Weibull distribution, using the random source src.
+func (d Weibull3Dist) RandR(src *rand.Rand) float64 {
+	return Weibull3NextR(src, d.Kappa, d.Lambda, d.Mu)
+}
+
+// Mean returns the mean of the three-parameter Weibull distribution.
+func (d Weibull3Dist) Mean() float64 { return Weibull3Mean(d.Kappa, d.Lambda, d.Mu) }
+
+// Var returns the variance of the three-parameter Weibull distribution.
+func (d Weibull3Dist) Var() float64 { return Weibull3Var(d.Kappa, d.Lambda, d.Mu) }
+
+// Skew returns the skewness of the three-parameter Weibull distribution.
+func (d Weibull3Dist) Skew() float64 { return Weibull3Skew(d.Kappa, d.Lambda, d.Mu) }
+
+// ExKurt returns the excess kurtosis of the three-parameter Weibull distribution.
+func (d Weibull3Dist) ExKurt() float64 { return Weibull3ExKurt(d.Kappa, d.Lambda, d.Mu) }
+
+// Entropy returns the differential entropy of the three-parameter Weibull distribution.
+func (d Weibull3Dist) Entropy() float64 { return Weibull3Entropy(d.Kappa, d.Lambda, d.Mu) }
+
+// CF returns the characteristic function of the three-parameter Weibull distribution at t.
+func (d Weibull3Dist) CF(t float64) complex128 { return Weibull3CF(d.Kappa, d.Lambda, d.Mu, t) }
+
+// CGF returns the cumulant generating function of the three-parameter Weibull distribution at t.
+func (d Weibull3Dist) CGF(t float64) float64 { return Weibull3CGF(d.Kappa, d.Lambda, d.Mu, t) }
+
+// Support returns the support of the three-parameter Weibull distribution.
+func (d Weibull3Dist) Support() (a, b float64) { return d.Mu, posInf }
@@ -14,6 +14,7 @@ package dst
 
 import (
 	m "github.com/skelterjohn/go.matrix"
+	"math/rand"
 )
 
 // WishartPDF returns the PDF of the Wishart distribution. 
@@ -50,14 +51,24 @@ func WishartLnPDF(n int, V *m.DenseMatrix) func(W *m.DenseMatrix) float64 {
 
 // WishartNext returns random number drawn from the Wishart distribution. 
 func WishartNext(n int, V *m.DenseMatrix) *m.DenseMatrix {
-	return Wishart(n, V)()
+	return WishartNextR(GlobalRand, n, V)
+}
+
+// WishartNextR returns random number drawn from the Wishart distribution, using the random source src.
+func WishartNextR(src *rand.Rand, n int, V *m.DenseMatrix) *m.DenseMatrix {
+	return WishartR(src, n, V)()
 }
 
 // Wishart returns the random number generator with  Wishart distribution. 
 func Wishart(n int, V *m.DenseMatrix) func() *m.DenseMatrix {
+	return WishartR(GlobalRand, n, V)
+}
+
+// WishartR returns the random number generator with  Wishart distribution, using the random source src.
+func WishartR(src *rand.Rand, n int, V *m.DenseMatrix) func() *m.DenseMatrix {
 	p := V.Rows()
 	zeros := m.Zeros(p, 1)
-	rowGen := MVNormal(zeros, V)
+	rowGen := MVNormalR(src, zeros, V)
 	return func() *m.DenseMatrix {
 		x := make([][]float64, n)
 		for i := 0; i < n; i++ {
@@ -68,3 +79,61 @@ func Wishart(n int, V *m.DenseMatrix) func() *m.DenseMatrix {
 		return S
 	}
 }
+
+// WishartFill fills x with random matrices drawn from the Wishart distribution.
+func WishartFill(n int, V *m.DenseMatrix, x []*m.DenseMatrix) {
+	WishartFillR(GlobalRand, n, V, x)
+}
+
+// WishartFillR fills x with random matrices drawn from the Wishart distribution, using the random source src;
+// the generator is set up once for all the draws.
+func WishartFillR(src *rand.Rand, n int, V *m.DenseMatrix, x []*m.DenseMatrix) {
+	next := WishartR(src, n, V)
+	for i := range x {
+		x[i] = next()
+	}
+}
+
+// wishartLnΓ returns the logarithm of the multivariate gamma function Γp(a).
+func wishartLnΓ(p int, a float64) float64 {
+	s := float64(p*(p-1)) / 4 * log(π)
+	for j := 0; j < p; j++ {
+		s += LnΓ(a - float64(j)/2)
+	}
+	return s
+}
+
+// wishartDigamma returns the multivariate digamma function ψp(a), the derivative of ln Γp(a).
+func wishartDigamma(p int, a float64) float64 {
+	s := fZero
+	for j := 0; j < p; j++ {
+		s += digamma(a - float64(j)/2)
+	}
+	return s
+}
+
+// WishartEntropy returns the differential entropy of the Wishart distribution.
+func WishartEntropy(n int, V *m.DenseMatrix) float64 {
+	p := V.Rows()
+	_, lnDetRt := mvChol(V)
+	nn, pp := float64(n), float64(p)
+	return (pp+1)*lnDetRt + pp*(pp+1)/2*log(2) + wishartLnΓ(p, nn/2) - (nn-pp-1)/2*wishartDigamma(p, nn/2) + nn*pp/2
+}
+
+// WishartKL returns the Kullback–Leibler divergence of the Wishart distribution with n2, V2 from the one with n1, V1.
+func WishartKL(n1 int, V1 *m.DenseMatrix, n2 int, V2 *m.DenseMatrix) float64 {
+	p := V1.Rows()
+	L1, lnDetRt1 := mvChol(V1)
+	L2, lnDetRt2 := mvChol(V2)
+	// tr(V2⁻¹ V1) = Σ |L2⁻¹ c|² over the columns c of L1
+	zero, c := m.Zeros(p, 1), m.Zeros(p, 1)
+	tr := fZero
+	for j := 0; j < p; j++ {
+		for i := 0; i < p; i++ {
+			c.Set(i, 0, L1.Get(i, j))
+		}
+		tr += mvMahalanobis2(L2, zero, c)
+	}
+	a1, a2 := float64(n1)/2, float64(n2)/2
+	return 2*a2*(lnDetRt2-lnDetRt1) + a1*(tr-float64(p)) + wishartLnΓ(p, a2) - wishartLnΓ(p, a1) + (a1-a2)*wishartDigamma(p, a1)
+}
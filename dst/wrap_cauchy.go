@@ -0,0 +1,301 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Wrapped Cauchy distribution.
+// The Cauchy distribution with location μ and scale γ wrapped around the circle; its mean resultant length is ρ = exp(-γ).
+//
+// Parameters:
+// μ ∈ R		mean direction
+// γ > 0		scale of the unwrapped Cauchy distribution
+//
+// Support:
+// x ∈ [μ - π; μ + π]
+
+import (
+	"math/rand"
+)
+
+// wrapCauchyCDF returns the CDF of the Wrapped Cauchy distribution at θ ∈ [-π; π], centred at 0.
+func wrapCauchyCDF(γ, θ float64) float64 {
+	if θ <= -π {
+		return 0
+	}
+	if θ >= π {
+		return 1
+	}
+	// F = 1/2 + atan(t) / π, with t = tan(θ/2) (1 + ρ) / (1 - ρ)
+	t := tan(θ/2) * (1 + exp(-γ)) / -expm1(-γ)
+	if t < 0 {
+		return atan(-1/t) / π
+	}
+	return 0.5 + atan(t)/π
+}
+
+// wrapCauchyMoments returns the trigonometric moments a_j = exp(-jγ) of the Wrapped Cauchy distribution, up to negligible ones.
+func wrapCauchyMoments(γ float64) []float64 {
+	// the series of the linear moments converge as 1/j² even if γ → 0
+	n := 1 + int(min(40/γ, 1e5))
+	a := make([]float64, n)
+	for j := range a {
+		a[j] = exp(-float64(j+1) * γ)
+	}
+	return a
+}
+
+// WrapCauchyPDF returns the PDF of the Wrapped Cauchy distribution.
+func WrapCauchyPDF(μ, γ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if γ <= 0 {
+			return NaN
+		}
+		θ := x - μ
+		if θ < -π || θ > π {
+			return 0
+		}
+		// (1 - ρ²) / 2π(1 + ρ² - 2ρ cos θ), with 1 + ρ² - 2ρ cos θ = (1 - ρ)² + 4ρ sin²(θ/2)
+		ρ, m := exp(-γ), expm1(-γ)
+		s := sin(θ / 2)
+		return -expm1(-2*γ) / (2 * π * (m*m + 4*ρ*s*s))
+	}
+}
+
+// WrapCauchyLnPDF returns the natural logarithm of the PDF of the Wrapped Cauchy distribution.
+func WrapCauchyLnPDF(μ, γ float64) func(x float64) float64 {
+	pdf := WrapCauchyPDF(μ, γ)
+	return func(x float64) float64 {
+		return log(pdf(x))
+	}
+}
+
+// WrapCauchyPDFAt returns the value of PDF of Wrapped Cauchy distribution at x.
+func WrapCauchyPDFAt(μ, γ, x float64) float64 {
+	pdf := WrapCauchyPDF(μ, γ)
+	return pdf(x)
+}
+
+// WrapCauchyCDF returns the CDF of the Wrapped Cauchy distribution.
+func WrapCauchyCDF(μ, γ float64) func(x float64) float64 {
+	return WrapCauchyCDFTail(μ, γ, true, false)
+}
+
+// WrapCauchyCDFAt returns the value of CDF of the Wrapped Cauchy distribution, at x.
+func WrapCauchyCDFAt(μ, γ, x float64) float64 {
+	cdf := WrapCauchyCDF(μ, γ)
+	return cdf(x)
+}
+
+// WrapCauchyCDFTail returns the CDF of the Wrapped Cauchy distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func WrapCauchyCDFTail(μ, γ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if γ <= 0 {
+			return NaN
+		}
+		// the upper tail by symmetry
+		θ := x - μ
+		return pTail2(wrapCauchyCDF(γ, θ), wrapCauchyCDF(γ, -θ), lowerTail, logP)
+	}
+}
+
+// WrapCauchyQtl returns the inverse of the CDF (quantile) of the Wrapped Cauchy distribution.
+func WrapCauchyQtl(μ, γ float64) func(p float64) float64 {
+	return WrapCauchyQtlTail(μ, γ, true, false)
+}
+
+// WrapCauchyQtlFor returns the inverse of the CDF (quantile) of the Wrapped Cauchy distribution, for given probability.
+func WrapCauchyQtlFor(μ, γ, p float64) float64 {
+	qtl := WrapCauchyQtl(μ, γ)
+	return qtl(p)
+}
+
+// WrapCauchyQtlTail returns the inverse of WrapCauchyCDFTail (quantile) of the Wrapped Cauchy distribution.
+func WrapCauchyQtlTail(μ, γ float64, lowerTail, logP bool) func(p float64) float64 {
+	return func(p float64) float64 {
+		if γ <= 0 || isNaN(p) || !pValid(p, logP) {
+			return NaN
+		}
+		// θ = 2 atan(tan(π(p - 1/2)) (1 - ρ) / (1 + ρ)), from the smaller tail
+		c := -expm1(-γ) / (1 + exp(-γ))
+		if lower := pLower(p, lowerTail, logP); lower < 0.5 {
+			return μ - 2*atan(c/tan(π*lower))
+		}
+		return μ + 2*atan(c/tan(π*pUpper(p, lowerTail, logP)))
+	}
+}
+
+// WrapCauchyNext returns random number drawn from the Wrapped Cauchy distribution.
+func WrapCauchyNext(μ, γ float64) float64 { return WrapCauchyNextR(GlobalRand, μ, γ) }
+
+// WrapCauchyNextR returns random number drawn from the Wrapped Cauchy distribution, using the random source src.
+func WrapCauchyNextR(src *rand.Rand, μ, γ float64) float64 {
+	return μ + wrapAngle(CauchyNextR(src, 0, γ))
+}
+
+// WrapCauchy returns the random number generator with  Wrapped Cauchy distribution.
+func WrapCauchy(μ, γ float64) func() float64 { return WrapCauchyR(GlobalRand, μ, γ) }
+
+// WrapCauchyR returns the random number generator with  Wrapped Cauchy distribution, using the random source src.
+func WrapCauchyR(src *rand.Rand, μ, γ float64) func() float64 {
+	return func() float64 { return WrapCauchyNextR(src, μ, γ) }
+}
+
+// WrapCauchyFill fills x with random numbers drawn from the Wrapped Cauchy distribution.
+func WrapCauchyFill(μ, γ float64, x []float64) {
+	WrapCauchyFillR(GlobalRand, μ, γ, x)
+}
+
+// WrapCauchyFillR fills x with random numbers drawn from the Wrapped Cauchy distribution, using the random source src.
+func WrapCauchyFillR(src *rand.Rand, μ, γ float64, x []float64) {
+	for i := range x {
+		x[i] = WrapCauchyNextR(src, μ, γ)
+	}
+}
+
+// WrapCauchyCircMean returns the circular mean (mean direction) of the Wrapped Cauchy distribution, in [-π; π).
+func WrapCauchyCircMean(μ, γ float64) float64 {
+	return wrapAngle(μ)
+}
+
+// WrapCauchyCircVar returns the circular variance 1 - E[cos(θ - μ)] of the Wrapped Cauchy distribution.
+func WrapCauchyCircVar(μ, γ float64) float64 {
+	return -expm1(-γ)
+}
+
+// WrapCauchyMean returns the mean of the Wrapped Cauchy distribution.
+func WrapCauchyMean(μ, γ float64) float64 {
+	return μ
+}
+
+// WrapCauchyMedian returns the median of the Wrapped Cauchy distribution.
+func WrapCauchyMedian(μ, γ float64) float64 {
+	return μ
+}
+
+// WrapCauchyMode returns the mode of the Wrapped Cauchy distribution.
+func WrapCauchyMode(μ, γ float64) float64 {
+	return μ
+}
+
+// WrapCauchyVar returns the variance of the Wrapped Cauchy distribution.
+func WrapCauchyVar(μ, γ float64) float64 {
+	v, _ := circVarKurt(wrapCauchyMoments(γ))
+	return v
+}
+
+// WrapCauchyStd returns the standard deviation of the Wrapped Cauchy distribution.
+func WrapCauchyStd(μ, γ float64) float64 {
+	return sqrt(WrapCauchyVar(μ, γ))
+}
+
+// WrapCauchySkew returns the skewness of the Wrapped Cauchy distribution.
+func WrapCauchySkew(μ, γ float64) float64 {
+	return 0
+}
+
+// WrapCauchyExKurt returns the excess kurtosis of the Wrapped Cauchy distribution.
+func WrapCauchyExKurt(μ, γ float64) float64 {
+	_, k := circVarKurt(wrapCauchyMoments(γ))
+	return k
+}
+
+// WrapCauchyEntropy returns the differential entropy of the Wrapped Cauchy distribution.
+func WrapCauchyEntropy(μ, γ float64) float64 {
+	// ln(2π (1 - ρ²)), ρ = exp(-γ)
+	return log(-2 * π * expm1(-2*γ))
+}
+
+// WrapCauchyCF returns the characteristic function of the Wrapped Cauchy distribution on [μ-π, μ+π], by numerical integration for t not an integer.
+func WrapCauchyCF(μ, γ, t float64) complex128 {
+	if t == trunc(t) {
+		// the trigonometric moment e^{itμ - |t|γ}
+		return cexp(complex(-abs(t)*γ, t*μ))
+	}
+	return cfLnPDF(WrapCauchyLnPDF(μ, γ), WrapCauchyQtl(μ, γ), μ-π, μ+π, t)
+}
+
+// WrapCauchyCGF returns the cumulant generating function of the Wrapped Cauchy distribution on [μ-π, μ+π], by numerical integration.
+func WrapCauchyCGF(μ, γ, t float64) float64 {
+	return cgfLnPDF(WrapCauchyLnPDF(μ, γ), WrapCauchyQtl(μ, γ), μ-π, μ+π, t)
+}
+
+// WrapCauchyMGF is not defined for the angles; see WrapCauchyCircMean and WrapCauchyCircVar for the trigonometric moments.
+
+// WrapCauchyFit returns the maximum-likelihood estimates of μ, γ of the Wrapped Cauchy distribution from the sample of angles x, starting from its mean direction.
+func WrapCauchyFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return circLnPDF(θ[0], WrapCauchyLnPDF(θ[0], θ[1])) })
+	μ, R := circSampleMean(x)
+	return mleFit(lnL, []float64{μ, -log(R)}, []mleBound{mleReal, mlePos})
+}
+
+// WrapCauchyDist is the Wrapped Cauchy distribution with mean direction μ = Mu and scale γ = Gamma of the unwrapped Cauchy distribution. It implements Continuous.
+type WrapCauchyDist struct {
+	Mu, Gamma float64
+}
+
+// PDF returns the value of PDF of the Wrapped Cauchy distribution at x.
+func (d WrapCauchyDist) PDF(x float64) float64 { return WrapCauchyPDFAt(d.Mu, d.Gamma, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Wrapped Cauchy distribution at x.
+func (d WrapCauchyDist) LnPDF(x float64) float64 { return WrapCauchyLnPDF(d.Mu, d.Gamma)(x) }
+
+// CDF returns the value of CDF of the Wrapped Cauchy distribution at x.
+func (d WrapCauchyDist) CDF(x float64) float64 { return WrapCauchyCDFAt(d.Mu, d.Gamma, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Wrapped Cauchy distribution at x.
+func (d WrapCauchyDist) Surv(x float64) float64 {
+	return WrapCauchyCDFTail(d.Mu, d.Gamma, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Wrapped Cauchy distribution at x.
+func (d WrapCauchyDist) LnCDF(x float64) float64 {
+	return WrapCauchyCDFTail(d.Mu, d.Gamma, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Wrapped Cauchy distribution at x.
+func (d WrapCauchyDist) LnSurv(x float64) float64 {
+	return WrapCauchyCDFTail(d.Mu, d.Gamma, false, true)(x)
+}
+
+// Qtl returns the quantile of the Wrapped Cauchy distribution for probability p.
+func (d WrapCauchyDist) Qtl(p float64) float64 { return WrapCauchyQtlFor(d.Mu, d.Gamma, p) }
+
+// QtlTail returns the quantile of the Wrapped Cauchy distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d WrapCauchyDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return WrapCauchyQtlTail(d.Mu, d.Gamma, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) Rand() float64 { return WrapCauchyNext(d.Mu, d.Gamma) }
+
+// RandR returns random number drawn from the Wrapped Cauchy distribution, using the random source src.
+func (d WrapCauchyDist) RandR(src *rand.Rand) float64 { return WrapCauchyNextR(src, d.Mu, d.Gamma) }
+
+// Mean returns the mean of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) Mean() float64 { return WrapCauchyMean(d.Mu, d.Gamma) }
+
+// Var returns the variance of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) Var() float64 { return WrapCauchyVar(d.Mu, d.Gamma) }
+
+// Skew returns the skewness of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) Skew() float64 { return WrapCauchySkew(d.Mu, d.Gamma) }
+
+// ExKurt returns the excess kurtosis of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) ExKurt() float64 { return WrapCauchyExKurt(d.Mu, d.Gamma) }
+
+// Entropy returns the differential entropy of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) Entropy() float64 { return WrapCauchyEntropy(d.Mu, d.Gamma) }
+
+// CF returns the characteristic function of the Wrapped Cauchy distribution at t.
+func (d WrapCauchyDist) CF(t float64) complex128 { return WrapCauchyCF(d.Mu, d.Gamma, t) }
+
+// CGF returns the cumulant generating function of the Wrapped Cauchy distribution at t.
+func (d WrapCauchyDist) CGF(t float64) float64 { return WrapCauchyCGF(d.Mu, d.Gamma, t) }
+
+// Support returns the support of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) Support() (a, b float64) { return d.Mu - π, d.Mu + π }
+
+// CircMean returns the circular mean (mean direction) of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) CircMean() float64 { return WrapCauchyCircMean(d.Mu, d.Gamma) }
+
+// CircVar returns the circular variance of the Wrapped Cauchy distribution.
+func (d WrapCauchyDist) CircVar() float64 { return WrapCauchyCircVar(d.Mu, d.Gamma) }
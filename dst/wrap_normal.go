@@ -0,0 +1,326 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Wrapped normal distribution.
+// The Normal distribution with mean μ and standard deviation σ wrapped around the circle; the limiting distribution of a random walk on the circle.
+//
+// Parameters:
+// μ ∈ R		mean direction
+// σ > 0		standard deviation of the unwrapped Normal distribution
+//
+// Support:
+// x ∈ [μ - π; μ + π]
+
+import (
+	"math/rand"
+)
+
+// wrapNormalMoments returns the trigonometric moments a_j = exp(-j²σ² / 2) of the Wrapped normal distribution, up to negligible ones.
+func wrapNormalMoments(σ float64) []float64 {
+	n := 1 + int(9/σ)
+	a := make([]float64, n)
+	for j := range a {
+		k := float64(j + 1)
+		a[j] = exp(-k * k * σ * σ / 2)
+	}
+	return a
+}
+
+// wrapNormalLnPDF returns the logarithm of the density of the Wrapped normal distribution at θ ∈ [-π; π], centred at 0.
+func wrapNormalLnPDF(σ, θ float64) float64 {
+	if σ >= 1 {
+		return log(circPDF(wrapNormalMoments(σ), θ))
+	}
+	// sum over the windings, relative to the central one
+	s := 1.0
+	for k := 1.0; k <= 7; k++ {
+		for _, y := range []float64{θ + 2*π*k, θ - 2*π*k} {
+			s += exp(-(y*y - θ*θ) / (2 * σ * σ))
+		}
+	}
+	return -θ*θ/(2*σ*σ) - log(σ) - M_LN_SQRT_2PI + log(s)
+}
+
+// wrapNormalCDF returns the CDF of the Wrapped normal distribution at θ ∈ [-π; π], centred at 0.
+func wrapNormalCDF(σ, θ float64) float64 {
+	if σ >= 1 {
+		return circCDF(wrapNormalMoments(σ), θ)
+	}
+	if θ <= -π {
+		return 0
+	}
+	if θ >= π {
+		return 1
+	}
+	// the mass of each winding falling in [-π; θ]
+	p := 0.0
+	for k := 7.0; k >= 1; k-- {
+		for _, s := range []float64{-k, k} {
+			p += pnorm((θ+2*π*s)/σ, true, false) - pnorm((-π+2*π*s)/σ, true, false)
+		}
+	}
+	return p + pnorm(θ/σ, true, false) - pnorm(-π/σ, true, false)
+}
+
+// WrapNormalPDF returns the PDF of the Wrapped normal distribution.
+func WrapNormalPDF(μ, σ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		θ := x - μ
+		if θ < -π || θ > π {
+			return 0
+		}
+		return exp(wrapNormalLnPDF(σ, θ))
+	}
+}
+
+// WrapNormalLnPDF returns the natural logarithm of the PDF of the Wrapped normal distribution.
+func WrapNormalLnPDF(μ, σ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		θ := x - μ
+		if θ < -π || θ > π {
+			return negInf
+		}
+		return wrapNormalLnPDF(σ, θ)
+	}
+}
+
+// WrapNormalPDFAt returns the value of PDF of Wrapped normal distribution at x.
+func WrapNormalPDFAt(μ, σ, x float64) float64 {
+	pdf := WrapNormalPDF(μ, σ)
+	return pdf(x)
+}
+
+// WrapNormalCDF returns the CDF of the Wrapped normal distribution.
+func WrapNormalCDF(μ, σ float64) func(x float64) float64 {
+	return WrapNormalCDFTail(μ, σ, true, false)
+}
+
+// WrapNormalCDFAt returns the value of CDF of the Wrapped normal distribution, at x.
+func WrapNormalCDFAt(μ, σ, x float64) float64 {
+	cdf := WrapNormalCDF(μ, σ)
+	return cdf(x)
+}
+
+// WrapNormalCDFTail returns the CDF of the Wrapped normal distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func WrapNormalCDFTail(μ, σ float64, lowerTail, logP bool) func(x float64) float64 {
+	return func(x float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		// the upper tail by symmetry
+		θ := x - μ
+		return pTail2(wrapNormalCDF(σ, θ), wrapNormalCDF(σ, -θ), lowerTail, logP)
+	}
+}
+
+// WrapNormalQtl returns the inverse of the CDF (quantile) of the Wrapped normal distribution.
+func WrapNormalQtl(μ, σ float64) func(p float64) float64 {
+	return WrapNormalQtlTail(μ, σ, true, false)
+}
+
+// WrapNormalQtlFor returns the inverse of the CDF (quantile) of the Wrapped normal distribution, for given probability.
+func WrapNormalQtlFor(μ, σ, p float64) float64 {
+	qtl := WrapNormalQtl(μ, σ)
+	return qtl(p)
+}
+
+// WrapNormalQtlTail returns the inverse of WrapNormalCDFTail (quantile) of the Wrapped normal distribution.
+func WrapNormalQtlTail(μ, σ float64, lowerTail, logP bool) func(p float64) float64 {
+	cdf := WrapNormalCDFTail(μ, σ, lowerTail, logP)
+	return func(p float64) float64 {
+		if σ <= 0 {
+			return NaN
+		}
+		return qtlTail(cdf, p, μ-π, μ+π, lowerTail, logP)
+	}
+}
+
+// WrapNormalNext returns random number drawn from the Wrapped normal distribution.
+func WrapNormalNext(μ, σ float64) float64 { return WrapNormalNextR(GlobalRand, μ, σ) }
+
+// WrapNormalNextR returns random number drawn from the Wrapped normal distribution, using the random source src.
+func WrapNormalNextR(src *rand.Rand, μ, σ float64) float64 {
+	return μ + wrapAngle(σ*normalZig(src))
+}
+
+// WrapNormal returns the random number generator with  Wrapped normal distribution.
+func WrapNormal(μ, σ float64) func() float64 { return WrapNormalR(GlobalRand, μ, σ) }
+
+// WrapNormalR returns the random number generator with  Wrapped normal distribution, using the random source src.
+func WrapNormalR(src *rand.Rand, μ, σ float64) func() float64 {
+	return func() float64 { return WrapNormalNextR(src, μ, σ) }
+}
+
+// WrapNormalFill fills x with random numbers drawn from the Wrapped normal distribution.
+func WrapNormalFill(μ, σ float64, x []float64) {
+	WrapNormalFillR(GlobalRand, μ, σ, x)
+}
+
+// WrapNormalFillR fills x with random numbers drawn from the Wrapped normal distribution, using the random source src.
+func WrapNormalFillR(src *rand.Rand, μ, σ float64, x []float64) {
+	for i := range x {
+		x[i] = WrapNormalNextR(src, μ, σ)
+	}
+}
+
+// WrapNormalCircMean returns the circular mean (mean direction) of the Wrapped normal distribution, in [-π; π).
+func WrapNormalCircMean(μ, σ float64) float64 {
+	return wrapAngle(μ)
+}
+
+// WrapNormalCircVar returns the circular variance 1 - E[cos(θ - μ)] of the Wrapped normal distribution.
+func WrapNormalCircVar(μ, σ float64) float64 {
+	return -expm1(-σ * σ / 2)
+}
+
+// WrapNormalMean returns the mean of the Wrapped normal distribution.
+func WrapNormalMean(μ, σ float64) float64 {
+	return μ
+}
+
+// WrapNormalMedian returns the median of the Wrapped normal distribution.
+func WrapNormalMedian(μ, σ float64) float64 {
+	return μ
+}
+
+// WrapNormalMode returns the mode of the Wrapped normal distribution.
+func WrapNormalMode(μ, σ float64) float64 {
+	return μ
+}
+
+// WrapNormalVar returns the variance of the Wrapped normal distribution.
+func WrapNormalVar(μ, σ float64) float64 {
+	if σ < 1e-3 {
+		// the wrapping is negligible
+		return σ * σ
+	}
+	v, _ := circVarKurt(wrapNormalMoments(σ))
+	return v
+}
+
+// WrapNormalStd returns the standard deviation of the Wrapped normal distribution.
+func WrapNormalStd(μ, σ float64) float64 {
+	return sqrt(WrapNormalVar(μ, σ))
+}
+
+// WrapNormalSkew returns the skewness of the Wrapped normal distribution.
+func WrapNormalSkew(μ, σ float64) float64 {
+	return 0
+}
+
+// WrapNormalExKurt returns the excess kurtosis of the Wrapped normal distribution.
+func WrapNormalExKurt(μ, σ float64) float64 {
+	if σ < 1e-3 {
+		return 0
+	}
+	_, k := circVarKurt(wrapNormalMoments(σ))
+	return k
+}
+
+// WrapNormalEntropy returns the differential entropy of the Wrapped normal distribution, by numerical integration.
+func WrapNormalEntropy(μ, σ float64) float64 {
+	return entropyLnPDF(WrapNormalLnPDF(μ, σ), WrapNormalQtl(μ, σ), μ-π, μ+π)
+}
+
+// WrapNormalCF returns the characteristic function of the Wrapped normal distribution on [μ-π, μ+π], by numerical integration for t not an integer.
+func WrapNormalCF(μ, σ, t float64) complex128 {
+	if t == trunc(t) {
+		// the trigonometric moment e^{itμ - t²σ²/2}
+		return cexp(complex(-t*t*σ*σ/2, t*μ))
+	}
+	return cfLnPDF(WrapNormalLnPDF(μ, σ), WrapNormalQtl(μ, σ), μ-π, μ+π, t)
+}
+
+// WrapNormalCGF returns the cumulant generating function of the Wrapped normal distribution on [μ-π, μ+π], by numerical integration.
+func WrapNormalCGF(μ, σ, t float64) float64 {
+	return cgfLnPDF(WrapNormalLnPDF(μ, σ), WrapNormalQtl(μ, σ), μ-π, μ+π, t)
+}
+
+// WrapNormalMGF is not defined for the angles; see WrapNormalCircMean and WrapNormalCircVar for the trigonometric moments.
+
+// WrapNormalFit returns the maximum-likelihood estimates of μ, σ of the Wrapped normal distribution from the sample of angles x, starting from its mean direction.
+func WrapNormalFit(x []float64) MLE {
+	lnL := mleLnL(x, func(θ []float64) func(x float64) float64 { return circLnPDF(θ[0], WrapNormalLnPDF(θ[0], θ[1])) })
+	μ, R := circSampleMean(x)
+	return mleFit(lnL, []float64{μ, sqrt(-2 * log(R))}, []mleBound{mleReal, mlePos})
+}
+
+// WrapNormalDist is the Wrapped normal distribution with mean direction μ = Mu and standard deviation σ = Sigma of the unwrapped Normal distribution. It implements Continuous.
+type WrapNormalDist struct {
+	Mu, Sigma float64
+}
+
+// PDF returns the value of PDF of the Wrapped normal distribution at x.
+func (d WrapNormalDist) PDF(x float64) float64 { return WrapNormalPDFAt(d.Mu, d.Sigma, x) }
+
+// LnPDF returns the natural logarithm of the PDF of the Wrapped normal distribution at x.
+func (d WrapNormalDist) LnPDF(x float64) float64 { return WrapNormalLnPDF(d.Mu, d.Sigma)(x) }
+
+// CDF returns the value of CDF of the Wrapped normal distribution at x.
+func (d WrapNormalDist) CDF(x float64) float64 { return WrapNormalCDFAt(d.Mu, d.Sigma, x) }
+
+// Surv returns the value of the survival function 1 - CDF of the Wrapped normal distribution at x.
+func (d WrapNormalDist) Surv(x float64) float64 {
+	return WrapNormalCDFTail(d.Mu, d.Sigma, false, false)(x)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Wrapped normal distribution at x.
+func (d WrapNormalDist) LnCDF(x float64) float64 {
+	return WrapNormalCDFTail(d.Mu, d.Sigma, true, true)(x)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Wrapped normal distribution at x.
+func (d WrapNormalDist) LnSurv(x float64) float64 {
+	return WrapNormalCDFTail(d.Mu, d.Sigma, false, true)(x)
+}
+
+// Qtl returns the quantile of the Wrapped normal distribution for probability p.
+func (d WrapNormalDist) Qtl(p float64) float64 { return WrapNormalQtlFor(d.Mu, d.Sigma, p) }
+
+// QtlTail returns the quantile of the Wrapped normal distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d WrapNormalDist) QtlTail(p float64, lowerTail, logP bool) float64 {
+	return WrapNormalQtlTail(d.Mu, d.Sigma, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Wrapped normal distribution.
+func (d WrapNormalDist) Rand() float64 { return WrapNormalNext(d.Mu, d.Sigma) }
+
+// RandR returns random number drawn from the Wrapped normal distribution, using the random source src.
+func (d WrapNormalDist) RandR(src *rand.Rand) float64 { return WrapNormalNextR(src, d.Mu, d.Sigma) }
+
+// Mean returns the mean of the Wrapped normal distribution.
+func (d WrapNormalDist) Mean() float64 { return WrapNormalMean(d.Mu, d.Sigma) }
+
+// Var returns the variance of the Wrapped normal distribution.
+func (d WrapNormalDist) Var() float64 { return WrapNormalVar(d.Mu, d.Sigma) }
+
+// Skew returns the skewness of the Wrapped normal distribution.
+func (d WrapNormalDist) Skew() float64 { return WrapNormalSkew(d.Mu, d.Sigma) }
+
+// ExKurt returns the excess kurtosis of the Wrapped normal distribution.
+func (d WrapNormalDist) ExKurt() float64 { return WrapNormalExKurt(d.Mu, d.Sigma) }
+
+// Entropy returns the differential entropy of the Wrapped normal distribution.
+func (d WrapNormalDist) Entropy() float64 { return WrapNormalEntropy(d.Mu, d.Sigma) }
+
+// CF returns the characteristic function of the Wrapped normal distribution at t.
+func (d WrapNormalDist) CF(t float64) complex128 { return WrapNormalCF(d.Mu, d.Sigma, t) }
+
+// CGF returns the cumulant generating function of the Wrapped normal distribution at t.
+func (d WrapNormalDist) CGF(t float64) float64 { return WrapNormalCGF(d.Mu, d.Sigma, t) }
+
+// Support returns the support of the Wrapped normal distribution.
+func (d WrapNormalDist) Support() (a, b float64) { return d.Mu - π, d.Mu + π }
+
+// CircMean returns the circular mean (mean direction) of the Wrapped normal distribution.
+func (d WrapNormalDist) CircMean() float64 { return WrapNormalCircMean(d.Mu, d.Sigma) }
+
+// CircVar returns the circular variance of the Wrapped normal distribution.
+func (d WrapNormalDist) CircVar() float64 { return WrapNormalCircVar(d.Mu, d.Sigma) }
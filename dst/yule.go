@@ -12,6 +12,10 @@ package dst
 // Support: 
 // k ∈ {1, 2, ... }
 
+import (
+	"math/rand"
+)
+
 // YulePMF returns the PMF of the Yule–Simon distribution. 
 func YulePMF(a float64) func(k int64) float64 {
 	return func(k int64) float64 {
@@ -41,19 +45,95 @@ func YuleCDFAt(a float64, k int64) float64 {
 	return cdf(k)
 }
 
+// YuleCDFTail returns the CDF of the Yule–Simon distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func YuleCDFTail(a float64, lowerTail, logP bool) func(k int64) float64 {
+	return func(k int64) float64 {
+		if k < 1 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		// P[X > k] = k * B(k, a+1)
+		kk := float64(k)
+		return pTailLnUpper(log(kk)+logB(kk, a+1), lowerTail, logP)
+	}
+}
+
+// YuleQtl returns the inverse of the CDF (quantile) of the Yule–Simon distribution.
+// It panics with the error that YuleQtlErr returns, if any.
+func YuleQtl(a float64) func(p float64) int64 {
+	qtl := YuleQtlErr(a)
+	return func(p float64) int64 {
+		k, err := qtl(p)
+		if err != nil {
+			panic(err)
+		}
+		return k
+	}
+}
+
+// YuleQtlErr returns the inverse of the CDF (quantile) of the Yule–Simon distribution, with the error of the inversion, if any.
+func YuleQtlErr(a float64) func(p float64) (int64, error) {
+	cdf := YuleCDFTail(a, true, false)
+	μ, σ, γ := YuleMean(a), YuleStd(a), YuleSkew(a)
+	return func(p float64) (int64, error) {
+		if !(a > 0) {
+			return 1, ErrQtlParam
+		}
+		return InvertCDFDiscrete(cdf, p, 1, posInfInt64, μ, σ, γ)
+	}
+}
+
+// YuleQtlFor returns the inverse of the CDF (quantile) of the Yule–Simon distribution, for given probability.
+func YuleQtlFor(a, p float64) int64 {
+	qtl := YuleQtl(a)
+	return qtl(p)
+}
+
+// YuleQtlTail returns the inverse of YuleCDFTail (quantile) of the Yule–Simon distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func YuleQtlTail(a float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := YuleCDFTail(a, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(a > 0) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 1, posInfInt64, lowerTail, logP)
+	}
+}
+
 // YuleNext returns random number drawn from the Yule–Simon distribution. 
 func YuleNext(a float64) (k int64) {
+	return YuleNextR(GlobalRand, a)
+}
+
+// YuleNextR returns random number drawn from the Yule–Simon distribution, using the random source src.
+func YuleNextR(src *rand.Rand, a float64) (k int64) {
 	// Devroye 1986: 553.
 	// Devroye, L. 1986: Non-Uniform Random Variate Generation. Springer-Verlag, New York. ISBN 0-387-96305-7.
-	e1 := ExponentialNext(2)
-	e2 := ExponentialNext(2)
-	k = int64(ceil(-e1 / (log(1 - exp(-e2/(a-1))))))
+	e1 := ExponentialNextR(src, 1)
+	e2 := ExponentialNextR(src, 1)
+	k = int64(ceil(-e1 / log(1-exp(-e2/a))))
 	return
 }
 
 // Yule returns the random number generator with  Yule–Simon distribution. 
 func Yule(a float64) func() int64 {
-	return func() int64 { return YuleNext(a) }
+	return YuleR(GlobalRand, a)
+}
+
+// YuleR returns the random number generator with  Yule–Simon distribution, using the random source src.
+func YuleR(src *rand.Rand, a float64) func() int64 {
+	return func() int64 { return YuleNextR(src, a) }
+}
+
+// YuleFill fills x with random numbers drawn from the Yule–Simon distribution.
+func YuleFill(a float64, x []int64) {
+	YuleFillR(GlobalRand, a, x)
+}
+
+// YuleFillR fills x with random numbers drawn from the Yule–Simon distribution, using the random source src.
+func YuleFillR(src *rand.Rand, a float64, x []int64) {
+	for i := range x {
+		x[i] = YuleNextR(src, a)
+	}
 }
 
 // YuleMean returns the mean of the Yule–Simon distribution. 
@@ -100,3 +180,108 @@ func YuleExKurt(a float64) float64 {
 	}
 	return a + 3 + (11*a*a*a-49*a-22)/((a-4)*(a-3)*a)
 }
+
+// YuleEntropy returns the Shannon entropy of the Yule–Simon distribution, by numerical summation.
+func YuleEntropy(a float64) float64 {
+	return entropyDiscrete(YuleDist{a})
+}
+
+// YuleCF returns the characteristic function of the Yule–Simon distribution, by numerical summation.
+func YuleCF(a, t float64) complex128 {
+	return cfDiscrete(YuleDist{a}, t)
+}
+
+// YuleCGF returns the cumulant generating function of the Yule–Simon distribution, by numerical summation; +Inf for t > 0.
+func YuleCGF(a, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	return cgfDiscrete(YuleDist{a}, t)
+}
+
+// YuleFit returns the maximum-likelihood estimate of a of the Yule–Simon distribution from the sample k, starting from its mean a / (a - 1).
+func YuleFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return YuleDist{θ[0]}.LnPMF })
+	μ, _ := mleMeanVarInt(k)
+	a := 2.0
+	if μ > 1 {
+		a = μ / (μ - 1)
+	}
+	return mleFit(lnL, []float64{a}, []mleBound{mlePos})
+}
+
+// YuleDist is the Yule–Simon distribution with shape A. It implements Discrete.
+type YuleDist struct {
+	A float64
+}
+
+// PMF returns the value of PMF of the Yule–Simon distribution at k.
+func (d YuleDist) PMF(k int64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return YulePMFAt(d.A, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Yule–Simon distribution at k.
+func (d YuleDist) LnPMF(k int64) float64 {
+	if k < 1 {
+		return negInf
+	}
+	return log(d.A) + logB(d.A+1, float64(k))
+}
+
+// CDF returns the value of CDF of the Yule–Simon distribution at k.
+func (d YuleDist) CDF(k int64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return YuleCDFAt(d.A, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Yule–Simon distribution at k.
+func (d YuleDist) Surv(k int64) float64 { return YuleCDFTail(d.A, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Yule–Simon distribution at k.
+func (d YuleDist) LnCDF(k int64) float64 { return YuleCDFTail(d.A, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Yule–Simon distribution at k.
+func (d YuleDist) LnSurv(k int64) float64 { return YuleCDFTail(d.A, false, true)(k) }
+
+// Qtl returns the quantile of the Yule–Simon distribution for probability p.
+func (d YuleDist) Qtl(p float64) int64 { return YuleQtlFor(d.A, p) }
+
+// QtlTail returns the quantile of the Yule–Simon distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d YuleDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return YuleQtlTail(d.A, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Yule–Simon distribution.
+func (d YuleDist) Rand() int64 { return YuleNext(d.A) }
+
+// RandR returns random number drawn from the Yule–Simon distribution, using the random source src.
+func (d YuleDist) RandR(src *rand.Rand) int64 { return YuleNextR(src, d.A) }
+
+// Mean returns the mean of the Yule–Simon distribution.
+func (d YuleDist) Mean() float64 { return YuleMean(d.A) }
+
+// Var returns the variance of the Yule–Simon distribution.
+func (d YuleDist) Var() float64 { return YuleVar(d.A) }
+
+// Skew returns the skewness of the Yule–Simon distribution.
+func (d YuleDist) Skew() float64 { return YuleSkew(d.A) }
+
+// ExKurt returns the excess kurtosis of the Yule–Simon distribution.
+func (d YuleDist) ExKurt() float64 { return YuleExKurt(d.A) }
+
+// Entropy returns the Shannon entropy of the Yule–Simon distribution.
+func (d YuleDist) Entropy() float64 { return YuleEntropy(d.A) }
+
+// CF returns the characteristic function of the Yule–Simon distribution at t.
+func (d YuleDist) CF(t float64) complex128 { return YuleCF(d.A, t) }
+
+// CGF returns the cumulant generating function of the Yule–Simon distribution at t.
+func (d YuleDist) CGF(t float64) float64 { return YuleCGF(d.A, t) }
+
+// Support returns the support of the Yule–Simon distribution.
+func (d YuleDist) Support() (a, b int64) { return 1, posInfInt64 }
@@ -37,6 +37,11 @@ func ZCDFAt(x float64) float64 {
 	return cdf(x)
 }
 
+// ZCDFTail returns the CDF of the Standard Normal distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ZCDFTail(lowerTail, logP bool) func(x float64) float64 {
+	return NormalCDFTail(0, 1, lowerTail, logP)
+}
+
 // ZQtl returns the inverse of the CDF (quantile) of the Standard Normal distribution. 
 func ZQtl() func(p float64) float64 {
 	return func(p float64) float64 {
@@ -77,3 +82,8 @@ func ZQtlFor(p float64) float64 {
 	qtl := ZQtl()
 	return qtl(p)
 }
+
+// ZQtlTail returns the inverse of ZCDFTail (quantile) of the Standard Normal distribution.
+func ZQtlTail(lowerTail, logP bool) func(p float64) float64 {
+	return NormalQtlTail(0, 1, lowerTail, logP)
+}
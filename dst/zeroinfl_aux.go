@@ -0,0 +1,113 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Zero-inflated and hurdle count distributions, helper functions.
+// A zero-inflated distribution is the base count distribution f with extra zeros mixed in with probability ψ:
+// P[X = 0] = ψ + (1-ψ) f(0), P[X = k] = (1-ψ) f(k), k > 0.
+// A hurdle distribution takes zero with probability ψ, and the base distribution truncated to k > 0 otherwise:
+// P[X = 0] = ψ, P[X = k] = (1-ψ) f(k) / (1 - f(0)), k > 0.
+// Both are computed on the log scale from the PMF and the tails of the base distribution.
+
+import (
+	"math/rand"
+)
+
+// zeroInflLnPMF returns the logarithm of the PMF at k ≥ 0 of the zero-inflated distribution, given the logarithm lnf of the base PMF at k.
+func zeroInflLnPMF(ψ float64, k int64, lnf float64) float64 {
+	if k == 0 {
+		return logspace_add(log(ψ), log1p(-ψ)+lnf)
+	}
+	return log1p(-ψ) + lnf
+}
+
+// zeroInflLnCDF returns the logarithm of the CDF at k ≥ 0 of the zero-inflated distribution if lowerTail, of its survival function otherwise,
+// given the logarithm lnP of the same tail of the base distribution at k.
+func zeroInflLnCDF(ψ, lnP float64, lowerTail bool) float64 {
+	if lowerTail {
+		return logspace_add(log(ψ), log1p(-ψ)+lnP)
+	}
+	return log1p(-ψ) + lnP
+}
+
+// hurdleLnPMF returns the logarithm of the PMF at k ≥ 0 of the hurdle distribution,
+// given the logarithm lnf of the base PMF at k and the logarithm lnS0 of the base P[X > 0].
+func hurdleLnPMF(ψ float64, k int64, lnf, lnS0 float64) float64 {
+	if k == 0 {
+		return log(ψ)
+	}
+	return log1p(-ψ) + lnf - lnS0
+}
+
+// hurdleLnCDF returns the logarithm of the CDF at k ≥ 0 of the hurdle distribution if lowerTail, of its survival function otherwise,
+// given the logarithm lnR of P[X > k | X > 0] for the base distribution.
+func hurdleLnCDF(ψ, lnR float64, lowerTail bool) float64 {
+	// lnR rounds above 0 at k = 0
+	lnR = min(lnR, 0)
+	if lowerTail {
+		return logspace_add(log(ψ), log1p(-ψ)+log1Exp(lnR))
+	}
+	return log1p(-ψ) + lnR
+}
+
+// zeroTruncNextR returns random number drawn from the base distribution conditioned on X > 0, using the random source src,
+// given the logarithm lnS0 of the base P[X > 0], the base generator next, and the base quantile lnQtlUpper of the upper tail on the log scale.
+func zeroTruncNextR(src *rand.Rand, lnS0 float64, next func() int64, lnQtlUpper func(p float64) int64) int64 {
+	if lnS0 > -Ln2 {
+		// rejection, accepting more than half of the draws
+		for {
+			if k := next(); k > 0 {
+				return k
+			}
+		}
+	}
+	// inversion of the upper tail, P[X > k] = u P[X > 0]
+	u := src.Float64()
+	for u == 0 {
+		u = src.Float64()
+	}
+	return lnQtlUpper(log(u) + lnS0)
+}
+
+// zeroModMoments returns the mean, variance, skewness and excess kurtosis of the distribution
+// equal to the distribution d with probability w, and to zero otherwise.
+func zeroModMoments(w float64, d moments4) (μ, σ2, skew, kurt float64) {
+	m := mixRawMoments([]float64{w}, func(int) moments4 { return d }, 4)
+	return rawMoments(m[1], m[2], m[3], m[4])
+}
+
+// zeroInflStart returns the starting values of ψ and of the mean of the base distribution for fitting a zero-inflated distribution to the sample k:
+// the zeros in excess of those of the Poisson distribution with the mean of k, ψ within [0.05, 0.95].
+func zeroInflStart(k []int64) (ψ, μ float64) {
+	μ, _ = mleMeanVarInt(k)
+	ψ = min(max(zeroFrac(k)-exp(-μ), 0.05), 0.95)
+	return ψ, μ / (1 - ψ)
+}
+
+// hurdleStart returns the starting values of ψ and of the mean of the base distribution for fitting a hurdle distribution to the sample k:
+// the fraction of zeros, within [0.01, 0.99], and the mean of the positive counts.
+func hurdleStart(k []int64) (ψ, μ float64) {
+	var s, n float64
+	for _, v := range k {
+		if v > 0 {
+			s += float64(v)
+			n++
+		}
+	}
+	μ = 1
+	if n > 0 {
+		μ = s / n
+	}
+	return min(max(zeroFrac(k), 0.01), 0.99), μ
+}
+
+// zeroFrac returns the fraction of zeros in the sample k.
+func zeroFrac(k []int64) float64 {
+	n := 0
+	for _, v := range k {
+		if v == 0 {
+			n++
+		}
+	}
+	return float64(n) / float64(len(k))
+}
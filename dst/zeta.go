@@ -31,6 +31,17 @@ func ZetaPMFAt(s float64, k int64) float64 {
 	return pmf(k)
 }
 
+// ZetaLnPMF returns the natural logarithm of the PMF of the Zeta distribution; ζ(s) is computed once.
+func ZetaLnPMF(s float64) func(k int64) float64 {
+	lnζ := log(ζ(s))
+	return func(k int64) float64 {
+		if k < 1 {
+			return negInf
+		}
+		return -s*log(float64(k)) - lnζ
+	}
+}
+
 // ZetaCDF returns the CDF of the Zeta distribution. 
 func ZetaCDF(s float64) func(k int64) float64 {
 	return func(k int64) float64 {
@@ -47,15 +58,125 @@ func ZetaCDFAt(s float64, k int64) float64 {
 	return pmf(k)
 }
 
+// ZetaCDFTail returns the CDF of the Zeta distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ZetaCDFTail(s float64, lowerTail, logP bool) func(k int64) float64 {
+	lnζ := log(hurwitzζ(s, 1))
+	return func(k int64) float64 {
+		if k < 1 {
+			return pTailBounds(false, lowerTail, logP)
+		}
+		// P[X > k] = ζ(s, k+1) / ζ(s), in constant time
+		return pTailLnUpper(log(hurwitzζ(s, float64(k)+1))-lnζ, lowerTail, logP)
+	}
+}
+
+// hurwitzζ returns the Hurwitz zeta function ζ(s, q), the sum of (q+j)^-s over j ≥ 0, for s > 1 and q > 0:
+// the first terms summed, the rest by the Euler–Maclaurin formula.
+func hurwitzζ(s, q float64) float64 {
+	const n = 9
+	// B_2k / (2k)!
+	b := []float64{1.0 / 12, -1.0 / 720, 1.0 / 30240, -1.0 / 1209600, 1.0 / 47900160, -691.0 / 1307674368000, 1.0 / 74724249600}
+	sum := 0.0
+	for j := 0; j < n; j++ {
+		sum += pow(q+float64(j), -s)
+	}
+	a := q + n
+	sum += pow(a, 1-s)/(s-1) + pow(a, -s)/2
+	// s (s+1) ... (s+2k-2) a^(-s-2k+1)
+	t := s * pow(a, -s-1)
+	for k := range b {
+		d := b[k] * t
+		sum += d
+		if abs(d) < eps64*sum {
+			break
+		}
+		t *= (s + float64(2*k+1)) * (s + float64(2*k+2)) / (a * a)
+	}
+	return sum
+}
+
+// hurwitzζLn returns Σ ln(q+k) (q+k)^-s, k ≥ 0, the derivative -∂ζ(s, q)/∂s, by the Euler–Maclaurin formula differentiated term by term.
+func hurwitzζLn(s, q float64) float64 {
+	const n = 9
+	b := []float64{1.0 / 12, -1.0 / 720, 1.0 / 30240, -1.0 / 1209600, 1.0 / 47900160, -691.0 / 1307674368000, 1.0 / 74724249600}
+	sum := 0.0
+	for j := 0; j < n; j++ {
+		x := q + float64(j)
+		sum += log(x) * pow(x, -s)
+	}
+	a := q + n
+	la := log(a)
+	sum += pow(a, 1-s)*(la/(s-1)+1/((s-1)*(s-1))) + la*pow(a, -s)/2
+	// the terms s (s+1) ... (s+2k) a^(-s-2k-1), and the sum of 1 / (s+i) of their derivative
+	t, r := s*pow(a, -s-1), 1/s
+	for k := range b {
+		d := b[k] * t * (la - r)
+		sum += d
+		if abs(d) < eps64*sum {
+			break
+		}
+		t *= (s + float64(2*k+1)) * (s + float64(2*k+2)) / (a * a)
+		r += 1/(s+float64(2*k+1)) + 1/(s+float64(2*k+2))
+	}
+	return sum
+}
+
+// ZetaQtl returns the inverse of the CDF (quantile) of the Zeta distribution.
+// It panics with the error that ZetaQtlErr returns, if any.
+func ZetaQtl(s float64) func(p float64) int64 {
+	qtl := ZetaQtlErr(s)
+	return func(p float64) int64 {
+		k, err := qtl(p)
+		if err != nil {
+			panic(err)
+		}
+		return k
+	}
+}
+
+// ZetaQtlErr returns the inverse of the CDF (quantile) of the Zeta distribution, with the error of the inversion, if any.
+func ZetaQtlErr(s float64) func(p float64) (int64, error) {
+	cdf := ZetaCDFTail(s, true, false)
+	μ, σ, γ := ZetaMean(s), sqrt(ZetaVar(s)), ZetaSkew(s)
+	return func(p float64) (int64, error) {
+		if !(s > 1) {
+			return 1, ErrQtlParam
+		}
+		return InvertCDFDiscrete(cdf, p, 1, posInfInt64, μ, σ, γ)
+	}
+}
+
+// ZetaQtlFor returns the inverse of the CDF (quantile) of the Zeta distribution, for given probability.
+func ZetaQtlFor(s, p float64) int64 {
+	qtl := ZetaQtl(s)
+	return qtl(p)
+}
+
+// ZetaQtlTail returns the inverse of ZetaCDFTail (quantile) of the Zeta distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
+func ZetaQtlTail(s float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := ZetaCDFTail(s, lowerTail, logP)
+	return func(p float64) int64 {
+		if !(s > 1) {
+			panic(ErrQtlParam)
+		}
+		return qtlSearchTail(cdf, p, 1, posInfInt64, lowerTail, logP)
+	}
+}
+
 // ZetaNext returns random number drawn from the Zeta distribution. 
 func ZetaNext(s float64) (k int64) {
+	return ZetaNextR(GlobalRand, s)
+}
+
+// ZetaNextR returns random number drawn from the Zeta distribution, using the random source src.
+func ZetaNextR(src *rand.Rand, s float64) (k int64) {
 	// Devroye 1986: 550. Called "Zipf distribution" there.
 	// Devroye, L. 1986: Non-Uniform Random Variate Generation. Springer-Verlag, New York. ISBN 0-387-96305-7.
 	var x float64
 	b := pow(2.0, s-1.0)
 	for {
-		u := rand.Float64()
-		v := rand.Float64()
+		u := src.Float64()
+		v := src.Float64()
 		x = floor(pow(u, -1/(s-1)))
 		t := pow(1+1.0/x, s-1)
 		delta := v * x * (t - 1.0) / (b - 1.0)
@@ -69,7 +190,24 @@ func ZetaNext(s float64) (k int64) {
 
 // Zeta returns the random number generator with  Zeta distribution. 
 func Zeta(s float64) func() int64 {
-	return func() int64 { return ZetaNext(s) }
+	return ZetaR(GlobalRand, s)
+}
+
+// ZetaR returns the random number generator with  Zeta distribution, using the random source src.
+func ZetaR(src *rand.Rand, s float64) func() int64 {
+	return func() int64 { return ZetaNextR(src, s) }
+}
+
+// ZetaFill fills x with random numbers drawn from the Zeta distribution.
+func ZetaFill(s float64, x []int64) {
+	ZetaFillR(GlobalRand, s, x)
+}
+
+// ZetaFillR fills x with random numbers drawn from the Zeta distribution, using the random source src.
+func ZetaFillR(src *rand.Rand, s float64, x []int64) {
+	for i := range x {
+		x[i] = ZetaNextR(src, s)
+	}
 }
 
 // ZetaMean returns the mean of the Zeta distribution. 
@@ -94,7 +232,142 @@ func ZetaVar(s float64) float64 {
 	}
 	t1 := ζ(s)
 	t2 := ζ(s - 2)
-	t3 := ζ((s - 1) * (s - 1))
-	t4 := ζ(s * s)
-	return (t1*t2 - t3) / t4
+	t3 := ζ(s - 1)
+	return (t1*t2 - t3*t3) / (t1 * t1)
 }
+
+// ZetaSkew returns the skewness of the Zeta distribution.
+func ZetaSkew(s float64) float64 {
+	if s <= 4 {
+		return NaN
+	}
+	z := ζ(s)
+	_, _, skew, _ := rawMoments(ζ(s-1)/z, ζ(s-2)/z, ζ(s-3)/z, 0)
+	return skew
+}
+
+// ZetaExKurt returns the excess kurtosis of the Zeta distribution.
+func ZetaExKurt(s float64) float64 {
+	if s <= 5 {
+		return NaN
+	}
+	z := ζ(s)
+	_, _, _, kurt := rawMoments(ζ(s-1)/z, ζ(s-2)/z, ζ(s-3)/z, ζ(s-4)/z)
+	return kurt
+}
+
+// ZetaEntropy returns the Shannon entropy of the Zeta distribution, ln ζ(s) - s ζ'(s) / ζ(s).
+func ZetaEntropy(s float64) float64 {
+	z := hurwitzζ(s, 1)
+	return log(z) + s*hurwitzζLn(s, 1)/z
+}
+
+// ZetaCF returns the characteristic function of the Zeta distribution, by numerical summation.
+func ZetaCF(s, t float64) complex128 {
+	lnPMF := ZetaLnPMF(s)
+	re := zetaSum(s, func(k int64) float64 { return exp(lnPMF(k)) * cos(t*float64(k)) })
+	im := zetaSum(s, func(k int64) float64 { return exp(lnPMF(k)) * sin(t*float64(k)) })
+	return complex(re, im)
+}
+
+// ZetaCGF returns the cumulant generating function of the Zeta distribution, by numerical summation; +Inf for t > 0.
+func ZetaCGF(s, t float64) float64 {
+	if t > 0 {
+		return posInf
+	}
+	lnPMF := ZetaLnPMF(s)
+	// the terms scaled by e^t at the mode 1
+	return t + log(zetaSum(s, func(k int64) float64 { return exp(lnPMF(k) + t*float64(k-1)) }))
+}
+
+// zetaSum returns the sum of f(k) for k ≥ 1, until the tail of the Zeta distribution, below k^{1-s} / ((s-1) ζ(s)), is under infoTiny, or after infoMaxTerms terms.
+func zetaSum(s float64, f func(k int64) float64) float64 {
+	lnζ := log(ζ(s))
+	sum := fZero
+	for k := int64(1); k <= infoMaxTerms; k++ {
+		sum += f(k)
+		if (1-s)*log(float64(k))-log(s-1)-lnζ < log(infoTiny) {
+			break
+		}
+	}
+	return sum
+}
+
+// ZetaFit returns the maximum-likelihood estimate of s of the Zeta distribution from the sample k.
+func ZetaFit(k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return ZetaLnPMF(θ[0]) })
+	return mleFit(lnL, []float64{2}, []mleBound{{1, posInf}})
+}
+
+// ZetaDist is the Zeta distribution with exponent S. It implements Discrete.
+type ZetaDist struct {
+	S float64
+}
+
+// PMF returns the value of PMF of the Zeta distribution at k.
+func (d ZetaDist) PMF(k int64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return ZetaPMFAt(d.S, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Zeta distribution at k.
+func (d ZetaDist) LnPMF(k int64) float64 {
+	return ZetaLnPMF(d.S)(k)
+}
+
+// CDF returns the value of CDF of the Zeta distribution at k.
+func (d ZetaDist) CDF(k int64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return ZetaCDFAt(d.S, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Zeta distribution at k.
+func (d ZetaDist) Surv(k int64) float64 { return ZetaCDFTail(d.S, false, false)(k) }
+
+// LnCDF returns the natural logarithm of the CDF of the Zeta distribution at k.
+func (d ZetaDist) LnCDF(k int64) float64 { return ZetaCDFTail(d.S, true, true)(k) }
+
+// LnSurv returns the natural logarithm of the survival function of the Zeta distribution at k.
+func (d ZetaDist) LnSurv(k int64) float64 { return ZetaCDFTail(d.S, false, true)(k) }
+
+// Qtl returns the quantile of the Zeta distribution for probability p.
+func (d ZetaDist) Qtl(p float64) int64 { return ZetaQtlFor(d.S, p) }
+
+// QtlTail returns the quantile of the Zeta distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ZetaDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return ZetaQtlTail(d.S, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Zeta distribution.
+func (d ZetaDist) Rand() int64 { return ZetaNext(d.S) }
+
+// RandR returns random number drawn from the Zeta distribution, using the random source src.
+func (d ZetaDist) RandR(src *rand.Rand) int64 { return ZetaNextR(src, d.S) }
+
+// Mean returns the mean of the Zeta distribution.
+func (d ZetaDist) Mean() float64 { return ZetaMean(d.S) }
+
+// Var returns the variance of the Zeta distribution.
+func (d ZetaDist) Var() float64 { return ZetaVar(d.S) }
+
+// Skew returns the skewness of the Zeta distribution.
+func (d ZetaDist) Skew() float64 { return ZetaSkew(d.S) }
+
+// ExKurt returns the excess kurtosis of the Zeta distribution.
+func (d ZetaDist) ExKurt() float64 { return ZetaExKurt(d.S) }
+
+// Entropy returns the Shannon entropy of the Zeta distribution.
+func (d ZetaDist) Entropy() float64 { return ZetaEntropy(d.S) }
+
+// CF returns the characteristic function of the Zeta distribution at t.
+func (d ZetaDist) CF(t float64) complex128 { return ZetaCF(d.S, t) }
+
+// CGF returns the cumulant generating function of the Zeta distribution at t.
+func (d ZetaDist) CGF(t float64) float64 { return ZetaCGF(d.S, t) }
+
+// Support returns the support of the Zeta distribution.
+func (d ZetaDist) Support() (a, b int64) { return 1, posInfInt64 }
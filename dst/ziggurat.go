@@ -0,0 +1,128 @@
+// Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Ziggurat generators of the standard Normal and Exponential distributions.
+// The density is covered by 256 layers of equal area: a draw takes one 64-bit number of the source in 99% of the cases,
+// whose low 8 bits choose the layer and the high 53 bits the signed abscissa, so that they are independent;
+// the wedges and the tail are drawn by rejection. Unlike NormFloat64 and ExpFloat64 of math/rand, the abscissa has 52 bits rather than 32 at most.
+// Ref.: Marsaglia, G., Tsang, W. W. (2000). The ziggurat method for generating random variables. Journal of Statistical Software 5(8).
+// Ref.: Doornik, J. A. (2005). An improved ziggurat method to generate normal random samples. University of Oxford.
+
+import (
+	"math/rand"
+)
+
+const (
+	zigNormalR = 3.6541528853610088    // start of the tail of the Normal ziggurat
+	zigNormalV = 0.00492867323399      // area of a layer of the Normal ziggurat
+	zigExpR    = 7.69711747013104972   // start of the tail of the Exponential ziggurat
+	zigExpV    = 0.0039496598225815572 // area of a layer of the Exponential ziggurat
+	zigM       = 1 << 52               // range of the abscissa
+)
+
+// zigTables holds the tables of a ziggurat: x = k w[i] lies inside the layer i if k < k[i], f[i] is the density at its upper edge.
+type zigTables struct {
+	k [256]uint64
+	w [256]float64
+	f [256]float64
+}
+
+var zigNormal, zigExp = zigNormalTables(), zigExpTables()
+
+// zigNormalTables returns the tables of the Normal ziggurat, for the density exp(-x²/2).
+func zigNormalTables() (z zigTables) {
+	dn := zigNormalR
+	tn := dn
+	q := zigNormalV / exp(-0.5*dn*dn)
+	z.k[0] = uint64(dn / q * zigM)
+	z.k[1] = 0
+	z.w[0] = q / zigM
+	z.w[255] = dn / zigM
+	z.f[0] = 1
+	z.f[255] = exp(-0.5 * dn * dn)
+	for i := 254; i >= 1; i-- {
+		dn = sqrt(-2 * log(zigNormalV/dn+exp(-0.5*dn*dn)))
+		z.k[i+1] = uint64(dn / tn * zigM)
+		tn = dn
+		z.f[i] = exp(-0.5 * dn * dn)
+		z.w[i] = dn / zigM
+	}
+	return z
+}
+
+// zigExpTables returns the tables of the Exponential ziggurat, for the density exp(-x).
+func zigExpTables() (z zigTables) {
+	de := zigExpR
+	te := de
+	q := zigExpV / exp(-de)
+	z.k[0] = uint64(de / q * zigM)
+	z.k[1] = 0
+	z.w[0] = q / zigM
+	z.w[255] = de / zigM
+	z.f[0] = 1
+	z.f[255] = exp(-de)
+	for i := 254; i >= 1; i-- {
+		de = -log(zigExpV/de + exp(-de))
+		z.k[i+1] = uint64(de / te * zigM)
+		te = de
+		z.f[i] = exp(-de)
+		z.w[i] = de / zigM
+	}
+	return z
+}
+
+// normalZig returns random number drawn from the standard Normal distribution, using the random source src.
+func normalZig(src *rand.Rand) float64 {
+	for {
+		u := src.Uint64()
+		i := u & 0xff
+		j := int64(u) >> 11 // the signed abscissa, in the high 53 bits
+		x := float64(j) * zigNormal.w[i]
+		k := uint64(j)
+		if j < 0 {
+			k = uint64(-j)
+		}
+		if k < zigNormal.k[i] {
+			return x
+		}
+		if i == 0 {
+			// the tail beyond r, by Marsaglia's method
+			for {
+				t := -log1p(-src.Float64()) / zigNormalR
+				y := -log1p(-src.Float64())
+				if y+y > t*t {
+					if x < 0 {
+						return -(zigNormalR + t)
+					}
+					return zigNormalR + t
+				}
+			}
+		}
+		// the wedge between the layers i and i-1
+		if zigNormal.f[i]+src.Float64()*(zigNormal.f[i-1]-zigNormal.f[i]) < exp(-0.5*x*x) {
+			return x
+		}
+	}
+}
+
+// expZig returns random number drawn from the standard Exponential distribution, using the random source src.
+func expZig(src *rand.Rand) float64 {
+	for {
+		u := src.Uint64()
+		i := u & 0xff
+		k := u >> 12
+		x := float64(int64(k)) * zigExp.w[i] // the conversion of int64 is faster
+		if k < zigExp.k[i] {
+			return x
+		}
+		if i == 0 {
+			// the tail beyond r is r + Exp(1)
+			return zigExpR - log1p(-src.Float64())
+		}
+		// the wedge between the layers i and i-1
+		if zigExp.f[i]+src.Float64()*(zigExp.f[i-1]-zigExp.f[i]) < exp(-x) {
+			return x
+		}
+	}
+}
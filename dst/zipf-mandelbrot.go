@@ -17,6 +17,7 @@ package dst
 
 import (
 	"math/rand"
+	"sort"
 )
 
 //  ZipfMandelbrotChkParams checks parameters of the Zipf-Mandelbrot  distribution.
@@ -42,6 +43,17 @@ func ZipfMandelbrotPMFAt(n int64, q, s float64, k int64) float64 {
 	return pmf(k)
 }
 
+// ZipfMandelbrotLnPMF returns the natural logarithm of the PMF of the Zipf-Mandelbrot distribution; the normalizing sum is computed once.
+func ZipfMandelbrotLnPMF(n int64, q, s float64) func(k int64) float64 {
+	lnH := log(hNumG(n, q, s))
+	return func(k int64) float64 {
+		if k < 1 || k > n {
+			return negInf
+		}
+		return -s*log(float64(k)+q) - lnH
+	}
+}
+
 // ZipfMandelbrotCDF returns the CDF of the Zipf-Mandelbrot distribution. 
 func ZipfMandelbrotCDF(n int64, q, s float64) func(k int64) float64 {
 	return func(k int64) float64 {
@@ -56,12 +68,32 @@ func ZipfMandelbrotCDFAt(n int64, q, s float64, k int64) float64 {
 	return cdf(k)
 }
 
-// Quantile Function for the Zipf-Mandelbrot distribution
+// ZipfMandelbrotCDFTail returns the CDF of the Zipf–Mandelbrot distribution if lowerTail, the survival function otherwise; their logarithm if logP.
+func ZipfMandelbrotCDFTail(n int64, q, s float64, lowerTail, logP bool) func(k int64) float64 {
+	cdf := cdfTailInt(ZipfMandelbrotCDF(n, q, s), lowerTail, logP)
+	return func(k int64) float64 {
+		switch {
+		case k < 1:
+			return pTailBounds(false, lowerTail, logP)
+		case k >= n:
+			return pTailBounds(true, lowerTail, logP)
+		}
+		return cdf(k)
+	}
+}
+
+// Quantile Function for the Zipf-Mandelbrot distribution; it panics with ErrQtlParam or ErrQtlProb for invalid parameters or p.
 func ZipfMandelbrotQtl(n int64, q, s float64) func(p float64) int64 {
 	return func(p float64) int64 {
 		var k int64
 		const kMax = 1e16
 		cdf := ZipfMandelbrotCDF(n, q, s)
+		if !(p >= 0 && p <= 1) {
+			panic(ErrQtlProb)
+		}
+		if isNaN(cdf(1)) {
+			panic(ErrQtlParam)
+		}
 		if cdf(1) >= p {
 			k = 1
 		} else {
@@ -75,19 +107,198 @@ func ZipfMandelbrotQtl(n int64, q, s float64) func(p float64) int64 {
 	}
 }
 
+// ZipfMandelbrotQtlTail returns the inverse of ZipfMandelbrotCDFTail (quantile) of the Zipf–Mandelbrot distribution.
+func ZipfMandelbrotQtlTail(n int64, q, s float64, lowerTail, logP bool) func(p float64) int64 {
+	cdf := ZipfMandelbrotCDFTail(n, q, s, lowerTail, logP)
+	return func(p float64) int64 {
+		return qtlSearchTail(cdf, p, 1, n, lowerTail, logP)
+	}
+}
+
 // ZipfMandelbrotNext returns random number drawn from the Zipf-Mandelbrot distribution. 
 func ZipfMandelbrotNext(n int64, q, s float64) (k int64) {
-	qtl := ZipfMandelbrotQtl(n, q, s)
-	p := rand.Float64()
-	return qtl(p)
+	return ZipfMandelbrotNextR(GlobalRand, n, q, s)
+}
+
+// ZipfMandelbrotNextR returns random number drawn from the Zipf-Mandelbrot distribution, using the random source src.
+func ZipfMandelbrotNextR(src *rand.Rand, n int64, q, s float64) (k int64) {
+	zs := newZipfMandelbrotSampler(n, q, s)
+	return zs.next(src)
 }
 
 // ZipfMandelbrot returns the random number generator with  Zipf-Mandelbrot distribution. 
 func ZipfMandelbrot(n int64, q, s float64) func() int64 {
-	return func() int64 { return ZipfMandelbrotNext(n, q, s) }
+	return ZipfMandelbrotR(GlobalRand, n, q, s)
+}
+
+// ZipfMandelbrotR returns the random number generator with  Zipf-Mandelbrot distribution, using the random source src.
+func ZipfMandelbrotR(src *rand.Rand, n int64, q, s float64) func() int64 {
+	zs := newZipfMandelbrotSampler(n, q, s)
+	return func() int64 { return zs.next(src) }
+}
+
+// ZipfMandelbrotFill fills x with random numbers drawn from the Zipf-Mandelbrot distribution.
+func ZipfMandelbrotFill(n int64, q, s float64, x []int64) {
+	ZipfMandelbrotFillR(GlobalRand, n, q, s, x)
+}
+
+// ZipfMandelbrotFillR fills x with random numbers drawn from the Zipf-Mandelbrot distribution, using the random source src;
+// the normalizing sum is computed once for all the draws, and the guide table of the weights built if n ≤ len(x).
+func ZipfMandelbrotFillR(src *rand.Rand, n int64, q, s float64, x []int64) {
+	if n <= int64(len(x)) && n <= guideMax {
+		w := make([]float64, n)
+		for i := range w {
+			w[i] = pow(float64(i+1)+q, -s)
+		}
+		g := NewGuide(1, w)
+		for i := range x {
+			x[i] = g.NextR(src)
+		}
+		return
+	}
+	zs := newZipfMandelbrotSampler(n, q, s)
+	for i := range x {
+		x[i] = zs.next(src)
+	}
+}
+
+// zipfMandelbrotSampler draws by inversion, from the table of the cumulative weights (k+q)^-s, extended as the draws need it.
+type zipfMandelbrotSampler struct {
+	n    int64
+	q, s float64
+	h    float64   // sum of the weights
+	cum  []float64 // cum[k-1], the sum of the weights of 1, ..., k
+}
+
+// newZipfMandelbrotSampler returns the sampler of the Zipf-Mandelbrot distribution.
+func newZipfMandelbrotSampler(n int64, q, s float64) zipfMandelbrotSampler {
+	h := fZero
+	for k := n; k >= 1; k-- {
+		// the smallest weights first
+		h += pow(float64(k)+q, -s)
+	}
+	return zipfMandelbrotSampler{n: n, q: q, s: s, h: h}
+}
+
+// next returns random number drawn from the Zipf-Mandelbrot distribution, using the random source src.
+func (zs *zipfMandelbrotSampler) next(src *rand.Rand) int64 {
+	u := src.Float64() * zs.h
+	for k := int64(len(zs.cum)); (k == 0 || zs.cum[k-1] < u) && k < zs.n; k++ {
+		c := pow(float64(k+1)+zs.q, -zs.s)
+		if k > 0 {
+			c += zs.cum[k-1]
+		}
+		zs.cum = append(zs.cum, c)
+	}
+	k := int64(sort.SearchFloat64s(zs.cum, u))
+	if k >= zs.n {
+		// u beyond the rounded sum of the table
+		k = zs.n - 1
+	}
+	return k + 1
 }
 
 // ZipfMandelbrotMean returns the mean of the Zipf-Mandelbrot distribution. 
 func ZipfMandelbrotMean(n int64, q, s float64) float64 {
 	return hNumG(n, q, s-1)/hNumG(n, q, s) - q
 }
+
+// ZipfMandelbrotFit returns the maximum-likelihood estimates of q, s of the Zipf-Mandelbrot distribution on {1, ..., n} from the sample k.
+func ZipfMandelbrotFit(n int64, k []int64) MLE {
+	lnL := mleLnLInt(k, func(θ []float64) func(k int64) float64 { return ZipfMandelbrotLnPMF(n, θ[0], θ[1]) })
+	return mleFit(lnL, []float64{1, 1}, []mleBound{mlePos, mlePos})
+}
+
+// ZipfMandelbrotDist is the Zipf–Mandelbrot distribution on {1, ..., N} with parameters Q and S. It implements Discrete.
+type ZipfMandelbrotDist struct {
+	N    int64
+	Q, S float64
+}
+
+// PMF returns the value of PMF of the Zipf–Mandelbrot distribution at k.
+func (d ZipfMandelbrotDist) PMF(k int64) float64 {
+	if k < 1 || k > d.N {
+		return 0
+	}
+	return ZipfMandelbrotPMFAt(d.N, d.Q, d.S, k)
+}
+
+// LnPMF returns the natural logarithm of the PMF of the Zipf–Mandelbrot distribution at k.
+func (d ZipfMandelbrotDist) LnPMF(k int64) float64 {
+	return ZipfMandelbrotLnPMF(d.N, d.Q, d.S)(k)
+}
+
+// CDF returns the value of CDF of the Zipf–Mandelbrot distribution at k.
+func (d ZipfMandelbrotDist) CDF(k int64) float64 {
+	switch {
+	case k < 1:
+		return 0
+	case k >= d.N:
+		return 1
+	}
+	return ZipfMandelbrotCDFAt(d.N, d.Q, d.S, k)
+}
+
+// Surv returns the value of the survival function 1 - CDF of the Zipf–Mandelbrot distribution at k.
+func (d ZipfMandelbrotDist) Surv(k int64) float64 {
+	return ZipfMandelbrotCDFTail(d.N, d.Q, d.S, false, false)(k)
+}
+
+// LnCDF returns the natural logarithm of the CDF of the Zipf–Mandelbrot distribution at k.
+func (d ZipfMandelbrotDist) LnCDF(k int64) float64 {
+	return ZipfMandelbrotCDFTail(d.N, d.Q, d.S, true, true)(k)
+}
+
+// LnSurv returns the natural logarithm of the survival function of the Zipf–Mandelbrot distribution at k.
+func (d ZipfMandelbrotDist) LnSurv(k int64) float64 {
+	return ZipfMandelbrotCDFTail(d.N, d.Q, d.S, false, true)(k)
+}
+
+// Qtl returns the quantile of the Zipf–Mandelbrot distribution for probability p.
+func (d ZipfMandelbrotDist) Qtl(p float64) int64 { return ZipfMandelbrotQtl(d.N, d.Q, d.S)(p) }
+
+// QtlTail returns the quantile of the Zipf–Mandelbrot distribution for probability p of the lower tail if lowerTail, of the upper tail otherwise, given as logarithm if logP.
+func (d ZipfMandelbrotDist) QtlTail(p float64, lowerTail, logP bool) int64 {
+	return ZipfMandelbrotQtlTail(d.N, d.Q, d.S, lowerTail, logP)(p)
+}
+
+// Rand returns random number drawn from the Zipf–Mandelbrot distribution.
+func (d ZipfMandelbrotDist) Rand() int64 { return ZipfMandelbrotNext(d.N, d.Q, d.S) }
+
+// RandR returns random number drawn from the Zipf–Mandelbrot distribution, using the random source src.
+func (d ZipfMandelbrotDist) RandR(src *rand.Rand) int64 {
+	return ZipfMandelbrotNextR(src, d.N, d.Q, d.S)
+}
+
+// Mean returns the mean of the Zipf–Mandelbrot distribution.
+func (d ZipfMandelbrotDist) Mean() float64 { return ZipfMandelbrotMean(d.N, d.Q, d.S) }
+
+// Var returns the variance of the Zipf–Mandelbrot distribution.
+func (d ZipfMandelbrotDist) Var() float64 {
+	_, σ2, _, _ := discreteMoments(d.PMF, 1, d.N)
+	return σ2
+}
+
+// Skew returns the skewness of the Zipf–Mandelbrot distribution.
+func (d ZipfMandelbrotDist) Skew() float64 {
+	_, _, s, _ := discreteMoments(d.PMF, 1, d.N)
+	return s
+}
+
+// ExKurt returns the excess kurtosis of the Zipf–Mandelbrot distribution.
+func (d ZipfMandelbrotDist) ExKurt() float64 {
+	_, _, _, k := discreteMoments(d.PMF, 1, d.N)
+	return k
+}
+
+// Entropy returns the Shannon entropy of the Zipf–Mandelbrot distribution.
+func (d ZipfMandelbrotDist) Entropy() float64 { return entropyDiscrete(d) }
+
+// CF returns the characteristic function of the Zipf–Mandelbrot distribution at t.
+func (d ZipfMandelbrotDist) CF(t float64) complex128 { return cfDiscrete(d, t) }
+
+// CGF returns the cumulant generating function of the Zipf–Mandelbrot distribution at t.
+func (d ZipfMandelbrotDist) CGF(t float64) float64 { return cgfDiscrete(d, t) }
+
+// Support returns the support of the Zipf–Mandelbrot distribution.
+func (d ZipfMandelbrotDist) Support() (a, b int64) { return 1, d.N }
@@ -0,0 +1,52 @@
+package stat
+
+import (
+	"code.google.com/p/probab/dst"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values, across the cut at ±π
+func TestCircMean(t *testing.T) {
+	fmt.Println("Testing circular mean and variance")
+	x := []float64{0.1, 2*math.Pi - 0.1}
+	if m := CircMean(x); math.Abs(m) > 1e-12 {
+		fmt.Println("failed: x, y ", m, 0)
+		t.Error()
+	}
+	if v, y := CircVar(x), 1-math.Cos(0.1); !check(v, y) {
+		fmt.Println("failed: x, y ", v, y)
+		t.Error()
+	}
+	x = []float64{0, math.Pi / 2}
+	r, θ := MeanResultant(x)
+	if !check(r, math.Sqrt(0.5)) || !check(θ, math.Pi/4) {
+		fmt.Println("failed: x, y ", r, θ)
+		t.Error()
+	}
+	if s, y := CircStd(x), math.Sqrt(math.Log(2)); !check(s, y) {
+		fmt.Println("failed: x, y ", s, y)
+		t.Error()
+	}
+}
+
+// the sample circular variance of von Mises variates should approach 1 - I1(κ) / I0(κ)
+func TestCircVarVonMises(t *testing.T) {
+	fmt.Println("Testing circular variance of von Mises sample")
+	m := 1000000
+	d := make([]float64, m)
+	for i := range d {
+		d[i] = dst.VonMisesNext(3, 2)
+	}
+	x := CircVar(d)
+	y := dst.VonMisesCircVar(3, 2)
+	if abs(x-y) > 2e-3 {
+		fmt.Println("failed: x, y ", x, y)
+		t.Error()
+	}
+	if x := CircMean(d); abs(x-3) > 5e-3 {
+		fmt.Println("failed: x, y ", x, 3)
+		t.Error()
+	}
+}
@@ -0,0 +1,123 @@
+package stat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// uniform is the CDF of the Uniform distribution on [0, 1].
+func uniform(x float64) float64 {
+	return math.Max(0, math.Min(1, x))
+}
+
+// Test against Marsaglia, Tsang & Wang (2003), and the distributions of the statistics of a single observation
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	const delta = 1e-12
+
+	fmt.Println("Kolmogorov-Smirnov test")
+	if x, y := kolmogorovExact(10, 0.274), 0.6284796154565043; abs(x-y) > delta {
+		fmt.Println("failed K(10, 0.274): ", x, y)
+		t.Error()
+	}
+	// D = max(F, 1-F) of one observation, P(D >= d) = 2(1-d); P(D+ >= d) = 1-d
+	d, p := KolmogorovSmirnov([]float64{0.3}, uniform, 0)
+	if abs(d-0.7) > delta || abs(p-0.6) > delta {
+		fmt.Println("failed two-sided: ", d, p)
+		t.Error()
+	}
+	d, p = KolmogorovSmirnov([]float64{0.3}, uniform, 2)
+	if abs(d-0.7) > delta || abs(p-0.3) > delta {
+		fmt.Println("failed greater: ", d, p)
+		t.Error()
+	}
+	d, p = KolmogorovSmirnov([]float64{0.3}, uniform, 1)
+	if abs(d-0.3) > delta || abs(p-0.7) > delta {
+		fmt.Println("failed less: ", d, p)
+		t.Error()
+	}
+	// the 5% point of the limiting distribution
+	if x := kolmogorovTail(1.3581); abs(x-0.05) > 1e-5 {
+		fmt.Println("failed limiting distribution: ", x)
+		t.Error()
+	}
+}
+
+// Test against the formula of Gnedenko & Korolyuk for samples of equal sizes
+
+func TestKolmogorovSmirnov2(t *testing.T) {
+	const delta = 1e-12
+
+	fmt.Println("Kolmogorov-Smirnov two-sample test")
+	x := []float64{0.61, 0.29, 0.06, 0.59, -1.73, -0.74, 0.51, -0.56, 0.39, 1.64}
+	y := []float64{-0.02, 1.27, 3.45, 0.87, 1.92, 2.19, 0.34, 1.14, 0.7, 1.5}
+	d, p := KolmogorovSmirnov2(x, y, 0)
+	// P(D >= k/n) = 2 Σ (-1)^(j+1) C(2n, n-jk) / C(2n, n)
+	n, k := 10, int(d*10+0.5)
+	gk := 0.0
+	for j, sign := 1, 1.0; n-j*k >= 0; j, sign = j+1, -sign {
+		gk += 2 * sign * math.Exp(lnChoose(float64(2*n), float64(n-j*k))-lnChoose(float64(2*n), float64(n)))
+	}
+	if abs(d-0.7) > delta || abs(p-gk) > delta {
+		fmt.Println("failed two-sided: ", d, p, gk)
+		t.Error()
+	}
+	d, _ = KolmogorovSmirnov2(x, y, 2)
+	if abs(d-0.7) > delta {
+		fmt.Println("failed greater: ", d)
+		t.Error()
+	}
+}
+
+// Test the statistics of a uniform sample, and the percentage points of the limiting distributions
+
+func TestAndersonDarling(t *testing.T) {
+	fmt.Println("Anderson-Darling test")
+	a2, _ := AndersonDarling([]float64{0.7, 0.1, 0.4}, uniform)
+	if !check(a2, 0.3660280874077375) {
+		fmt.Println("failed statistic: ", a2)
+		t.Error()
+	}
+	if x := andersonDarlingInf(2.492); abs(x-0.95) > 1e-4 {
+		fmt.Println("failed limiting distribution: ", x)
+		t.Error()
+	}
+}
+
+func TestCramerVonMises(t *testing.T) {
+	fmt.Println("Cramér-von Mises test")
+	w2, _ := CramerVonMises([]float64{0.7, 0.1, 0.4}, uniform)
+	if !check(w2, 0.06) {
+		fmt.Println("failed statistic: ", w2)
+		t.Error()
+	}
+	for _, tt := range []struct{ x, p float64 }{{0.34730, 0.90}, {0.46136, 0.95}, {0.74346, 0.99}} {
+		if x := cramerVonMisesCDF(tt.x); abs(x-tt.p) > 1e-5 {
+			fmt.Println("failed limiting distribution: ", tt.x, x, tt.p)
+			t.Error()
+		}
+	}
+}
+
+// Test against the Chi-squared distribution with one degree of freedom, P(X > x) = erfc(sqrt(x/2))
+
+func TestChiSquareGOF(t *testing.T) {
+	fmt.Println("Chi-square goodness-of-fit test")
+	x := []float64{-0.3, -1.2, -0.1, 0.4, -2.2, -0.8, 1.1, -0.5, 0.9, -0.6}
+	normal := func(x float64) float64 { return 0.5 * math.Erfc(-x/math.Sqrt2) }
+	chi2, df, p := ChiSquareGOF(x, normal, []float64{0}, 0)
+	// 7 and 3 observations in the bins, 5 expected in each
+	if !check(chi2, 1.6) || df != 1 || !check(p, math.Erfc(math.Sqrt(0.8))) {
+		fmt.Println("failed: ", chi2, df, p)
+		t.Error()
+	}
+	k := []int64{0, 3, 1, 1, 2, 0, 5, 1}
+	geometric := func(k int64) float64 { return 1 - math.Pow(0.5, float64(k+1)) }
+	chi2, df, p = ChiSquareGOFInt(k, geometric, []int64{0}, 0)
+	// 2 and 6 observations in the bins, 4 expected in each
+	if !check(chi2, 2) || df != 1 || !check(p, math.Erfc(1)) {
+		fmt.Println("failed discrete: ", chi2, df, p)
+		t.Error()
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Anderson-Darling test of a sample against a continuous distribution.
+// Ref.: Anderson & Darling (1954). A test of goodness of fit. Journal of the American Statistical Association 49, 765-769.
+// Ref.: Marsaglia & Marsaglia (2004). Evaluating the Anderson-Darling distribution. Journal of Statistical Software 9(2).
+
+// AndersonDarling performs the Anderson-Darling test of the sample x against the continuous distribution with the CDF cdf,
+// such as dst.NormalCDF(0, 1).
+func AndersonDarling(x []float64, cdf func(float64) float64) (a2, pVal float64) {
+	// Arguments:
+	// x - vector of observations
+	// cdf - the CDF of the distribution under the null hypothesis, with no parameters estimated from x
+	//
+	// Details:
+	// The statistic A² = -n - Σ (2i-1)/n [log F(x(i)) + log(1 - F(x(n+1-i)))] weighs the squared distance between
+	// the empirical CDF of x and cdf by 1/(F(1-F)), so that it is more sensitive in the tails than the Kolmogorov-Smirnov test.
+	// The p-value is that of the distribution of A² for n observations, after Marsaglia and Marsaglia (2004),
+	// accurate to about 1e-6.
+	//
+	// Returns:
+	// a2 - the Anderson-Darling statistic
+	// pVal - the p-value for the test.
+
+	s := sorted(x)
+	n := len(s)
+	nf := float64(n)
+	u := make([]float64, n)
+	for i, v := range s {
+		u[i] = cdf(v)
+	}
+	a2 = -nf
+	for i := range u {
+		a2 -= float64(2*i+1) / nf * (log(u[i]) + log1p(-u[n-1-i]))
+	}
+	pVal = 1 - andersonDarlingCDF(n, a2)
+	return a2, min(1, max(0, pVal))
+}
+
+// andersonDarlingCDF returns P(A² <= z) for n observations.
+func andersonDarlingCDF(n int, z float64) float64 {
+	if z <= 0 {
+		return 0
+	}
+	if isInf(z, 1) {
+		return 1
+	}
+	p := andersonDarlingInf(z)
+	return p + andersonDarlingErr(float64(n), p)
+}
+
+// andersonDarlingInf returns P(A² <= z) of the limiting distribution, accurate to 2e-6.
+func andersonDarlingInf(z float64) float64 {
+	if z < 2 {
+		return exp(-1.2337141/z) / sqrt(z) * (2.00012 + (0.247105-(0.0649821-(0.0347962-(0.011672-0.00168691*z)*z)*z)*z)*z)
+	}
+	return exp(-exp(1.0776 - (2.30695-(0.43424-(0.082433-(0.008056-0.0003146*z)*z)*z)*z)*z))
+}
+
+// andersonDarlingErr returns the correction for n observations of the limiting distribution function p.
+func andersonDarlingErr(n, p float64) float64 {
+	if p > 0.8 {
+		return (-130.2137 + (745.2337-(1705.091-(1950.646-(1116.360-255.7844*p)*p)*p)*p)*p) / n
+	}
+	c := 0.01265 + 0.1757/n
+	if p < c {
+		t := p / c
+		t = sqrt(t) * (1 - t) * (49*t - 102)
+		return t * (0.0037/(n*n) + 0.00078/n + 0.00006) / n
+	}
+	t := (p - c) / (0.8 - c)
+	t = -0.00022633 + (6.54034-(14.6538-(14.458-(8.259-1.91864*t)*t)*t)*t)*t
+	return t * (0.04213 + 0.01365/n) / n
+}
@@ -0,0 +1,93 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Pearson's chi-square goodness-of-fit tests of a binned sample against a distribution.
+// Ref.: Pearson (1900). On the criterion that a given system of deviations from the probable in the case of a correlated system of variables is such that it can be reasonably supposed to have arisen from random sampling. Philosophical Magazine 50, 157-175.
+
+import (
+	"code.google.com/p/probab/dst"
+	"sort"
+)
+
+// ChiSquareGOF performs Pearson's chi-square goodness-of-fit test of the sample x against the continuous distribution with the CDF cdf,
+// such as dst.NormalCDF(0, 1), on the bins delimited by breaks.
+func ChiSquareGOF(x []float64, cdf func(float64) float64, breaks []float64, nPar int) (chi2 float64, df int, pVal float64) {
+	// Arguments:
+	// x - vector of observations
+	// cdf - the CDF of the distribution under the null hypothesis
+	// breaks - the increasing inner limits b1 < ... < bk of the k+1 bins (-∞, b1], (b1, b2], ..., (bk, ∞)
+	// nPar - the number of the parameters of cdf estimated from x
+	//
+	// Details:
+	// The statistic Σ (O - E)²/E sums over the bins the squared differences of the observed counts O and of the expected ones,
+	// E = n (F(b) - F(a)) for the bin (a, b]. Its distribution is approximately Chi-squared, with k - nPar degrees of freedom,
+	// if the expected counts are not too small (about 5 or more).
+	//
+	// Returns:
+	// chi2 - the chi-square statistic
+	// df - its degrees of freedom
+	// pVal - the p-value for the test.
+
+	obs := make([]float64, len(breaks)+1)
+	for _, v := range x {
+		obs[sort.SearchFloat64s(breaks, v)]++
+	}
+	prob := make([]float64, len(breaks)+1)
+	lo := fZero
+	for i, b := range breaks {
+		f := cdf(b)
+		prob[i] = f - lo
+		lo = f
+	}
+	prob[len(breaks)] = 1 - lo
+	return chiSquareBins(obs, prob, nPar)
+}
+
+// ChiSquareGOFInt performs Pearson's chi-square goodness-of-fit test of the sample k against the discrete distribution with the CDF cdf,
+// such as dst.PoissonCDF(3), on the bins delimited by breaks.
+func ChiSquareGOFInt(k []int64, cdf func(int64) float64, breaks []int64, nPar int) (chi2 float64, df int, pVal float64) {
+	// Arguments:
+	// k - vector of observations
+	// cdf - the CDF of the distribution under the null hypothesis
+	// breaks - the increasing inner limits b1 < ... < bk of the k+1 bins k <= b1, b1 < k <= b2, ..., k > bk
+	// nPar - the number of the parameters of cdf estimated from k
+	//
+	// Details:
+	// As ChiSquareGOF; with breaks 0, 1, ..., b each value up to b has its own bin.
+	//
+	// Returns:
+	// chi2 - the chi-square statistic
+	// df - its degrees of freedom
+	// pVal - the p-value for the test.
+
+	obs := make([]float64, len(breaks)+1)
+	for _, v := range k {
+		obs[sort.Search(len(breaks), func(i int) bool { return breaks[i] >= v })]++
+	}
+	prob := make([]float64, len(breaks)+1)
+	lo := fZero
+	for i, b := range breaks {
+		f := cdf(b)
+		prob[i] = f - lo
+		lo = f
+	}
+	prob[len(breaks)] = 1 - lo
+	return chiSquareBins(obs, prob, nPar)
+}
+
+// chiSquareBins returns the chi-square statistic of the observed counts obs against the probabilities prob of the bins,
+// its degrees of freedom and its p-value.
+func chiSquareBins(obs, prob []float64, nPar int) (chi2 float64, df int, pVal float64) {
+	n := sum(obs)
+	for i, o := range obs {
+		e := n * prob[i]
+		chi2 += (o - e) * (o - e) / e
+	}
+	df = len(obs) - 1 - nPar
+	if df < 1 {
+		return chi2, df, nan
+	}
+	pVal = dst.ChiSquareCDFTail(int64(df), false, false)(chi2)
+	return chi2, df, pVal
+}
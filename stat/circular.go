@@ -0,0 +1,36 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Circular statistics for a data vector of angles, in radians.
+
+// MeanResultant returns the mean resultant length r ∈ [0, 1] and the mean direction θ ∈ (-π; π] of the angles x.
+func MeanResultant(x []float64) (r, θ float64) {
+	var c, s float64
+	for _, val := range x {
+		c += cos(val)
+		s += sin(val)
+	}
+	n := float64(len(x))
+	c /= n
+	s /= n
+	return sqrt(c*c + s*s), atan2(s, c)
+}
+
+// CircMean returns the circular mean (mean direction) of the angles x.
+func CircMean(x []float64) float64 {
+	_, θ := MeanResultant(x)
+	return θ
+}
+
+// CircVar returns the circular variance 1 - r of the angles x, where r is the mean resultant length.
+func CircVar(x []float64) float64 {
+	r, _ := MeanResultant(x)
+	return 1 - r
+}
+
+// CircStd returns the circular standard deviation sqrt(-2 log r) of the angles x, where r is the mean resultant length.
+func CircStd(x []float64) float64 {
+	r, _ := MeanResultant(x)
+	return sqrt(-2 * log(r))
+}
@@ -0,0 +1,78 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Cramér-von Mises test of a sample against a continuous distribution.
+// Ref.: Anderson & Darling (1952). Asymptotic theory of certain "goodness of fit" criteria based on stochastic processes. Annals of Mathematical Statistics 23, 193-212.
+// Ref.: Stephens (1970). Use of the Kolmogorov-Smirnov, Cramér-von Mises and related statistics without extensive tables. JRSS B 32, 115-122.
+
+// CramerVonMises performs the Cramér-von Mises test of the sample x against the continuous distribution with the CDF cdf,
+// such as dst.NormalCDF(0, 1).
+func CramerVonMises(x []float64, cdf func(float64) float64) (w2, pVal float64) {
+	// Arguments:
+	// x - vector of observations
+	// cdf - the CDF of the distribution under the null hypothesis, with no parameters estimated from x
+	//
+	// Details:
+	// The statistic W² = 1/(12n) + Σ (F(x(i)) - (2i-1)/(2n))² is n times the integral of the squared distance between
+	// the empirical CDF of x and cdf, over cdf. The p-value is that of the limiting distribution (Anderson and Darling 1952)
+	// at the statistic modified for n observations by Stephens (1970), W*² = (W² - 0.4/n + 0.6/n²)(1 + 1/n),
+	// which is accurate in the upper tail, for p-values of 0.25 or less.
+	//
+	// Returns:
+	// w2 - the Cramér-von Mises statistic
+	// pVal - the p-value for the test.
+
+	s := sorted(x)
+	nf := float64(len(s))
+	w2 = 1 / (12 * nf)
+	for i, v := range s {
+		e := cdf(v) - float64(2*i+1)/(2*nf)
+		w2 += e * e
+	}
+	ws := (w2 - 0.4/nf + 0.6/(nf*nf)) * (1 + 1/nf)
+	pVal = 1 - cramerVonMisesCDF(ws)
+	return w2, min(1, max(0, pVal))
+}
+
+// cramerVonMisesCDF returns P(W² <= x) of the limiting distribution,
+// 1/(π sqrt(x)) Σ Γ(j+1/2)/(Γ(1/2) j!) sqrt(4j+1) exp(-u) K1/4(u), with u = (4j+1)²/(16x).
+func cramerVonMisesCDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if isInf(x, 1) {
+		return 1
+	}
+	s := fZero
+	c := 1.0 // Γ(j+1/2)/(Γ(1/2) j!)
+	for j := 0; j < 1000; j++ {
+		jf := float64(j)
+		u := (4*jf + 1) * (4*jf + 1) / (16 * x)
+		term := c * sqrt(4*jf+1) * expBesselK14(u)
+		s += term
+		if term < eps64*s {
+			break
+		}
+		c *= (jf + 0.5) / (jf + 1)
+	}
+	return min(1, s/(π*sqrt(x)))
+}
+
+// expBesselK14 returns exp(-u) K1/4(u), the modified Bessel function of the second kind of order 1/4 scaled by exp(-u), for u > 0,
+// from K1/4(u) = ∫ exp(-u cosh t) cosh(t/4) dt on (0, ∞), by the trapezoidal rule, which converges geometrically for this integrand.
+func expBesselK14(u float64) float64 {
+	const h = 0.02
+	s := 0.5 * exp(-2*u)
+	for k := 1; ; k++ {
+		t := float64(k) * h
+		// exp(-u) exp(-u cosh t) = exp(-2u - u (cosh t - 1)), with cosh t - 1 = 2 sinh²(t/2)
+		sh := (exp(t/2) - exp(-t/2)) / 2
+		term := exp(-2*u-2*u*sh*sh) * (exp(t/4) + exp(-t/4)) / 2
+		s += term
+		if term <= eps64*s {
+			break
+		}
+	}
+	return h * s
+}
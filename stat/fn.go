@@ -36,6 +36,9 @@ var exp func(float64) float64 = math.Exp
 var sqrt func(float64) float64 = math.Sqrt
 var pow func(float64, float64) float64 = math.Pow
 var atan func(float64) float64 = math.Atan
+var atan2 func(float64, float64) float64 = math.Atan2
+var sin func(float64) float64 = math.Sin
+var cos func(float64) float64 = math.Cos
 var tan func(float64) float64 = math.Tan
 var trunc func(float64) float64 = math.Trunc
 var erf func(float64) float64 = math.Erf
@@ -94,3 +97,22 @@ func diffMean(x []float64) []float64 {
 	}
 	return d
 }
+
+func min(x, y float64) float64 {
+	if x > y {
+		return y
+	}
+	return x
+}
+
+func max(x, y float64) float64 {
+	if x < y {
+		return y
+	}
+	return x
+}
+
+// lnChoose returns the natural logarithm of the binomial coefficient n over k.
+func lnChoose(n, k float64) float64 {
+	return lnΓ(n+1) - lnΓ(k+1) - lnΓ(n-k+1)
+}
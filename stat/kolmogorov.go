@@ -0,0 +1,314 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Kolmogorov-Smirnov tests, of a sample against a continuous distribution, and of two samples.
+// Ref.: Marsaglia, Tsang & Wang (2003). Evaluating Kolmogorov's distribution. Journal of Statistical Software 8(18).
+// Ref.: Birnbaum & Tingey (1951). One-sided confidence contours for probability distribution functions. Annals of Mathematical Statistics 22, 592-596.
+// Ref.: R:stats:ks.test
+
+import (
+	"sort"
+)
+
+// KolmogorovSmirnov performs the one-sample Kolmogorov-Smirnov test of the sample x against the continuous distribution with the CDF cdf,
+// such as dst.NormalCDF(0, 1).
+func KolmogorovSmirnov(x []float64, cdf func(float64) float64, alternative int) (d, pVal float64) {
+	// Arguments:
+	// x - vector of observations
+	// cdf - the CDF of the distribution under the null hypothesis, with no parameters estimated from x
+	// alternative - 0 = "twoSided", 1 = "less", 2 = "greater"
+	//
+	// Details:
+	// The statistic is the largest distance between the empirical CDF of x and cdf: D = max |Fn - F| if twoSided,
+	// D+ = max (Fn - F) if "greater" (the CDF of x lies above cdf), and D- = max (F - Fn) if "less".
+	// The p-values are exact for samples of less than 100 observations, by Marsaglia, Tsang and Wang (2003) if twoSided,
+	// and by Birnbaum and Tingey (1951) if one-sided; they are those of the limiting distribution otherwise.
+	//
+	// Returns:
+	// d - the Kolmogorov-Smirnov statistic
+	// pVal - the p-value for the test.
+
+	const (
+		twoSided = iota
+		less
+		greater
+	)
+
+	s := sorted(x)
+	n := len(s)
+	nf := float64(n)
+	var dPlus, dMinus float64
+	for i, v := range s {
+		f := cdf(v)
+		dPlus = max(dPlus, float64(i+1)/nf-f)
+		dMinus = max(dMinus, f-float64(i)/nf)
+	}
+
+	switch alternative {
+	case twoSided:
+		d = max(dPlus, dMinus)
+		if n < 100 {
+			pVal = 1 - kolmogorovExact(n, d)
+		} else {
+			pVal = kolmogorovTail(sqrt(nf) * d)
+		}
+	case less, greater:
+		d = dPlus
+		if alternative == less {
+			d = dMinus
+		}
+		if n < 100 {
+			pVal = smirnovExact(n, d)
+		} else {
+			pVal = exp(-2 * nf * d * d)
+		}
+	}
+	return d, min(1, max(0, pVal))
+}
+
+// KolmogorovSmirnov2 performs the two-sample Kolmogorov-Smirnov test of the samples x and y coming from the same continuous distribution.
+func KolmogorovSmirnov2(x, y []float64, alternative int) (d, pVal float64) {
+	// Arguments:
+	// x, y - vectors of observations
+	// alternative - 0 = "twoSided", 1 = "less", 2 = "greater"
+	//
+	// Details:
+	// The statistic is the largest distance between the empirical CDFs Fx, Fy of x and y: D = max |Fx - Fy| if twoSided,
+	// D+ = max (Fx - Fy) if "greater" (the CDF of x lies above that of y), and D- = max (Fy - Fx) if "less".
+	// The two-sided p-value is exact if the product of the sizes of the samples is less than 10000, and there are no ties;
+	// the other p-values are those of the limiting distributions.
+	//
+	// Returns:
+	// d - the Kolmogorov-Smirnov statistic
+	// pVal - the p-value for the test.
+
+	const (
+		twoSided = iota
+		less
+		greater
+	)
+
+	sx, sy := sorted(x), sorted(y)
+	m, n := len(sx), len(sy)
+	mf, nf := float64(m), float64(n)
+	var dPlus, dMinus float64
+	ties := false
+	for i, j := 0, 0; i < m || j < n; {
+		// step past all the observations equal to the smallest remaining one
+		var v float64
+		switch {
+		case j == n || i < m && sx[i] < sy[j]:
+			v = sx[i]
+		default:
+			v = sy[j]
+		}
+		ti, tj := i, j
+		for i < m && sx[i] == v {
+			i++
+		}
+		for j < n && sy[j] == v {
+			j++
+		}
+		ties = ties || i-ti+j-tj > 1
+		f := float64(i)/mf - float64(j)/nf
+		dPlus = max(dPlus, f)
+		dMinus = max(dMinus, -f)
+	}
+
+	ne := mf * nf / (mf + nf)
+	switch alternative {
+	case twoSided:
+		d = max(dPlus, dMinus)
+		if m*n < 10000 && !ties {
+			pVal = 1 - smirnov2Exact(m, n, d)
+		} else {
+			pVal = kolmogorovTail(sqrt(ne) * d)
+		}
+	case less, greater:
+		d = dPlus
+		if alternative == less {
+			d = dMinus
+		}
+		pVal = exp(-2 * ne * d * d)
+	}
+	return d, min(1, max(0, pVal))
+}
+
+// sorted returns a sorted copy of x.
+func sorted(x []float64) []float64 {
+	s := append([]float64(nil), x...)
+	sort.Float64s(s)
+	return s
+}
+
+// kolmogorovTail returns P(K > t) of the limiting Kolmogorov distribution, of sqrt(n) D.
+func kolmogorovTail(t float64) float64 {
+	if t <= 0 {
+		return 1
+	}
+	if t < 1 {
+		// P(K <= t) = sqrt(2π)/t Σ exp(-(2k-1)²π²/(8t²)), which converges fast for small t
+		z := -π * π / (8 * t * t)
+		w := log(t)
+		s := fZero
+		for k := 1; k < 1000; k += 2 {
+			term := exp(float64(k*k)*z - w)
+			s += term
+			if term < eps64*s {
+				break
+			}
+		}
+		return 1 - sqrt(2*π)*s
+	}
+	// P(K > t) = 2 Σ (-1)^(k-1) exp(-2k²t²)
+	s := fZero
+	sign := 1.0
+	for k := 1; k < 1000; k++ {
+		term := exp(-2 * float64(k*k) * t * t)
+		s += sign * term
+		sign = -sign
+		if term < eps64*s {
+			break
+		}
+	}
+	return 2 * s
+}
+
+// kolmogorovExact returns P(D < d) of the two-sided one-sample statistic for n observations,
+// as the power of a matrix, after Marsaglia, Tsang and Wang (2003).
+func kolmogorovExact(n int, d float64) float64 {
+	nf := float64(n)
+	if d <= 0 {
+		return 0
+	}
+	if d >= 1 {
+		return 1
+	}
+	k := int(nf*d) + 1
+	m := 2*k - 1
+	h := float64(k) - nf*d
+	hh := make([]float64, m*m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i-j+1 >= 0 {
+				hh[i*m+j] = 1
+			}
+		}
+	}
+	for i := 0; i < m; i++ {
+		hh[i*m] -= pow(h, float64(i+1))
+		hh[(m-1)*m+i] -= pow(h, float64(m-i))
+	}
+	if 2*h-1 > 0 {
+		hh[(m-1)*m] += pow(2*h-1, float64(m))
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i-j+1 > 0 {
+				for g := 1; g <= i-j+1; g++ {
+					hh[i*m+j] /= float64(g)
+				}
+			}
+		}
+	}
+	q, e := kolmogorovPower(hh, m, n)
+	s := q[(k-1)*m+k-1]
+	// n!/n^n, keeping the scale in the decimal exponent e
+	for i := 1; i <= n; i++ {
+		s = s * float64(i) / nf
+		if s < 1e-140 {
+			s *= 1e140
+			e -= 140
+		}
+	}
+	return s * pow(10, float64(e))
+}
+
+// kolmogorovPower returns the n-th power of the m×m matrix a, scaled by 10^-e to avoid overflow.
+func kolmogorovPower(a []float64, m, n int) (v []float64, e int) {
+	if n == 1 {
+		return append([]float64(nil), a...), 0
+	}
+	v, e = kolmogorovPower(a, m, n/2)
+	b := kolmogorovMul(v, v, m)
+	e *= 2
+	if n%2 == 0 {
+		v = b
+	} else {
+		v = kolmogorovMul(a, b, m)
+	}
+	if v[(m/2)*m+m/2] > 1e140 {
+		for i := range v {
+			v[i] *= 1e-140
+		}
+		e += 140
+	}
+	return v, e
+}
+
+// kolmogorovMul returns the product of the m×m matrices a and b.
+func kolmogorovMul(a, b []float64, m int) []float64 {
+	c := make([]float64, m*m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			s := fZero
+			for k := 0; k < m; k++ {
+				s += a[i*m+k] * b[k*m+j]
+			}
+			c[i*m+j] = s
+		}
+	}
+	return c
+}
+
+// smirnovExact returns P(D+ >= d) of the one-sided one-sample statistic for n observations, after Birnbaum and Tingey (1951):
+// d Σ C(n, j) (1 - d - j/n)^(n-j) (d + j/n)^(j-1), j = 0, ..., floor(n(1-d)).
+func smirnovExact(n int, d float64) float64 {
+	if d <= 0 {
+		return 1
+	}
+	if d >= 1 {
+		return 0
+	}
+	nf := float64(n)
+	s := fZero
+	for j := 0; j <= int(floor(nf*(1-d))); j++ {
+		jf := float64(j)
+		s += exp(lnChoose(nf, jf) + (nf-jf)*log(1-d-jf/nf) + (jf-1)*log(d+jf/nf))
+	}
+	return d * s
+}
+
+// smirnov2Exact returns P(D < d) of the two-sided two-sample statistic for samples of sizes m and n without ties,
+// by counting the paths of the two empirical CDFs, as R's psmirnov2x.
+func smirnov2Exact(m, n int, d float64) float64 {
+	if m > n {
+		m, n = n, m
+	}
+	md, nd := float64(m), float64(n)
+	// the statistic is a multiple of 1/(mn)
+	q := (0.5 + floor(d*md*nd-1e-7)) / (md * nd)
+	u := make([]float64, n+1)
+	for j := range u {
+		if float64(j)/nd <= q {
+			u[j] = 1
+		}
+	}
+	for i := 1; i <= m; i++ {
+		w := float64(i) / float64(i+n)
+		if float64(i)/md > q {
+			u[0] = 0
+		} else {
+			u[0] = w * u[0]
+		}
+		for j := 1; j <= n; j++ {
+			if abs(float64(i)/md-float64(j)/nd) > q {
+				u[j] = 0
+			} else {
+				u[j] = w*u[j] + u[j-1]
+			}
+		}
+	}
+	return u[n]
+}